@@ -6,6 +6,7 @@ import (
 	"bufio"
 	"bytes"
 	"compress/gzip"
+	"context"
 	"crypto/md5"
 	"encoding/hex"
 	"errors"
@@ -13,12 +14,12 @@ import (
 	"io"
 	"io/ioutil"
 	"mime"
-	"net/http"
 	"os"
 	"path"
 	"path/filepath"
 	"regexp"
 	"strings"
+	"sync/atomic"
 	"syscall"
 )
 
@@ -113,17 +114,119 @@ func (kf *LkkFile) WriteFile(fpath string, data []byte, perm ...os.FileMode) err
 	return ioutil.WriteFile(fpath, data, p)
 }
 
+// WriteFileAtomic 以原子方式将内容写入文件.
+// 先写入同目录下的临时文件并同步到磁盘,再重命名覆盖目标文件,最后同步目录,中途崩溃不会留下写了一半的文件.
+// backup为true时,将旧文件保留为"fpath.bak";目标文件已存在时,保留其权限模式和所有者,
+// 否则使用perm作为权限,默认0644.
+func (kf *LkkFile) WriteFileAtomic(fpath string, data []byte, backup bool, perm ...os.FileMode) (err error) {
+	if fpath == "" {
+		return errors.New("No path provided")
+	}
+
+	//目标为链接时,写入其指向的真实文件,而不是替换链接本身
+	if realPath, e := filepath.EvalSymlinks(fpath); e == nil {
+		fpath = realPath
+	}
+
+	dir := filepath.Dir(fpath)
+	if err = os.MkdirAll(dir, os.ModePerm); err != nil {
+		return err
+	}
+
+	var p os.FileMode = 0644
+	if len(perm) > 0 {
+		p = perm[0]
+	}
+
+	uid, gid := -1, -1
+	oldInfo, statErr := os.Stat(fpath)
+	if statErr == nil {
+		if !oldInfo.Mode().IsRegular() {
+			return fmt.Errorf("%s is not a regular file", fpath)
+		}
+		p = oldInfo.Mode()
+		if st, ok := oldInfo.Sys().(*syscall.Stat_t); ok {
+			uid, gid = int(st.Uid), int(st.Gid)
+		}
+	}
+
+	tmp, err := ioutil.TempFile(dir, "."+filepath.Base(fpath)+".tmp")
+	if err != nil {
+		return err
+	}
+	tmpName := tmp.Name()
+	defer func() {
+		if err != nil {
+			_ = tmp.Close()
+			_ = os.Remove(tmpName)
+		}
+	}()
+
+	if _, err = tmp.Write(data); err != nil {
+		return err
+	}
+	if err = tmp.Sync(); err != nil {
+		return err
+	}
+	if err = tmp.Close(); err != nil {
+		return err
+	}
+	if err = os.Chmod(tmpName, p); err != nil {
+		return err
+	}
+	if uid >= 0 {
+		//非root用户可能无权修改所有者,忽略该错误
+		if chErr := os.Chown(tmpName, uid, gid); chErr != nil && !os.IsPermission(chErr) {
+			err = chErr
+			return err
+		}
+	}
+
+	if backup && statErr == nil {
+		bak := fpath + ".bak"
+		_ = os.Remove(bak)
+		//优先使用硬链接保留旧文件的inode,失败时再拷贝
+		if lnkErr := os.Link(fpath, bak); lnkErr != nil {
+			if _, err = kf.CopyFile(fpath, bak, FILE_COVER_ALLOW); err != nil {
+				return err
+			}
+		}
+	}
+
+	if err = os.Rename(tmpName, fpath); err != nil {
+		return err
+	}
+
+	return syncDir(dir)
+}
+
+// syncDir 将目录项的变更同步到磁盘.
+func syncDir(dir string) error {
+	d, err := os.Open(dir)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = d.Close()
+	}()
+
+	return d.Sync()
+}
+
 // AppendFile 插入文件内容.
-func (kf *LkkFile) AppendFile(fpath string, data []byte) error {
+// durable为true时,写入后将文件同步到磁盘;新建文件时还会同步其所在目录.
+func (kf *LkkFile) AppendFile(fpath string, data []byte, durable ...bool) error {
 	if fpath == "" {
 		return errors.New("No path provided")
 	}
 
 	var file *os.File
+	var created bool
 	filePerm, err := kf.GetFileMode(fpath)
 	if err != nil {
 		// create the file
 		file, err = os.Create(fpath)
+		created = true
 	} else {
 		// open for append
 		file, err = os.OpenFile(fpath, os.O_APPEND|os.O_CREATE|os.O_WRONLY, filePerm)
@@ -138,6 +241,11 @@ func (kf *LkkFile) AppendFile(fpath string, data []byte) error {
 	}()
 
 	_, err = file.Write(data)
+	if err == nil && len(durable) > 0 && durable[0] {
+		if err = file.Sync(); err == nil && created {
+			err = syncDir(filepath.Dir(fpath))
+		}
+	}
 
 	return err
 }
@@ -151,27 +259,18 @@ func (kf *LkkFile) GetFileMode(fpath string) (os.FileMode, error) {
 	return finfo.Mode(), nil
 }
 
-// GetMime 获取文件mime类型;fast为true时根据后缀快速获取;为false时读取文件头获取.
+// GetMime 获取文件mime类型;fast为true时根据后缀快速获取,标准库未知的后缀再查已注册的类型;为false时读取文件头的魔数获取.
+// 结果保留charset等参数,如文本文件返回"text/plain; charset=utf-8";只需类型时使用MimeByExt/DetectMime.
 func (kf *LkkFile) GetMime(fpath string, fast bool) string {
-	var res string
 	if fast {
-		suffix := filepath.Ext(fpath)
-		res = mime.TypeByExtension(suffix)
-	} else {
-		srcFile, err := os.Open(fpath)
-		if err != nil {
-			return res
-		}
-
-		buffer := make([]byte, 512)
-		_, err = srcFile.Read(buffer)
-		if err != nil {
+		ext := filepath.Ext(fpath)
+		if res := mime.TypeByExtension(ext); res != "" {
 			return res
 		}
-
-		res = http.DetectContentType(buffer)
+		return kf.MimeByExt(ext)
 	}
 
+	res, _ := detectMime(fpath)
 	return res
 }
 
@@ -184,18 +283,19 @@ func (kf *LkkFile) FileSize(fpath string) int64 {
 	return f.Size()
 }
 
-// DirSize 获取目录大小(bytes字节).
+// DirSize 获取目录大小(bytes字节).使用WalkParallel并发遍历,根路径为链接时返回链接本身的大小;需要详细统计时使用DirReport.
 func (kf *LkkFile) DirSize(fpath string) int64 {
+	//与filepath.Walk一致,根路径为链接时不跟随
+	if info, err := os.Lstat(fpath); err == nil && info.Mode()&os.ModeSymlink != 0 {
+		return info.Size()
+	}
+
 	var size int64
-	//filepath.Walk压测很慢
-	_ = filepath.Walk(fpath, func(_ string, info os.FileInfo, err error) error {
-		if err != nil {
-			return err
+	_ = kf.WalkParallel(context.Background(), fpath, nil, func(_ string, info os.FileInfo, _ int, err error) error {
+		if err == nil && !info.IsDir() {
+			atomic.AddInt64(&size, info.Size())
 		}
-		if !info.IsDir() {
-			size += info.Size()
-		}
-		return err
+		return nil
 	})
 	return size
 }
@@ -635,7 +735,7 @@ func (kf *LkkFile) DelDir(dir string, delRoot bool) error {
 // FileTree 获取目录的文件树列表.
 // ftype为枚举(FILE_TREE_ALL、FILE_TREE_DIR、FILE_TREE_FILE);
 // recursive为是否递归;
-// filters为一个或多个文件过滤器函数,FileFilter类型.
+// filters为一个或多个文件过滤器函数,FileFilter类型;递归时同样作用于各级子目录,未通过的目录连同其内容一起跳过.
 func (kf *LkkFile) FileTree(fpath string, ftype LkkFileTree, recursive bool, filters ...FileFilter) []string {
 	var trees []string
 
@@ -673,7 +773,7 @@ func (kf *LkkFile) FileTree(fpath string, ftype LkkFileTree, recursive bool, fil
 			}
 
 			if recursive {
-				subs := kf.FileTree(file, ftype, recursive)
+				subs := kf.FileTree(file, ftype, recursive, filters...)
 				trees = append(trees, subs...)
 			}
 		} else if ftype != FILE_TREE_DIR {
@@ -797,25 +897,14 @@ func (kf *LkkFile) Glob(pattern string) ([]string, error) {
 	return filepath.Glob(pattern)
 }
 
-// TarGz 打包压缩tar.gz;src为源文件或目录,dstTar为打包的路径名,ignorePatterns为要忽略的文件正则.
+// TarGz 打包压缩tar.gz;src为源文件或目录,dstTar为打包的路径名,ignorePatterns为要忽略的文件正则,同样作用于各级子目录.
 func (kf *LkkFile) TarGz(src string, dstTar string, ignorePatterns ...string) (bool, error) {
-	//过滤器,检查要忽略的文件
-	var filter = func(file string) bool {
-		res := true
-		for _, pattern := range ignorePatterns {
-			re, err := regexp.Compile(pattern)
-			if err != nil {
-				continue
-			}
-			chk := re.MatchString(file)
-			if chk {
-				res = false
-				break
-			}
-		}
-		return res
-	}
+	return kf.TarGzWithFilter(src, dstTar, ignoreFilter(ignorePatterns))
+}
 
+// TarGzWithFilter 打包压缩tar.gz;src为源文件或目录,dstTar为打包的路径名.
+// filters为文件过滤器,须全部通过才会打包,未通过的目录连同其内容一起跳过;如IgnoreSet.Filter().
+func (kf *LkkFile) TarGzWithFilter(src string, dstTar string, filters ...FileFilter) (bool, error) {
 	src = kf.AbsPath(src)
 	dstTar = kf.AbsPath(dstTar)
 
@@ -824,7 +913,7 @@ func (kf *LkkFile) TarGz(src string, dstTar string, ignorePatterns ...string) (b
 		_ = kf.Mkdir(dstDir, os.ModePerm)
 	}
 
-	files := kf.FileTree(src, FILE_TREE_ALL, true, filter)
+	files := kf.FileTree(src, FILE_TREE_ALL, true, filters...)
 	if len(files) == 0 {
 		return false, fmt.Errorf("src no files to tar.gz")
 	}
@@ -858,7 +947,8 @@ func (kf *LkkFile) TarGz(src string, dstTar string, ignorePatterns ...string) (b
 		if err != nil {
 			continue
 		}
-		newName := strings.Replace(file, parentDir, "", -1)
+		newName, _ := filepath.Rel(parentDir, file)
+		newName = filepath.ToSlash(newName)
 
 		// Create tar header
 		hdr := new(tar.Header)
@@ -913,7 +1003,8 @@ func (kf *LkkFile) TarGz(src string, dstTar string, ignorePatterns ...string) (b
 }
 
 // UnTarGz 将tar.gz文件解压缩;srcTar为压缩包,dstDir为解压目录.
-func (kf *LkkFile) UnTarGz(srcTar, dstDir string) (bool, error) {
+// limits为解压限制,可选;含有".."、绝对路径或指向dstDir之外的链接的条目及硬链接条目将被拒绝,返回*ArchiveEntryError.
+func (kf *LkkFile) UnTarGz(srcTar, dstDir string, limits ...ExtractLimits) (bool, error) {
 	fr, err := os.Open(srcTar)
 	if err != nil {
 		return false, err
@@ -930,44 +1021,29 @@ func (kf *LkkFile) UnTarGz(srcTar, dstDir string) (bool, error) {
 		}
 	}
 
-	// Gzip reader
-	gr, err := gzip.NewReader(fr)
-
-	// Tar reader
-	tr := tar.NewReader(gr)
-	for {
-		hdr, err := tr.Next()
-		if err == io.EOF {
-			// End of tar archive
-			break
-		} else if err != nil {
-			return false, err
-		}
-
-		// Create diretory before create file
-		newPath := dstDir + "/" + strings.TrimLeft(hdr.Name, "/\\")
-		parentDir := path.Dir(newPath)
-		if !kf.IsExist(parentDir) {
-			_ = os.MkdirAll(parentDir, os.ModePerm)
-		}
-
-		if hdr.Typeflag != tar.TypeDir {
-			// Write data to file
-			fw, err := os.Create(newPath)
-			if err != nil {
-				return false, fmt.Errorf("CreateErr: %s file:%s\n", err.Error(), newPath)
-			}
+	var limit ExtractLimits
+	if len(limits) > 0 {
+		limit = limits[0]
+	}
 
-			_, err = io.Copy(fw, tr)
-			if err != nil {
-				return false, fmt.Errorf("CopyErr: %s file:%s\n", err.Error(), newPath)
-			}
+	// Gzip reader
+	cnt := &countReader{r: fr}
+	gr, err := gzip.NewReader(cnt)
+	if err != nil {
+		return false, err
+	}
 
-			_ = fw.Close()
-		}
+	guard, err := newExtractGuard(dstDir, limit, func() int64 {
+		return cnt.n
+	})
+	if err != nil {
+		return false, err
 	}
 
-	return true, nil
+	// Tar reader
+	err = extractTar(tar.NewReader(gr), guard, nil)
+
+	return err == nil, err
 }
 
 // SafeFileName 将文件名转换为安全可用的字符串.
@@ -1047,6 +1123,12 @@ func (kf *LkkFile) CountLines(fpath string, buffLength int) (int, error) {
 
 // Zip 将文件目录进行zip打包.fpaths为文件或目录的路径.
 func (kf *LkkFile) Zip(dst string, fpaths ...string) (bool, error) {
+	return kf.ZipWithFilter(dst, fpaths)
+}
+
+// ZipWithFilter 将文件目录进行zip打包.fpaths为文件或目录的路径.
+// filters为文件过滤器,须全部通过才会打包,未通过的目录连同其内容一起跳过;如IgnoreSet.Filter().
+func (kf *LkkFile) ZipWithFilter(dst string, fpaths []string, filters ...FileFilter) (bool, error) {
 	dst = kf.AbsPath(dst)
 	dstDir := kf.Dirname(dst)
 	if !kf.IsExist(dstDir) {
@@ -1070,12 +1152,20 @@ func (kf *LkkFile) Zip(dst string, fpaths ...string) (bool, error) {
 	for _, fpath = range fpaths {
 		fpath = KStr.Trim(fpath)
 		if kf.IsDir(fpath) {
-			files = kf.FileTree(fpath, FILE_TREE_FILE, true)
+			files = kf.FileTree(fpath, FILE_TREE_FILE, true, filters...)
 			if len(files) != 0 {
 				allfiles = append(allfiles, files...)
 			}
 		} else if fpath != "" {
-			allfiles = append(allfiles, fpath)
+			chk := true
+			for _, filter := range filters {
+				if chk = filter(fpath); !chk {
+					break
+				}
+			}
+			if chk {
+				allfiles = append(allfiles, fpath)
+			}
 		}
 	}
 
@@ -1102,7 +1192,7 @@ func (kf *LkkFile) Zip(dst string, fpaths ...string) (bool, error) {
 			_ = fileToZip.Close()
 		}()
 
-		wr, _ := zipw.Create(fpath)
+		wr, _ := zipw.Create(zipEntryName(fpath))
 		keys[fpath] = true
 		if _, err := io.Copy(wr, fileToZip); err != nil {
 			return false, fmt.Errorf("Failed to write %s to zip: %s", fpath, err)
@@ -1112,14 +1202,24 @@ func (kf *LkkFile) Zip(dst string, fpaths ...string) (bool, error) {
 	return true, nil
 }
 
+// zipEntryName 获取文件在zip中的条目名称,去掉开头的"/"和"../",以免解压时被拒绝.
+func zipEntryName(fpath string) string {
+	name := path.Clean(filepath.ToSlash(fpath))
+	for strings.HasPrefix(name, "../") || strings.HasPrefix(name, "/") {
+		name = strings.TrimPrefix(strings.TrimPrefix(name, "../"), "/")
+	}
+	return name
+}
+
 // UnZip 解压zip文件.srcZip为zip文件路径,dstDir为解压目录.
-func (kf *LkkFile) UnZip(srcZip, dstDir string) (bool, error) {
-	reader, err := zip.OpenReader(srcZip)
+// limits为解压限制,可选;含有".."、绝对路径或指向dstDir之外的链接的条目将被拒绝,返回*ArchiveEntryError.
+func (kf *LkkFile) UnZip(srcZip, dstDir string, limits ...ExtractLimits) (bool, error) {
+	fr, reader, compressed, err := openZipFile(srcZip)
 	if err != nil {
 		return false, err
 	}
 	defer func() {
-		_ = reader.Close()
+		_ = fr.Close()
 	}()
 
 	dstDir = strings.TrimRight(kf.AbsPath(dstDir), "/\\")
@@ -1130,35 +1230,15 @@ func (kf *LkkFile) UnZip(srcZip, dstDir string) (bool, error) {
 		}
 	}
 
-	// 迭代压缩文件中的文件
-	for _, f := range reader.File {
-		// Create diretory before create file
-		newPath := dstDir + "/" + strings.TrimLeft(f.Name, "/\\")
-		parentDir := path.Dir(newPath)
-		if !kf.IsExist(parentDir) {
-			err := os.MkdirAll(parentDir, os.ModePerm)
-			if err != nil {
-				return false, err
-			}
-		}
-
-		if !f.FileInfo().IsDir() {
-			if fcreate, err := os.Create(newPath); err == nil {
-				if rc, err := f.Open(); err == nil {
-					_, _ = io.Copy(fcreate, rc)
-					_ = rc.Close() //不要用defer来关闭，如果文件太多的话，会报too many open files 的错误
-					_ = fcreate.Close()
-				} else {
-					_ = fcreate.Close()
-					return false, err
-				}
-			} else {
-				return false, err
-			}
-		}
+	var limit ExtractLimits
+	if len(limits) > 0 {
+		limit = limits[0]
 	}
 
-	return true, nil
+	// 迭代压缩文件中的文件
+	err = extractZipFiles(reader.File, dstDir, nil, limit, compressed)
+
+	return err == nil, err
 }
 
 // IsZip 是否zip文件.
@@ -1179,5 +1259,5 @@ func (kf *LkkFile) IsZip(fpath string) bool {
 	buf := make([]byte, 4)
 	n, err := f.Read(buf)
 
-	return err == nil && n == 4 && bytes.Equal(buf, []byte("PK\x03\x04"))
+	return err == nil && n == 4 && archiveFormatByMagic(buf) == "zip"
 }
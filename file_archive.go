@@ -0,0 +1,1007 @@
+package kgo
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bufio"
+	"bytes"
+	"compress/bzip2"
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+)
+
+// ArchiveCompressor tar归档的压缩器
+type ArchiveCompressor struct {
+	Name      string                                    // 名称,同时作为格式后缀,如"gz"对应格式"tar.gz"
+	Exts      []string                                  // 额外的扩展名别名,如".tgz";".tar.{Name}"无须列出
+	Magic     []byte                                    // 压缩数据的文件头魔数,用于检测格式
+	NewReader func(r io.Reader) (io.ReadCloser, error)  // 解压器,为nil时不支持解压
+	NewWriter func(w io.Writer) (io.WriteCloser, error) // 压缩器,为nil时不支持压缩
+}
+
+// ExtractLimits 解压限制,用于防范压缩炸弹;各项为0时不限制
+type ExtractLimits struct {
+	MaxSize    int64   // 解压后的总字节数上限
+	MaxEntries int     // 条目数上限
+	MaxRatio   float64 // 压缩比(解压后大小/压缩大小)上限,解压总量超过1MB后才检查
+}
+
+// ArchiveEntryError 归档条目被拒绝时的错误,Entry为被拒绝的条目名称
+type ArchiveEntryError struct {
+	Entry string
+	Err   error
+}
+
+var (
+	// ErrEntryPathEscape 条目路径含有".."
+	ErrEntryPathEscape = errors.New("entry path escapes destination")
+	// ErrEntryAbsolutePath 条目为绝对路径
+	ErrEntryAbsolutePath = errors.New("entry path is absolute")
+	// ErrEntryLinkEscape 链接指向解压目录之外
+	ErrEntryLinkEscape = errors.New("entry link escapes destination")
+	// ErrArchiveTooLarge 解压总大小超限
+	ErrArchiveTooLarge = errors.New("archive uncompressed size exceeds limit")
+	// ErrArchiveTooManyEntries 条目数超限
+	ErrArchiveTooManyEntries = errors.New("archive entry count exceeds limit")
+	// ErrArchiveRatio 压缩比超限
+	ErrArchiveRatio = errors.New("archive compression ratio exceeds limit")
+	// ErrEntryHardLink 不支持的硬链接条目
+	ErrEntryHardLink = errors.New("entry hard link is not supported")
+)
+
+// Error 实现error接口.
+func (ae *ArchiveEntryError) Error() string {
+	return fmt.Sprintf("refuse entry %q: %s", ae.Entry, ae.Err.Error())
+}
+
+// Unwrap 获取原始错误,以支持errors.Is.
+func (ae *ArchiveEntryError) Unwrap() error {
+	return ae.Err
+}
+
+// extractGuard 解压时的路径包含及限制检查
+type extractGuard struct {
+	dstDir     string
+	realDst    string
+	limits     ExtractLimits
+	entries    int
+	total      int64
+	compressed func() int64 // 已读取的压缩数据字节数
+}
+
+// countReader 统计已读取字节数的Reader
+type countReader struct {
+	r io.Reader
+	n int64
+}
+
+// Read 实现io.Reader接口.
+func (cr *countReader) Read(p []byte) (int, error) {
+	n, err := cr.r.Read(p)
+	cr.n += int64(n)
+	return n, err
+}
+
+// countReaderAt 统计已读取字节数的ReaderAt
+type countReaderAt struct {
+	r io.ReaderAt
+	n int64
+}
+
+// ReadAt 实现io.ReaderAt接口.
+func (cr *countReaderAt) ReadAt(p []byte, off int64) (int, error) {
+	n, err := cr.r.ReadAt(p, off)
+	cr.n += int64(n)
+	return n, err
+}
+
+// newExtractGuard 创建解压检查器,dstDir须为已存在的绝对路径.
+func newExtractGuard(dstDir string, limits ExtractLimits, compressed func() int64) (*extractGuard, error) {
+	realDst, err := filepath.EvalSymlinks(dstDir)
+	if err != nil {
+		return nil, err
+	}
+	return &extractGuard{dstDir: filepath.Clean(dstDir), realDst: realDst, limits: limits, compressed: compressed}, nil
+}
+
+// isWithin 路径fpath是否在目录dir之内(含dir本身).
+func isWithin(dir, fpath string) bool {
+	rel, err := filepath.Rel(dir, fpath)
+	return err == nil && rel != ".." && !strings.HasPrefix(rel, ".."+string(os.PathSeparator))
+}
+
+// entryPath 检查条目名称并返回其解压路径.
+// 指向解压目录本身的条目(如"./")返回空路径,调用方应跳过该条目.
+func (eg *extractGuard) entryPath(name string) (string, error) {
+	eg.entries++
+	if eg.limits.MaxEntries > 0 && eg.entries > eg.limits.MaxEntries {
+		return "", &ArchiveEntryError{Entry: name, Err: ErrArchiveTooManyEntries}
+	}
+
+	slashed := strings.Replace(name, "\\", "/", -1)
+	if strings.HasPrefix(slashed, "/") || (len(slashed) > 1 && slashed[1] == ':') {
+		return "", &ArchiveEntryError{Entry: name, Err: ErrEntryAbsolutePath}
+	}
+	for _, part := range strings.Split(slashed, "/") {
+		if part == ".." {
+			return "", &ArchiveEntryError{Entry: name, Err: ErrEntryPathEscape}
+		}
+	}
+
+	newPath := filepath.Join(eg.dstDir, filepath.FromSlash(slashed))
+	if !isWithin(eg.dstDir, newPath) {
+		return "", &ArchiveEntryError{Entry: name, Err: ErrEntryPathEscape}
+	} else if newPath == eg.dstDir {
+		return "", nil
+	}
+
+	//已存在的上级目录可能是链接,须在创建子目录前检查其真实路径
+	parent := filepath.Dir(newPath)
+	existing := parent
+	for existing != eg.dstDir && !KFile.IsExist(existing) {
+		existing = filepath.Dir(existing)
+	}
+	realParent, err := filepath.EvalSymlinks(existing)
+	if err != nil {
+		return "", err
+	} else if !isWithin(eg.realDst, realParent) {
+		return "", &ArchiveEntryError{Entry: name, Err: ErrEntryLinkEscape}
+	}
+	if err = os.MkdirAll(parent, os.ModePerm); err != nil {
+		return "", err
+	}
+
+	//目标本身是链接时先移除,避免写入链接指向的文件
+	if info, err := os.Lstat(newPath); err == nil && info.Mode()&os.ModeSymlink != 0 {
+		_ = os.Remove(newPath)
+	}
+
+	return newPath, nil
+}
+
+// checkLink 检查链接目标是否在解压目录之内.
+// 目标按其真实路径检查,逐级解析已存在的链接,以免通过归档中先前创建的链接(如"x -> .")逃逸.
+func (eg *extractGuard) checkLink(name, newPath, target string) error {
+	if filepath.IsAbs(target) || strings.HasPrefix(target, "/") {
+		return &ArchiveEntryError{Entry: name, Err: ErrEntryLinkEscape}
+	}
+
+	resolved, err := filepath.EvalSymlinks(filepath.Dir(newPath))
+	if err != nil {
+		return err
+	}
+	//missing为已经过不存在的路径,其后可能被创建为链接,因此不允许再有".."
+	missing := false
+	for _, part := range strings.Split(filepath.ToSlash(target), "/") {
+		switch part {
+		case "", ".":
+			continue
+		case "..":
+			if missing {
+				return &ArchiveEntryError{Entry: name, Err: ErrEntryLinkEscape}
+			}
+			resolved = filepath.Dir(resolved)
+		default:
+			resolved = filepath.Join(resolved, part)
+			if real, err := filepath.EvalSymlinks(resolved); err == nil {
+				resolved = real
+			} else {
+				missing = true
+			}
+		}
+		if !isWithin(eg.realDst, resolved) {
+			return &ArchiveEntryError{Entry: name, Err: ErrEntryLinkEscape}
+		}
+	}
+
+	return nil
+}
+
+// writeFile 将条目内容写入文件,并检查大小和压缩比限制.
+func (eg *extractGuard) writeFile(name, fpath string, r io.Reader, mode os.FileMode) error {
+	fw, err := os.OpenFile(fpath, os.O_RDWR|os.O_CREATE|os.O_TRUNC, mode.Perm())
+	if err != nil {
+		return fmt.Errorf("CreateErr: %s file:%s", err.Error(), fpath)
+	}
+	defer func() {
+		_ = fw.Close()
+	}()
+
+	buf := make([]byte, 32768)
+	for {
+		n, rerr := r.Read(buf)
+		if n > 0 {
+			eg.total += int64(n)
+			if eg.limits.MaxSize > 0 && eg.total > eg.limits.MaxSize {
+				return &ArchiveEntryError{Entry: name, Err: ErrArchiveTooLarge}
+			}
+			if eg.limits.MaxRatio > 0 && eg.total > 1048576 && eg.compressed != nil {
+				if c := eg.compressed(); c > 0 && float64(eg.total)/float64(c) > eg.limits.MaxRatio {
+					return &ArchiveEntryError{Entry: name, Err: ErrArchiveRatio}
+				}
+			}
+			if _, err = fw.Write(buf[:n]); err != nil {
+				return fmt.Errorf("CopyErr: %s file:%s", err.Error(), fpath)
+			}
+		}
+
+		if rerr == io.EOF {
+			break
+		} else if rerr != nil {
+			return fmt.Errorf("CopyErr: %s file:%s", rerr.Error(), fpath)
+		}
+	}
+
+	return fw.Close()
+}
+
+// ArchiveEntry 内存中的归档条目,用于不经过磁盘直接生成归档
+type ArchiveEntry struct {
+	Name    string      // 归档内的名称,以"/"分隔
+	Mode    os.FileMode // 权限模式,默认0644;含os.ModeDir时为目录
+	ModTime time.Time   // 修改时间,默认当前时间
+	Size    int64       // 内容大小;tar须事先知道大小,为0时将读取全部Body到内存计算
+	Body    io.Reader   // 内容,目录时忽略
+}
+
+// perm 获取条目的权限.
+func (ae *ArchiveEntry) perm() os.FileMode {
+	if perm := ae.Mode.Perm(); perm != 0 {
+		return perm
+	} else if ae.Mode.IsDir() {
+		return 0755
+	}
+	return 0644
+}
+
+// modTime 获取条目的修改时间.
+func (ae *ArchiveEntry) modTime() time.Time {
+	if ae.ModTime.IsZero() {
+		return time.Now()
+	}
+	return ae.ModTime
+}
+
+// sizedBody 获取条目内容及其大小.
+func (ae *ArchiveEntry) sizedBody() (io.Reader, int64, error) {
+	if ae.Body == nil || ae.Mode.IsDir() {
+		return nil, 0, nil
+	} else if ae.Size > 0 {
+		return io.LimitReader(ae.Body, ae.Size), ae.Size, nil
+	}
+
+	data, err := ioutil.ReadAll(ae.Body)
+	return bytes.NewReader(data), int64(len(data)), err
+}
+
+// archiveFile 待归档的文件
+type archiveFile struct {
+	path string // 磁盘路径
+	name string // 归档内的名称
+	info os.FileInfo
+}
+
+// archiveCompressors 已注册的压缩器
+var archiveCompressors = struct {
+	sync.RWMutex
+	items []*ArchiveCompressor
+}{
+	items: []*ArchiveCompressor{
+		{
+			Name:  "gz",
+			Exts:  []string{".tgz"},
+			Magic: []byte{0x1f, 0x8b},
+			NewReader: func(r io.Reader) (io.ReadCloser, error) {
+				return gzip.NewReader(r)
+			},
+			NewWriter: func(w io.Writer) (io.WriteCloser, error) {
+				return gzip.NewWriter(w), nil
+			},
+		},
+		{
+			Name:  "bz2",
+			Exts:  []string{".tbz2", ".tbz"},
+			Magic: []byte("BZh"),
+			NewReader: func(r io.Reader) (io.ReadCloser, error) {
+				return ioutil.NopCloser(bzip2.NewReader(r)), nil
+			},
+		},
+	},
+}
+
+// zipMagics zip文件头魔数(普通及空zip)
+var zipMagics = [][]byte{[]byte("PK\x03\x04"), []byte("PK\x05\x06")}
+
+// RegisterCompressor 注册tar归档的压缩器,如zstd、xz等;同名的压缩器将被替换.
+func (kf *LkkFile) RegisterCompressor(c *ArchiveCompressor) error {
+	if c == nil || c.Name == "" {
+		return errors.New("Compressor name is empty")
+	} else if c.NewReader == nil && c.NewWriter == nil {
+		return errors.New("Compressor has neither reader nor writer")
+	}
+
+	archiveCompressors.Lock()
+	defer archiveCompressors.Unlock()
+	for i, item := range archiveCompressors.items {
+		if item.Name == c.Name {
+			archiveCompressors.items[i] = c
+			return nil
+		}
+	}
+	archiveCompressors.items = append(archiveCompressors.items, c)
+
+	return nil
+}
+
+// compressorByName 根据名称获取压缩器.
+func compressorByName(name string) *ArchiveCompressor {
+	archiveCompressors.RLock()
+	defer archiveCompressors.RUnlock()
+	for _, item := range archiveCompressors.items {
+		if item.Name == name {
+			return item
+		}
+	}
+	return nil
+}
+
+// archiveFormatByExt 根据扩展名获取归档格式,如"zip","tar","tar.gz";未知时返回空字符串.
+func archiveFormatByExt(fpath string) string {
+	lower := strings.ToLower(fpath)
+	if strings.HasSuffix(lower, ".zip") {
+		return "zip"
+	}
+
+	archiveCompressors.RLock()
+	defer archiveCompressors.RUnlock()
+	for _, item := range archiveCompressors.items {
+		if strings.HasSuffix(lower, ".tar."+strings.ToLower(item.Name)) {
+			return "tar." + item.Name
+		}
+		for _, ext := range item.Exts {
+			if strings.HasSuffix(lower, strings.ToLower(ext)) {
+				return "tar." + item.Name
+			}
+		}
+	}
+
+	if strings.HasSuffix(lower, ".tar") {
+		return "tar"
+	}
+
+	return ""
+}
+
+// archiveFormatByMagic 根据文件头获取归档格式;未知时返回空字符串.
+// 压缩数据无法确定是否tar,按tar处理.
+func archiveFormatByMagic(header []byte) string {
+	for _, magic := range zipMagics {
+		if bytes.HasPrefix(header, magic) {
+			return "zip"
+		}
+	}
+
+	if len(header) >= 262 && bytes.Equal(header[257:262], []byte("ustar")) {
+		return "tar"
+	}
+
+	archiveCompressors.RLock()
+	defer archiveCompressors.RUnlock()
+	for _, item := range archiveCompressors.items {
+		if len(item.Magic) > 0 && bytes.HasPrefix(header, item.Magic) {
+			return "tar." + item.Name
+		}
+	}
+
+	return ""
+}
+
+// ArchiveFormat 检测归档文件的格式,优先读取文件头魔数,无法识别时根据扩展名判断.
+// 返回如"zip","tar","tar.gz","tar.bz2"或已注册压缩器对应的"tar.{Name}";未知格式返回空字符串.
+func (kf *LkkFile) ArchiveFormat(fpath string) string {
+	f, err := os.Open(fpath)
+	if err == nil {
+		defer func() {
+			_ = f.Close()
+		}()
+
+		header := make([]byte, 512)
+		n, _ := io.ReadFull(f, header)
+		if res := archiveFormatByMagic(header[:n]); res != "" {
+			return res
+		}
+	}
+
+	return archiveFormatByExt(fpath)
+}
+
+// ignoreFilter 将要忽略的文件正则转换为过滤器,匹配任一正则时返回false.
+func ignoreFilter(ignorePatterns []string) FileFilter {
+	var regs []*regexp.Regexp
+	for _, pattern := range ignorePatterns {
+		if re, err := regexp.Compile(pattern); err == nil {
+			regs = append(regs, re)
+		}
+	}
+
+	return func(file string) bool {
+		for _, re := range regs {
+			if re.MatchString(file) {
+				return false
+			}
+		}
+		return true
+	}
+}
+
+// collectArchiveFiles 收集src下要归档的文件,归档名称相对于src的父目录;排除路径为skip的文件.
+func collectArchiveFiles(src, skip string, filter FileFilter) ([]archiveFile, error) {
+	var files []archiveFile
+	parentDir := filepath.Dir(src)
+	err := filepath.Walk(src, func(fpath string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		} else if fpath == skip {
+			return nil
+		} else if fpath != src && filter != nil && !filter(fpath) {
+			if info.IsDir() {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+
+		name, _ := filepath.Rel(parentDir, fpath)
+		files = append(files, archiveFile{path: fpath, name: filepath.ToSlash(name), info: info})
+		return nil
+	})
+
+	if err == nil && len(files) == 0 {
+		err = fmt.Errorf("src no files to archive")
+	}
+
+	return files, err
+}
+
+// writeTarFiles 将文件写入tar.
+func writeTarFiles(tw *tar.Writer, files []archiveFile) error {
+	for _, file := range files {
+		var link string
+		if file.info.Mode()&os.ModeSymlink != 0 {
+			link, _ = os.Readlink(file.path)
+		}
+
+		hdr, err := tar.FileInfoHeader(file.info, link)
+		if err != nil {
+			return fmt.Errorf("HeaderErr: %s file:%s", err.Error(), file.path)
+		}
+		hdr.Name = file.name
+		if file.info.IsDir() {
+			hdr.Name += "/"
+		}
+
+		if err = tw.WriteHeader(hdr); err != nil {
+			return fmt.Errorf("HeaderErr: %s file:%s", err.Error(), file.path)
+		}
+
+		if file.info.Mode().IsRegular() {
+			if err = copyFileTo(tw, file.path); err != nil {
+				return fmt.Errorf("CopyErr: %s file:%s", err.Error(), file.path)
+			}
+		}
+	}
+
+	return nil
+}
+
+// writeZipFiles 将文件写入zip.
+func writeZipFiles(zw *zip.Writer, files []archiveFile) error {
+	for _, file := range files {
+		if file.info.Mode()&os.ModeSymlink != 0 {
+			//zip不保留链接,按其指向的文件处理
+			info, err := os.Stat(file.path)
+			if err != nil {
+				continue
+			}
+			file.info = info
+		}
+
+		hdr, err := zip.FileInfoHeader(file.info)
+		if err != nil {
+			return fmt.Errorf("HeaderErr: %s file:%s", err.Error(), file.path)
+		}
+		hdr.Name = file.name
+		if file.info.IsDir() {
+			hdr.Name += "/"
+		} else {
+			hdr.Method = zip.Deflate
+		}
+
+		wr, err := zw.CreateHeader(hdr)
+		if err != nil {
+			return fmt.Errorf("HeaderErr: %s file:%s", err.Error(), file.path)
+		}
+
+		if file.info.Mode().IsRegular() {
+			if err = copyFileTo(wr, file.path); err != nil {
+				return fmt.Errorf("CopyErr: %s file:%s", err.Error(), file.path)
+			}
+		}
+	}
+
+	return nil
+}
+
+// copyFileTo 将文件内容拷贝到w.
+func copyFileTo(w io.Writer, fpath string) error {
+	fr, err := os.Open(fpath)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = fr.Close()
+	}()
+
+	_, err = io.Copy(w, fr)
+	return err
+}
+
+// Archive 将文件或目录打包为归档文件,格式由dst的扩展名决定(zip/tar/tar.gz/tgz/已注册的压缩器);
+// ignorePatterns为要忽略的文件正则,与TarGz相同.
+func (kf *LkkFile) Archive(src string, dst string, ignorePatterns ...string) (bool, error) {
+	format := archiveFormatByExt(dst)
+	if format == "" {
+		return false, fmt.Errorf("Unknown archive format: %s", dst)
+	} else if err := checkArchiveWriter(format); err != nil {
+		return false, err
+	}
+
+	src = kf.AbsPath(src)
+	dst = kf.AbsPath(dst)
+	files, err := collectArchiveFiles(src, dst, ignoreFilter(ignorePatterns))
+	if err != nil {
+		return false, err
+	}
+
+	dstDir := kf.Dirname(dst)
+	if !kf.IsExist(dstDir) {
+		_ = kf.Mkdir(dstDir, os.ModePerm)
+	}
+
+	fw, err := os.Create(dst)
+	if err != nil {
+		return false, err
+	}
+	defer func() {
+		_ = fw.Close()
+	}()
+
+	if err = writeArchive(fw, format, files, nil); err == nil {
+		err = fw.Close()
+	}
+
+	return err == nil, err
+}
+
+// ArchiveToWriter 将磁盘上的文件或目录及内存中的条目打包,直接写入w(如http响应),无须临时文件.
+// format为归档格式,如"zip","tar","tar.gz"或已注册压缩器对应的"tar.{Name}";
+// srcs为磁盘上的文件或目录,entries为内存中的条目,两者可任选其一;
+// ignorePatterns为要忽略的文件正则,仅作用于srcs.
+func (kf *LkkFile) ArchiveToWriter(w io.Writer, format string, srcs []string, entries []*ArchiveEntry, ignorePatterns ...string) error {
+	if err := checkArchiveWriter(format); err != nil {
+		return err
+	}
+
+	var files []archiveFile
+	filter := ignoreFilter(ignorePatterns)
+	for _, src := range srcs {
+		items, err := collectArchiveFiles(kf.AbsPath(src), "", filter)
+		if err != nil {
+			return err
+		}
+		files = append(files, items...)
+	}
+
+	if len(files) == 0 && len(entries) == 0 {
+		return errors.New("No files or entries to archive")
+	}
+
+	return writeArchive(w, format, files, entries)
+}
+
+// checkArchiveWriter 检查归档格式是否支持写入.
+func checkArchiveWriter(format string) error {
+	if format == "zip" || format == "tar" {
+		return nil
+	} else if strings.HasPrefix(format, "tar.") {
+		if comp := compressorByName(format[4:]); comp != nil && comp.NewWriter != nil {
+			return nil
+		}
+		return fmt.Errorf("Compressor %s does not support writing", format[4:])
+	}
+
+	return fmt.Errorf("Unknown archive format: %s", format)
+}
+
+// writeArchive 将文件和内存条目按格式写入w.
+func writeArchive(w io.Writer, format string, files []archiveFile, entries []*ArchiveEntry) (err error) {
+	if format == "zip" {
+		zw := zip.NewWriter(w)
+		if err = writeZipFiles(zw, files); err == nil {
+			err = writeZipEntries(zw, entries)
+		}
+		if cerr := zw.Close(); err == nil {
+			err = cerr
+		}
+		return
+	}
+
+	var cw io.WriteCloser
+	if strings.HasPrefix(format, "tar.") {
+		if cw, err = compressorByName(format[4:]).NewWriter(w); err != nil {
+			return
+		}
+		w = cw
+	}
+
+	tw := tar.NewWriter(w)
+	if err = writeTarFiles(tw, files); err == nil {
+		err = writeTarEntries(tw, entries)
+	}
+	if cerr := tw.Close(); err == nil {
+		err = cerr
+	}
+	if cw != nil {
+		if cerr := cw.Close(); err == nil {
+			err = cerr
+		}
+	}
+
+	return
+}
+
+// writeTarEntries 将内存条目写入tar.
+func writeTarEntries(tw *tar.Writer, entries []*ArchiveEntry) error {
+	for _, entry := range entries {
+		body, size, err := entry.sizedBody()
+		if err != nil {
+			return fmt.Errorf("ReadErr: %s entry:%s", err.Error(), entry.Name)
+		}
+
+		hdr := &tar.Header{
+			Name:    zipEntryName(entry.Name),
+			Mode:    int64(entry.perm()),
+			ModTime: entry.modTime(),
+			Size:    size,
+			Format:  tar.FormatPAX,
+		}
+		if entry.Mode.IsDir() {
+			hdr.Typeflag = tar.TypeDir
+			hdr.Name = strings.TrimRight(hdr.Name, "/") + "/"
+			hdr.Size = 0
+		} else {
+			hdr.Typeflag = tar.TypeReg
+		}
+
+		if err = tw.WriteHeader(hdr); err != nil {
+			return fmt.Errorf("HeaderErr: %s entry:%s", err.Error(), entry.Name)
+		}
+		if hdr.Typeflag == tar.TypeReg && body != nil {
+			if _, err = io.Copy(tw, body); err != nil {
+				return fmt.Errorf("CopyErr: %s entry:%s", err.Error(), entry.Name)
+			}
+		}
+	}
+
+	return nil
+}
+
+// writeZipEntries 将内存条目写入zip.
+func writeZipEntries(zw *zip.Writer, entries []*ArchiveEntry) error {
+	for _, entry := range entries {
+		hdr := &zip.FileHeader{
+			Name:     zipEntryName(entry.Name),
+			Method:   zip.Deflate,
+			Modified: entry.modTime(),
+		}
+		if entry.Mode.IsDir() {
+			hdr.Name = strings.TrimRight(hdr.Name, "/") + "/"
+			hdr.Method = zip.Store
+			hdr.SetMode(os.ModeDir | entry.perm())
+		} else {
+			hdr.SetMode(entry.perm())
+		}
+
+		wr, err := zw.CreateHeader(hdr)
+		if err != nil {
+			return fmt.Errorf("HeaderErr: %s entry:%s", err.Error(), entry.Name)
+		}
+		if !entry.Mode.IsDir() && entry.Body != nil {
+			body := entry.Body
+			if entry.Size > 0 {
+				body = io.LimitReader(body, entry.Size)
+			}
+			if _, err = io.Copy(wr, body); err != nil {
+				return fmt.Errorf("CopyErr: %s entry:%s", err.Error(), entry.Name)
+			}
+		}
+	}
+
+	return nil
+}
+
+// Extract 解压归档文件到目录dstDir,格式根据文件头魔数或扩展名自动检测;
+// ignorePatterns为要忽略的归档内文件名正则.
+// 含有".."、绝对路径或指向dstDir之外的链接的条目及tar中的硬链接条目将被拒绝,返回*ArchiveEntryError.
+func (kf *LkkFile) Extract(src string, dstDir string, ignorePatterns ...string) (bool, error) {
+	return kf.ExtractWithLimits(src, dstDir, ExtractLimits{}, ignorePatterns...)
+}
+
+// ExtractWithLimits 与Extract相同,但限制解压的总大小、条目数和压缩比,以防范压缩炸弹.
+func (kf *LkkFile) ExtractWithLimits(src string, dstDir string, limits ExtractLimits, ignorePatterns ...string) (bool, error) {
+	format := kf.ArchiveFormat(src)
+	if format == "" {
+		return false, fmt.Errorf("Unknown archive format: %s", src)
+	}
+
+	if format == "zip" {
+		dstDir, err := kf.mkExtractDir(dstDir)
+		if err != nil {
+			return false, err
+		}
+
+		fr, zr, compressed, err := openZipFile(src)
+		if err != nil {
+			return false, err
+		}
+		defer func() {
+			_ = fr.Close()
+		}()
+
+		err = extractZipFiles(zr.File, dstDir, ignoreFilter(ignorePatterns), limits, compressed)
+		return err == nil, err
+	}
+
+	fr, err := os.Open(src)
+	if err != nil {
+		return false, err
+	}
+	defer func() {
+		_ = fr.Close()
+	}()
+
+	err = kf.ExtractFromReader(fr, format, dstDir, limits, ignorePatterns...)
+	return err == nil, err
+}
+
+// ExtractFromReader 从r(如http请求体)读取归档并解压到目录dstDir.
+// format为归档格式,为空时根据数据头自动检测;limits为解压限制;ignorePatterns为要忽略的归档内文件名正则.
+// zip须随机读取,会先缓存到临时文件.
+func (kf *LkkFile) ExtractFromReader(r io.Reader, format string, dstDir string, limits ExtractLimits, ignorePatterns ...string) error {
+	br := bufio.NewReaderSize(r, 4096)
+	if format == "" {
+		header, _ := br.Peek(512)
+		if format = archiveFormatByMagic(header); format == "" {
+			return errors.New("Unknown archive format")
+		}
+	}
+
+	dstDir, err := kf.mkExtractDir(dstDir)
+	if err != nil {
+		return err
+	}
+
+	filter := ignoreFilter(ignorePatterns)
+	if format == "zip" {
+		tmp, err := ioutil.TempFile("", "kgo-unzip-")
+		if err != nil {
+			return err
+		}
+		defer func() {
+			_ = tmp.Close()
+			_ = os.Remove(tmp.Name())
+		}()
+
+		size, err := io.Copy(tmp, br)
+		if err != nil {
+			return err
+		}
+
+		zr, compressed, err := newZipReader(tmp, size)
+		if err != nil {
+			return err
+		}
+
+		return extractZipFiles(zr.File, dstDir, filter, limits, compressed)
+	}
+
+	cnt := &countReader{r: br}
+	var tr io.Reader = cnt
+	if strings.HasPrefix(format, "tar.") {
+		comp := compressorByName(format[4:])
+		if comp == nil || comp.NewReader == nil {
+			return fmt.Errorf("Compressor %s does not support reading", format[4:])
+		}
+
+		cr, err := comp.NewReader(cnt)
+		if err != nil {
+			return err
+		}
+		defer func() {
+			_ = cr.Close()
+		}()
+		tr = cr
+	} else if format != "tar" {
+		return fmt.Errorf("Unknown archive format: %s", format)
+	}
+
+	guard, err := newExtractGuard(dstDir, limits, func() int64 {
+		return cnt.n
+	})
+	if err != nil {
+		return err
+	}
+
+	return extractTar(tar.NewReader(tr), guard, filter)
+}
+
+// mkExtractDir 获取解压目录的绝对路径,不存在时创建.
+func (kf *LkkFile) mkExtractDir(dstDir string) (string, error) {
+	dstDir = strings.TrimRight(kf.AbsPath(dstDir), "/\\")
+	if !kf.IsExist(dstDir) {
+		if err := kf.Mkdir(dstDir, os.ModePerm); err != nil {
+			return dstDir, err
+		}
+	}
+
+	return dstDir, nil
+}
+
+// extractTar 将tar内容解压到目录.
+func extractTar(tr *tar.Reader, guard *extractGuard, filter FileFilter) error {
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return err
+		}
+
+		if filter != nil && !filter(hdr.Name) {
+			continue
+		} else if hdr.Typeflag == tar.TypeLink {
+			return &ArchiveEntryError{Entry: hdr.Name, Err: ErrEntryHardLink}
+		}
+
+		newPath, err := guard.entryPath(hdr.Name)
+		if err != nil {
+			return err
+		} else if newPath == "" {
+			continue
+		}
+
+		mode := hdr.FileInfo().Mode()
+		switch hdr.Typeflag {
+		case tar.TypeDir:
+			if err = os.MkdirAll(newPath, mode.Perm()|0700); err != nil {
+				return err
+			}
+		case tar.TypeSymlink:
+			if err = guard.checkLink(hdr.Name, newPath, hdr.Linkname); err != nil {
+				return err
+			}
+			_ = os.Remove(newPath)
+			if err = os.Symlink(hdr.Linkname, newPath); err != nil {
+				return err
+			}
+		case tar.TypeReg, tar.TypeRegA:
+			if err = guard.writeFile(hdr.Name, newPath, tr, mode); err != nil {
+				return err
+			}
+			_ = os.Chtimes(newPath, hdr.ModTime, hdr.ModTime)
+		}
+	}
+
+	return nil
+}
+
+// openZipFile 打开zip文件,返回值同newZipReader;调用方负责关闭文件.
+func openZipFile(src string) (*os.File, *zip.Reader, func() int64, error) {
+	fr, err := os.Open(src)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	info, err := fr.Stat()
+	if err == nil {
+		var zr *zip.Reader
+		var compressed func() int64
+		if zr, compressed, err = newZipReader(fr, info.Size()); err == nil {
+			return fr, zr, compressed, nil
+		}
+	}
+	_ = fr.Close()
+
+	return nil, nil, nil, err
+}
+
+// newZipReader 从ra读取大小为size的zip,同时返回已读取的zip数据字节数的统计函数.
+// 压缩比按实际读取的数据计算,而非条目头中声明的压缩大小,以免被伪造的条目头绕过.
+func newZipReader(ra io.ReaderAt, size int64) (*zip.Reader, func() int64, error) {
+	cnt := &countReaderAt{r: ra}
+	zr, err := zip.NewReader(cnt, size)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return zr, func() int64 {
+		return cnt.n
+	}, nil
+}
+
+// extractZipFiles 将zip内容解压到目录,compressed为已读取的压缩数据字节数的统计函数.
+func extractZipFiles(files []*zip.File, dstDir string, filter FileFilter, limits ExtractLimits, compressed func() int64) error {
+	guard, err := newExtractGuard(dstDir, limits, compressed)
+	if err != nil {
+		return err
+	}
+
+	for _, f := range files {
+		if filter != nil && !filter(f.Name) {
+			continue
+		}
+
+		newPath, err := guard.entryPath(f.Name)
+		if err != nil {
+			return err
+		} else if newPath == "" {
+			continue
+		}
+
+		info := f.FileInfo()
+		if info.IsDir() {
+			if err := os.MkdirAll(newPath, info.Mode().Perm()|0700); err != nil {
+				return err
+			}
+			continue
+		}
+
+		rc, err := f.Open()
+		if err != nil {
+			return err
+		}
+
+		if info.Mode()&os.ModeSymlink != 0 {
+			var target []byte
+			target, err = ioutil.ReadAll(io.LimitReader(rc, 4096))
+			_ = rc.Close()
+			if err != nil {
+				return err
+			} else if err = guard.checkLink(f.Name, newPath, string(target)); err != nil {
+				return err
+			}
+			_ = os.Remove(newPath)
+			if err = os.Symlink(string(target), newPath); err != nil {
+				return err
+			}
+			continue
+		}
+
+		err = guard.writeFile(f.Name, newPath, rc, info.Mode())
+		_ = rc.Close()
+		if err != nil {
+			return err
+		}
+		_ = os.Chtimes(newPath, f.Modified, f.Modified)
+	}
+
+	return nil
+}
@@ -0,0 +1,540 @@
+package kgo
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"testing"
+)
+
+// tarEntry 测试用的tar条目
+type tarEntry struct {
+	name string
+	link string
+	hard bool // link是否为硬链接
+	dir  bool // 是否为目录
+	body []byte
+}
+
+// makeEvilTarGz 生成测试用的tar.gz文件.
+func makeEvilTarGz(fpath string, entries ...tarEntry) {
+	var buf bytes.Buffer
+	gw := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(gw)
+	for _, e := range entries {
+		hdr := &tar.Header{Name: e.name, Mode: 0644, Size: int64(len(e.body)), Typeflag: tar.TypeReg}
+		if e.dir {
+			hdr.Typeflag = tar.TypeDir
+			hdr.Mode = 0755
+		} else if e.link != "" {
+			hdr.Typeflag = tar.TypeSymlink
+			hdr.Linkname = e.link
+			hdr.Size = 0
+			if e.hard {
+				hdr.Typeflag = tar.TypeLink
+			}
+		}
+		_ = tw.WriteHeader(hdr)
+		_, _ = tw.Write(e.body)
+	}
+	_ = tw.Close()
+	_ = gw.Close()
+	_ = KFile.WriteFile(fpath, buf.Bytes())
+}
+
+// makeEvilZip 生成测试用的zip文件.
+func makeEvilZip(fpath string, names []string, body []byte) {
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	for _, name := range names {
+		w, _ := zw.Create(name)
+		_, _ = w.Write(body)
+	}
+	_ = zw.Close()
+	_ = KFile.WriteFile(fpath, buf.Bytes())
+}
+
+func TestArchiveExtract(t *testing.T) {
+	ts, _ := KOS.NewTempSpace(nil)
+	defer func() {
+		_ = ts.Close()
+	}()
+
+	dir, _ := ts.Dir("archive-*")
+	var tests = []struct {
+		dst    string
+		format string
+	}{
+		{dir + "/test.zip", "zip"},
+		{dir + "/test.tar", "tar"},
+		{dir + "/test.tar.gz", "tar.gz"},
+		{dir + "/test.tgz", "tar.gz"},
+	}
+	patterns := []string{".*\\.pem", ".*\\.jpg"}
+	for _, test := range tests {
+		res, err := KFile.Archive("./testdata", test.dst, patterns...)
+		if !res || err != nil {
+			t.Errorf("Archive %s fail: %v", test.dst, err)
+			return
+		} else if KFile.ArchiveFormat(test.dst) != test.format {
+			t.Errorf("ArchiveFormat %s fail", test.dst)
+			return
+		}
+
+		out := test.dst + "_dir"
+		res, err = KFile.Extract(test.dst, out, ".*\\.svg")
+		if !res || err != nil {
+			t.Errorf("Extract %s fail: %v", test.dst, err)
+			return
+		} else if !KFile.IsFile(out+"/testdata/dante.txt") || KFile.IsExist(out+"/testdata/rsa/private_key.pem") || KFile.IsExist(out+"/testdata/jetbrains.svg") {
+			t.Errorf("Extract %s content fail", test.dst)
+			return
+		}
+	}
+
+	//按文件头检测
+	_, _ = KFile.CopyFile(dir+"/test.tgz", dir+"/tgz.dat", FILE_COVER_ALLOW)
+	if KFile.ArchiveFormat(dir+"/tgz.dat") != "tar.gz" {
+		t.Error("ArchiveFormat magic fail")
+		return
+	}
+
+	//tar.bz2,依赖系统的tar命令生成
+	if ret, _, _ := KOS.Exec("tar -cjf " + dir + "/test.tar.bz2 ./testdata/dante.txt"); ret == 0 {
+		res, err := KFile.Extract(dir+"/test.tar.bz2", dir+"/bz2")
+		if !res || err != nil || !KFile.IsFile(dir+"/bz2/testdata/dante.txt") {
+			t.Error("Extract tar.bz2 fail")
+			return
+		}
+		if res, _ = KFile.Archive("./testdata", dir+"/new.tar.bz2"); res {
+			t.Error("Archive tar.bz2 should not be supported")
+			return
+		}
+	}
+
+	_, _ = KFile.Archive("./testdata", dir+"/test.rar")
+	_, _ = KFile.Archive("./hello", dir+"/hello.zip")
+	_, _ = KFile.Archive("./testdata", dir+"/test.zip/test.zip")
+	_, _ = KFile.Extract("./testdata/dante.txt", dir+"/dante")
+	_, _ = KFile.Extract("./hello.zip", dir+"/hello")
+	_ = KFile.ArchiveFormat("./hello.zip")
+}
+
+func TestRegisterCompressor(t *testing.T) {
+	err := KFile.RegisterCompressor(&ArchiveCompressor{
+		Name: "gzx",
+		NewReader: func(r io.Reader) (io.ReadCloser, error) {
+			return gzip.NewReader(r)
+		},
+		NewWriter: func(w io.Writer) (io.WriteCloser, error) {
+			return gzip.NewWriterLevel(w, gzip.BestCompression)
+		},
+	})
+	if err != nil {
+		t.Error("RegisterCompressor fail")
+		return
+	}
+
+	ts, _ := KOS.NewTempSpace(nil)
+	defer func() {
+		_ = ts.Close()
+	}()
+	dir, _ := ts.Dir("archive-*")
+	res, err := KFile.Archive("./testdata/dante.txt", dir+"/dante.tar.gzx")
+	if !res || err != nil {
+		t.Error("Archive with custom compressor fail")
+		return
+	}
+	res, err = KFile.Extract(dir+"/dante.tar.gzx", dir+"/gzx")
+	if !res || err != nil || !KFile.IsFile(dir+"/gzx/dante.txt") {
+		t.Error("Extract with custom compressor fail")
+		return
+	}
+
+	//替换同名
+	_ = KFile.RegisterCompressor(&ArchiveCompressor{Name: "gzx", NewReader: func(r io.Reader) (io.ReadCloser, error) {
+		return gzip.NewReader(r)
+	}})
+	_ = KFile.RegisterCompressor(nil)
+	_ = KFile.RegisterCompressor(&ArchiveCompressor{Name: "none"})
+}
+
+func TestExtractContainment(t *testing.T) {
+	ts, _ := KOS.NewTempSpace(nil)
+	defer func() {
+		_ = ts.Close()
+	}()
+
+	dir, _ := ts.Dir("evil-*")
+	var tests = []struct {
+		entries []tarEntry
+		err     error
+		refused string
+	}{
+		{[]tarEntry{{name: "../evil.txt", body: []byte("evil")}}, ErrEntryPathEscape, "../evil.txt"},
+		{[]tarEntry{{name: "a/../../evil.txt", body: []byte("evil")}}, ErrEntryPathEscape, "a/../../evil.txt"},
+		{[]tarEntry{{name: "/tmp/evil.txt", body: []byte("evil")}}, ErrEntryAbsolutePath, "/tmp/evil.txt"},
+		{[]tarEntry{{name: "lnk", link: "/etc/passwd"}}, ErrEntryLinkEscape, "lnk"},
+		{[]tarEntry{{name: "sub/lnk", link: "../../"}}, ErrEntryLinkEscape, "sub/lnk"},
+		{[]tarEntry{{name: "ok.txt", body: []byte("ok")}, {name: "sub/lnk", link: "../ok.txt"}}, nil, ""},
+		{[]tarEntry{{name: "./", dir: true}, {name: "./sub/", dir: true}, {name: "./sub/ok.txt", body: []byte("ok")}}, nil, ""},
+	}
+
+	for i, test := range tests {
+		src := fmt.Sprintf("%s/evil_%d.tar.gz", dir, i)
+		makeEvilTarGz(src, test.entries...)
+		for _, extract := range []func(string, string) (bool, error){
+			func(s, d string) (bool, error) { return KFile.UnTarGz(s, d) },
+			func(s, d string) (bool, error) { return KFile.Extract(s, d) },
+		} {
+			res, err := extract(src, fmt.Sprintf("%s/out_%d", dir, i))
+			var ae *ArchiveEntryError
+			if test.err == nil {
+				if !res || err != nil {
+					t.Errorf("extract %d fail: %v", i, err)
+					return
+				}
+			} else if res || !errors.Is(err, test.err) || !errors.As(err, &ae) || ae.Entry != test.refused || err.Error() == "" {
+				t.Errorf("extract %d should be refused: %v", i, err)
+				return
+			}
+		}
+	}
+	if KFile.IsExist(dir+"/evil.txt") || KFile.IsExist("/tmp/evil.txt") {
+		t.Error("extract escaped")
+		return
+	}
+
+	//已存在的链接指向外部时,不得通过它写入
+	out := dir + "/out_link"
+	_ = KFile.Mkdir(out, 0766)
+	_ = os.Symlink(KFile.AbsPath(dir), out+"/outside")
+	makeEvilTarGz(dir+"/evil_link.tar.gz", tarEntry{name: "outside/x/evil.txt", body: []byte("evil")})
+	_, err := KFile.UnTarGz(dir+"/evil_link.tar.gz", out)
+	if !errors.Is(err, ErrEntryLinkEscape) || KFile.IsExist(dir+"/x") {
+		t.Error("UnTarGz through existing link fail")
+		return
+	}
+
+	//以"./"开头的归档,如tar -C dir -czf x.tgz .
+	if cont, _ := KFile.ReadFile(fmt.Sprintf("%s/out_%d/sub/ok.txt", dir, len(tests)-1)); string(cont) != "ok" {
+		t.Error("extract ./ entry fail")
+		return
+	}
+	makeEvilZip(dir+"/dot.zip", []string{"./", "./ok.txt"}, []byte("ok"))
+	if res, err := KFile.UnZip(dir+"/dot.zip", dir+"/dotzip"); !res || err != nil || !KFile.IsFile(dir+"/dotzip/ok.txt") {
+		t.Error("UnZip ./ entry fail")
+		return
+	}
+
+	//zip
+	makeEvilZip(dir+"/evil.zip", []string{"../evil.txt"}, []byte("evil"))
+	if _, err = KFile.UnZip(dir+"/evil.zip", dir+"/zip"); !errors.Is(err, ErrEntryPathEscape) {
+		t.Error("UnZip containment fail")
+		return
+	}
+	makeEvilZip(dir+"/evil2.zip", []string{"C:/evil.txt"}, []byte("evil"))
+	if _, err = KFile.Extract(dir+"/evil2.zip", dir+"/zip"); !errors.Is(err, ErrEntryAbsolutePath) {
+		t.Error("Extract zip containment fail")
+		return
+	}
+}
+
+func TestExtractLinkChain(t *testing.T) {
+	ts, _ := KOS.NewTempSpace(nil)
+	defer func() {
+		_ = ts.Close()
+	}()
+	_ = KFile.WriteFile(ts.Join("secret"), []byte("secret"))
+
+	var tests = []struct {
+		entries []tarEntry
+		err     error
+		refused string
+	}{
+		{[]tarEntry{{name: "x", link: "."}, {name: "x/y", link: "../secret"}}, ErrEntryLinkEscape, "x/y"},
+		{[]tarEntry{{name: "a/x", link: ".."}, {name: "a/x/y", link: "../secret"}}, ErrEntryLinkEscape, "a/x/y"},
+		{[]tarEntry{{name: "l", link: "z/.."}, {name: "z", link: "."}}, ErrEntryLinkEscape, "l"},
+		{[]tarEntry{{name: "x", link: "."}, {name: "x/y", link: "secret"}}, nil, ""},
+		{[]tarEntry{{name: "a.txt", body: []byte("a")}, {name: "b.txt", link: "a.txt", hard: true}}, ErrEntryHardLink, "b.txt"},
+	}
+
+	for i, test := range tests {
+		src := ts.Join(fmt.Sprintf("chain_%d.tar.gz", i))
+		makeEvilTarGz(src, test.entries...)
+		for j, extract := range []func(string, string) error{
+			func(s, d string) error { _, err := KFile.UnTarGz(s, d); return err },
+			func(s, d string) error { _, err := KFile.Extract(s, d); return err },
+			func(s, d string) error {
+				fr, _ := os.Open(s)
+				defer func() {
+					_ = fr.Close()
+				}()
+				return KFile.ExtractFromReader(fr, "", d, ExtractLimits{})
+			},
+		} {
+			dst := ts.Join(fmt.Sprintf("out_%d_%d", i, j))
+			err := extract(src, dst)
+			var ae *ArchiveEntryError
+			if test.err == nil {
+				if err != nil {
+					t.Errorf("extract %d fail: %v", i, err)
+					return
+				}
+			} else if !errors.Is(err, test.err) || !errors.As(err, &ae) || ae.Entry != test.refused {
+				t.Errorf("extract %d should be refused: %v", i, err)
+				return
+			}
+			if content, _ := KFile.ReadFile(dst + "/y"); string(content) == "secret" {
+				t.Errorf("extract %d escaped", i)
+				return
+			}
+		}
+	}
+
+	//zip中的链接
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	for _, e := range [][2]string{{"x", "."}, {"x/y", "../secret"}} {
+		hdr := &zip.FileHeader{Name: e[0]}
+		hdr.SetMode(os.ModeSymlink | 0777)
+		w, _ := zw.CreateHeader(hdr)
+		_, _ = w.Write([]byte(e[1]))
+	}
+	_ = zw.Close()
+	src := ts.Join("chain.zip")
+	_ = KFile.WriteFile(src, buf.Bytes())
+	if _, err := KFile.UnZip(src, ts.Join("zip")); !errors.Is(err, ErrEntryLinkEscape) {
+		t.Error("UnZip link chain fail")
+		return
+	}
+	if _, err := KFile.Extract(src, ts.Join("zip2")); !errors.Is(err, ErrEntryLinkEscape) {
+		t.Error("Extract zip link chain fail")
+		return
+	}
+}
+
+func BenchmarkExtractLinkChain(b *testing.B) {
+	ts, _ := KOS.NewTempSpace(nil)
+	defer func() {
+		_ = ts.Close()
+	}()
+	src := ts.Join("chain.tar.gz")
+	makeEvilTarGz(src, tarEntry{name: "x", link: "."}, tarEntry{name: "x/y", link: "z"})
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, _ = KFile.UnTarGz(src, ts.Join(fmt.Sprintf("out_%d", i)))
+	}
+}
+
+func TestExtractLimits(t *testing.T) {
+	ts, _ := KOS.NewTempSpace(nil)
+	defer func() {
+		_ = ts.Close()
+	}()
+
+	dir, _ := ts.Dir("bomb-*")
+	zeros := make([]byte, 4*1048576)
+	makeEvilZip(dir+"/bomb.zip", []string{"a.bin", "b.bin", "c.bin"}, zeros)
+	makeEvilTarGz(dir+"/bomb.tar.gz", tarEntry{name: "a.bin", body: zeros}, tarEntry{name: "b.bin", body: zeros})
+
+	var tests = []struct {
+		limits ExtractLimits
+		err    error
+	}{
+		{ExtractLimits{MaxEntries: 2}, ErrArchiveTooManyEntries},
+		{ExtractLimits{MaxSize: 5 * 1048576}, ErrArchiveTooLarge},
+		{ExtractLimits{MaxRatio: 100}, ErrArchiveRatio},
+	}
+	for _, test := range tests {
+		_, err := KFile.UnZip(dir+"/bomb.zip", dir+"/zip", test.limits)
+		if !errors.Is(err, test.err) {
+			t.Errorf("UnZip limits %v fail: %v", test.limits, err)
+			return
+		}
+		_, err = KFile.ExtractWithLimits(dir+"/bomb.zip", dir+"/zip", test.limits)
+		if !errors.Is(err, test.err) {
+			t.Errorf("ExtractWithLimits limits %v fail: %v", test.limits, err)
+			return
+		}
+	}
+
+	_, err := KFile.UnTarGz(dir+"/bomb.tar.gz", dir+"/tar", ExtractLimits{MaxRatio: 100})
+	if !errors.Is(err, ErrArchiveRatio) {
+		t.Error("UnTarGz ratio limit fail")
+		return
+	}
+	_, err = KFile.UnTarGz(dir+"/bomb.tar.gz", dir+"/tar", ExtractLimits{MaxSize: 16 * 1048576, MaxEntries: 2, MaxRatio: 5000})
+	if err != nil {
+		t.Error("UnTarGz limits fail")
+		return
+	}
+
+	//伪造中央目录中的压缩大小(并去掉数据描述符标志),压缩比仍按实际读取的数据计算
+	forged := ts.Join("forged.zip")
+	makeEvilZip(forged, []string{"a.bin"}, zeros)
+	data, _ := KFile.ReadFile(forged)
+	pos := bytes.Index(data, []byte("PK\x01\x02"))
+	binary.LittleEndian.PutUint16(data[pos+8:], binary.LittleEndian.Uint16(data[pos+8:])&^0x8)
+	binary.LittleEndian.PutUint32(data[pos+20:], uint32(len(zeros)))
+	_ = KFile.WriteFile(forged, data)
+	if _, err = KFile.UnZip(forged, ts.Join("forged")); err != nil {
+		t.Errorf("UnZip forged zip fail: %v", err)
+		return
+	}
+	for _, extract := range []func() error{
+		func() error {
+			_, err := KFile.UnZip(forged, ts.Join("forged1"), ExtractLimits{MaxRatio: 100})
+			return err
+		},
+		func() error {
+			_, err := KFile.ExtractWithLimits(forged, ts.Join("forged2"), ExtractLimits{MaxRatio: 100})
+			return err
+		},
+		func() error {
+			return KFile.ExtractFromReader(bytes.NewReader(data), "zip", ts.Join("forged3"), ExtractLimits{MaxRatio: 100})
+		},
+	} {
+		if err = extract(); !errors.Is(err, ErrArchiveRatio) {
+			t.Errorf("forged zip ratio limit fail: %v", err)
+			return
+		}
+	}
+}
+
+func TestArchiveToWriterExtractFromReader(t *testing.T) {
+	ts, _ := KOS.NewTempSpace(nil)
+	defer func() {
+		_ = ts.Close()
+	}()
+
+	dir, _ := ts.Dir("stream-*")
+	entries := []*ArchiveEntry{
+		{Name: "mem/conf", Mode: os.ModeDir},
+		{Name: "mem/conf/app.json", Body: bytes.NewReader([]byte(`{"debug":true}`))},
+		{Name: "/mem/hello.txt", Mode: 0600, Size: 5, Body: bytes.NewReader([]byte("hello world"))},
+	}
+
+	for _, format := range []string{"zip", "tar", "tar.gz"} {
+		var buf bytes.Buffer
+		for _, entry := range entries {
+			if sk, ok := entry.Body.(io.Seeker); ok {
+				_, _ = sk.Seek(0, io.SeekStart)
+			}
+		}
+
+		err := KFile.ArchiveToWriter(&buf, format, []string{"./testdata/rsa"}, entries, `private_key`)
+		if err != nil || buf.Len() == 0 {
+			t.Error("ArchiveToWriter fail: " + format)
+			return
+		}
+
+		//自动检测格式
+		dst := dir + "/" + format
+		err = KFile.ExtractFromReader(bytes.NewReader(buf.Bytes()), "", dst, ExtractLimits{})
+		if err != nil {
+			t.Error("ExtractFromReader fail: " + format)
+			return
+		}
+
+		body, _ := KFile.ReadFile(dst + "/mem/hello.txt")
+		if string(body) != "hello" || !KFile.IsFile(dst+"/mem/conf/app.json") || !KFile.IsFile(dst+"/rsa/public_key.pem") {
+			t.Error("ExtractFromReader content fail: " + format)
+			return
+		} else if KFile.IsExist(dst + "/rsa/private_key.pem") {
+			t.Error("ArchiveToWriter ignore fail: " + format)
+			return
+		}
+
+		//指定格式及限制
+		err = KFile.ExtractFromReader(bytes.NewReader(buf.Bytes()), format, dst+"2", ExtractLimits{MaxEntries: 1})
+		if !errors.Is(err, ErrArchiveTooManyEntries) {
+			t.Error("ExtractFromReader limits fail: " + format)
+			return
+		}
+	}
+
+	var buf bytes.Buffer
+	if err := KFile.ArchiveToWriter(&buf, "rar", nil, entries); err == nil {
+		t.Error("ArchiveToWriter unknown format fail")
+		return
+	} else if err = KFile.ArchiveToWriter(&buf, "tar.bz2", nil, entries); err == nil {
+		t.Error("ArchiveToWriter reader only compressor fail")
+		return
+	} else if err = KFile.ArchiveToWriter(&buf, "zip", nil, nil); err == nil {
+		t.Error("ArchiveToWriter empty fail")
+		return
+	} else if err = KFile.ExtractFromReader(bytes.NewReader([]byte("hello")), "", dir+"/bad", ExtractLimits{}); err == nil {
+		t.Error("ExtractFromReader unknown format fail")
+		return
+	}
+}
+
+func BenchmarkArchive(b *testing.B) {
+	ts, _ := KOS.NewTempSpace(nil)
+	defer func() {
+		_ = ts.Close()
+	}()
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		dst := ts.Join(fmt.Sprintf("bench_%d.tar.gz", i%10))
+		_, _ = KFile.Archive("./README.md", dst)
+	}
+}
+
+func BenchmarkExtract(b *testing.B) {
+	ts, _ := KOS.NewTempSpace(nil)
+	defer func() {
+		_ = ts.Close()
+	}()
+
+	b.ResetTimer()
+	_, _ = KFile.Archive("./README.md", ts.Join("bench.zip"))
+	for i := 0; i < b.N; i++ {
+		_, _ = KFile.Extract(ts.Join("bench.zip"), ts.Join("bench"))
+	}
+}
+
+func BenchmarkArchiveFormat(b *testing.B) {
+	ts, _ := KOS.NewTempSpace(nil)
+	defer func() {
+		_ = ts.Close()
+	}()
+
+	b.ResetTimer()
+	_, _ = KFile.Archive("./README.md", ts.Join("bench.zip"))
+	for i := 0; i < b.N; i++ {
+		_ = KFile.ArchiveFormat(ts.Join("bench.zip"))
+	}
+}
+
+func BenchmarkArchiveToWriter(b *testing.B) {
+	b.ResetTimer()
+	var buf bytes.Buffer
+	for i := 0; i < b.N; i++ {
+		buf.Reset()
+		_ = KFile.ArchiveToWriter(&buf, "tar.gz", []string{"./README.md"}, nil)
+	}
+}
+
+func BenchmarkExtractFromReader(b *testing.B) {
+	ts, _ := KOS.NewTempSpace(nil)
+	defer func() {
+		_ = ts.Close()
+	}()
+
+	b.ResetTimer()
+	var buf bytes.Buffer
+	_ = KFile.ArchiveToWriter(&buf, "zip", []string{"./README.md"}, nil)
+	for i := 0; i < b.N; i++ {
+		_ = KFile.ExtractFromReader(bytes.NewReader(buf.Bytes()), "zip", ts.Join("benchr"), ExtractLimits{})
+	}
+}
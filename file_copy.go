@@ -0,0 +1,315 @@
+package kgo
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"runtime"
+	"sync"
+	"time"
+)
+
+// CopyProgress 拷贝进度
+type CopyProgress struct {
+	TotalFiles int64  `json:"total_files"` // 待拷贝的文件总数
+	TotalBytes int64  `json:"total_bytes"` // 待拷贝的字节总数
+	DoneFiles  int64  `json:"done_files"`  // 已处理的文件数(包括跳过和失败的)
+	DoneBytes  int64  `json:"done_bytes"`  // 已拷贝的字节数
+	Current    string `json:"current"`     // 当前处理的源文件
+}
+
+// CopyProgressFunc 拷贝进度回调函数
+type CopyProgressFunc func(CopyProgress)
+
+// CopyDirOptions 目录拷贝选项
+type CopyDirOptions struct {
+	Cover    LkkFileCover     // 文件覆盖方式,枚举(FILE_COVER_ALLOW、FILE_COVER_IGNORE、FILE_COVER_DENY),零值为FILE_COVER_IGNORE,即默认不覆盖
+	Workers  int              // 并发拷贝的协程数,默认为CPU核数
+	Includes []FileFilter     // 包含过滤器,文件须全部通过才会拷贝,对目录无效
+	Excludes []FileFilter     // 排除过滤器,任一命中则跳过该文件或整个子目录
+	Progress CopyProgressFunc // 进度回调,可为nil
+}
+
+// CopyFileError 单个文件的拷贝错误
+type CopyFileError struct {
+	Source string // 源路径
+	Dest   string // 目标路径
+	Err    error  // 错误
+}
+
+// Error 实现error接口.
+func (ce *CopyFileError) Error() string {
+	return fmt.Sprintf("copy %s to %s: %s", ce.Source, ce.Dest, ce.Err.Error())
+}
+
+// CopyDirResult 目录拷贝结果
+type CopyDirResult struct {
+	Files   int64            // 成功拷贝的文件数
+	Bytes   int64            // 成功拷贝的字节数
+	Skipped int64            // 因覆盖规则跳过的文件数
+	Errors  []*CopyFileError // 拷贝失败的文件列表
+}
+
+// copyJob 拷贝任务
+type copyJob struct {
+	src  string
+	dst  string
+	info os.FileInfo
+}
+
+// copyDirTask 一次目录拷贝的共享状态
+type copyDirTask struct {
+	sync.Mutex
+	opts     *CopyDirOptions
+	res      *CopyDirResult
+	progress CopyProgress
+}
+
+// addError 记录拷贝错误.
+func (ct *copyDirTask) addError(src, dst string, err error) {
+	ct.Lock()
+	ct.res.Errors = append(ct.res.Errors, &CopyFileError{Source: src, Dest: dst, Err: err})
+	ct.Unlock()
+}
+
+// report 累加进度并回调.
+func (ct *copyDirTask) report(current string, files, bytes int64) {
+	ct.Lock()
+	defer ct.Unlock()
+	ct.progress.DoneFiles += files
+	ct.progress.DoneBytes += bytes
+	ct.progress.Current = current
+	if ct.opts.Progress != nil {
+		ct.opts.Progress(ct.progress)
+	}
+}
+
+// isExcluded 路径是否被排除.
+func (co *CopyDirOptions) isExcluded(fpath string) bool {
+	for _, filter := range co.Excludes {
+		if filter(fpath) {
+			return true
+		}
+	}
+	return false
+}
+
+// isIncluded 文件是否被包含.
+func (co *CopyDirOptions) isIncluded(fpath string) bool {
+	for _, filter := range co.Includes {
+		if !filter(fpath) {
+			return false
+		}
+	}
+	return true
+}
+
+// CopyDirContext 并发拷贝源目录到目标目录,可通过ctx取消.
+// opts为拷贝选项,为nil时等同零值选项,即不覆盖已存在的文件;拷贝会保留文件和目录的权限模式及修改时间.
+// 单个文件拷贝失败不会中止整个任务,失败的文件记录在结果的Errors中;
+// 仅当源目录无效、目标目录无法创建或ctx被取消时才返回error.
+func (kf *LkkFile) CopyDirContext(ctx context.Context, source string, dest string, opts *CopyDirOptions) (*CopyDirResult, error) {
+	if opts == nil {
+		opts = &CopyDirOptions{}
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	sourceInfo, err := os.Stat(source)
+	if err != nil {
+		return nil, err
+	} else if !sourceInfo.IsDir() {
+		return nil, fmt.Errorf("%s is not a directory", source)
+	}
+
+	task := &copyDirTask{opts: opts, res: &CopyDirResult{}}
+
+	// 先扫描源目录,创建目标目录结构并收集要拷贝的文件
+	var jobs []copyJob
+	var dirs []copyJob
+	err = filepath.Walk(source, func(fpath string, info os.FileInfo, err error) error {
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return ctxErr
+		}
+
+		rel, _ := filepath.Rel(source, fpath)
+		destPath := filepath.Join(dest, rel)
+		if err != nil {
+			task.addError(fpath, destPath, err)
+			if info != nil && info.IsDir() {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+
+		if fpath != source && opts.isExcluded(fpath) {
+			if info.IsDir() {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+
+		if info.IsDir() {
+			if err := os.MkdirAll(destPath, info.Mode().Perm()|0700); err != nil {
+				if fpath == source {
+					return err
+				}
+				task.addError(fpath, destPath, err)
+				return filepath.SkipDir
+			}
+			dirs = append(dirs, copyJob{src: fpath, dst: destPath, info: info})
+		} else if opts.isIncluded(fpath) {
+			jobs = append(jobs, copyJob{src: fpath, dst: destPath, info: info})
+			task.progress.TotalFiles++
+			if info.Mode().IsRegular() {
+				task.progress.TotalBytes += info.Size()
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return task.res, err
+	}
+
+	workers := opts.Workers
+	if workers <= 0 {
+		workers = runtime.NumCPU()
+	}
+
+	jobCh := make(chan copyJob)
+	var wg sync.WaitGroup
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for job := range jobCh {
+				kf.copyDirJob(ctx, task, job)
+			}
+		}()
+	}
+
+	for _, job := range jobs {
+		if ctx.Err() != nil {
+			break
+		}
+		jobCh <- job
+	}
+	close(jobCh)
+	wg.Wait()
+
+	if err = ctx.Err(); err != nil {
+		return task.res, err
+	}
+
+	// 最后恢复目录的权限和修改时间,由深至浅,避免被子项的写入改变
+	for i := len(dirs) - 1; i >= 0; i-- {
+		_ = os.Chmod(dirs[i].dst, dirs[i].info.Mode())
+		_ = os.Chtimes(dirs[i].dst, dirs[i].info.ModTime(), dirs[i].info.ModTime())
+	}
+
+	return task.res, nil
+}
+
+// copyDirJob 执行单个文件的拷贝任务.
+func (kf *LkkFile) copyDirJob(ctx context.Context, task *copyDirTask, job copyJob) {
+	if destInfo, err := os.Lstat(job.dst); err == nil {
+		if os.SameFile(job.info, destInfo) || task.opts.Cover == FILE_COVER_IGNORE {
+			task.Lock()
+			task.res.Skipped++
+			task.Unlock()
+			task.report(job.src, 1, 0)
+			return
+		} else if task.opts.Cover == FILE_COVER_DENY {
+			task.addError(job.src, job.dst, fmt.Errorf("File %s already exists", job.dst))
+			task.report(job.src, 1, 0)
+			return
+		}
+	}
+
+	var nBytes int64
+	var err error
+	if job.info.Mode()&os.ModeSymlink != 0 {
+		err = kf.CopyLink(job.src, job.dst)
+	} else if !job.info.Mode().IsRegular() {
+		err = fmt.Errorf("%s is not a regular file", job.src)
+	} else {
+		nBytes, err = copyFileContext(ctx, job.src, job.dst, job.info, func(n int64) {
+			task.report(job.src, 0, n)
+		})
+	}
+
+	if err != nil {
+		task.addError(job.src, job.dst, err)
+	} else {
+		task.Lock()
+		task.res.Files++
+		task.res.Bytes += nBytes
+		task.Unlock()
+	}
+	task.report(job.src, 1, 0)
+}
+
+// copyFileContext 可取消地拷贝单个常规文件,并保留权限模式和修改时间.
+// onWrite在每写入一块数据后回调,参数为本次写入的字节数.
+// 拷贝失败或被取消时删除已写入的目标文件,以免残留不完整的文件.
+func copyFileContext(ctx context.Context, src, dst string, info os.FileInfo, onWrite func(int64)) (total int64, err error) {
+	sourceFile, err := os.Open(src)
+	if err != nil {
+		return 0, err
+	}
+	defer func() {
+		_ = sourceFile.Close()
+	}()
+
+	destFile, err := os.OpenFile(dst, os.O_RDWR|os.O_CREATE|os.O_TRUNC, info.Mode().Perm())
+	if err != nil {
+		return 0, err
+	}
+	defer func() {
+		_ = destFile.Close()
+		if err != nil {
+			_ = os.Remove(dst)
+		}
+	}()
+
+	buf := make([]byte, 102400)
+	for {
+		if err = ctx.Err(); err != nil {
+			return total, err
+		}
+
+		n, rerr := sourceFile.Read(buf)
+		if n > 0 {
+			if _, err = destFile.Write(buf[:n]); err != nil {
+				return total, err
+			}
+			total += int64(n)
+			if onWrite != nil {
+				onWrite(int64(n))
+			}
+		}
+
+		if rerr == io.EOF {
+			break
+		} else if rerr != nil {
+			return total, rerr
+		}
+	}
+
+	if err = destFile.Close(); err != nil {
+		return total, err
+	}
+	if err = os.Chmod(dst, info.Mode()); err != nil {
+		return total, err
+	}
+	mtime := info.ModTime()
+	if err = os.Chtimes(dst, time.Now(), mtime); err != nil {
+		return total, err
+	}
+
+	return total, nil
+}
@@ -0,0 +1,126 @@
+package kgo
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+)
+
+func TestCopyDirContext(t *testing.T) {
+	ts, _ := KOS.NewTempSpace(nil)
+	defer func() {
+		_ = ts.Close()
+	}()
+
+	src := "./testdata"
+	des := ts.Join("copyctx")
+
+	var last CopyProgress
+	opts := &CopyDirOptions{
+		Cover:   FILE_COVER_ALLOW,
+		Workers: 4,
+		Excludes: []FileFilter{func(fpath string) bool {
+			return strings.HasSuffix(fpath, ".pem")
+		}},
+		Progress: func(p CopyProgress) {
+			last = p
+		},
+	}
+
+	res, err := KFile.CopyDirContext(context.Background(), src, des, opts)
+	if err != nil || res.Files == 0 || res.Bytes == 0 {
+		t.Error("CopyDirContext fail")
+		return
+	} else if last.DoneFiles != last.TotalFiles || last.DoneBytes != res.Bytes {
+		t.Error("CopyDirContext progress fail")
+		return
+	} else if KFile.IsExist(des + "/rsa/public_key.pem") {
+		t.Error("CopyDirContext exclude fail")
+		return
+	}
+
+	srcInfo, _ := os.Stat(src + "/dante.txt")
+	desInfo, _ := os.Stat(des + "/dante.txt")
+	if !srcInfo.ModTime().Equal(desInfo.ModTime()) || srcInfo.Mode() != desInfo.Mode() {
+		t.Error("CopyDirContext preserve mode/mtime fail")
+		return
+	}
+
+	//不覆盖
+	res, _ = KFile.CopyDirContext(context.Background(), src, des, &CopyDirOptions{Cover: FILE_COVER_IGNORE})
+	if res.Skipped == 0 || res.Files+res.Skipped != int64(len(KFile.FileTree(src, FILE_TREE_FILE, true))) {
+		t.Error("CopyDirContext FILE_COVER_IGNORE fail")
+		return
+	}
+
+	//禁止覆盖
+	res, _ = KFile.CopyDirContext(context.Background(), src, des, &CopyDirOptions{Cover: FILE_COVER_DENY})
+	if len(res.Errors) == 0 || res.Errors[0].Error() == "" {
+		t.Error("CopyDirContext FILE_COVER_DENY fail")
+		return
+	}
+
+	//包含过滤
+	includes := []FileFilter{func(fpath string) bool {
+		return strings.HasSuffix(fpath, ".txt")
+	}}
+	res, _ = KFile.CopyDirContext(context.Background(), src, des+"2", &CopyDirOptions{Includes: includes})
+	if res.Files != 1 {
+		t.Error("CopyDirContext include fail")
+		return
+	}
+
+	//取消
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = KFile.CopyDirContext(ctx, src, des+"3", nil)
+	if err == nil {
+		t.Error("CopyDirContext cancel fail")
+		return
+	}
+
+	//nil选项等同零值,不覆盖
+	res, _ = KFile.CopyDirContext(context.Background(), src, des, nil)
+	if res.Files != 0 || res.Skipped == 0 {
+		t.Error("CopyDirContext nil options fail")
+		return
+	}
+
+	//拷贝中途取消,不残留目标文件
+	big, _ := ts.Dir("big-*")
+	_ = KFile.WriteFile(big+"/big.dat", make([]byte, 1024*1024))
+	ctx, cancel = context.WithCancel(context.Background())
+	res, err = KFile.CopyDirContext(ctx, big, ts.Join("bigcopy"), &CopyDirOptions{
+		Workers: 1,
+		Progress: func(p CopyProgress) {
+			if p.DoneBytes > 0 {
+				cancel()
+			}
+		},
+	})
+	if err == nil || len(res.Errors) != 1 || KFile.IsExist(ts.Join("bigcopy/big.dat")) {
+		t.Error("CopyDirContext cancel partial fail")
+		return
+	}
+
+	_, _ = KFile.CopyDirContext(context.Background(), "./hello", des, nil)
+	_, _ = KFile.CopyDirContext(context.Background(), "./file.go", des, nil)
+	_, _ = KFile.CopyDirContext(nil, src, ts.Join("tdir"), nil)
+}
+
+func BenchmarkCopyDirContext(b *testing.B) {
+	ts, _ := KOS.NewTempSpace(nil)
+	defer func() {
+		_ = ts.Close()
+	}()
+
+	b.ResetTimer()
+	src := "./testdata"
+	des := ""
+	for i := 0; i < b.N; i++ {
+		des = ts.Join(fmt.Sprintf("copyctx_%d", i))
+		_, _ = KFile.CopyDirContext(context.Background(), src, des, nil)
+	}
+}
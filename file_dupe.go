@@ -0,0 +1,272 @@
+package kgo
+
+import (
+	"crypto/md5"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"runtime"
+	"sort"
+	"sync"
+	"syscall"
+	"time"
+)
+
+// FindDupesOptions 重复文件查找选项
+type FindDupesOptions struct {
+	MinSize   int64         // 最小文件大小,小于该值的文件不参与比较;默认1,即忽略空文件
+	Workers   int           // 并发计算散列的协程数,默认为CPU核数
+	BlockSize int64         // 部分散列读取的首尾块大小,默认4096
+	ShaX      uint16        // 完整散列的算法,为1/256/512,默认256
+	Filters   []FileFilter  // 文件过滤器,须全部通过才参与比较
+	Action    LkkDupeAction // 对每组中除保留文件外的副本的处理动作,默认DUPE_ACTION_NONE
+}
+
+// DuplicateGroup 重复文件组
+type DuplicateGroup struct {
+	Size      int64    `json:"size"`      // 单个文件的大小
+	Hash      string   `json:"hash"`      // 完整的shaX散列值
+	Files     []string `json:"files"`     // 内容相同的文件路径,已排序;第一个为保留的文件
+	Reclaimed int64    `json:"reclaimed"` // 执行处理动作后释放的字节数
+	Errors    []error  `json:"-"`         // 执行处理动作失败的错误
+}
+
+// dupeFile 参与比较的文件
+type dupeFile struct {
+	path  string
+	size  int64
+	mtime time.Time
+	hash  string
+}
+
+// FindDuplicates 按内容查找root目录下的重复文件,返回重复文件组.
+// 先按文件大小分组,再比较首尾块的部分散列,最后比较完整的shaX散列;散列由有限数量的协程并发计算.
+// 互为硬链接的路径视为同一文件;无法读取的文件将被忽略.
+// opts.Action不为DUPE_ACTION_NONE时,对每组中除第一个文件外的副本执行硬链接替换或删除;
+// 执行前会检查副本的大小和修改时间,已变化的副本将被跳过,错误记录在组的Errors中.
+func (kf *LkkFile) FindDuplicates(root string, opts *FindDupesOptions) ([]*DuplicateGroup, error) {
+	if opts == nil {
+		opts = &FindDupesOptions{}
+	}
+	o := *opts
+	if o.MinSize <= 0 {
+		o.MinSize = 1
+	}
+	if o.Workers <= 0 {
+		o.Workers = runtime.NumCPU()
+	}
+	if o.BlockSize <= 0 {
+		o.BlockSize = 4096
+	}
+	if o.ShaX == 0 {
+		o.ShaX = 256
+	} else if o.ShaX != 1 && o.ShaX != 256 && o.ShaX != 512 {
+		return nil, fmt.Errorf("Unsupported shaX: %d", o.ShaX)
+	}
+
+	rootInfo, err := os.Stat(root)
+	if err != nil {
+		return nil, err
+	} else if !rootInfo.IsDir() {
+		return nil, fmt.Errorf("%s is not a directory", root)
+	}
+
+	// 按大小分组,同一inode只保留一个路径
+	bySize := make(map[int64][]*dupeFile)
+	inodes := make(map[[2]uint64]bool)
+	err = filepath.Walk(root, func(fpath string, info os.FileInfo, err error) error {
+		if err != nil {
+			if info != nil && info.IsDir() {
+				return filepath.SkipDir
+			}
+			return nil
+		} else if !info.Mode().IsRegular() || info.Size() < o.MinSize {
+			return nil
+		}
+
+		for _, filter := range o.Filters {
+			if !filter(fpath) {
+				return nil
+			}
+		}
+
+		if st, ok := info.Sys().(*syscall.Stat_t); ok {
+			key := [2]uint64{uint64(st.Dev), st.Ino}
+			if inodes[key] {
+				return nil
+			}
+			inodes[key] = true
+		}
+
+		bySize[info.Size()] = append(bySize[info.Size()], &dupeFile{path: fpath, size: info.Size(), mtime: info.ModTime()})
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var candidates [][]*dupeFile
+	for _, files := range bySize {
+		if len(files) > 1 {
+			candidates = append(candidates, files)
+		}
+	}
+
+	// 部分散列
+	candidates = regroupDupes(candidates, o.Workers, func(df *dupeFile) (string, error) {
+		return partialHash(df.path, df.size, o.BlockSize)
+	})
+
+	// 完整散列
+	candidates = regroupDupes(candidates, o.Workers, func(df *dupeFile) (string, error) {
+		return kf.ShaX(df.path, o.ShaX)
+	})
+
+	res := make([]*DuplicateGroup, 0, len(candidates))
+	for _, files := range candidates {
+		sort.Slice(files, func(i, j int) bool {
+			return files[i].path < files[j].path
+		})
+
+		group := &DuplicateGroup{Size: files[0].size, Hash: files[0].hash}
+		for _, df := range files {
+			group.Files = append(group.Files, df.path)
+		}
+		if o.Action != DUPE_ACTION_NONE {
+			applyDupeAction(group, files, o.Action)
+		}
+		res = append(res, group)
+	}
+
+	sort.Slice(res, func(i, j int) bool {
+		if res[i].Size != res[j].Size {
+			return res[i].Size > res[j].Size
+		}
+		return res[i].Files[0] < res[j].Files[0]
+	})
+
+	return res, nil
+}
+
+// regroupDupes 并发计算各组文件的散列,按散列重新分组,并丢弃不足2个文件的组.
+func regroupDupes(groups [][]*dupeFile, workers int, hashFn func(*dupeFile) (string, error)) [][]*dupeFile {
+	var files []*dupeFile
+	for _, group := range groups {
+		files = append(files, group...)
+	}
+
+	hashes := make([]string, len(files))
+	failed := make([]bool, len(files))
+	jobs := make(chan int)
+	var wg sync.WaitGroup
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for idx := range jobs {
+				var err error
+				if hashes[idx], err = hashFn(files[idx]); err != nil {
+					failed[idx] = true
+				}
+			}
+		}()
+	}
+	for i := range files {
+		jobs <- i
+	}
+	close(jobs)
+	wg.Wait()
+
+	var res [][]*dupeFile
+	idx := 0
+	for _, group := range groups {
+		byHash := make(map[string][]*dupeFile)
+		var order []string
+		for _, df := range group {
+			if !failed[idx] {
+				if _, ok := byHash[hashes[idx]]; !ok {
+					order = append(order, hashes[idx])
+				}
+				df.hash = hashes[idx]
+				byHash[df.hash] = append(byHash[df.hash], df)
+			}
+			idx++
+		}
+
+		for _, hash := range order {
+			if len(byHash[hash]) > 1 {
+				res = append(res, byHash[hash])
+			}
+		}
+	}
+
+	return res
+}
+
+// partialHash 计算文件首尾块的md5值.
+func partialHash(fpath string, size, blockSize int64) (string, error) {
+	f, err := os.Open(fpath)
+	if err != nil {
+		return "", err
+	}
+	defer func() {
+		_ = f.Close()
+	}()
+
+	hash := md5.New()
+	if _, err = io.CopyN(hash, f, blockSize); err != nil && err != io.EOF {
+		return "", err
+	}
+
+	if size > blockSize {
+		offset := size - blockSize
+		if offset < blockSize {
+			offset = blockSize
+		}
+		if _, err = f.Seek(offset, io.SeekStart); err != nil {
+			return "", err
+		} else if _, err = io.CopyN(hash, f, blockSize); err != nil && err != io.EOF {
+			return "", err
+		}
+	}
+
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+// applyDupeAction 对重复文件组中除第一个文件外的副本执行处理动作.
+func applyDupeAction(group *DuplicateGroup, files []*dupeFile, action LkkDupeAction) {
+	keep := files[0].path
+	for _, df := range files[1:] {
+		info, err := os.Lstat(df.path)
+		if err != nil {
+			group.Errors = append(group.Errors, err)
+			continue
+		} else if !info.Mode().IsRegular() || info.Size() != df.size || !info.ModTime().Equal(df.mtime) {
+			group.Errors = append(group.Errors, fmt.Errorf("File %s changed since scan", df.path))
+			continue
+		}
+
+		switch action {
+		case DUPE_ACTION_HARDLINK:
+			// 先在同目录建立临时链接再重命名覆盖,保证副本路径始终可用
+			tmp := df.path + ".kgo-dupe"
+			if err = os.Link(keep, tmp); err == nil {
+				if err = os.Rename(tmp, df.path); err != nil {
+					_ = os.Remove(tmp)
+				}
+			}
+		case DUPE_ACTION_DELETE:
+			err = os.Remove(df.path)
+		default:
+			err = fmt.Errorf("Unknown dupe action: %d", action)
+		}
+
+		if err != nil {
+			group.Errors = append(group.Errors, err)
+		} else {
+			group.Reclaimed += df.size
+		}
+	}
+}
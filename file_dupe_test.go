@@ -0,0 +1,104 @@
+package kgo
+
+import (
+	"bytes"
+	"os"
+	"strings"
+	"testing"
+)
+
+func TestFindDuplicates(t *testing.T) {
+	ts, _ := KOS.NewTempSpace(nil)
+	defer func() {
+		_ = ts.Close()
+	}()
+
+	dir, _ := ts.Dir("dupes-*")
+	_ = os.MkdirAll(dir+"/sub", 0755)
+
+	big := bytes.Repeat([]byte("kakuilan"), 2048)
+	diffMid := make([]byte, len(big))
+	copy(diffMid, big)
+	diffMid[len(big)/2] = 'x'
+
+	_ = KFile.WriteFile(dir+"/a.txt", []byte("hello world"))
+	_ = KFile.WriteFile(dir+"/b.txt", []byte("hello world"))
+	_ = KFile.WriteFile(dir+"/sub/c.txt", []byte("hello world"))
+	_ = KFile.WriteFile(dir+"/d.txt", []byte("hello WORLD"))
+	_ = KFile.WriteFile(dir+"/big1.dat", big)
+	_ = KFile.WriteFile(dir+"/sub/big2.dat", big)
+	_ = KFile.WriteFile(dir+"/big3.dat", diffMid)
+	_ = KFile.WriteFile(dir+"/empty1", []byte{})
+	_ = KFile.WriteFile(dir+"/empty2", []byte{})
+	_ = os.Link(dir+"/a.txt", dir+"/sub/a_link.txt")
+
+	groups, err := KFile.FindDuplicates(dir, &FindDupesOptions{Workers: 2, BlockSize: 64})
+	if err != nil || len(groups) != 2 {
+		t.Error("FindDuplicates fail")
+		return
+	} else if groups[0].Size != int64(len(big)) || len(groups[0].Files) != 2 || groups[0].Hash == "" {
+		t.Error("FindDuplicates big group fail")
+		return
+	} else if len(groups[1].Files) != 3 || !strings.HasSuffix(groups[1].Files[0], "a.txt") {
+		t.Error("FindDuplicates small group fail")
+		return
+	}
+
+	//过滤
+	filters := []FileFilter{func(fpath string) bool {
+		return strings.HasSuffix(fpath, ".dat")
+	}}
+	groups, _ = KFile.FindDuplicates(dir, &FindDupesOptions{Filters: filters, ShaX: 512})
+	if len(groups) != 1 || len(groups[0].Hash) != 128 {
+		t.Error("FindDuplicates filter fail")
+		return
+	}
+
+	//硬链接
+	txtFilters := []FileFilter{func(fpath string) bool {
+		return strings.HasSuffix(fpath, ".txt")
+	}}
+	groups, _ = KFile.FindDuplicates(dir, &FindDupesOptions{Filters: txtFilters, Action: DUPE_ACTION_HARDLINK})
+	if len(groups) != 1 || groups[0].Reclaimed != 22 || len(groups[0].Errors) != 0 {
+		t.Error("FindDuplicates hardlink fail")
+		return
+	}
+	info1, _ := os.Stat(dir + "/a.txt")
+	info2, _ := os.Stat(dir + "/sub/c.txt")
+	if !os.SameFile(info1, info2) {
+		t.Error("FindDuplicates hardlink fail")
+		return
+	}
+
+	//删除
+	groups, _ = KFile.FindDuplicates(dir, &FindDupesOptions{Action: DUPE_ACTION_DELETE})
+	if len(groups) != 1 || groups[0].Reclaimed != int64(len(big)) || KFile.IsExist(dir+"/sub/big2.dat") {
+		t.Error("FindDuplicates delete fail")
+		return
+	}
+
+	groups, _ = KFile.FindDuplicates(dir, nil)
+	if len(groups) != 0 {
+		t.Error("FindDuplicates fail")
+		return
+	}
+
+	_, err = KFile.FindDuplicates(dir, &FindDupesOptions{ShaX: 3})
+	if err == nil {
+		t.Error("FindDuplicates shaX fail")
+		return
+	}
+	_, err = KFile.FindDuplicates("./file.go", nil)
+	if err == nil {
+		t.Error("FindDuplicates not dir fail")
+		return
+	}
+	_, _ = KFile.FindDuplicates("./hello", nil)
+}
+
+func BenchmarkFindDuplicates(b *testing.B) {
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, _ = KFile.FindDuplicates("./testdata", nil)
+	}
+}
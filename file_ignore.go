@@ -0,0 +1,291 @@
+package kgo
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"sync"
+)
+
+// IgnoreSet gitignore风格的忽略模式集,支持"**"、"!"取反、"/"结尾仅匹配目录及以"/"锚定的路径
+type IgnoreSet struct {
+	mu    sync.RWMutex
+	root  string
+	rules []ignoreRule
+}
+
+// ignoreRule 一条忽略规则
+type ignoreRule struct {
+	base     string         // 规则所属的目录,相对于根目录,以"/"分隔,根目录为空
+	negate   bool           // 是否为取反规则,即重新包含
+	dirOnly  bool           // 是否仅匹配目录
+	anchored bool           // 是否匹配相对于base的完整路径;否则仅匹配文件名
+	re       *regexp.Regexp // 模式的正则
+}
+
+// NewIgnoreSet 创建gitignore风格的忽略模式集.root为模式所相对的根目录,patterns为根目录下的模式行.
+func (kf *LkkFile) NewIgnoreSet(root string, patterns ...string) *IgnoreSet {
+	is := &IgnoreSet{root: kf.AbsPath(root)}
+	is.Add("", patterns...)
+	return is
+}
+
+// LoadIgnoreFiles 读取root目录及其子目录中名为name的忽略文件(默认".gitignore"),创建忽略模式集.
+// 子目录中的规则仅作用于该子目录且优先于上级规则;被忽略的目录中的忽略文件不会读取.
+// 与git一致,同时读取".git/info/exclude"并总是忽略".git"目录.
+func (kf *LkkFile) LoadIgnoreFiles(root string, name ...string) (*IgnoreSet, error) {
+	fname := ".gitignore"
+	if len(name) > 0 && name[0] != "" {
+		fname = name[0]
+	}
+
+	is := kf.NewIgnoreSet(root, ".git")
+	if err := is.AddFile("", filepath.Join(is.root, ".git", "info", "exclude")); err != nil && !os.IsNotExist(err) {
+		return nil, err
+	}
+
+	err := filepath.Walk(is.root, func(fpath string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		} else if !info.IsDir() {
+			return nil
+		} else if fpath != is.root && is.Match(fpath, true) {
+			return filepath.SkipDir
+		}
+
+		rel, _ := filepath.Rel(is.root, fpath)
+		if rel == "." {
+			rel = ""
+		}
+		if err := is.AddFile(filepath.ToSlash(rel), filepath.Join(fpath, fname)); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return is, nil
+}
+
+// Root 获取模式集的根目录.
+func (is *IgnoreSet) Root() string {
+	return is.root
+}
+
+// Add 添加模式行.base为模式所属的目录,相对于根目录,为空表示根目录.
+// 空行及"#"开头的注释行被忽略,"\#"、"\!"用于转义.
+func (is *IgnoreSet) Add(base string, patterns ...string) {
+	base = strings.Trim(filepath.ToSlash(base), "/")
+	if base == "." {
+		base = ""
+	}
+
+	var rules []ignoreRule
+	for _, line := range patterns {
+		if rule, ok := parseIgnoreLine(base, line); ok {
+			rules = append(rules, rule)
+		}
+	}
+
+	is.mu.Lock()
+	is.rules = append(is.rules, rules...)
+	is.mu.Unlock()
+}
+
+// AddFile 读取忽略文件fpath中的模式行并添加.base同Add.
+func (is *IgnoreSet) AddFile(base string, fpath string) error {
+	file, err := os.Open(fpath)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = file.Close()
+	}()
+
+	var lines []string
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		lines = append(lines, scanner.Text())
+	}
+	if err = scanner.Err(); err != nil {
+		return fmt.Errorf("read %s: %w", fpath, err)
+	}
+
+	is.Add(base, lines...)
+	return nil
+}
+
+// Match 检查路径是否被忽略;isDir为该路径是否为目录.
+// fpath可为绝对路径或相对于当前目录的路径,不在根目录下时返回false.
+// 与git一致,上级目录被忽略时其中的文件也被忽略,且无法被取反规则重新包含.
+func (is *IgnoreSet) Match(fpath string, isDir bool) bool {
+	rel, ok := is.relPath(fpath)
+	if !ok || rel == "" {
+		return false
+	}
+
+	is.mu.RLock()
+	defer is.mu.RUnlock()
+
+	//逐级检查上级目录
+	for i := 0; i < len(rel); i++ {
+		if rel[i] == '/' && is.matchRel(rel[:i], true) {
+			return true
+		}
+	}
+
+	return is.matchRel(rel, isDir)
+}
+
+// IsIgnored 检查文件或目录是否被忽略,根据实际文件判断是否为目录.
+// 可作为CopyDirOptions.Excludes等排除过滤器使用.
+func (is *IgnoreSet) IsIgnored(fpath string) bool {
+	info, err := os.Lstat(fpath)
+	return is.Match(fpath, err == nil && info.IsDir())
+}
+
+// Filter 获取文件过滤器,路径未被忽略时返回true;可用于FileTree、TarGzWithFilter、ZipWithFilter等.
+func (is *IgnoreSet) Filter() FileFilter {
+	return func(fpath string) bool {
+		return !is.IsIgnored(fpath)
+	}
+}
+
+// relPath 获取相对于根目录的路径,以"/"分隔.
+func (is *IgnoreSet) relPath(fpath string) (string, bool) {
+	abs, err := filepath.Abs(fpath)
+	if err != nil {
+		return "", false
+	}
+
+	rel, err := filepath.Rel(is.root, abs)
+	if err != nil || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return "", false
+	} else if rel == "." {
+		return "", true
+	}
+
+	return filepath.ToSlash(rel), true
+}
+
+// matchRel 按规则检查单个相对路径,最后一条匹配的规则决定结果.
+func (is *IgnoreSet) matchRel(rel string, isDir bool) bool {
+	for i := len(is.rules) - 1; i >= 0; i-- {
+		rule := is.rules[i]
+		if rule.dirOnly && !isDir {
+			continue
+		}
+
+		sub := rel
+		if rule.base != "" {
+			if !strings.HasPrefix(rel, rule.base+"/") {
+				continue
+			}
+			sub = rel[len(rule.base)+1:]
+		}
+		if !rule.anchored {
+			sub = sub[strings.LastIndexByte(sub, '/')+1:]
+		}
+
+		if rule.re.MatchString(sub) {
+			return !rule.negate
+		}
+	}
+
+	return false
+}
+
+// parseIgnoreLine 解析一行gitignore模式.
+func parseIgnoreLine(base, line string) (ignoreRule, bool) {
+	rule := ignoreRule{base: base}
+
+	//去掉未转义的行尾空格
+	line = strings.TrimRight(line, "\r")
+	for strings.HasSuffix(line, " ") && !strings.HasSuffix(line, "\\ ") {
+		line = line[:len(line)-1]
+	}
+	if line == "" || strings.HasPrefix(line, "#") {
+		return rule, false
+	}
+
+	if strings.HasPrefix(line, "!") {
+		rule.negate = true
+		line = line[1:]
+	} else if strings.HasPrefix(line, "\\!") || strings.HasPrefix(line, "\\#") {
+		line = line[1:]
+	}
+
+	if strings.HasSuffix(line, "/") {
+		rule.dirOnly = true
+		line = strings.TrimRight(line, "/")
+	}
+	if strings.Contains(line, "/") {
+		//含有"/"的模式相对于所属目录
+		rule.anchored = true
+		line = strings.TrimPrefix(line, "/")
+	}
+	if line == "" {
+		return rule, false
+	}
+
+	re, err := regexp.Compile("^" + ignoreGlobToRegexp(line) + "$")
+	if err != nil {
+		return rule, false
+	}
+	rule.re = re
+
+	return rule, true
+}
+
+// ignoreGlobToRegexp 将gitignore的通配模式转换为正则.
+func ignoreGlobToRegexp(pattern string) string {
+	var sb strings.Builder
+	for i := 0; i < len(pattern); i++ {
+		c := pattern[i]
+		switch c {
+		case '*':
+			if strings.HasPrefix(pattern[i:], "**") && (i == 0 || pattern[i-1] == '/') && (i+2 == len(pattern) || pattern[i+2] == '/') {
+				if i+2 == len(pattern) {
+					//结尾的"**"匹配其中的一切
+					sb.WriteString(".*")
+				} else {
+					//"**/"匹配零或多级目录
+					sb.WriteString("(?:.*/)?")
+					i++
+				}
+				i++
+			} else {
+				sb.WriteString("[^/]*")
+			}
+		case '?':
+			sb.WriteString("[^/]")
+		case '[':
+			end := strings.IndexByte(pattern[i+1:], ']')
+			if end == -1 {
+				sb.WriteString(`\[`)
+				continue
+			}
+			class := pattern[i+1 : i+1+end]
+			if strings.HasPrefix(class, "!") {
+				class = "^" + class[1:]
+			}
+			sb.WriteString("[" + strings.Replace(class, `\`, `\\`, -1) + "]")
+			i += end + 1
+		case '\\':
+			if i+1 < len(pattern) {
+				i++
+				c = pattern[i]
+			}
+			sb.WriteString(regexp.QuoteMeta(string(c)))
+		default:
+			sb.WriteString(regexp.QuoteMeta(string(c)))
+		}
+	}
+
+	return sb.String()
+}
@@ -0,0 +1,229 @@
+package kgo
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"compress/gzip"
+	"context"
+	"os"
+	"sort"
+	"strings"
+	"testing"
+)
+
+// makeIgnoreTree 生成带有.gitignore的测试目录.
+func makeIgnoreTree(root string) {
+	_ = os.RemoveAll(root)
+	files := map[string]string{
+		".gitignore":       "*.log\n!keep.log\nbuild/\n/top.txt\ndocs/**/*.tmp\n\\#hash\n# comment\nspace.txt   \n",
+		"a.txt":            "a",
+		"b.log":            "b",
+		"keep.log":         "k",
+		"top.txt":          "t",
+		"space.txt":        "s",
+		"#hash":            "h",
+		"sub/top.txt":      "t",
+		"sub/b.log":        "b",
+		"sub/c.md":         "c",
+		"sub/build":        "file named build",
+		"sub/.gitignore":   "*.txt\n!b.log\n",
+		"build/x.txt":      "x",
+		"build/.gitignore": "!x.txt\n",
+		"docs/c.tmp":       "c",
+		"docs/a/b/c.tmp":   "c",
+		"docs/a/b/c.md":    "c",
+		".git/config":      "[core]",
+	}
+	for name, content := range files {
+		_ = KFile.WriteFile(root+"/"+name, []byte(content))
+	}
+}
+
+func TestIgnoreSet(t *testing.T) {
+	ts, _ := KOS.NewTempSpace(nil)
+	defer func() {
+		_ = ts.Close()
+	}()
+
+	root := ts.Join("repo")
+	makeIgnoreTree(root)
+
+	is, err := KFile.LoadIgnoreFiles(root)
+	if err != nil || is.Root() != KFile.AbsPath(root) {
+		t.Error("LoadIgnoreFiles fail")
+		return
+	}
+
+	var tests = []struct {
+		name     string
+		expected bool
+	}{
+		{"a.txt", false},
+		{"b.log", true},
+		{"keep.log", false},
+		{"top.txt", true},
+		{"space.txt", true},
+		{"#hash", true},
+		{".gitignore", false},
+		{"sub/top.txt", true},
+		{"sub/b.log", false},
+		{"sub/c.md", false},
+		{"sub/build", false},
+		{"build", true},
+		{"build/x.txt", true},
+		{"docs/c.tmp", true},
+		{"docs/a/b/c.tmp", true},
+		{"docs/a/b/c.md", false},
+		{".git", true},
+		{".git/config", true},
+	}
+	for _, test := range tests {
+		if res := is.IsIgnored(root + "/" + test.name); res != test.expected {
+			t.Errorf("Expected IsIgnored(%s) to be %v, got %v", test.name, test.expected, res)
+			return
+		}
+	}
+
+	if is.Match("/tmp/outside.log", false) || is.Match(root, true) {
+		t.Error("IgnoreSet Match outside fail")
+		return
+	}
+
+	//手动添加规则
+	set := KFile.NewIgnoreSet(root, "**/b", "a/**", "!a/keep", "x?.[!a-c]", "[abc].go")
+	var matches = []struct {
+		name     string
+		isDir    bool
+		expected bool
+	}{
+		{"b", false, true},
+		{"x/y/b", true, true},
+		{"a", true, false},
+		{"a/z/1.txt", false, true},
+		{"a/keep", false, false},
+		{"x1.d", false, true},
+		{"x1.a", false, false},
+		{"a.go", false, true},
+		{"d.go", false, false},
+	}
+	for _, test := range matches {
+		if res := set.Match(root+"/"+test.name, test.isDir); res != test.expected {
+			t.Errorf("Expected Match(%s) to be %v, got %v", test.name, test.expected, res)
+			return
+		}
+	}
+	set.Add("sub", "c.md")
+	if !set.Match(root+"/sub/c.md", false) || set.Match(root+"/c.md", false) {
+		t.Error("IgnoreSet Add base fail")
+		return
+	}
+	if err = set.AddFile("", root+"/hello"); err == nil {
+		t.Error("IgnoreSet AddFile not exist fail")
+		return
+	}
+
+	_, err = KFile.LoadIgnoreFiles(ts.Join("hello"))
+	if err == nil {
+		t.Error("LoadIgnoreFiles not exist fail")
+		return
+	}
+}
+
+func TestIgnoreSetFilter(t *testing.T) {
+	ts, _ := KOS.NewTempSpace(nil)
+	defer func() {
+		_ = ts.Close()
+	}()
+
+	root := ts.Join("filter")
+	makeIgnoreTree(root)
+	is, _ := KFile.LoadIgnoreFiles(root)
+	expected := []string{".gitignore", "a.txt", "docs/a/b/c.md", "keep.log", "sub/.gitignore", "sub/b.log", "sub/build", "sub/c.md"}
+
+	//FileTree
+	var names []string
+	for _, fpath := range KFile.FileTree(root, FILE_TREE_FILE, true, is.Filter()) {
+		names = append(names, strings.TrimPrefix(fpath, root+"/"))
+	}
+	sort.Strings(names)
+	if strings.Join(names, ",") != strings.Join(expected, ",") {
+		t.Errorf("FileTree with IgnoreSet fail: %v", names)
+		return
+	}
+
+	//CopyDirContext
+	dst := ts.Join("copy")
+	res, err := KFile.CopyDirContext(context.Background(), root, dst, &CopyDirOptions{Excludes: []FileFilter{is.IsIgnored}})
+	if err != nil || res.Files != int64(len(expected)) || KFile.IsExist(dst+"/build") || !KFile.IsFile(dst+"/sub/b.log") {
+		t.Error("CopyDirContext with IgnoreSet fail")
+		return
+	}
+
+	//TarGzWithFilter
+	tarPath := ts.Join("repo.tar.gz")
+	_, err = KFile.TarGzWithFilter(root, tarPath, is.Filter())
+	if err != nil {
+		t.Error("TarGzWithFilter fail")
+		return
+	}
+	file, _ := os.Open(tarPath)
+	gr, _ := gzip.NewReader(file)
+	tr := tar.NewReader(gr)
+	names = nil
+	for {
+		hdr, err := tr.Next()
+		if err != nil {
+			break
+		}
+		if hdr.Typeflag == tar.TypeReg {
+			names = append(names, strings.TrimPrefix(hdr.Name, "filter/"))
+		}
+	}
+	_ = file.Close()
+	sort.Strings(names)
+	if strings.Join(names, ",") != strings.Join(expected, ",") {
+		t.Errorf("TarGzWithFilter entries fail: %v", names)
+		return
+	}
+
+	//ZipWithFilter
+	zipPath := ts.Join("repo.zip")
+	_, err = KFile.ZipWithFilter(zipPath, []string{root, root + "/b.log"}, is.Filter())
+	if err != nil {
+		t.Error("ZipWithFilter fail")
+		return
+	}
+	zr, _ := zip.OpenReader(zipPath)
+	names = nil
+	for _, f := range zr.File {
+		names = append(names, strings.TrimPrefix(f.Name, strings.TrimPrefix(root, "/")+"/"))
+	}
+	_ = zr.Close()
+	sort.Strings(names)
+	if strings.Join(names, ",") != strings.Join(expected, ",") {
+		t.Errorf("ZipWithFilter entries fail: %v", names)
+		return
+	}
+}
+
+func BenchmarkIgnoreSetMatch(b *testing.B) {
+	is := KFile.NewIgnoreSet("./", "*.log", "!keep.log", "build/", "docs/**/*.tmp")
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = is.Match("./docs/a/b/c.tmp", false)
+	}
+}
+
+func BenchmarkLoadIgnoreFiles(b *testing.B) {
+	ts, _ := KOS.NewTempSpace(nil)
+	defer func() {
+		_ = ts.Close()
+	}()
+
+	root := ts.Join("bench")
+	makeIgnoreTree(root)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, _ = KFile.LoadIgnoreFiles(root)
+	}
+}
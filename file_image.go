@@ -0,0 +1,786 @@
+package kgo
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"image"
+	"image/draw"
+	"image/gif"
+	"image/jpeg"
+	"image/png"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ImageInfo 图片的基本信息
+type ImageInfo struct {
+	Format string     `json:"format"`         // 格式,为png/jpeg/gif/bmp/webp/svg
+	Width  int        `json:"width"`          // 宽度(像素)
+	Height int        `json:"height"`         // 高度(像素)
+	Exif   *ImageExif `json:"exif,omitempty"` // EXIF信息,仅jpeg且含有EXIF时不为nil
+}
+
+// ImageExif 图片的主要EXIF信息
+type ImageExif struct {
+	Orientation int       `json:"orientation"`        // 方向,1-8,为0时未设置
+	Make        string    `json:"make,omitempty"`     // 相机厂商
+	Model       string    `json:"model,omitempty"`    // 相机型号
+	Software    string    `json:"software,omitempty"` // 处理软件
+	DateTime    time.Time `json:"datetime"`           // 拍摄时间,优先取DateTimeOriginal
+	HasGPS      bool      `json:"has_gps"`            // 是否含有GPS位置信息
+}
+
+// ImageOptions 图片缩放及格式转换选项
+type ImageOptions struct {
+	Width     int         // 目标宽度,为0时按高度等比计算
+	Height    int         // 目标高度,为0时按宽度等比计算;宽高都为0时保持原尺寸
+	Fit       LkkImageFit // 缩放方式,默认IMAGE_FIT_CONTAIN
+	Enlarge   bool        // 是否放大小于目标尺寸的图片,默认不放大
+	Format    string      // 输出格式,为png/jpeg/gif;为空时根据目标文件的扩展名,否则与原图相同
+	Quality   int         // jpeg质量,1-100,默认85
+	MaxPixels int64       // 允许解码的最大像素数(宽*高),为0时使用ImageMaxPixels
+}
+
+// ImageMaxPixels 缩放及转换图片时默认允许解码的最大像素数,防止声明了超大尺寸的图片耗尽内存
+var ImageMaxPixels int64 = 50000000
+
+var (
+	// ErrUnknownImage 无法识别的图片格式
+	ErrUnknownImage = errors.New("Unknown image format")
+	// ErrImageTooLarge 图片像素数超过限制
+	ErrImageTooLarge = errors.New("Image pixels exceed limit")
+)
+
+// EXIF标签
+const (
+	exifTagMake             = 0x010F
+	exifTagModel            = 0x0110
+	exifTagOrientation      = 0x0112
+	exifTagSoftware         = 0x0131
+	exifTagDateTime         = 0x0132
+	exifTagExifIFD          = 0x8769
+	exifTagGPSIFD           = 0x8825
+	exifTagDateTimeOriginal = 0x9003
+)
+
+// ImageInfo 读取图片的格式、宽高,仅解析文件头而不解码整个图片;支持png/jpeg/gif/bmp/webp/svg.
+// jpeg图片还会读取其EXIF中的方向、相机、拍摄时间等信息.
+func (kf *LkkFile) ImageInfo(fpath string) (*ImageInfo, error) {
+	file, err := os.Open(fpath)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = file.Close()
+	}()
+
+	return readImageInfo(bufio.NewReader(file))
+}
+
+// readImageInfo 从reader中读取图片头信息.
+func readImageInfo(reader *bufio.Reader) (*ImageInfo, error) {
+	header, _ := reader.Peek(32)
+
+	switch {
+	case bytes.HasPrefix(header, []byte("\x89PNG\r\n\x1a\n")):
+		return pngInfo(header)
+	case bytes.HasPrefix(header, []byte("\xFF\xD8")):
+		return jpegInfo(reader)
+	case bytes.HasPrefix(header, []byte("GIF8")):
+		return gifInfo(header)
+	case bytes.HasPrefix(header, []byte("BM")):
+		return bmpInfo(header)
+	case bytes.HasPrefix(header, []byte("RIFF")) && len(header) >= 12 && string(header[8:12]) == "WEBP":
+		return webpInfo(header)
+	}
+
+	//svg为文本,读取前8KB解析
+	buf := make([]byte, mimeSniffLen)
+	n, _ := io.ReadFull(reader, buf)
+	if isSvgHeader(buf[:n]) {
+		return svgInfo(buf[:n])
+	}
+
+	return nil, ErrUnknownImage
+}
+
+// pngInfo 解析png的IHDR块.
+func pngInfo(header []byte) (*ImageInfo, error) {
+	if len(header) < 24 || string(header[12:16]) != "IHDR" {
+		return nil, ErrUnknownImage
+	}
+	return &ImageInfo{
+		Format: "png",
+		Width:  int(binary.BigEndian.Uint32(header[16:20])),
+		Height: int(binary.BigEndian.Uint32(header[20:24])),
+	}, nil
+}
+
+// gifInfo 解析gif的逻辑屏幕描述符.
+func gifInfo(header []byte) (*ImageInfo, error) {
+	if len(header) < 10 {
+		return nil, ErrUnknownImage
+	}
+	return &ImageInfo{
+		Format: "gif",
+		Width:  int(binary.LittleEndian.Uint16(header[6:8])),
+		Height: int(binary.LittleEndian.Uint16(header[8:10])),
+	}, nil
+}
+
+// bmpInfo 解析bmp的DIB头.
+func bmpInfo(header []byte) (*ImageInfo, error) {
+	if len(header) < 26 {
+		return nil, ErrUnknownImage
+	}
+
+	info := &ImageInfo{Format: "bmp"}
+	if binary.LittleEndian.Uint32(header[14:18]) == 12 {
+		//OS/2 BITMAPCOREHEADER
+		info.Width = int(binary.LittleEndian.Uint16(header[18:20]))
+		info.Height = int(binary.LittleEndian.Uint16(header[20:22]))
+	} else {
+		info.Width = int(int32(binary.LittleEndian.Uint32(header[18:22])))
+		info.Height = int(int32(binary.LittleEndian.Uint32(header[22:26])))
+	}
+
+	//高度为负时表示自上而下存储
+	if info.Height < 0 {
+		info.Height = -info.Height
+	}
+	return info, nil
+}
+
+// webpInfo 解析webp的VP8/VP8L/VP8X块.
+func webpInfo(header []byte) (*ImageInfo, error) {
+	if len(header) < 30 {
+		return nil, ErrUnknownImage
+	}
+
+	info := &ImageInfo{Format: "webp"}
+	switch string(header[12:16]) {
+	case "VP8 ":
+		info.Width = int(binary.LittleEndian.Uint16(header[26:28]) & 0x3FFF)
+		info.Height = int(binary.LittleEndian.Uint16(header[28:30]) & 0x3FFF)
+	case "VP8L":
+		bits := binary.LittleEndian.Uint32(header[21:25])
+		info.Width = int(bits&0x3FFF) + 1
+		info.Height = int((bits>>14)&0x3FFF) + 1
+	case "VP8X":
+		info.Width = int(uint32(header[24])|uint32(header[25])<<8|uint32(header[26])<<16) + 1
+		info.Height = int(uint32(header[27])|uint32(header[28])<<8|uint32(header[29])<<16) + 1
+	default:
+		return nil, ErrUnknownImage
+	}
+	return info, nil
+}
+
+// svgInfo 解析svg根元素的width/height或viewBox属性.
+func svgInfo(data []byte) (*ImageInfo, error) {
+	decoder := xml.NewDecoder(bytes.NewReader(data))
+	decoder.Strict = false
+	for {
+		token, err := decoder.Token()
+		if err != nil {
+			return nil, ErrUnknownImage
+		}
+
+		elem, ok := token.(xml.StartElement)
+		if !ok || elem.Name.Local != "svg" {
+			continue
+		}
+
+		info := &ImageInfo{Format: "svg"}
+		var viewBox []string
+		for _, attr := range elem.Attr {
+			switch attr.Name.Local {
+			case "width":
+				info.Width = svgLength(attr.Value)
+			case "height":
+				info.Height = svgLength(attr.Value)
+			case "viewBox":
+				viewBox = strings.Fields(strings.Replace(attr.Value, ",", " ", -1))
+			}
+		}
+		if (info.Width == 0 || info.Height == 0) && len(viewBox) == 4 {
+			info.Width = svgLength(viewBox[2])
+			info.Height = svgLength(viewBox[3])
+		}
+		return info, nil
+	}
+}
+
+// svgLength 解析svg的长度值,忽略px等单位;百分比等相对值返回0.
+func svgLength(val string) int {
+	val = strings.TrimSpace(val)
+	if strings.HasSuffix(val, "%") {
+		return 0
+	}
+	val = strings.TrimRight(val, "abcdefghijklmnopqrstuvwxyz")
+	num, err := strconv.ParseFloat(val, 64)
+	if err != nil {
+		return 0
+	}
+	return int(num + 0.5)
+}
+
+// jpegInfo 遍历jpeg的段,读取SOF中的宽高及APP1中的EXIF.
+func jpegInfo(reader *bufio.Reader) (*ImageInfo, error) {
+	info := &ImageInfo{Format: "jpeg"}
+	if _, err := reader.Discard(2); err != nil {
+		return nil, err
+	}
+
+	for {
+		marker, length, err := readJpegMarker(reader)
+		if err != nil {
+			return nil, ErrUnknownImage
+		}
+
+		switch {
+		case marker == 0xE1 && info.Exif == nil:
+			data := make([]byte, length)
+			if _, err = io.ReadFull(reader, data); err != nil {
+				return nil, ErrUnknownImage
+			}
+			if bytes.HasPrefix(data, []byte("Exif\x00\x00")) {
+				info.Exif, _ = parseExif(data[6:])
+			}
+		case marker >= 0xC0 && marker <= 0xCF && marker != 0xC4 && marker != 0xC8 && marker != 0xCC:
+			//SOF段:精度(1)、高(2)、宽(2)
+			data := make([]byte, 5)
+			if _, err = io.ReadFull(reader, data); err != nil {
+				return nil, ErrUnknownImage
+			}
+			info.Height = int(binary.BigEndian.Uint16(data[1:3]))
+			info.Width = int(binary.BigEndian.Uint16(data[3:5]))
+			return info, nil
+		case marker == 0xDA || marker == 0xD9:
+			return nil, ErrUnknownImage
+		default:
+			if _, err = reader.Discard(length); err != nil {
+				return nil, ErrUnknownImage
+			}
+		}
+	}
+}
+
+// readJpegMarker 读取jpeg段的标记及数据长度(不含长度字段本身).
+func readJpegMarker(reader *bufio.Reader) (byte, int, error) {
+	b, err := reader.ReadByte()
+	if err != nil {
+		return 0, 0, err
+	} else if b != 0xFF {
+		return 0, 0, ErrUnknownImage
+	}
+
+	//跳过填充的0xFF
+	marker := byte(0xFF)
+	for marker == 0xFF {
+		if marker, err = reader.ReadByte(); err != nil {
+			return 0, 0, err
+		}
+	}
+
+	//无数据的标记
+	if marker == 0x01 || (marker >= 0xD0 && marker <= 0xD9) {
+		return marker, 0, nil
+	}
+
+	var size [2]byte
+	if _, err = io.ReadFull(reader, size[:]); err != nil {
+		return 0, 0, err
+	}
+	length := int(binary.BigEndian.Uint16(size[:])) - 2
+	if length < 0 {
+		return 0, 0, ErrUnknownImage
+	}
+
+	return marker, length, nil
+}
+
+// parseExif 解析TIFF格式的EXIF数据.
+func parseExif(data []byte) (*ImageExif, error) {
+	if len(data) < 8 {
+		return nil, ErrUnknownImage
+	}
+
+	var order binary.ByteOrder
+	switch string(data[:2]) {
+	case "II":
+		order = binary.LittleEndian
+	case "MM":
+		order = binary.BigEndian
+	default:
+		return nil, ErrUnknownImage
+	}
+	if order.Uint16(data[2:4]) != 42 {
+		return nil, ErrUnknownImage
+	}
+
+	exif := &ImageExif{}
+	var dateTime, original string
+	var exifIFD uint32
+	walkExifIFD(data, order, order.Uint32(data[4:8]), func(tag uint16, typ uint16, count uint32, value []byte) {
+		switch tag {
+		case exifTagOrientation:
+			if typ == 3 && len(value) >= 2 {
+				exif.Orientation = int(order.Uint16(value))
+			}
+		case exifTagMake:
+			exif.Make = exifString(value)
+		case exifTagModel:
+			exif.Model = exifString(value)
+		case exifTagSoftware:
+			exif.Software = exifString(value)
+		case exifTagDateTime:
+			dateTime = exifString(value)
+		case exifTagExifIFD:
+			if len(value) >= 4 {
+				exifIFD = order.Uint32(value)
+			}
+		case exifTagGPSIFD:
+			exif.HasGPS = true
+		}
+	})
+
+	if exifIFD > 0 {
+		walkExifIFD(data, order, exifIFD, func(tag uint16, typ uint16, count uint32, value []byte) {
+			if tag == exifTagDateTimeOriginal {
+				original = exifString(value)
+			}
+		})
+	}
+
+	if original == "" {
+		original = dateTime
+	}
+	if t, err := time.ParseInLocation("2006:01:02 15:04:05", original, time.Local); err == nil {
+		exif.DateTime = t
+	}
+
+	return exif, nil
+}
+
+// walkExifIFD 遍历IFD中的条目,回调标签、类型、数量及值(值超过4字节时为偏移处的数据).
+func walkExifIFD(data []byte, order binary.ByteOrder, offset uint32, fn func(tag uint16, typ uint16, count uint32, value []byte)) {
+	typeSizes := map[uint16]uint32{1: 1, 2: 1, 3: 2, 4: 4, 5: 8, 7: 1, 9: 4, 10: 8}
+	if int(offset)+2 > len(data) {
+		return
+	}
+
+	num := int(order.Uint16(data[offset:]))
+	pos := int(offset) + 2
+	for i := 0; i < num && pos+12 <= len(data); i, pos = i+1, pos+12 {
+		tag := order.Uint16(data[pos:])
+		typ := order.Uint16(data[pos+2:])
+		count := order.Uint32(data[pos+4:])
+		size, ok := typeSizes[typ]
+		if !ok || count > uint32(len(data)) {
+			continue
+		}
+
+		total := size * count
+		value := data[pos+8 : pos+12]
+		if total > 4 {
+			start := order.Uint32(data[pos+8:])
+			if uint64(start)+uint64(total) > uint64(len(data)) {
+				continue
+			}
+			value = data[start : start+total]
+		} else {
+			value = value[:total]
+		}
+		fn(tag, typ, count, value)
+	}
+}
+
+// exifString 获取EXIF中的ASCII字符串.
+func exifString(value []byte) string {
+	if pos := bytes.IndexByte(value, 0); pos != -1 {
+		value = value[:pos]
+	}
+	return strings.TrimSpace(string(value))
+}
+
+// StripExif 移除jpeg图片中的EXIF和XMP元数据(APP1段),以保护隐私;结果写入dst,dst可与src相同.
+// 返回移除的字节数;非jpeg图片返回错误.
+func (kf *LkkFile) StripExif(src, dst string) (int, error) {
+	data, err := ioutil.ReadFile(src)
+	if err != nil {
+		return 0, err
+	} else if !bytes.HasPrefix(data, []byte("\xFF\xD8")) {
+		return 0, fmt.Errorf("%w: %s is not a jpeg", ErrUnknownImage, src)
+	}
+
+	res, removed, err := stripJpegApp1(data)
+	if err != nil {
+		return 0, err
+	}
+
+	perm := os.FileMode(0644)
+	if info, err := os.Stat(src); err == nil {
+		perm = info.Mode().Perm()
+	}
+	if err = kf.WriteFileAtomic(dst, res, false, perm); err != nil {
+		return 0, err
+	}
+
+	return removed, nil
+}
+
+// stripJpegApp1 移除jpeg数据中的APP1段,返回新数据及移除的字节数.
+func stripJpegApp1(data []byte) ([]byte, int, error) {
+	res := make([]byte, 0, len(data))
+	res = append(res, data[:2]...)
+	pos := 2
+	for pos < len(data) {
+		if data[pos] != 0xFF || pos+1 >= len(data) {
+			return nil, 0, ErrUnknownImage
+		}
+
+		marker := data[pos+1]
+		if marker == 0xFF {
+			pos++
+			continue
+		} else if marker == 0xDA || marker == 0xD9 {
+			//图像数据开始,其后原样保留
+			res = append(res, data[pos:]...)
+			break
+		} else if marker == 0x01 || (marker >= 0xD0 && marker <= 0xD7) {
+			res = append(res, data[pos:pos+2]...)
+			pos += 2
+			continue
+		}
+
+		if pos+4 > len(data) {
+			return nil, 0, ErrUnknownImage
+		}
+		end := pos + 2 + int(binary.BigEndian.Uint16(data[pos+2:]))
+		if end > len(data) {
+			return nil, 0, ErrUnknownImage
+		}
+		if marker != 0xE1 {
+			res = append(res, data[pos:end]...)
+		}
+		pos = end
+	}
+
+	return res, len(data) - len(res), nil
+}
+
+// ResizeImage 缩放图片src并保存为dst,支持png/jpeg/gif,可同时转换格式;会按EXIF方向自动旋转jpeg图片,转为jpeg时透明部分填充为白色.
+// opts为缩放选项,可为nil(仅转换格式及校正方向);图片像素数超过限制时返回ErrImageTooLarge,不会解码.
+func (kf *LkkFile) ResizeImage(src, dst string, opts *ImageOptions) error {
+	data, err := ioutil.ReadFile(src)
+	if err != nil {
+		return err
+	}
+
+	if opts == nil {
+		opts = &ImageOptions{}
+	}
+	format := opts.Format
+	if format == "" {
+		format = imageFormatByExt(dst)
+	}
+
+	res, _, err := transformImage(data, opts, format)
+	if err != nil {
+		return err
+	}
+
+	return kf.WriteFileAtomic(dst, res, false)
+}
+
+// ConvertImage 转换图片格式,format为png/jpeg/gif,为空时根据dst的扩展名;quality为jpeg质量,默认85.
+// 图片像素数超过ImageMaxPixels时返回ErrImageTooLarge.
+func (kf *LkkFile) ConvertImage(src, dst, format string, quality ...int) error {
+	opts := &ImageOptions{Format: format}
+	if len(quality) > 0 {
+		opts.Quality = quality[0]
+	}
+	return kf.ResizeImage(src, dst, opts)
+}
+
+// Img2ThumbBase64 生成图片的缩略图,并转换为base64字符串(data URI).
+// 缩略图等比缩放至width*height的框内,不放大,保持原格式;quality为jpeg质量,默认85.
+// 图片像素数超过ImageMaxPixels时返回ErrImageTooLarge.
+func (kf *LkkFile) Img2ThumbBase64(fpath string, width, height int, quality ...int) (string, error) {
+	data, err := ioutil.ReadFile(fpath)
+	if err != nil {
+		return "", err
+	}
+
+	opts := &ImageOptions{Width: width, Height: height}
+	if len(quality) > 0 {
+		opts.Quality = quality[0]
+	}
+
+	res, format, err := transformImage(data, opts, "")
+	if err != nil {
+		return "", err
+	}
+
+	return KStr.Img2Base64(res, format), nil
+}
+
+// imageFormatByExt 根据文件扩展名获取可编码的图片格式,不支持时返回空.
+func imageFormatByExt(fpath string) string {
+	switch KFile.MimeByExt(filepath.Ext(fpath)) {
+	case "image/png":
+		return "png"
+	case "image/jpeg":
+		return "jpeg"
+	case "image/gif":
+		return "gif"
+	default:
+		return ""
+	}
+}
+
+// transformImage 解码图片数据,校正方向、缩放后按format编码;format为空时与原图相同.
+// 返回编码后的数据及其格式.
+func transformImage(data []byte, opts *ImageOptions, format string) ([]byte, string, error) {
+	//先只读取头部的尺寸,以免解码声明了超大尺寸的图片
+	cfg, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return nil, "", fmt.Errorf("%w: %v", ErrUnknownImage, err)
+	}
+	maxPixels := opts.MaxPixels
+	if maxPixels <= 0 {
+		maxPixels = ImageMaxPixels
+	}
+	if int64(cfg.Width)*int64(cfg.Height) > maxPixels {
+		return nil, "", fmt.Errorf("%w: %dx%d", ErrImageTooLarge, cfg.Width, cfg.Height)
+	}
+
+	img, srcFormat, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, "", fmt.Errorf("%w: %v", ErrUnknownImage, err)
+	}
+
+	if srcFormat == "jpeg" {
+		if info, err := readImageInfo(bufio.NewReader(bytes.NewReader(data))); err == nil && info.Exif != nil {
+			img = orientImage(img, info.Exif.Orientation)
+		}
+	}
+	img = fitImage(img, opts)
+
+	if format == "" {
+		format = srcFormat
+	}
+	var buf bytes.Buffer
+	switch strings.ToLower(format) {
+	case "png":
+		format, err = "png", png.Encode(&buf, img)
+	case "jpeg", "jpg":
+		quality := opts.Quality
+		if quality <= 0 {
+			quality = 85
+		} else if quality > 100 {
+			quality = 100
+		}
+		format, err = "jpeg", jpeg.Encode(&buf, flattenImage(img), &jpeg.Options{Quality: quality})
+	case "gif":
+		format, err = "gif", gif.Encode(&buf, img, &gif.Options{NumColors: 256, Drawer: draw.FloydSteinberg})
+	default:
+		return nil, "", fmt.Errorf("Unsupported image format: %s", format)
+	}
+	if err != nil {
+		return nil, "", err
+	}
+
+	return buf.Bytes(), format, nil
+}
+
+// orientImage 按EXIF方向值(2-8)翻转或旋转图片,使其正向显示.
+func orientImage(img image.Image, orientation int) image.Image {
+	if orientation < 2 || orientation > 8 {
+		return img
+	}
+
+	b := img.Bounds()
+	w, h := b.Dx(), b.Dy()
+	dw, dh := w, h
+	if orientation >= 5 {
+		dw, dh = h, w
+	}
+
+	src := toRGBA(img, b)
+	dst := image.NewRGBA(image.Rect(0, 0, dw, dh))
+	for y := 0; y < h; y++ {
+		for x := 0; x < w; x++ {
+			var dx, dy int
+			switch orientation {
+			case 2:
+				dx, dy = w-1-x, y
+			case 3:
+				dx, dy = w-1-x, h-1-y
+			case 4:
+				dx, dy = x, h-1-y
+			case 5:
+				dx, dy = y, x
+			case 6:
+				dx, dy = h-1-y, x
+			case 7:
+				dx, dy = h-1-y, w-1-x
+			case 8:
+				dx, dy = y, w-1-x
+			}
+			sp, dp := src.PixOffset(x, y), dst.PixOffset(dx, dy)
+			copy(dst.Pix[dp:dp+4], src.Pix[sp:sp+4])
+		}
+	}
+
+	return dst
+}
+
+// fitImage 按选项计算目标尺寸并缩放图片.
+func fitImage(img image.Image, opts *ImageOptions) image.Image {
+	b := img.Bounds()
+	sw, sh := b.Dx(), b.Dy()
+	w, h := opts.Width, opts.Height
+	if (w <= 0 && h <= 0) || sw == 0 || sh == 0 {
+		return img
+	}
+
+	if w <= 0 {
+		w = scaleSize(sw, float64(h)/float64(sh))
+	} else if h <= 0 {
+		h = scaleSize(sh, float64(w)/float64(sw))
+	}
+
+	switch opts.Fit {
+	case IMAGE_FIT_STRETCH:
+		if !opts.Enlarge {
+			w, h = KNum.MinInt(w, sw), KNum.MinInt(h, sh)
+		}
+		return resizeImage(img, b, w, h)
+	case IMAGE_FIT_COVER:
+		scale := float64(w) / float64(sw)
+		if s := float64(h) / float64(sh); s > scale {
+			scale = s
+		}
+		if !opts.Enlarge && scale > 1 {
+			//原图较小时仅裁剪
+			scale = 1
+			w, h = KNum.MinInt(w, sw), KNum.MinInt(h, sh)
+		}
+
+		cw, ch := KNum.MinInt(scaleSize(w, 1/scale), sw), KNum.MinInt(scaleSize(h, 1/scale), sh)
+		x0, y0 := b.Min.X+(sw-cw)/2, b.Min.Y+(sh-ch)/2
+		return resizeImage(img, image.Rect(x0, y0, x0+cw, y0+ch), w, h)
+	default:
+		scale := float64(w) / float64(sw)
+		if s := float64(h) / float64(sh); s < scale {
+			scale = s
+		}
+		if !opts.Enlarge && scale > 1 {
+			scale = 1
+		}
+		return resizeImage(img, b, scaleSize(sw, scale), scaleSize(sh, scale))
+	}
+}
+
+// scaleSize 按比例计算尺寸,四舍五入且至少为1.
+func scaleSize(size int, scale float64) int {
+	res := int(float64(size)*scale + 0.5)
+	if res < 1 {
+		res = 1
+	}
+	return res
+}
+
+// flattenImage 将带透明通道的图片合成到白色背景上,用于不支持透明的jpeg编码.
+func flattenImage(img image.Image) image.Image {
+	if op, ok := img.(interface{ Opaque() bool }); ok && op.Opaque() {
+		return img
+	}
+
+	b := img.Bounds()
+	res := image.NewRGBA(image.Rect(0, 0, b.Dx(), b.Dy()))
+	draw.Draw(res, res.Bounds(), image.White, image.Point{}, draw.Src)
+	draw.Draw(res, res.Bounds(), img, b.Min, draw.Over)
+	return res
+}
+
+// toRGBA 将图片的rect区域复制为原点在(0,0)的RGBA图片.
+func toRGBA(img image.Image, rect image.Rectangle) *image.RGBA {
+	res := image.NewRGBA(image.Rect(0, 0, rect.Dx(), rect.Dy()))
+	draw.Draw(res, res.Bounds(), img, rect.Min, draw.Src)
+	return res
+}
+
+// resizeImage 将图片的rect区域缩放为w*h,使用区域平均(盒式滤波),先水平后垂直.
+func resizeImage(img image.Image, rect image.Rectangle, w, h int) *image.RGBA {
+	res := toRGBA(img, rect)
+	if w != rect.Dx() {
+		res = boxResample(res, w, rect.Dy(), true)
+	}
+	if h != rect.Dy() {
+		res = boxResample(res, w, h, false)
+	}
+	return res
+}
+
+// boxResample 在一个方向上将图片重采样为dw*dh,每个目标像素取其覆盖的源像素的平均值.
+func boxResample(src *image.RGBA, dw, dh int, horizontal bool) *image.RGBA {
+	srcLen, dstLen := src.Bounds().Dy(), dh
+	if horizontal {
+		srcLen, dstLen = src.Bounds().Dx(), dw
+	}
+
+	//每个目标像素对应的源像素区间[lo,hi)
+	ranges := make([][2]int, dstLen)
+	for i := range ranges {
+		lo := i * srcLen / dstLen
+		hi := ((i+1)*srcLen + dstLen - 1) / dstLen
+		if hi <= lo {
+			hi = lo + 1
+		}
+		if hi > srcLen {
+			hi = srcLen
+		}
+		ranges[i] = [2]int{lo, hi}
+	}
+
+	dst := image.NewRGBA(image.Rect(0, 0, dw, dh))
+	for y := 0; y < dh; y++ {
+		for x := 0; x < dw; x++ {
+			i := y
+			if horizontal {
+				i = x
+			}
+			rg := ranges[i]
+
+			var sum [4]uint32
+			for j := rg[0]; j < rg[1]; j++ {
+				p := src.PixOffset(x, j)
+				if horizontal {
+					p = src.PixOffset(j, y)
+				}
+				for c := 0; c < 4; c++ {
+					sum[c] += uint32(src.Pix[p+c])
+				}
+			}
+
+			n := uint32(rg[1] - rg[0])
+			d := dst.PixOffset(x, y)
+			for c := 0; c < 4; c++ {
+				dst.Pix[d+c] = uint8((sum[c] + n/2) / n)
+			}
+		}
+	}
+
+	return dst
+}
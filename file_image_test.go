@@ -0,0 +1,477 @@
+package kgo
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"hash/crc32"
+	"image"
+	"image/color"
+	"image/gif"
+	"image/jpeg"
+	"image/png"
+	"os"
+	"strings"
+	"testing"
+)
+
+// makeExifJpeg 生成含有EXIF的jpeg数据.
+func makeExifJpeg(width, height int, orientation uint16) []byte {
+	img := image.NewRGBA(image.Rect(0, 0, width, height))
+	for x := 0; x < width; x++ {
+		img.Set(x, 0, color.RGBA{R: 255, A: 255})
+	}
+	var buf bytes.Buffer
+	_ = jpeg.Encode(&buf, img, nil)
+
+	//TIFF:头(8) + IFD0(2+4*12+4) + ExifIFD(2+12+4) + 字符串
+	order := binary.BigEndian
+	tiff := make([]byte, 8+54+18)
+	copy(tiff, "MM\x00\x2A\x00\x00\x00\x08")
+	make1 := "KgoCam\x00"
+	date := "2020:05:06 07:08:09\x00"
+	makeOff := uint32(len(tiff))
+	tiff = append(tiff, make1...)
+	dateOff := uint32(len(tiff))
+	tiff = append(tiff, date...)
+
+	entry := func(pos int, tag, typ uint16, count, value uint32) {
+		order.PutUint16(tiff[pos:], tag)
+		order.PutUint16(tiff[pos+2:], typ)
+		order.PutUint32(tiff[pos+4:], count)
+		order.PutUint32(tiff[pos+8:], value)
+	}
+	order.PutUint16(tiff[8:], 4)
+	entry(10, exifTagMake, 2, uint32(len(make1)), makeOff)
+	entry(22, exifTagOrientation, 3, 1, uint32(orientation)<<16)
+	entry(34, exifTagExifIFD, 4, 1, 62)
+	entry(46, exifTagGPSIFD, 4, 1, 0)
+	order.PutUint16(tiff[62:], 1)
+	entry(64, exifTagDateTimeOriginal, 2, uint32(len(date)), dateOff)
+
+	app1 := append([]byte("Exif\x00\x00"), tiff...)
+	seg := []byte{0xFF, 0xE1, 0, 0}
+	order.PutUint16(seg[2:], uint16(len(app1)+2))
+	seg = append(seg, app1...)
+
+	data := buf.Bytes()
+	res := append([]byte{}, data[:2]...)
+	res = append(res, seg...)
+	return append(res, data[2:]...)
+}
+
+func TestImageInfo(t *testing.T) {
+	var tests = []struct {
+		fpath  string
+		format string
+	}{
+		{"./testdata/diglett.png", "png"},
+		{"./testdata/gopher10th-large.jpg", "jpeg"},
+		{"./testdata/gopher10th-small.jpg", "jpeg"},
+		{"./testdata/jetbrains.svg", "svg"},
+	}
+	for _, test := range tests {
+		info, err := KFile.ImageInfo(test.fpath)
+		if err != nil || info.Format != test.format || info.Width <= 0 || info.Height <= 0 {
+			t.Errorf("ImageInfo(%s) fail: %v %v", test.fpath, info, err)
+			return
+		}
+
+		//与标准库解码的结果比较
+		if test.format != "svg" {
+			file, _ := os.Open(test.fpath)
+			conf, _, _ := image.DecodeConfig(file)
+			_ = file.Close()
+			if conf.Width != info.Width || conf.Height != info.Height {
+				t.Errorf("ImageInfo(%s) size fail", test.fpath)
+				return
+			}
+		}
+	}
+
+	ts, _ := KOS.NewTempSpace(nil)
+	defer func() {
+		_ = ts.Close()
+	}()
+
+	dir, _ := ts.Dir("image-*")
+
+	var buf bytes.Buffer
+	_ = gif.Encode(&buf, image.NewPaletted(image.Rect(0, 0, 7, 5), color.Palette{color.Black}), nil)
+	_ = KFile.WriteFile(dir+"/a.gif", buf.Bytes())
+
+	bmp := make([]byte, 54)
+	copy(bmp, "BM")
+	binary.LittleEndian.PutUint32(bmp[14:], 40)
+	binary.LittleEndian.PutUint32(bmp[18:], 12)
+	binary.LittleEndian.PutUint32(bmp[22:], uint32(0xFFFFFFF6)) //-10
+	_ = KFile.WriteFile(dir+"/a.bmp", bmp)
+
+	webp := []byte("RIFF\x00\x00\x00\x00WEBPVP8X\x0A\x00\x00\x00\x00\x00\x00\x00\x1F\x00\x00\x0F\x00\x00")
+	_ = KFile.WriteFile(dir+"/a.webp", webp)
+	webpl := []byte("RIFF\x00\x00\x00\x00WEBPVP8L\x00\x00\x00\x00\x2F")
+	webpl = append(webpl, 0, 0, 0, 0, 0, 0, 0, 0, 0)
+	binary.LittleEndian.PutUint32(webpl[21:], uint32(99)|uint32(49)<<14)
+	_ = KFile.WriteFile(dir+"/b.webp", webpl)
+
+	_ = KFile.WriteFile(dir+"/a.svg", []byte(`<?xml version="1.0"?><svg xmlns="http://www.w3.org/2000/svg" viewBox="0 0 120.5 60"></svg>`))
+	_ = KFile.WriteFile(dir+"/b.svg", []byte(`<svg width="300px" height="150px"></svg>`))
+	_ = KFile.WriteFile(dir+"/exif.jpg", makeExifJpeg(16, 8, 6))
+	_ = KFile.WriteFile(dir+"/a.txt", []byte("hello world"))
+
+	var sizes = []struct {
+		fpath  string
+		format string
+		width  int
+		height int
+	}{
+		{dir + "/a.gif", "gif", 7, 5},
+		{dir + "/a.bmp", "bmp", 12, 10},
+		{dir + "/a.webp", "webp", 32, 16},
+		{dir + "/b.webp", "webp", 100, 50},
+		{dir + "/a.svg", "svg", 121, 60},
+		{dir + "/b.svg", "svg", 300, 150},
+		{dir + "/exif.jpg", "jpeg", 16, 8},
+	}
+	for _, test := range sizes {
+		info, err := KFile.ImageInfo(test.fpath)
+		if err != nil || info.Format != test.format || info.Width != test.width || info.Height != test.height {
+			t.Errorf("Expected ImageInfo(%s) to be %s %dx%d, got %v %v", test.fpath, test.format, test.width, test.height, info, err)
+			return
+		}
+	}
+
+	info, _ := KFile.ImageInfo(dir + "/exif.jpg")
+	if info.Exif == nil || info.Exif.Orientation != 6 || info.Exif.Make != "KgoCam" || !info.Exif.HasGPS ||
+		info.Exif.DateTime.Format("2006-01-02 15:04:05") != "2020-05-06 07:08:09" {
+		t.Errorf("ImageInfo exif fail: %+v", info.Exif)
+		return
+	}
+
+	_, err := KFile.ImageInfo(dir + "/a.txt")
+	if err != ErrUnknownImage {
+		t.Error("ImageInfo unknown fail")
+		return
+	}
+	_, err = KFile.ImageInfo("./hello")
+	if err == nil {
+		t.Error("ImageInfo not exist fail")
+		return
+	}
+}
+
+func TestStripExif(t *testing.T) {
+	ts, _ := KOS.NewTempSpace(nil)
+	defer func() {
+		_ = ts.Close()
+	}()
+
+	dir, _ := ts.Dir("image-*")
+	src := dir + "/strip.jpg"
+	dst := dir + "/stripped.jpg"
+	_ = KFile.WriteFile(src, makeExifJpeg(16, 8, 3))
+
+	removed, err := KFile.StripExif(src, dst)
+	if err != nil || removed <= 0 {
+		t.Error("StripExif fail")
+		return
+	}
+
+	info, err := KFile.ImageInfo(dst)
+	if err != nil || info.Exif != nil || info.Width != 16 || info.Height != 8 {
+		t.Error("StripExif result fail")
+		return
+	}
+
+	//结果仍可被解码
+	file, _ := os.Open(dst)
+	_, err = jpeg.Decode(file)
+	_ = file.Close()
+	if err != nil {
+		t.Error("StripExif decode fail")
+		return
+	}
+
+	//原地移除
+	removed, err = KFile.StripExif(dst, dst)
+	if err != nil || removed != 0 {
+		t.Error("StripExif in place fail")
+		return
+	}
+
+	_, err = KFile.StripExif("./testdata/diglett.png", dst)
+	if err == nil {
+		t.Error("StripExif png fail")
+		return
+	}
+	_, err = KFile.StripExif("./hello", dst)
+	if err == nil {
+		t.Error("StripExif not exist fail")
+		return
+	}
+}
+
+// makeHugePng 生成头部声明为width*height的1像素png数据.
+func makeHugePng(width, height uint32) []byte {
+	var buf bytes.Buffer
+	_ = png.Encode(&buf, image.NewGray(image.Rect(0, 0, 1, 1)))
+	data := buf.Bytes()
+
+	//IHDR:长度(4)+类型(4)+宽(4)+高(4)+...+CRC,CRC覆盖类型和数据
+	binary.BigEndian.PutUint32(data[16:], width)
+	binary.BigEndian.PutUint32(data[20:], height)
+	binary.BigEndian.PutUint32(data[29:], crc32.ChecksumIEEE(data[12:29]))
+	return data
+}
+
+func TestResizeImage(t *testing.T) {
+	ts, _ := KOS.NewTempSpace(nil)
+	defer func() {
+		_ = ts.Close()
+	}()
+
+	dir, _ := ts.Dir("image-*")
+	src := "./testdata/gopher10th-large.jpg"
+	info, _ := KFile.ImageInfo(src)
+
+	var tests = []struct {
+		opts   *ImageOptions
+		dst    string
+		format string
+		width  int
+		height int
+	}{
+		{&ImageOptions{Width: 100, Height: 100}, dir + "/contain.jpg", "jpeg", 100, scaleSize(info.Height, 100/float64(info.Width))},
+		{&ImageOptions{Width: 100, Height: 100, Fit: IMAGE_FIT_COVER}, dir + "/cover.png", "png", 100, 100},
+		{&ImageOptions{Width: 100, Height: 30, Fit: IMAGE_FIT_STRETCH}, dir + "/stretch.gif", "gif", 100, 30},
+		{&ImageOptions{Height: 50}, dir + "/height.jpg", "jpeg", scaleSize(info.Width, 50/float64(info.Height)), 50},
+		{&ImageOptions{Width: 50, Format: "png", Quality: 200}, dir + "/format.jpg", "png", 50, scaleSize(info.Height, 50/float64(info.Width))},
+		{&ImageOptions{Width: info.Width * 2}, dir + "/noenlarge.jpg", "jpeg", info.Width, info.Height},
+		{&ImageOptions{Width: info.Width * 2, Enlarge: true}, dir + "/enlarge.jpg", "jpeg", info.Width * 2, info.Height * 2},
+		{&ImageOptions{Width: info.Width * 2, Height: 20, Fit: IMAGE_FIT_COVER}, dir + "/crop.jpg", "jpeg", info.Width, 20},
+	}
+	for _, test := range tests {
+		err := KFile.ResizeImage(src, test.dst, test.opts)
+		res, _ := KFile.ImageInfo(test.dst)
+		if err != nil || res == nil || res.Format != test.format || res.Width != test.width || res.Height != test.height {
+			t.Errorf("Expected ResizeImage(%s) to be %s %dx%d, got %v %v", test.dst, test.format, test.width, test.height, res, err)
+			return
+		}
+	}
+
+	//按EXIF方向旋转
+	_ = KFile.WriteFile(dir+"/orient.jpg", makeExifJpeg(16, 8, 6))
+	err := KFile.ResizeImage(dir+"/orient.jpg", dir+"/oriented.png", nil)
+	res, _ := KFile.ImageInfo(dir + "/oriented.png")
+	if err != nil || res.Width != 8 || res.Height != 16 {
+		t.Errorf("ResizeImage orientation fail: %v %v", res, err)
+		return
+	}
+	for i := 2; i <= 8; i++ {
+		img := orientImage(image.NewRGBA(image.Rect(0, 0, 4, 2)), i)
+		if (i >= 5 && img.Bounds().Dx() != 2) || (i < 5 && img.Bounds().Dx() != 4) {
+			t.Errorf("orientImage %d fail", i)
+			return
+		}
+	}
+
+	err = KFile.ResizeImage("./testdata/jetbrains.svg", dir+"/svg.png", nil)
+	if err == nil {
+		t.Error("ResizeImage svg fail")
+		return
+	}
+	err = KFile.ResizeImage(src, dir+"/unknown.bmp", nil)
+	if err != nil {
+		t.Error("ResizeImage keep format fail")
+		return
+	}
+	err = KFile.ResizeImage(src, dir+"/a.out", &ImageOptions{Format: "tiff"})
+	if err == nil {
+		t.Error("ResizeImage unsupported format fail")
+		return
+	}
+	err = KFile.ResizeImage("./hello", dir+"/hello.png", nil)
+	if err == nil {
+		t.Error("ResizeImage not exist fail")
+		return
+	}
+
+	//像素数限制
+	_ = KFile.WriteFile(dir+"/huge.png", makeHugePng(100000, 100000))
+	err = KFile.ResizeImage(dir+"/huge.png", dir+"/huge.jpg", nil)
+	if !errors.Is(err, ErrImageTooLarge) || KFile.IsExist(dir+"/huge.jpg") {
+		t.Error("ResizeImage pixel limit fail")
+		return
+	}
+	err = KFile.ResizeImage(src, dir+"/limit.jpg", &ImageOptions{MaxPixels: 100})
+	if !errors.Is(err, ErrImageTooLarge) {
+		t.Error("ResizeImage MaxPixels fail")
+		return
+	}
+	_, err = KFile.Img2ThumbBase64(dir+"/huge.png", 32, 32)
+	if !errors.Is(err, ErrImageTooLarge) {
+		t.Error("Img2ThumbBase64 pixel limit fail")
+		return
+	}
+}
+
+func TestConvertImage(t *testing.T) {
+	ts, _ := KOS.NewTempSpace(nil)
+	defer func() {
+		_ = ts.Close()
+	}()
+
+	dir, _ := ts.Dir("image-*")
+
+	err := KFile.ConvertImage("./testdata/diglett.png", dir+"/diglett.jpg", "", 60)
+	info, _ := KFile.ImageInfo(dir + "/diglett.jpg")
+	if err != nil || info.Format != "jpeg" {
+		t.Error("ConvertImage fail")
+		return
+	}
+
+	err = KFile.ConvertImage(dir+"/diglett.jpg", dir+"/diglett.out", "gif")
+	info, _ = KFile.ImageInfo(dir + "/diglett.out")
+	if err != nil || info.Format != "gif" {
+		t.Error("ConvertImage format fail")
+		return
+	}
+}
+
+func TestConvertImageAlpha(t *testing.T) {
+	ts, _ := KOS.NewTempSpace(nil)
+	defer func() {
+		_ = ts.Close()
+	}()
+
+	//透明的png转为jpeg时以白色为背景
+	img := image.NewNRGBA(image.Rect(0, 0, 16, 16))
+	for x := 0; x < 16; x++ {
+		for y := 0; y < 8; y++ {
+			img.Set(x, y, color.NRGBA{R: 255, A: 255})
+		}
+	}
+	var buf bytes.Buffer
+	_ = png.Encode(&buf, img)
+	src, _ := ts.WriteFile("alpha-*.png", buf.Bytes())
+	dst := ts.Join("alpha.jpg")
+
+	err := KFile.ConvertImage(src, dst, "jpeg", 100)
+	data, _ := KFile.ReadFile(dst)
+	res, derr := jpeg.Decode(bytes.NewReader(data))
+	if err != nil || derr != nil {
+		t.Error("ConvertImage alpha fail")
+		return
+	}
+	r, g, b, _ := res.At(8, 12).RGBA()
+	if r>>8 < 250 || g>>8 < 250 || b>>8 < 250 {
+		t.Error("ConvertImage alpha background fail")
+		return
+	}
+	r, g, b, _ = res.At(8, 2).RGBA()
+	if r>>8 < 200 || g>>8 > 80 || b>>8 > 80 {
+		t.Error("ConvertImage alpha color fail")
+		return
+	}
+}
+
+func TestImg2ThumbBase64(t *testing.T) {
+	res, err := KFile.Img2ThumbBase64("./testdata/gopher10th-large.jpg", 64, 64, 70)
+	if err != nil || !strings.HasPrefix(res, "data:image/jpeg;base64,") {
+		t.Error("Img2ThumbBase64 fail")
+		return
+	}
+	full, _ := KFile.Img2Base64("./testdata/gopher10th-large.jpg")
+	if len(res) >= len(full) {
+		t.Error("Img2ThumbBase64 size fail")
+		return
+	}
+
+	res, err = KFile.Img2ThumbBase64("./testdata/diglett.png", 32, 32)
+	if err != nil || !strings.HasPrefix(res, "data:image/png;base64,") {
+		t.Error("Img2ThumbBase64 png fail")
+		return
+	}
+
+	_, err = KFile.Img2ThumbBase64("./testdata/dante.txt", 32, 32)
+	if err == nil {
+		t.Error("Img2ThumbBase64 not image fail")
+		return
+	}
+	_, err = KFile.Img2ThumbBase64("./hello", 32, 32)
+	if err == nil {
+		t.Error("Img2ThumbBase64 not exist fail")
+		return
+	}
+}
+
+func BenchmarkImageInfo(b *testing.B) {
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, _ = KFile.ImageInfo("./testdata/gopher10th-large.jpg")
+	}
+}
+
+func BenchmarkStripExif(b *testing.B) {
+	ts, _ := KOS.NewTempSpace(nil)
+	defer func() {
+		_ = ts.Close()
+	}()
+
+	dir, _ := ts.Dir("image-*")
+	src := dir + "/bench.jpg"
+	_ = KFile.WriteFile(src, makeExifJpeg(16, 8, 1))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, _ = KFile.StripExif(src, dir+"/bench_out.jpg")
+	}
+}
+
+func BenchmarkResizeImage(b *testing.B) {
+	ts, _ := KOS.NewTempSpace(nil)
+	defer func() {
+		_ = ts.Close()
+	}()
+
+	dir, _ := ts.Dir("image-*")
+	opts := &ImageOptions{Width: 100, Height: 100, Fit: IMAGE_FIT_COVER}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = KFile.ResizeImage("./testdata/gopher10th-large.jpg", dir+"/bench_resize.jpg", opts)
+	}
+}
+
+func BenchmarkConvertImage(b *testing.B) {
+	ts, _ := KOS.NewTempSpace(nil)
+	defer func() {
+		_ = ts.Close()
+	}()
+
+	dir, _ := ts.Dir("image-*")
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = KFile.ConvertImage("./testdata/diglett.png", dir+"/bench_convert.jpg", "")
+	}
+}
+
+func BenchmarkConvertImageAlpha(b *testing.B) {
+	ts, _ := KOS.NewTempSpace(nil)
+	defer func() {
+		_ = ts.Close()
+	}()
+
+	var buf bytes.Buffer
+	_ = png.Encode(&buf, image.NewNRGBA(image.Rect(0, 0, 64, 64)))
+	src, _ := ts.WriteFile("alpha-*.png", buf.Bytes())
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = KFile.ConvertImage(src, ts.Join("alpha.jpg"), "jpeg")
+	}
+}
+
+func BenchmarkImg2ThumbBase64(b *testing.B) {
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, _ = KFile.Img2ThumbBase64("./testdata/gopher10th-large.jpg", 64, 64)
+	}
+}
@@ -0,0 +1,290 @@
+package kgo
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"io"
+	"os"
+	"runtime"
+	"sync"
+)
+
+// LineReader 流式逐行读取器,每次只在内存中保留一行
+type LineReader struct {
+	file    *os.File
+	scanner *bufio.Scanner
+	num     int
+	err     error
+}
+
+// ChunkOptions 分块处理选项
+type ChunkOptions struct {
+	ChunkSize int64 // 每块的大约字节数,实际在其后的第一个换行处切分,默认4MB
+	Workers   int   // 并发处理的协程数,默认为CPU核数
+	Ordered   bool  // 是否按块在文件中的顺序返回结果
+}
+
+// FileChunk 文件块,包含完整的若干行
+type FileChunk struct {
+	Index  int    // 块序号,从0开始
+	Offset int64  // 块在文件中的起始位置
+	Data   []byte // 块数据
+}
+
+// ChunkResult 文件块的处理结果
+type ChunkResult struct {
+	Index  int         // 块序号
+	Offset int64       // 块在文件中的起始位置
+	Value  interface{} // 处理函数的返回值
+	Err    error       // 处理函数返回的错误,或读取该块时的错误
+}
+
+// ChunkFunc 文件块处理函数
+type ChunkFunc func(chunk *FileChunk) (interface{}, error)
+
+// chunkJob 分块处理任务
+type chunkJob struct {
+	chunk *FileChunk
+	err   error
+}
+
+// ErrLineTooLong 行的长度超出限制
+var ErrLineTooLong = errors.New("Line too long")
+
+// NewLineReader 创建文件的流式逐行读取器,使用完毕须调用Close.
+// maxLineSize为单行的最大字节数,默认1MB;超出时停止读取,Err返回ErrLineTooLong.
+func (kf *LkkFile) NewLineReader(fpath string, maxLineSize ...int) (*LineReader, error) {
+	file, err := os.Open(fpath)
+	if err != nil {
+		return nil, err
+	}
+
+	maxSize := 1048576
+	if len(maxLineSize) > 0 && maxLineSize[0] > 0 {
+		maxSize = maxLineSize[0]
+	}
+
+	bufSize := 65536
+	if maxSize < bufSize {
+		bufSize = maxSize
+	}
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(make([]byte, bufSize), maxSize)
+
+	return &LineReader{file: file, scanner: scanner}, nil
+}
+
+// ReadLines 逐行读取文件并回调fn,不会将整个文件读入内存.
+// fn的参数为行号(从1开始)和行内容(不包括换行符);fn返回错误时停止读取并返回该错误.
+// maxLineSize为单行的最大字节数,默认1MB.
+func (kf *LkkFile) ReadLines(fpath string, fn func(num int, line string) error, maxLineSize ...int) error {
+	lr, err := kf.NewLineReader(fpath, maxLineSize...)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = lr.Close()
+	}()
+
+	for lr.Next() {
+		if err = fn(lr.LineNum(), lr.Text()); err != nil {
+			return err
+		}
+	}
+
+	return lr.Err()
+}
+
+// Next 读取下一行,到达文件末尾或出错时返回false.
+func (lr *LineReader) Next() bool {
+	if lr.err != nil {
+		return false
+	}
+
+	if lr.scanner.Scan() {
+		lr.num++
+		return true
+	}
+
+	if err := lr.scanner.Err(); err == bufio.ErrTooLong {
+		lr.err = ErrLineTooLong
+	} else {
+		lr.err = err
+	}
+	return false
+}
+
+// Text 获取当前行的内容,不包括换行符.
+func (lr *LineReader) Text() string {
+	return lr.scanner.Text()
+}
+
+// Bytes 获取当前行的内容,不包括换行符;返回的切片在下次调用Next后失效.
+func (lr *LineReader) Bytes() []byte {
+	return lr.scanner.Bytes()
+}
+
+// LineNum 获取当前行号,从1开始.
+func (lr *LineReader) LineNum() int {
+	return lr.num
+}
+
+// Err 获取读取过程中的错误,正常到达文件末尾时为nil.
+func (lr *LineReader) Err() error {
+	return lr.err
+}
+
+// Close 关闭文件.
+func (lr *LineReader) Close() error {
+	return lr.file.Close()
+}
+
+// ProcessChunks 将文件在换行处切分为若干块,由多个协程并发调用fn处理,通过通道返回结果.
+// opts为分块选项,可为nil;opts.Ordered为true时按块的顺序返回结果,否则按完成的顺序返回.
+// 同时在内存中的块不超过协程数的2倍;ctx结束时停止处理并关闭结果通道.
+func (kf *LkkFile) ProcessChunks(ctx context.Context, fpath string, opts *ChunkOptions, fn ChunkFunc) (<-chan *ChunkResult, error) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	if opts == nil {
+		opts = &ChunkOptions{}
+	}
+	chunkSize := opts.ChunkSize
+	if chunkSize <= 0 {
+		chunkSize = 4 * 1048576
+	}
+	workers := opts.Workers
+	if workers <= 0 {
+		workers = runtime.NumCPU()
+	}
+
+	file, err := os.Open(fpath)
+	if err != nil {
+		return nil, err
+	}
+
+	sem := make(chan struct{}, workers*2)
+	jobs := make(chan chunkJob)
+	raw := make(chan *ChunkResult)
+	out := make(chan *ChunkResult, workers)
+
+	go readChunks(ctx, file, chunkSize, sem, jobs)
+
+	var wg sync.WaitGroup
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for job := range jobs {
+				res := &ChunkResult{Err: job.err}
+				if job.chunk != nil {
+					res.Index = job.chunk.Index
+					res.Offset = job.chunk.Offset
+					if job.err == nil {
+						res.Value, res.Err = fn(job.chunk)
+					}
+				}
+
+				select {
+				case raw <- res:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}()
+	}
+	go func() {
+		wg.Wait()
+		close(raw)
+	}()
+
+	go func() {
+		defer close(out)
+		emit := func(res *ChunkResult) bool {
+			select {
+			case out <- res:
+				<-sem
+				return true
+			case <-ctx.Done():
+				return false
+			}
+		}
+
+		next := 0
+		pending := make(map[int]*ChunkResult)
+		for res := range raw {
+			if !opts.Ordered {
+				if !emit(res) {
+					return
+				}
+				continue
+			}
+
+			pending[res.Index] = res
+			for {
+				item, ok := pending[next]
+				if !ok {
+					break
+				}
+				delete(pending, next)
+				next++
+				if !emit(item) {
+					return
+				}
+			}
+		}
+	}()
+
+	return out, nil
+}
+
+// readChunks 读取文件并在换行处切分为块,发送到jobs.
+func readChunks(ctx context.Context, file *os.File, chunkSize int64, sem chan struct{}, jobs chan<- chunkJob) {
+	defer func() {
+		close(jobs)
+		_ = file.Close()
+	}()
+
+	reader := bufio.NewReaderSize(file, 65536)
+	var offset int64
+	for index := 0; ; index++ {
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+			return
+		}
+
+		buf := make([]byte, chunkSize)
+		n, err := io.ReadFull(reader, buf)
+		buf = buf[:n]
+		if err == nil {
+			//补齐到行尾
+			var rest []byte
+			rest, err = reader.ReadBytes('\n')
+			buf = append(buf, rest...)
+		}
+
+		eof := err == io.EOF || err == io.ErrUnexpectedEOF
+		if eof {
+			err = nil
+		}
+
+		if len(buf) == 0 && err == nil {
+			<-sem
+			return
+		}
+
+		job := chunkJob{chunk: &FileChunk{Index: index, Offset: offset, Data: buf}, err: err}
+		select {
+		case jobs <- job:
+		case <-ctx.Done():
+			return
+		}
+		offset += int64(len(buf))
+
+		if eof || err != nil {
+			return
+		}
+	}
+}
@@ -0,0 +1,212 @@
+package kgo
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestLineReader(t *testing.T) {
+	ts, _ := KOS.NewTempSpace(nil)
+	defer func() {
+		_ = ts.Close()
+	}()
+
+	fpath := ts.Join("data.csv")
+
+	var sb strings.Builder
+	for i := 1; i <= 1000; i++ {
+		sb.WriteString(fmt.Sprintf("%d,name%d,value%d\r\n", i, i, i))
+	}
+	_ = KFile.WriteFile(fpath, []byte(sb.String()))
+
+	lr, err := KFile.NewLineReader(fpath)
+	if err != nil {
+		t.Error("NewLineReader fail")
+		return
+	}
+	var last string
+	for lr.Next() {
+		last = lr.Text()
+		if len(lr.Bytes()) != len(last) {
+			t.Error("LineReader Bytes fail")
+			return
+		}
+	}
+	if lr.Err() != nil || lr.LineNum() != 1000 || last != "1000,name1000,value1000" {
+		t.Error("LineReader fail")
+		return
+	}
+	_ = lr.Close()
+
+	//超长行
+	_ = KFile.WriteFile(fpath+".long", []byte("short\n"+strings.Repeat("x", 100)+"\nshort\n"))
+	lr, _ = KFile.NewLineReader(fpath+".long", 50)
+	for lr.Next() {
+	}
+	if lr.Err() != ErrLineTooLong || lr.LineNum() != 1 || lr.Next() {
+		t.Error("LineReader max line fail")
+		return
+	}
+	_ = lr.Close()
+
+	_, err = KFile.NewLineReader("./hello")
+	if err == nil {
+		t.Error("NewLineReader not exist fail")
+		return
+	}
+}
+
+func TestReadLines(t *testing.T) {
+	var num int
+	err := KFile.ReadLines("./testdata/dante.txt", func(n int, line string) error {
+		num = n
+		return nil
+	})
+	lines, _ := KFile.CountLines("./testdata/dante.txt", 0)
+	if err != nil || num != lines {
+		t.Error("ReadLines fail")
+		return
+	}
+
+	stop := errors.New("stop")
+	err = KFile.ReadLines("./testdata/dante.txt", func(n int, line string) error {
+		num = n
+		if n == 3 {
+			return stop
+		}
+		return nil
+	})
+	if err != stop || num != 3 {
+		t.Error("ReadLines stop fail")
+		return
+	}
+
+	err = KFile.ReadLines("./hello", func(n int, line string) error {
+		return nil
+	})
+	if err == nil {
+		t.Error("ReadLines not exist fail")
+		return
+	}
+}
+
+func TestProcessChunks(t *testing.T) {
+	ts, _ := KOS.NewTempSpace(nil)
+	defer func() {
+		_ = ts.Close()
+	}()
+
+	fpath := ts.Join("chunks.log")
+
+	var sb strings.Builder
+	for i := 0; i < 5000; i++ {
+		sb.WriteString(fmt.Sprintf("line %d %s\n", i, strings.Repeat("z", i%37)))
+	}
+	content := sb.String()
+	_ = KFile.WriteFile(fpath, []byte(content))
+
+	countFn := func(chunk *FileChunk) (interface{}, error) {
+		if len(chunk.Data) > 0 && chunk.Data[len(chunk.Data)-1] != '\n' {
+			return nil, errors.New("chunk not end with newline")
+		}
+		return bytes.Count(chunk.Data, []byte{'\n'}), nil
+	}
+
+	//有序
+	results, err := KFile.ProcessChunks(context.Background(), fpath, &ChunkOptions{ChunkSize: 1000, Workers: 4, Ordered: true}, func(chunk *FileChunk) (interface{}, error) {
+		if _, err := countFn(chunk); err != nil {
+			return nil, err
+		}
+		return len(chunk.Data), nil
+	})
+	if err != nil {
+		t.Error("ProcessChunks fail")
+		return
+	}
+	var next int
+	var offset int64
+	for res := range results {
+		if res.Err != nil || res.Index != next || res.Offset != offset {
+			t.Error("ProcessChunks ordered fail")
+			return
+		}
+		next++
+		offset += int64(res.Value.(int))
+	}
+	if offset != int64(len(content)) || next < 2 {
+		t.Error("ProcessChunks ordered size fail")
+		return
+	}
+
+	//逐块校验偏移与内容
+	data := []byte(content)
+	seen := make(map[int]bool)
+	results, _ = KFile.ProcessChunks(context.Background(), fpath, &ChunkOptions{ChunkSize: 1000, Workers: 3}, func(chunk *FileChunk) (interface{}, error) {
+		if !bytes.Equal(data[chunk.Offset:chunk.Offset+int64(len(chunk.Data))], chunk.Data) {
+			return nil, errors.New("offset mismatch")
+		}
+		return countFn(chunk)
+	})
+	total := 0
+	for res := range results {
+		if res.Err != nil || seen[res.Index] {
+			t.Error("ProcessChunks unordered fail")
+			return
+		}
+		seen[res.Index] = true
+		total += res.Value.(int)
+	}
+	if total != 5000 {
+		t.Error("ProcessChunks total fail")
+		return
+	}
+
+	//处理错误
+	results, _ = KFile.ProcessChunks(nil, fpath, nil, func(chunk *FileChunk) (interface{}, error) {
+		return nil, errors.New("fail")
+	})
+	for res := range results {
+		if res.Err == nil {
+			t.Error("ProcessChunks fn error fail")
+			return
+		}
+	}
+
+	//取消
+	ctx, cancel := context.WithCancel(context.Background())
+	results, _ = KFile.ProcessChunks(ctx, fpath, &ChunkOptions{ChunkSize: 100, Workers: 2}, countFn)
+	<-results
+	cancel()
+	for range results {
+	}
+
+	_, err = KFile.ProcessChunks(context.Background(), "./hello", nil, countFn)
+	if err == nil {
+		t.Error("ProcessChunks not exist fail")
+		return
+	}
+}
+
+func BenchmarkReadLines(b *testing.B) {
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = KFile.ReadLines("./testdata/dante.txt", func(n int, line string) error {
+			return nil
+		})
+	}
+}
+
+func BenchmarkProcessChunks(b *testing.B) {
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		results, _ := KFile.ProcessChunks(context.Background(), "./testdata/dante.txt", &ChunkOptions{ChunkSize: 1024}, func(chunk *FileChunk) (interface{}, error) {
+			return len(chunk.Data), nil
+		})
+		for range results {
+		}
+	}
+}
@@ -0,0 +1,244 @@
+package kgo
+
+import (
+	"context"
+	"errors"
+	"os"
+	"sync"
+	"syscall"
+	"time"
+)
+
+// FileLock 基于flock的文件建议锁,可跨进程使用;同一进程内不同的FileLock之间同样互斥.
+type FileLock struct {
+	path   string
+	file   *os.File
+	mu     sync.Mutex
+	locked bool
+	shared bool
+}
+
+// ErrLockTimeout 获取文件锁超时
+var ErrLockTimeout = errors.New("File lock timeout")
+
+// lockPollMax 非阻塞轮询获取锁时的最大间隔
+const lockPollMax = 100 * time.Millisecond
+
+// NewLock 创建文件锁.fpath为要加锁的文件;
+// sidecar为true时锁定旁路文件"fpath.lock"而不是数据文件本身,适用于数据文件会被替换(如原子写入)的场景.
+// 锁文件不存在时将被创建;使用完毕须调用Close.
+func (kf *LkkFile) NewLock(fpath string, sidecar bool) (*FileLock, error) {
+	if sidecar {
+		fpath += ".lock"
+	}
+
+	file, err := os.OpenFile(fpath, os.O_RDWR|os.O_CREATE, 0644)
+	if err != nil && os.IsPermission(err) {
+		//只读文件同样可以加锁
+		file, err = os.Open(fpath)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &FileLock{path: fpath, file: file}, nil
+}
+
+// WithLock 获取fpath的排他锁后执行fn,fn返回或panic后均会释放锁.
+// sidecar为true时锁定旁路文件"fpath.lock".
+func (kf *LkkFile) WithLock(fpath string, fn func() error, sidecar ...bool) error {
+	return kf.withLock(fpath, false, fn, sidecar...)
+}
+
+// WithRLock 获取fpath的共享锁后执行fn,fn返回或panic后均会释放锁.
+// sidecar为true时锁定旁路文件"fpath.lock".
+func (kf *LkkFile) WithRLock(fpath string, fn func() error, sidecar ...bool) error {
+	return kf.withLock(fpath, true, fn, sidecar...)
+}
+
+// withLock 加锁执行fn.
+func (kf *LkkFile) withLock(fpath string, shared bool, fn func() error, sidecar ...bool) error {
+	lock, err := kf.NewLock(fpath, len(sidecar) > 0 && sidecar[0])
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = lock.Close()
+	}()
+
+	if shared {
+		err = lock.RLock()
+	} else {
+		err = lock.Lock()
+	}
+	if err != nil {
+		return err
+	}
+
+	return fn()
+}
+
+// Path 获取锁文件的路径.
+func (fl *FileLock) Path() string {
+	return fl.path
+}
+
+// File 获取锁文件的句柄;锁定数据文件本身时,可通过它读写数据.
+func (fl *FileLock) File() *os.File {
+	return fl.file
+}
+
+// Locked 是否已持有锁;shared表示是否共享锁.
+func (fl *FileLock) Locked() (locked bool, shared bool) {
+	fl.mu.Lock()
+	defer fl.mu.Unlock()
+	return fl.locked, fl.shared
+}
+
+// Lock 获取排他锁,阻塞直到成功.
+func (fl *FileLock) Lock() error {
+	return fl.flock(false, true)
+}
+
+// RLock 获取共享锁,阻塞直到成功.
+func (fl *FileLock) RLock() error {
+	return fl.flock(true, true)
+}
+
+// TryLock 尝试获取排他锁,不阻塞;锁已被占用时返回false.
+func (fl *FileLock) TryLock() (bool, error) {
+	return fl.tryLock(false)
+}
+
+// TryRLock 尝试获取共享锁,不阻塞;锁已被占用时返回false.
+func (fl *FileLock) TryRLock() (bool, error) {
+	return fl.tryLock(true)
+}
+
+// LockContext 获取排他锁,直到成功或ctx结束.
+func (fl *FileLock) LockContext(ctx context.Context) error {
+	return fl.lockContext(ctx, false)
+}
+
+// RLockContext 获取共享锁,直到成功或ctx结束.
+func (fl *FileLock) RLockContext(ctx context.Context) error {
+	return fl.lockContext(ctx, true)
+}
+
+// LockTimeout 获取排他锁,超过timeout仍未成功时返回ErrLockTimeout.
+func (fl *FileLock) LockTimeout(timeout time.Duration) error {
+	return fl.lockTimeout(timeout, false)
+}
+
+// RLockTimeout 获取共享锁,超过timeout仍未成功时返回ErrLockTimeout.
+func (fl *FileLock) RLockTimeout(timeout time.Duration) error {
+	return fl.lockTimeout(timeout, true)
+}
+
+// Unlock 释放锁;未持有锁时不做任何操作.
+func (fl *FileLock) Unlock() error {
+	fl.mu.Lock()
+	defer fl.mu.Unlock()
+	if !fl.locked {
+		return nil
+	}
+
+	if err := syscall.Flock(int(fl.file.Fd()), syscall.LOCK_UN); err != nil {
+		return &os.PathError{Op: "flock", Path: fl.path, Err: err}
+	}
+	fl.locked = false
+	fl.shared = false
+
+	return nil
+}
+
+// Close 释放锁并关闭锁文件.旁路锁文件不会被删除,以免其他进程锁定到不同的文件.
+func (fl *FileLock) Close() error {
+	err := fl.Unlock()
+	if cerr := fl.file.Close(); err == nil {
+		err = cerr
+	}
+	return err
+}
+
+// flock 执行flock系统调用,并记录锁状态.
+func (fl *FileLock) flock(shared, block bool) error {
+	how := syscall.LOCK_EX
+	if shared {
+		how = syscall.LOCK_SH
+	}
+	if !block {
+		how |= syscall.LOCK_NB
+	}
+
+	fl.mu.Lock()
+	defer fl.mu.Unlock()
+
+	var err error
+	for {
+		err = syscall.Flock(int(fl.file.Fd()), how)
+		if err != syscall.EINTR {
+			break
+		}
+	}
+	if err != nil {
+		return &os.PathError{Op: "flock", Path: fl.path, Err: err}
+	}
+	fl.locked = true
+	fl.shared = shared
+
+	return nil
+}
+
+// tryLock 非阻塞地尝试加锁.
+func (fl *FileLock) tryLock(shared bool) (bool, error) {
+	err := fl.flock(shared, false)
+	if err == nil {
+		return true, nil
+	}
+
+	var pe *os.PathError
+	if errors.As(err, &pe) && pe.Err == syscall.EWOULDBLOCK {
+		return false, nil
+	}
+	return false, err
+}
+
+// lockContext 轮询加锁直到成功或ctx结束,轮询间隔逐渐增大.
+func (fl *FileLock) lockContext(ctx context.Context, shared bool) error {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	interval := time.Millisecond
+	for {
+		ok, err := fl.tryLock(shared)
+		if ok || err != nil {
+			return err
+		}
+
+		timer := time.NewTimer(interval)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+
+		if interval *= 2; interval > lockPollMax {
+			interval = lockPollMax
+		}
+	}
+}
+
+// lockTimeout 在限定时间内加锁.
+func (fl *FileLock) lockTimeout(timeout time.Duration, shared bool) error {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	err := fl.lockContext(ctx, shared)
+	if err == context.DeadlineExceeded {
+		err = ErrLockTimeout
+	}
+	return err
+}
@@ -0,0 +1,184 @@
+package kgo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestFileLock(t *testing.T) {
+	ts, _ := KOS.NewTempSpace(nil)
+	defer func() {
+		_ = ts.Close()
+	}()
+
+	fpath := ts.Join("data.log")
+
+	lock1, err := KFile.NewLock(fpath, false)
+	if err != nil || lock1.Path() != fpath || lock1.File() == nil {
+		t.Error("NewLock fail")
+		return
+	}
+	defer func() {
+		_ = lock1.Close()
+	}()
+	lock2, _ := KFile.NewLock(fpath, false)
+	defer func() {
+		_ = lock2.Close()
+	}()
+
+	//排他锁
+	if err = lock1.Lock(); err != nil {
+		t.Error("Lock fail")
+		return
+	}
+	if locked, shared := lock1.Locked(); !locked || shared {
+		t.Error("Locked fail")
+		return
+	}
+	if ok, err := lock2.TryLock(); ok || err != nil {
+		t.Error("TryLock fail")
+		return
+	} else if ok, _ = lock2.TryRLock(); ok {
+		t.Error("TryRLock fail")
+		return
+	} else if err = lock2.LockTimeout(30 * time.Millisecond); err != ErrLockTimeout {
+		t.Error("LockTimeout fail")
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Millisecond)
+	defer cancel()
+	if err = lock2.RLockContext(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Error("RLockContext fail")
+		return
+	}
+
+	//释放后可获取
+	go func() {
+		time.Sleep(20 * time.Millisecond)
+		_ = lock1.Unlock()
+	}()
+	if err = lock2.LockContext(context.Background()); err != nil {
+		t.Error("LockContext fail")
+		return
+	}
+	_ = lock2.Unlock()
+
+	//共享锁
+	if err = lock1.RLock(); err != nil {
+		t.Error("RLock fail")
+		return
+	} else if err = lock2.RLockTimeout(time.Second); err != nil {
+		t.Error("RLockTimeout fail")
+		return
+	}
+	if locked, shared := lock2.Locked(); !locked || !shared {
+		t.Error("Locked shared fail")
+		return
+	}
+	_ = lock1.Unlock()
+	_ = lock2.Unlock()
+	_ = lock2.Unlock()
+
+	_, err = KFile.NewLock(ts.Join("nodir/data.log"), false)
+	if err == nil {
+		t.Error("NewLock fail")
+		return
+	}
+}
+
+func TestWithLock(t *testing.T) {
+	ts, _ := KOS.NewTempSpace(nil)
+	defer func() {
+		_ = ts.Close()
+	}()
+
+	fpath := ts.Join("append.log")
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			_ = KFile.WithLock(fpath, func() error {
+				return KFile.AppendFile(fpath, []byte(fmt.Sprintf("line %d\n", i)))
+			}, true)
+		}(i)
+	}
+	wg.Wait()
+
+	lines, _ := KFile.ReadInArray(fpath)
+	if len(lines) < 8 || !KFile.IsFile(fpath+".lock") {
+		t.Error("WithLock fail")
+		return
+	}
+
+	//panic时释放锁
+	func() {
+		defer func() {
+			_ = recover()
+		}()
+		_ = KFile.WithLock(fpath, func() error {
+			panic("oops")
+		})
+	}()
+
+	lock, _ := KFile.NewLock(fpath, false)
+	defer func() {
+		_ = lock.Close()
+	}()
+	if ok, _ := lock.TryLock(); !ok {
+		t.Error("WithLock panic unlock fail")
+		return
+	}
+	_ = lock.Unlock()
+
+	err := KFile.WithRLock(fpath, func() error {
+		return errors.New("fn error")
+	})
+	if err == nil || err.Error() != "fn error" {
+		t.Error("WithRLock fail")
+		return
+	}
+
+	err = KFile.WithLock(ts.Join("nodir/data.log"), func() error {
+		return nil
+	})
+	if err == nil {
+		t.Error("WithLock fail")
+		return
+	}
+}
+
+func BenchmarkFileLock(b *testing.B) {
+	ts, _ := KOS.NewTempSpace(nil)
+	defer func() {
+		_ = ts.Close()
+	}()
+
+	b.ResetTimer()
+	lock, _ := KFile.NewLock(ts.Join("bench.log"), false)
+	for i := 0; i < b.N; i++ {
+		_ = lock.Lock()
+		_ = lock.Unlock()
+	}
+	_ = lock.Close()
+}
+
+func BenchmarkWithLock(b *testing.B) {
+	ts, _ := KOS.NewTempSpace(nil)
+	defer func() {
+		_ = ts.Close()
+	}()
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = KFile.WithLock(ts.Join("bench.log"), func() error {
+			return nil
+		}, true)
+	}
+}
@@ -0,0 +1,442 @@
+package kgo
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"mime"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+)
+
+// MimeSignature mime类型的文件头特征
+type MimeSignature struct {
+	Mime   string            // mime类型
+	Offset int               // 魔数在文件头中的偏移
+	Magic  []byte            // 魔数,为空时仅使用Check判断
+	Check  func([]byte) bool // 魔数匹配后对文件头的额外检查,可为nil
+}
+
+// mimeEntry 内置的mime类型
+type mimeEntry struct {
+	mime   string           // mime类型
+	exts   string           // 扩展名,以空格分隔,第一个为首选
+	parent string           // 父类型,如docx的父类型为zip;内容无法进一步识别时检测为父类型
+	sigs   []*MimeSignature // 文件头特征
+}
+
+// mimeSniffLen 检测mime类型时读取的文件头长度
+const mimeSniffLen = 8192
+
+// mimeRegistry mime类型及扩展名的注册表
+var mimeRegistry = newMimeRegistry()
+
+// mimeSig 创建文件头特征.
+func mimeSig(offset int, magic string, check func([]byte) bool) *MimeSignature {
+	return &MimeSignature{Offset: offset, Magic: []byte(magic), Check: check}
+}
+
+// ftypBrands 检查ISO媒体文件(mp4/mov/heic等)的主品牌.
+func ftypBrands(brands ...string) func([]byte) bool {
+	return func(header []byte) bool {
+		if len(header) < 12 {
+			return false
+		}
+		major := string(header[8:12])
+		for _, brand := range brands {
+			if major == brand {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// subType 检查文件头偏移offset处的子类型标识.
+func subType(offset int, kind string) func([]byte) bool {
+	return func(header []byte) bool {
+		return len(header) >= offset+len(kind) && string(header[offset:offset+len(kind)]) == kind
+	}
+}
+
+// headerContains 检查文件头中是否包含任一标识.
+func headerContains(marks ...string) func([]byte) bool {
+	return func(header []byte) bool {
+		for _, mark := range marks {
+			if bytes.Contains(header, []byte(mark)) {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// isSvgHeader 检查文件头是否svg图片.
+func isSvgHeader(header []byte) bool {
+	if bytes.IndexByte(header, 0) != -1 {
+		return false
+	}
+	text := bytes.TrimSpace(header)
+	return (bytes.HasPrefix(text, []byte("<?xml")) || bytes.HasPrefix(text, []byte("<svg")) || bytes.HasPrefix(text, []byte("<!DOCTYPE svg"))) &&
+		bytes.Contains(text, []byte("<svg"))
+}
+
+// isMp3Header 检查文件头是否无ID3标签的mp3帧.
+func isMp3Header(header []byte) bool {
+	return len(header) >= 2 && header[0] == 0xFF && (header[1]&0xE6 == 0xE2)
+}
+
+// builtinMimes 内置的mime类型表,有特征的类型按检测优先级排列(具体的类型在前)
+var builtinMimes = []mimeEntry{
+	// 图片
+	{mime: "image/png", exts: ".png", sigs: []*MimeSignature{mimeSig(0, "\x89PNG\r\n\x1a\n", nil)}},
+	{mime: "image/jpeg", exts: ".jpg .jpeg .jpe .jfif", sigs: []*MimeSignature{mimeSig(0, "\xFF\xD8\xFF", nil)}},
+	{mime: "image/gif", exts: ".gif", sigs: []*MimeSignature{mimeSig(0, "GIF87a", nil), mimeSig(0, "GIF89a", nil)}},
+	{mime: "image/bmp", exts: ".bmp .dib", sigs: []*MimeSignature{mimeSig(0, "BM", subType(6, "\x00\x00\x00\x00"))}},
+	{mime: "image/webp", exts: ".webp", sigs: []*MimeSignature{mimeSig(0, "RIFF", subType(8, "WEBP"))}},
+	{mime: "image/tiff", exts: ".tif .tiff", sigs: []*MimeSignature{mimeSig(0, "II*\x00", nil), mimeSig(0, "MM\x00*", nil)}},
+	{mime: "image/x-icon", exts: ".ico", sigs: []*MimeSignature{mimeSig(0, "\x00\x00\x01\x00", nil)}},
+	{mime: "image/vnd.adobe.photoshop", exts: ".psd", sigs: []*MimeSignature{mimeSig(0, "8BPS", nil)}},
+	{mime: "image/heic", exts: ".heic .heif", sigs: []*MimeSignature{mimeSig(4, "ftyp", ftypBrands("heic", "heix", "mif1", "msf1"))}},
+	{mime: "image/avif", exts: ".avif", sigs: []*MimeSignature{mimeSig(4, "ftyp", ftypBrands("avif", "avis"))}},
+	{mime: "image/svg+xml", exts: ".svg .svgz", sigs: []*MimeSignature{{Check: isSvgHeader}}},
+
+	// 音频
+	{mime: "audio/mpeg", exts: ".mp3", sigs: []*MimeSignature{mimeSig(0, "ID3", nil), {Check: isMp3Header}}},
+	{mime: "audio/flac", exts: ".flac", sigs: []*MimeSignature{mimeSig(0, "fLaC", nil)}},
+	{mime: "audio/wav", exts: ".wav", sigs: []*MimeSignature{mimeSig(0, "RIFF", subType(8, "WAVE"))}},
+	{mime: "audio/aiff", exts: ".aif .aiff", sigs: []*MimeSignature{mimeSig(0, "FORM", subType(8, "AIFF"))}},
+	{mime: "audio/ogg", exts: ".ogg .oga .opus", sigs: []*MimeSignature{mimeSig(0, "OggS", nil)}},
+	{mime: "audio/mp4", exts: ".m4a", sigs: []*MimeSignature{mimeSig(4, "ftyp", ftypBrands("M4A ", "M4B "))}},
+	{mime: "audio/aac", exts: ".aac", sigs: []*MimeSignature{mimeSig(0, "\xFF\xF1", nil), mimeSig(0, "\xFF\xF9", nil)}},
+	{mime: "audio/midi", exts: ".mid .midi", sigs: []*MimeSignature{mimeSig(0, "MThd", nil)}},
+	{mime: "audio/amr", exts: ".amr", sigs: []*MimeSignature{mimeSig(0, "#!AMR", nil)}},
+
+	// 视频
+	{mime: "video/quicktime", exts: ".mov .qt", sigs: []*MimeSignature{mimeSig(4, "ftyp", ftypBrands("qt  "))}},
+	{mime: "video/3gpp", exts: ".3gp", sigs: []*MimeSignature{mimeSig(4, "ftyp", ftypBrands("3gp4", "3gp5", "3gp6", "3ge6", "3gg6"))}},
+	{mime: "video/mp4", exts: ".mp4 .m4v", sigs: []*MimeSignature{mimeSig(4, "ftyp", nil)}},
+	{mime: "video/webm", exts: ".webm", sigs: []*MimeSignature{mimeSig(0, "\x1A\x45\xDF\xA3", headerContains("webm"))}},
+	{mime: "video/x-matroska", exts: ".mkv .mka", sigs: []*MimeSignature{mimeSig(0, "\x1A\x45\xDF\xA3", nil)}},
+	{mime: "video/x-msvideo", exts: ".avi", sigs: []*MimeSignature{mimeSig(0, "RIFF", subType(8, "AVI "))}},
+	{mime: "video/x-flv", exts: ".flv", sigs: []*MimeSignature{mimeSig(0, "FLV\x01", nil)}},
+	{mime: "video/mpeg", exts: ".mpg .mpeg", sigs: []*MimeSignature{mimeSig(0, "\x00\x00\x01\xBA", nil), mimeSig(0, "\x00\x00\x01\xB3", nil)}},
+	{mime: "video/x-ms-wmv", exts: ".wmv .wma .asf", sigs: []*MimeSignature{mimeSig(0, "\x30\x26\xB2\x75\x8E\x66\xCF\x11", nil)}},
+
+	// 文档
+	{mime: "application/pdf", exts: ".pdf", sigs: []*MimeSignature{mimeSig(0, "%PDF-", nil)}},
+	{mime: "application/rtf", exts: ".rtf", sigs: []*MimeSignature{mimeSig(0, "{\\rtf", nil)}},
+	{mime: "application/postscript", exts: ".ps .eps .ai", sigs: []*MimeSignature{mimeSig(0, "%!PS", nil)}},
+	{mime: "application/vnd.openxmlformats-officedocument.wordprocessingml.document", exts: ".docx", parent: "application/zip",
+		sigs: []*MimeSignature{mimeSig(0, "PK\x03\x04", headerContains("word/"))}},
+	{mime: "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet", exts: ".xlsx", parent: "application/zip",
+		sigs: []*MimeSignature{mimeSig(0, "PK\x03\x04", headerContains("xl/"))}},
+	{mime: "application/vnd.openxmlformats-officedocument.presentationml.presentation", exts: ".pptx", parent: "application/zip",
+		sigs: []*MimeSignature{mimeSig(0, "PK\x03\x04", headerContains("ppt/"))}},
+	{mime: "application/vnd.oasis.opendocument.text", exts: ".odt", parent: "application/zip",
+		sigs: []*MimeSignature{mimeSig(30, "mimetypeapplication/vnd.oasis.opendocument.text", nil)}},
+	{mime: "application/vnd.oasis.opendocument.spreadsheet", exts: ".ods", parent: "application/zip",
+		sigs: []*MimeSignature{mimeSig(30, "mimetypeapplication/vnd.oasis.opendocument.spreadsheet", nil)}},
+	{mime: "application/vnd.oasis.opendocument.presentation", exts: ".odp", parent: "application/zip",
+		sigs: []*MimeSignature{mimeSig(30, "mimetypeapplication/vnd.oasis.opendocument.presentation", nil)}},
+	{mime: "application/epub+zip", exts: ".epub", parent: "application/zip",
+		sigs: []*MimeSignature{mimeSig(30, "mimetypeapplication/epub+zip", nil)}},
+	{mime: "application/java-archive", exts: ".jar .war .ear", parent: "application/zip",
+		sigs: []*MimeSignature{mimeSig(0, "PK\x03\x04", headerContains("META-INF/MANIFEST.MF"))}},
+	{mime: "application/vnd.android.package-archive", exts: ".apk", parent: "application/zip",
+		sigs: []*MimeSignature{mimeSig(0, "PK\x03\x04", headerContains("AndroidManifest.xml"))}},
+	{mime: "application/msword", exts: ".doc .dot", parent: "application/x-ole-storage"},
+	{mime: "application/vnd.ms-excel", exts: ".xls .xlt", parent: "application/x-ole-storage"},
+	{mime: "application/vnd.ms-powerpoint", exts: ".ppt .pps", parent: "application/x-ole-storage"},
+	{mime: "application/vnd.ms-outlook", exts: ".msg", parent: "application/x-ole-storage"},
+	{mime: "application/x-msi", exts: ".msi", parent: "application/x-ole-storage"},
+	{mime: "application/x-ole-storage", sigs: []*MimeSignature{mimeSig(0, "\xD0\xCF\x11\xE0\xA1\xB1\x1A\xE1", nil)}},
+
+	// 压缩包
+	{mime: "application/zip", exts: ".zip", sigs: []*MimeSignature{mimeSig(0, "PK\x03\x04", nil), mimeSig(0, "PK\x05\x06", nil), mimeSig(0, "PK\x07\x08", nil)}},
+	{mime: "application/gzip", exts: ".gz .tgz", sigs: []*MimeSignature{mimeSig(0, "\x1F\x8B", nil)}},
+	{mime: "application/x-bzip2", exts: ".bz2 .tbz2", sigs: []*MimeSignature{mimeSig(0, "BZh", nil)}},
+	{mime: "application/x-xz", exts: ".xz .txz", sigs: []*MimeSignature{mimeSig(0, "\xFD7zXZ\x00", nil)}},
+	{mime: "application/zstd", exts: ".zst", sigs: []*MimeSignature{mimeSig(0, "\x28\xB5\x2F\xFD", nil)}},
+	{mime: "application/x-7z-compressed", exts: ".7z", sigs: []*MimeSignature{mimeSig(0, "7z\xBC\xAF\x27\x1C", nil)}},
+	{mime: "application/vnd.rar", exts: ".rar", sigs: []*MimeSignature{mimeSig(0, "Rar!\x1A\x07", nil)}},
+	{mime: "application/x-tar", exts: ".tar", sigs: []*MimeSignature{mimeSig(257, "ustar", nil)}},
+	{mime: "application/x-lzip", exts: ".lz", sigs: []*MimeSignature{mimeSig(0, "LZIP", nil)}},
+	{mime: "application/vnd.ms-cab-compressed", exts: ".cab", sigs: []*MimeSignature{mimeSig(0, "MSCF", nil)}},
+	{mime: "application/x-iso9660-image", exts: ".iso"},
+
+	// 字体
+	{mime: "font/woff", exts: ".woff", sigs: []*MimeSignature{mimeSig(0, "wOFF", nil)}},
+	{mime: "font/woff2", exts: ".woff2", sigs: []*MimeSignature{mimeSig(0, "wOF2", nil)}},
+	{mime: "font/ttf", exts: ".ttf", sigs: []*MimeSignature{mimeSig(0, "\x00\x01\x00\x00\x00", nil)}},
+	{mime: "font/otf", exts: ".otf", sigs: []*MimeSignature{mimeSig(0, "OTTO", nil)}},
+	{mime: "font/collection", exts: ".ttc", sigs: []*MimeSignature{mimeSig(0, "ttcf", nil)}},
+	{mime: "application/vnd.ms-fontobject", exts: ".eot"},
+
+	// 可执行文件
+	{mime: "application/x-elf", exts: ".elf .so .o", sigs: []*MimeSignature{mimeSig(0, "\x7FELF", nil)}},
+	{mime: "application/vnd.microsoft.portable-executable", exts: ".exe .dll .sys", sigs: []*MimeSignature{mimeSig(0, "MZ", nil)}},
+	{mime: "application/x-mach-binary", exts: ".dylib", sigs: []*MimeSignature{
+		mimeSig(0, "\xFE\xED\xFA\xCE", nil), mimeSig(0, "\xFE\xED\xFA\xCF", nil),
+		mimeSig(0, "\xCE\xFA\xED\xFE", nil), mimeSig(0, "\xCF\xFA\xED\xFE", nil),
+	}},
+	{mime: "application/java-vm", exts: ".class", sigs: []*MimeSignature{mimeSig(0, "\xCA\xFE\xBA\xBE", nil)}},
+	{mime: "application/wasm", exts: ".wasm", sigs: []*MimeSignature{mimeSig(0, "\x00asm", nil)}},
+	{mime: "application/x-sh", exts: ".sh", sigs: []*MimeSignature{mimeSig(0, "#!/bin/sh", nil), mimeSig(0, "#!/bin/bash", nil), mimeSig(0, "#!/usr/bin/env bash", nil)}},
+	{mime: "application/vnd.sqlite3", exts: ".sqlite .db", sigs: []*MimeSignature{mimeSig(0, "SQLite format 3\x00", nil)}},
+
+	// 文本,无特征,由http.DetectContentType识别
+	{mime: "text/plain", exts: ".txt .text .log .conf .ini", parent: "text/plain"},
+	{mime: "text/html", exts: ".html .htm", parent: "text/plain"},
+	{mime: "text/css", exts: ".css", parent: "text/plain"},
+	{mime: "text/csv", exts: ".csv", parent: "text/plain"},
+	{mime: "text/markdown", exts: ".md .markdown", parent: "text/plain"},
+	{mime: "text/xml", exts: ".xml", parent: "text/plain"},
+	{mime: "text/javascript", exts: ".js .mjs", parent: "text/plain"},
+	{mime: "application/json", exts: ".json", parent: "text/plain"},
+	{mime: "application/yaml", exts: ".yaml .yml", parent: "text/plain"},
+	{mime: "text/x-go", exts: ".go", parent: "text/plain"},
+}
+
+// mimeTable mime类型及扩展名的注册表
+type mimeTable struct {
+	sync.RWMutex
+	sigs     []*MimeSignature
+	extMime  map[string]string   // 扩展名 => mime类型
+	mimeExts map[string][]string // mime类型 => 扩展名
+	parents  map[string]string   // mime类型 => 父类型
+}
+
+// newMimeRegistry 根据内置表创建注册表.
+func newMimeRegistry() *mimeTable {
+	mt := &mimeTable{
+		extMime:  make(map[string]string),
+		mimeExts: make(map[string][]string),
+		parents:  make(map[string]string),
+	}
+	for _, entry := range builtinMimes {
+		mt.add(entry.mime, strings.Fields(entry.exts)...)
+		if entry.parent != "" && entry.parent != entry.mime {
+			mt.parents[entry.mime] = entry.parent
+		}
+		for _, sig := range entry.sigs {
+			sig.Mime = entry.mime
+			mt.sigs = append(mt.sigs, sig)
+		}
+	}
+	return mt
+}
+
+// add 添加mime类型与扩展名的对应关系,须持有写锁.
+func (mt *mimeTable) add(mimeType string, exts ...string) {
+	for _, ext := range exts {
+		ext = normalizeExt(ext)
+		if ext == "" {
+			continue
+		}
+		if old, ok := mt.extMime[ext]; ok && old != mimeType {
+			mt.mimeExts[old] = removeExt(mt.mimeExts[old], ext)
+		}
+		mt.extMime[ext] = mimeType
+		mt.mimeExts[mimeType] = append(removeExt(mt.mimeExts[mimeType], ext), ext)
+	}
+	if _, ok := mt.mimeExts[mimeType]; !ok {
+		mt.mimeExts[mimeType] = nil
+	}
+}
+
+// removeExt 从扩展名列表中移除ext.
+func removeExt(exts []string, ext string) []string {
+	res := exts[:0:0]
+	for _, item := range exts {
+		if item != ext {
+			res = append(res, item)
+		}
+	}
+	return res
+}
+
+// normalizeExt 将扩展名转换为小写并以"."开头.
+func normalizeExt(ext string) string {
+	ext = strings.ToLower(strings.TrimSpace(ext))
+	if ext != "" && !strings.HasPrefix(ext, ".") {
+		ext = "." + ext
+	}
+	return ext
+}
+
+// baseMime 去掉mime类型中的参数,如"text/plain; charset=utf-8"返回"text/plain".
+func baseMime(mimeType string) string {
+	if pos := strings.IndexByte(mimeType, ';'); pos != -1 {
+		mimeType = mimeType[:pos]
+	}
+	return strings.ToLower(strings.TrimSpace(mimeType))
+}
+
+// RegisterMime 注册mime类型与扩展名的对应关系,已有的扩展名将被改为对应该类型;
+// 第一个扩展名为该类型的首选扩展名.
+func (kf *LkkFile) RegisterMime(mimeType string, exts ...string) error {
+	mimeType = baseMime(mimeType)
+	if mimeType == "" || !strings.Contains(mimeType, "/") {
+		return errors.New("Invalid mime type: " + mimeType)
+	}
+
+	mimeRegistry.Lock()
+	defer mimeRegistry.Unlock()
+
+	//首选扩展名放在最前
+	mimeRegistry.add(mimeType, exts...)
+	if len(exts) > 0 {
+		first := normalizeExt(exts[0])
+		list := removeExt(mimeRegistry.mimeExts[mimeType], first)
+		mimeRegistry.mimeExts[mimeType] = append([]string{first}, list...)
+	}
+
+	return nil
+}
+
+// RegisterMimeSignature 注册mime类型的文件头特征,优先于内置特征检测;parent为其父类型,可为空.
+func (kf *LkkFile) RegisterMimeSignature(sig *MimeSignature, parent string) error {
+	if sig == nil || baseMime(sig.Mime) == "" || (len(sig.Magic) == 0 && sig.Check == nil) || sig.Offset < 0 {
+		return errors.New("Invalid mime signature")
+	}
+
+	s := *sig
+	s.Mime = baseMime(s.Mime)
+	mimeRegistry.Lock()
+	defer mimeRegistry.Unlock()
+
+	mimeRegistry.sigs = append([]*MimeSignature{&s}, mimeRegistry.sigs...)
+	if _, ok := mimeRegistry.mimeExts[s.Mime]; !ok {
+		mimeRegistry.mimeExts[s.Mime] = nil
+	}
+	if parent = baseMime(parent); parent != "" && parent != s.Mime {
+		mimeRegistry.parents[s.Mime] = parent
+	}
+
+	return nil
+}
+
+// MimeByExt 根据扩展名获取mime类型,如".png"或"png";未知时返回空字符串.
+func (kf *LkkFile) MimeByExt(ext string) string {
+	ext = normalizeExt(ext)
+	mimeRegistry.RLock()
+	res, ok := mimeRegistry.extMime[ext]
+	mimeRegistry.RUnlock()
+	if ok {
+		return res
+	}
+
+	return baseMime(mime.TypeByExtension(ext))
+}
+
+// ExtsByMime 获取mime类型对应的扩展名列表,第一个为首选扩展名.
+func (kf *LkkFile) ExtsByMime(mimeType string) []string {
+	mimeType = baseMime(mimeType)
+	mimeRegistry.RLock()
+	exts, ok := mimeRegistry.mimeExts[mimeType]
+	mimeRegistry.RUnlock()
+	if ok {
+		return append([]string{}, exts...)
+	}
+
+	exts, _ = mime.ExtensionsByType(mimeType)
+	return exts
+}
+
+// DetectMime 根据文件头的魔数检测文件的mime类型,无法识别时返回"application/octet-stream".
+func (kf *LkkFile) DetectMime(fpath string) (string, error) {
+	res, err := detectMime(fpath)
+	return baseMime(res), err
+}
+
+// detectMime 检测文件的mime类型,保留内容嗅探所得的charset等参数.
+func detectMime(fpath string) (string, error) {
+	file, err := os.Open(fpath)
+	if err != nil {
+		return "", err
+	}
+	defer func() {
+		_ = file.Close()
+	}()
+
+	header := make([]byte, mimeSniffLen)
+	n, err := io.ReadFull(file, header)
+	if err != nil && err != io.EOF && err != io.ErrUnexpectedEOF {
+		return "", err
+	}
+	header = header[:n]
+
+	//iso镜像的特征在32K之后
+	if n == mimeSniffLen {
+		tail := make([]byte, 5)
+		if _, err := file.ReadAt(tail, 32769); err == nil && string(tail) == "CD001" {
+			return "application/x-iso9660-image", nil
+		}
+	}
+
+	return sniffMime(header), nil
+}
+
+// DetectMimeBytes 根据数据头的魔数检测mime类型,建议传入数据的前8KB;无法识别时返回"application/octet-stream".
+func (kf *LkkFile) DetectMimeBytes(header []byte) string {
+	return baseMime(sniffMime(header))
+}
+
+// sniffMime 根据数据头检测mime类型,未匹配魔数时由内容嗅探,结果保留charset等参数.
+func sniffMime(header []byte) string {
+	if len(header) == 0 {
+		return "application/octet-stream"
+	}
+
+	mimeRegistry.RLock()
+	sigs := mimeRegistry.sigs
+	mimeRegistry.RUnlock()
+
+	for _, sig := range sigs {
+		if len(sig.Magic) > 0 {
+			end := sig.Offset + len(sig.Magic)
+			if len(header) < end || !bytes.Equal(header[sig.Offset:end], sig.Magic) {
+				continue
+			}
+		}
+		if sig.Check == nil || sig.Check(header) {
+			return sig.Mime
+		}
+	}
+
+	if len(header) > 512 {
+		header = header[:512]
+	}
+	return http.DetectContentType(header)
+}
+
+// ExtMismatch 检查文件的扩展名与内容是否不符,用于上传校验;返回是否不符及根据内容检测的mime类型.
+// 扩展名未知时视为相符;内容仅能识别为父类型(如docx识别为zip)或纯文本类扩展名的内容为文本时,视为相符.
+func (kf *LkkFile) ExtMismatch(fpath string) (bool, string, error) {
+	detected, err := kf.DetectMime(fpath)
+	if err != nil {
+		return false, "", err
+	}
+
+	expected := kf.MimeByExt(kf.GetExt(fpath))
+	if expected == "" || expected == detected {
+		return false, detected, nil
+	}
+
+	mimeRegistry.RLock()
+	parent := mimeRegistry.parents[expected]
+	mimeRegistry.RUnlock()
+	if parent != "" && parent == detected {
+		return false, detected, nil
+	}
+
+	return true, detected, nil
+}
+
+// isImageMime 是否图片的mime类型.
+func isImageMime(mimeType string) bool {
+	return strings.HasPrefix(mimeType, "image/")
+}
@@ -0,0 +1,193 @@
+package kgo
+
+import (
+	"archive/zip"
+	"bytes"
+	"testing"
+)
+
+func TestDetectMime(t *testing.T) {
+	var tests = []struct {
+		fpath    string
+		expected string
+	}{
+		{"./testdata/diglett.png", "image/png"},
+		{"./testdata/gopher10th-large.jpg", "image/jpeg"},
+		{"./testdata/jetbrains.svg", "image/svg+xml"},
+		{"./testdata/dante.txt", "text/plain"},
+		{"./testdata/rsa/public_key.pem", "text/plain"},
+	}
+	for _, test := range tests {
+		actual, err := KFile.DetectMime(test.fpath)
+		if err != nil || actual != test.expected {
+			t.Errorf("Expected DetectMime(%s) to be %s, got %s", test.fpath, test.expected, actual)
+			return
+		}
+	}
+
+	_, err := KFile.DetectMime("./hello")
+	if err == nil {
+		t.Error("DetectMime not exist fail")
+		return
+	}
+	_, err = KFile.DetectMime("./testdata")
+	if err == nil {
+		t.Error("DetectMime dir fail")
+		return
+	}
+}
+
+func TestDetectMimeBytes(t *testing.T) {
+	var tests = []struct {
+		header   string
+		expected string
+	}{
+		{"GIF89a\x01\x00", "image/gif"},
+		{"RIFF\x00\x00\x00\x00WEBPVP8 ", "image/webp"},
+		{"RIFF\x00\x00\x00\x00WAVEfmt ", "audio/wav"},
+		{"RIFF\x00\x00\x00\x00AVI LIST", "video/x-msvideo"},
+		{"\x00\x00\x00\x18ftypheic\x00\x00\x00\x00", "image/heic"},
+		{"\x00\x00\x00\x18ftypM4A \x00\x00\x00\x00", "audio/mp4"},
+		{"\x00\x00\x00\x18ftypisom\x00\x00\x00\x00", "video/mp4"},
+		{"\x1A\x45\xDF\xA3\x9F\x42\x86\x81\x01webm", "video/webm"},
+		{"ID3\x03\x00", "audio/mpeg"},
+		{"fLaC\x00\x00", "audio/flac"},
+		{"%PDF-1.7\n", "application/pdf"},
+		{"\xD0\xCF\x11\xE0\xA1\xB1\x1A\xE1\x00", "application/x-ole-storage"},
+		{"\x1F\x8B\x08\x00", "application/gzip"},
+		{"7z\xBC\xAF\x27\x1C\x00", "application/x-7z-compressed"},
+		{"Rar!\x1A\x07\x00", "application/vnd.rar"},
+		{"wOF2\x00\x01", "font/woff2"},
+		{"\x7FELF\x02\x01", "application/x-elf"},
+		{"MZ\x90\x00", "application/vnd.microsoft.portable-executable"},
+		{"\x00asm\x01\x00\x00\x00", "application/wasm"},
+		{"SQLite format 3\x00", "application/vnd.sqlite3"},
+		{"<html><body>hello</body></html>", "text/html"},
+		{"", "application/octet-stream"},
+		{"\x01\x02\x03\x04\x05", "application/octet-stream"},
+	}
+	for _, test := range tests {
+		actual := KFile.DetectMimeBytes([]byte(test.header))
+		if actual != test.expected {
+			t.Errorf("Expected DetectMimeBytes(%q) to be %s, got %s", test.header, test.expected, actual)
+			return
+		}
+	}
+
+	//docx
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	w, _ := zw.Create("[Content_Types].xml")
+	_, _ = w.Write([]byte("<Types/>"))
+	w, _ = zw.Create("word/document.xml")
+	_, _ = w.Write([]byte("<document/>"))
+	_ = zw.Close()
+	if res := KFile.DetectMimeBytes(buf.Bytes()); res != "application/vnd.openxmlformats-officedocument.wordprocessingml.document" {
+		t.Error("DetectMimeBytes docx fail")
+		return
+	}
+}
+
+func TestMimeTable(t *testing.T) {
+	if KFile.MimeByExt(".PNG") != "image/png" || KFile.MimeByExt("jpg") != "image/jpeg" || KFile.MimeByExt(".unknownext") != "" {
+		t.Error("MimeByExt fail")
+		return
+	}
+	if exts := KFile.ExtsByMime("image/jpeg"); len(exts) < 2 || exts[0] != ".jpg" {
+		t.Error("ExtsByMime fail")
+		return
+	}
+	if exts := KFile.ExtsByMime("text/plain; charset=utf-8"); len(exts) == 0 || exts[0] != ".txt" {
+		t.Error("ExtsByMime params fail")
+		return
+	}
+
+	err := KFile.RegisterMime("application/x-kgo", "KGO", ".kgo2")
+	if err != nil || KFile.MimeByExt(".kgo") != "application/x-kgo" || KFile.ExtsByMime("application/x-kgo")[0] != ".kgo" {
+		t.Error("RegisterMime fail")
+		return
+	}
+	//改变已有扩展名的类型
+	_ = KFile.RegisterMime("application/x-kgo-new", ".kgo2")
+	if KFile.MimeByExt(".kgo2") != "application/x-kgo-new" || len(KFile.ExtsByMime("application/x-kgo")) != 1 {
+		t.Error("RegisterMime move fail")
+		return
+	}
+	if err = KFile.RegisterMime("invalid"); err == nil {
+		t.Error("RegisterMime invalid fail")
+		return
+	}
+
+	err = KFile.RegisterMimeSignature(&MimeSignature{Mime: "application/x-kgo", Magic: []byte("KGO!")}, "")
+	if err != nil || KFile.DetectMimeBytes([]byte("KGO!data")) != "application/x-kgo" {
+		t.Error("RegisterMimeSignature fail")
+		return
+	}
+	if err = KFile.RegisterMimeSignature(&MimeSignature{Mime: "application/x-kgo"}, ""); err == nil {
+		t.Error("RegisterMimeSignature invalid fail")
+		return
+	}
+}
+
+func TestExtMismatch(t *testing.T) {
+	ts, _ := KOS.NewTempSpace(nil)
+	defer func() {
+		_ = ts.Close()
+	}()
+
+	dir, _ := ts.Dir("mime-*")
+	png, _ := KFile.ReadFile("./testdata/diglett.png")
+	_ = KFile.WriteFile(dir+"/real.png", png)
+	_ = KFile.WriteFile(dir+"/fake.jpg", png)
+	_ = KFile.WriteFile(dir+"/script.png", []byte("#!/bin/sh\nrm -rf /\n"))
+	_ = KFile.WriteFile(dir+"/data.csv", []byte("a,b,c\n1,2,3\n"))
+	_ = KFile.WriteFile(dir+"/plain.zip.docx", []byte("PK\x05\x06"+string(make([]byte, 18))))
+	_ = KFile.WriteFile(dir+"/noext", png)
+
+	var tests = []struct {
+		fpath    string
+		mismatch bool
+		detected string
+	}{
+		{dir + "/real.png", false, "image/png"},
+		{dir + "/fake.jpg", true, "image/png"},
+		{dir + "/script.png", true, "application/x-sh"},
+		{dir + "/data.csv", false, "text/plain"},
+		{dir + "/plain.zip.docx", false, "application/zip"},
+		{dir + "/noext", false, "image/png"},
+	}
+	for _, test := range tests {
+		mismatch, detected, err := KFile.ExtMismatch(test.fpath)
+		if err != nil || mismatch != test.mismatch || detected != test.detected {
+			t.Errorf("Expected ExtMismatch(%s) to be %v %s, got %v %s", test.fpath, test.mismatch, test.detected, mismatch, detected)
+			return
+		}
+	}
+
+	_, _, err := KFile.ExtMismatch("./hello")
+	if err == nil {
+		t.Error("ExtMismatch not exist fail")
+		return
+	}
+}
+
+func BenchmarkDetectMime(b *testing.B) {
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, _ = KFile.DetectMime("./testdata/diglett.png")
+	}
+}
+
+func BenchmarkMimeByExt(b *testing.B) {
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = KFile.MimeByExt(".png")
+	}
+}
+
+func BenchmarkExtMismatch(b *testing.B) {
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, _, _ = KFile.ExtMismatch("./testdata/gopher10th-large.jpg")
+	}
+}
@@ -0,0 +1,367 @@
+package kgo
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// RotateOptions 日志轮转选项
+type RotateOptions struct {
+	MaxSize    int64         // 单个文件的最大字节数,写入将超出时轮转;为0时不按大小轮转
+	Interval   time.Duration // 按时间轮转的周期,如time.Hour、24*time.Hour;整天的周期按本地零点对齐;为0时不按时间轮转
+	MaxBackups int           // 保留的旧文件数,为0时全部保留
+	Compress   bool          // 是否将旧文件压缩为gzip
+	Pattern    string        // 旧文件名中的时间格式,使用KTime.Date的格式符,默认"Ymd-His";旧文件名形如"app.20200101-150405.log"
+	Perm       os.FileMode   // 新建日志文件的权限,默认0644
+}
+
+// RotateWriter 可按大小和/或时间自动轮转的日志写入器,实现io.WriteCloser,可并发使用.
+type RotateWriter struct {
+	fpath      string
+	opts       RotateOptions
+	mu         sync.Mutex
+	file       *os.File
+	size       int64
+	openTime   time.Time
+	nextRotate time.Time
+	millMu     sync.Mutex
+	wg         sync.WaitGroup
+}
+
+// NewRotateWriter 创建日志轮转写入器,fpath为当前日志文件的路径,opts为轮转选项,可为nil.
+// 已存在的日志文件将被追加写入;若其最后修改时间早于当前时间周期,则先轮转.
+func (kf *LkkFile) NewRotateWriter(fpath string, opts *RotateOptions) (*RotateWriter, error) {
+	if opts == nil {
+		opts = &RotateOptions{}
+	}
+	o := *opts
+	if o.Pattern == "" {
+		o.Pattern = "Ymd-His"
+	}
+	if o.Perm == 0 {
+		o.Perm = 0644
+	}
+	if o.MaxSize < 0 || o.Interval < 0 || o.MaxBackups < 0 {
+		return nil, errors.New("Rotate options must not be negative")
+	}
+
+	rw := &RotateWriter{fpath: fpath, opts: o}
+	rw.mu.Lock()
+	defer rw.mu.Unlock()
+	if err := rw.open(); err != nil {
+		return nil, err
+	}
+
+	return rw, nil
+}
+
+// Write 写入数据,必要时先轮转.单次写入的数据不会被拆分到两个文件.
+// 轮转失败(如日志文件已被外部删除)时,数据仍写入重新打开的日志文件,并返回轮转的错误.
+func (rw *RotateWriter) Write(p []byte) (int, error) {
+	rw.mu.Lock()
+	defer rw.mu.Unlock()
+
+	if rw.file == nil {
+		return 0, os.ErrClosed
+	}
+
+	var rotateErr error
+	now := time.Now()
+	if (!rw.nextRotate.IsZero() && !now.Before(rw.nextRotate)) ||
+		(rw.opts.MaxSize > 0 && rw.size > 0 && rw.size+int64(len(p)) > rw.opts.MaxSize) {
+		//轮转失败时仍写入原文件,并返回轮转的错误
+		if rotateErr = rw.rotate(); rw.file == nil {
+			return 0, rotateErr
+		}
+	}
+
+	n, err := rw.file.Write(p)
+	rw.size += int64(n)
+	if err == nil {
+		err = rotateErr
+	}
+
+	return n, err
+}
+
+// Rotate 立即轮转日志文件.
+func (rw *RotateWriter) Rotate() error {
+	rw.mu.Lock()
+	defer rw.mu.Unlock()
+
+	if rw.file == nil {
+		return os.ErrClosed
+	}
+	return rw.rotate()
+}
+
+// Sync 将已写入的数据刷到磁盘.
+func (rw *RotateWriter) Sync() error {
+	rw.mu.Lock()
+	defer rw.mu.Unlock()
+
+	if rw.file == nil {
+		return os.ErrClosed
+	}
+	return rw.file.Sync()
+}
+
+// Close 关闭当前日志文件,并等待后台的压缩和清理完成.
+func (rw *RotateWriter) Close() error {
+	rw.mu.Lock()
+	var err error
+	if rw.file != nil {
+		err = rw.file.Close()
+		rw.file = nil
+	}
+	rw.mu.Unlock()
+	rw.wg.Wait()
+
+	return err
+}
+
+// open 打开日志文件,须持有锁.
+func (rw *RotateWriter) open() error {
+	now := time.Now()
+	if info, err := os.Stat(rw.fpath); err == nil {
+		//已有文件属于之前的时间周期
+		if rw.opts.Interval > 0 && info.ModTime().Before(rotatePeriodStart(now, rw.opts.Interval)) {
+			rw.openTime = info.ModTime()
+			if err = rw.backup(); err != nil {
+				return err
+			}
+		} else if rw.opts.MaxSize > 0 && info.Size() >= rw.opts.MaxSize {
+			rw.openTime = info.ModTime()
+			if err = rw.backup(); err != nil {
+				return err
+			}
+		}
+	}
+
+	return rw.openFile(now)
+}
+
+// openFile 以追加方式打开日志文件,不检查是否需要轮转,须持有锁.
+func (rw *RotateWriter) openFile(now time.Time) error {
+	dir := filepath.Dir(rw.fpath)
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return err
+	}
+
+	file, err := os.OpenFile(rw.fpath, os.O_WRONLY|os.O_APPEND|os.O_CREATE, rw.opts.Perm)
+	if err != nil {
+		return err
+	}
+	info, err := file.Stat()
+	if err != nil {
+		_ = file.Close()
+		return err
+	}
+
+	rw.file = file
+	rw.size = info.Size()
+	rw.openTime = now
+	if rw.opts.Interval > 0 {
+		rw.nextRotate = rotatePeriodStart(now, rw.opts.Interval).Add(rw.opts.Interval)
+	}
+
+	return nil
+}
+
+// rotate 关闭当前文件,重命名为旧文件后重新打开,须持有锁.
+// 重命名或重新打开失败时,重新打开原路径继续追加写入,以免写入器被关闭而丢失日志.
+func (rw *RotateWriter) rotate() error {
+	err := rw.file.Close()
+	rw.file = nil
+	if err == nil {
+		err = rw.backup()
+	}
+	if err == nil {
+		err = rw.open()
+	}
+
+	if rw.file == nil {
+		if ferr := rw.openFile(time.Now()); ferr != nil {
+			return ferr
+		}
+	}
+	return err
+}
+
+// backup 将当前日志文件重命名为旧文件,并在后台压缩和清理,须持有锁.
+func (rw *RotateWriter) backup() error {
+	name := rw.backupName(rw.openTime)
+	if err := os.Rename(rw.fpath, name); err != nil {
+		return err
+	}
+
+	rw.wg.Add(1)
+	go func() {
+		defer rw.wg.Done()
+		rw.millMu.Lock()
+		defer rw.millMu.Unlock()
+
+		if rw.opts.Compress {
+			_ = gzipFile(name)
+		}
+		if rw.opts.MaxBackups > 0 {
+			rw.prune()
+		}
+	}()
+
+	return nil
+}
+
+// backupName 获取不与已有文件重名的旧文件路径.
+func (rw *RotateWriter) backupName(t time.Time) string {
+	dir, prefix, ext := rw.nameParts()
+	base := filepath.Join(dir, prefix+KTime.Date(rw.opts.Pattern, t))
+	name := base + ext
+	for i := 1; KFile.IsExist(name) || KFile.IsExist(name+".gz"); i++ {
+		name = fmt.Sprintf("%s.%d%s", base, i, ext)
+	}
+	return name
+}
+
+// nameParts 获取日志文件的目录、旧文件名前缀和扩展名.
+func (rw *RotateWriter) nameParts() (dir, prefix, ext string) {
+	dir = filepath.Dir(rw.fpath)
+	base := filepath.Base(rw.fpath)
+	ext = filepath.Ext(base)
+	prefix = strings.TrimSuffix(base, ext) + "."
+	return
+}
+
+// isBackupName 文件名是否为backupName生成的旧文件名,即"前缀+时间+可选的.序号+扩展名",可带".gz"后缀.
+func (rw *RotateWriter) isBackupName(name, prefix, ext string) bool {
+	name = strings.TrimSuffix(name, ".gz")
+	if len(name) <= len(prefix)+len(ext) || !strings.HasPrefix(name, prefix) || !strings.HasSuffix(name, ext) {
+		return false
+	}
+
+	stamp := name[len(prefix) : len(name)-len(ext)]
+	layout := strings.NewReplacer(datePatterns...).Replace(rw.opts.Pattern)
+	if _, err := time.Parse(layout, stamp); err == nil {
+		return true
+	}
+
+	//重名时追加的序号
+	if pos := strings.LastIndex(stamp, "."); pos > 0 && pos < len(stamp)-1 && strings.Trim(stamp[pos+1:], "0123456789") == "" {
+		_, err := time.Parse(layout, stamp[:pos])
+		return err == nil
+	}
+	return false
+}
+
+// Backups 获取已有的旧文件列表,按修改时间由新到旧排序.
+func (rw *RotateWriter) Backups() []string {
+	dir, prefix, ext := rw.nameParts()
+	files, err := filepath.Glob(filepath.Join(dir, prefix+"*"))
+	if err != nil {
+		return nil
+	}
+
+	type backupFile struct {
+		path  string
+		mtime time.Time
+	}
+	var backups []backupFile
+	for _, fpath := range files {
+		if fpath == filepath.Clean(rw.fpath) || !rw.isBackupName(filepath.Base(fpath), prefix, ext) {
+			continue
+		}
+		if info, err := os.Stat(fpath); err == nil && info.Mode().IsRegular() {
+			backups = append(backups, backupFile{path: fpath, mtime: info.ModTime()})
+		}
+	}
+
+	sort.SliceStable(backups, func(i, j int) bool {
+		if !backups[i].mtime.Equal(backups[j].mtime) {
+			return backups[i].mtime.After(backups[j].mtime)
+		}
+		return backups[i].path > backups[j].path
+	})
+
+	res := make([]string, len(backups))
+	for i, bf := range backups {
+		res[i] = bf.path
+	}
+	return res
+}
+
+// prune 删除超出保留数量的旧文件.
+func (rw *RotateWriter) prune() {
+	backups := rw.Backups()
+	if len(backups) <= rw.opts.MaxBackups {
+		return
+	}
+	for _, fpath := range backups[rw.opts.MaxBackups:] {
+		_ = os.Remove(fpath)
+	}
+}
+
+// rotatePeriodStart 获取t所在时间周期的开始时间;整天的周期按本地零点对齐.
+func rotatePeriodStart(t time.Time, interval time.Duration) time.Time {
+	day := 24 * time.Hour
+	if interval%day == 0 {
+		midnight := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+		days := int(interval / day)
+		if days > 1 {
+			//以年内的第几天对齐多天的周期
+			midnight = midnight.AddDate(0, 0, -((midnight.YearDay() - 1) % days))
+		}
+		return midnight
+	}
+
+	_, offset := t.Zone()
+	shift := time.Duration(offset) * time.Second
+	return t.Add(shift).Truncate(interval).Add(-shift)
+}
+
+// gzipFile 使用gz压缩器将文件压缩为"fpath.gz",成功后删除原文件并保留其修改时间.
+func gzipFile(fpath string) error {
+	info, err := os.Stat(fpath)
+	if err != nil {
+		return err
+	}
+	src, err := os.Open(fpath)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = src.Close()
+	}()
+
+	tmp := fpath + ".gz.tmp"
+	dst, err := os.OpenFile(tmp, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, info.Mode().Perm())
+	if err != nil {
+		return err
+	}
+
+	zw, err := compressorByName("gz").NewWriter(dst)
+	if err == nil {
+		if _, err = io.Copy(zw, src); err == nil {
+			err = zw.Close()
+		}
+	}
+	if cerr := dst.Close(); err == nil {
+		err = cerr
+	}
+	if err == nil {
+		err = os.Rename(tmp, fpath+".gz")
+	}
+	if err != nil {
+		_ = os.Remove(tmp)
+		return err
+	}
+
+	_ = os.Chtimes(fpath+".gz", info.ModTime(), info.ModTime())
+	return os.Remove(fpath)
+}
@@ -0,0 +1,236 @@
+package kgo
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestRotateWriter(t *testing.T) {
+	ts, _ := KOS.NewTempSpace(nil)
+	defer func() {
+		_ = ts.Close()
+	}()
+
+	dir, _ := ts.Dir("rotate-*")
+	fpath := dir + "/app.log"
+
+	rw, err := KFile.NewRotateWriter(fpath, &RotateOptions{MaxSize: 100, MaxBackups: 3})
+	if err != nil {
+		t.Error("NewRotateWriter fail")
+		return
+	}
+
+	//并发写入
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			for j := 0; j < 10; j++ {
+				_, _ = fmt.Fprintf(rw, "writer %d line %02d\n", i, j)
+			}
+		}(i)
+	}
+	wg.Wait()
+	_ = rw.Sync()
+	_ = rw.Close()
+
+	backups := rw.Backups()
+	if len(backups) != 3 || KFile.FileSize(fpath) > 100 {
+		t.Error("RotateWriter size rotate fail")
+		return
+	}
+	for _, backup := range backups {
+		if size := KFile.FileSize(backup); size > 100 || size <= 0 || !strings.HasPrefix(KFile.Basename(backup), "app.") {
+			t.Error("RotateWriter backup fail")
+			return
+		}
+		lines, _ := KFile.ReadInArray(backup)
+		for _, line := range lines {
+			if line != "" && !strings.HasPrefix(line, "writer ") {
+				t.Error("RotateWriter interleave fail")
+				return
+			}
+		}
+	}
+
+	if _, err = rw.Write([]byte("closed")); err == nil {
+		t.Error("RotateWriter write closed fail")
+		return
+	} else if err = rw.Rotate(); err == nil {
+		t.Error("RotateWriter rotate closed fail")
+		return
+	}
+
+	//压缩
+	rw, _ = KFile.NewRotateWriter(fpath, &RotateOptions{Compress: true, Pattern: "Ymd"})
+	_, _ = rw.Write([]byte("hello world\n"))
+	if err = rw.Rotate(); err != nil {
+		t.Error("RotateWriter Rotate fail")
+		return
+	}
+	_ = rw.Close()
+
+	gzName := dir + "/app." + KTime.Date("Ymd") + ".log.gz"
+	if !KFile.IsFile(gzName) {
+		gzName = dir + "/app." + KTime.Date("Ymd") + ".1.log.gz"
+	}
+	fr, err := os.Open(gzName)
+	if err != nil {
+		t.Error("RotateWriter compress fail")
+		return
+	}
+	zr, _ := compressorByName("gz").NewReader(fr)
+	data, _ := ioutil.ReadAll(zr)
+	_ = fr.Close()
+	if !strings.Contains(string(data), "hello world") {
+		t.Error("RotateWriter compress content fail")
+		return
+	}
+
+	//按时间轮转
+	_ = os.RemoveAll(dir)
+	_ = KFile.WriteFile(fpath, []byte("yesterday\n"))
+	yesterday := time.Now().Add(-25 * time.Hour)
+	_ = os.Chtimes(fpath, yesterday, yesterday)
+	rw, _ = KFile.NewRotateWriter(fpath, &RotateOptions{Interval: 24 * time.Hour, Pattern: "Y-m-d"})
+	_, _ = rw.Write([]byte("today\n"))
+	_ = rw.Close()
+	if !KFile.IsFile(dir+"/app."+KTime.Date("Y-m-d", yesterday)+".log") || KFile.FileSize(fpath) != 6 {
+		t.Error("RotateWriter interval fail")
+		return
+	}
+
+	_, err = KFile.NewRotateWriter(fpath, &RotateOptions{MaxSize: -1})
+	if err == nil {
+		t.Error("NewRotateWriter options fail")
+		return
+	}
+	_, err = KFile.NewRotateWriter(dir+"/\x00/app.log", nil)
+	if err == nil {
+		t.Error("NewRotateWriter path fail")
+		return
+	}
+}
+
+func TestRotateWriterFailure(t *testing.T) {
+	ts, _ := KOS.NewTempSpace(nil)
+	defer func() {
+		_ = ts.Close()
+	}()
+
+	fpath := ts.Join("app.log")
+	rw, _ := KFile.NewRotateWriter(fpath, &RotateOptions{MaxSize: 10})
+	defer func() {
+		_ = rw.Close()
+	}()
+	_, _ = rw.Write([]byte("hello\n"))
+
+	//日志文件被外部删除,轮转时重命名失败
+	_ = KFile.Unlink(fpath)
+	if err := rw.Rotate(); err == nil {
+		t.Error("RotateWriter rotate fail")
+		return
+	}
+	if n, err := rw.Write([]byte("world\n")); n != 6 || err != nil {
+		t.Error("RotateWriter reopen fail")
+		return
+	}
+
+	//写入时轮转失败,数据仍写入
+	_ = KFile.Unlink(fpath)
+	if n, err := rw.Write([]byte("again\n")); n != 6 || err == nil {
+		t.Error("RotateWriter write rotate fail")
+		return
+	}
+	_ = rw.Sync()
+	if content, _ := KFile.ReadFile(fpath); string(content) != "again\n" {
+		t.Error("RotateWriter write after failure fail")
+		return
+	}
+}
+
+func BenchmarkRotateWriterFailure(b *testing.B) {
+	ts, _ := KOS.NewTempSpace(nil)
+	defer func() {
+		_ = ts.Close()
+	}()
+
+	fpath := ts.Join("app.log")
+	rw, _ := KFile.NewRotateWriter(fpath, nil)
+	defer func() {
+		_ = rw.Close()
+	}()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = KFile.Unlink(fpath)
+		_ = rw.Rotate()
+	}
+}
+
+func TestRotateWriterBackups(t *testing.T) {
+	ts, _ := KOS.NewTempSpace(nil)
+	defer func() {
+		_ = ts.Close()
+	}()
+
+	for _, name := range []string{"app.other.log", "app.20200101-150405.log", "app.20200101-150405.1.log.gz", "app.20200101.log", "app.20200101-150405.log.gz.tmp", "app.20200101-150405.x.log"} {
+		_ = KFile.WriteFile(ts.Join(name), []byte(name))
+	}
+
+	rw, _ := KFile.NewRotateWriter(ts.Join("app.log"), nil)
+	_ = rw.Close()
+	backups := rw.Backups()
+	sort.Strings(backups)
+	if len(backups) != 2 || KFile.Basename(backups[0]) != "app.20200101-150405.1.log.gz" || KFile.Basename(backups[1]) != "app.20200101-150405.log" {
+		t.Errorf("RotateWriter Backups fail: %v", backups)
+		return
+	}
+}
+
+func BenchmarkRotateWriterBackups(b *testing.B) {
+	ts, _ := KOS.NewTempSpace(nil)
+	defer func() {
+		_ = ts.Close()
+	}()
+	_ = KFile.WriteFile(ts.Join("app.20200101-150405.log"), []byte("hello"))
+
+	rw, _ := KFile.NewRotateWriter(ts.Join("app.log"), nil)
+	_ = rw.Close()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = rw.Backups()
+	}
+}
+
+func TestRotatePeriodStart(t *testing.T) {
+	now := time.Date(2020, 3, 15, 13, 45, 10, 0, time.Local)
+	if start := rotatePeriodStart(now, time.Hour); !start.Equal(time.Date(2020, 3, 15, 13, 0, 0, 0, time.Local)) {
+		t.Error("rotatePeriodStart hourly fail")
+		return
+	} else if start = rotatePeriodStart(now, 24*time.Hour); !start.Equal(time.Date(2020, 3, 15, 0, 0, 0, 0, time.Local)) {
+		t.Error("rotatePeriodStart daily fail")
+		return
+	}
+}
+
+func BenchmarkRotateWriter(b *testing.B) {
+	ts, _ := KOS.NewTempSpace(nil)
+	defer func() {
+		_ = ts.Close()
+	}()
+
+	b.ResetTimer()
+	rw, _ := KFile.NewRotateWriter(ts.Join("bench.log"), &RotateOptions{MaxSize: 1048576, MaxBackups: 2})
+	line := []byte("benchmark rotate writer line\n")
+	for i := 0; i < b.N; i++ {
+		_, _ = rw.Write(line)
+	}
+	_ = rw.Close()
+}
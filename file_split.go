@@ -0,0 +1,256 @@
+package kgo
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+)
+
+// SplitManifest 文件切分清单
+type SplitManifest struct {
+	Name     string      `json:"name"`      // 原文件名
+	Size     int64       `json:"size"`      // 原文件大小
+	PartSize int64       `json:"part_size"` // 分片大小
+	ShaX     uint16      `json:"sha_x"`     // 散列算法,为1/256/512
+	Sha      string      `json:"sha"`       // 原文件的散列值
+	Parts    []SplitPart `json:"parts"`     // 分片列表
+}
+
+// SplitPart 文件分片
+type SplitPart struct {
+	Name string `json:"name"` // 分片文件名,与清单位于同一目录
+	Size int64  `json:"size"` // 分片大小
+	Sha  string `json:"sha"`  // 分片的散列值
+}
+
+// ErrChecksumMismatch 散列值校验失败
+var ErrChecksumMismatch = errors.New("Checksum mismatch")
+
+// Split 将文件切分为大小为partSize的若干分片"name.001","name.002"...,并生成清单"name.manifest.json".
+// dstDir为分片和清单的存放目录,为空时使用原文件所在目录;x为散列算法,为1/256/512,默认256.
+// 返回切分清单.
+func (kf *LkkFile) Split(fpath string, partSize int64, dstDir string, x ...uint16) (*SplitManifest, error) {
+	if partSize <= 0 {
+		return nil, errors.New("Split partSize must be greater than 0")
+	}
+
+	shaX := uint16(256)
+	if len(x) > 0 {
+		shaX = x[0]
+	}
+	if newShaX(shaX) == nil {
+		return nil, fmt.Errorf("Unsupported shaX: %d", shaX)
+	}
+
+	src, err := os.Open(fpath)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = src.Close()
+	}()
+
+	info, err := src.Stat()
+	if err != nil {
+		return nil, err
+	} else if !info.Mode().IsRegular() {
+		return nil, fmt.Errorf("%s is not a regular file", fpath)
+	}
+
+	if dstDir == "" {
+		dstDir = filepath.Dir(fpath)
+	}
+	if err = os.MkdirAll(dstDir, 0755); err != nil {
+		return nil, err
+	}
+
+	manifest := &SplitManifest{
+		Name:     info.Name(),
+		Size:     info.Size(),
+		PartSize: partSize,
+		ShaX:     shaX,
+	}
+
+	whole := newShaX(shaX)
+	reader := io.TeeReader(src, whole)
+	for i := 1; ; i++ {
+		part := SplitPart{Name: fmt.Sprintf("%s.%03d", info.Name(), i)}
+		part.Size, part.Sha, err = writeSplitPart(filepath.Join(dstDir, part.Name), reader, partSize, shaX)
+		if err != nil {
+			return nil, err
+		} else if part.Size == 0 && i > 1 {
+			//原文件大小恰为分片大小的整数倍
+			_ = os.Remove(filepath.Join(dstDir, part.Name))
+			break
+		}
+
+		manifest.Parts = append(manifest.Parts, part)
+		if part.Size < partSize {
+			break
+		}
+	}
+	manifest.Sha = hex.EncodeToString(whole.Sum(nil))
+
+	data, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	if err = kf.WriteFileAtomic(filepath.Join(dstDir, info.Name()+".manifest.json"), data, false); err != nil {
+		return nil, err
+	}
+
+	return manifest, nil
+}
+
+// Join 根据切分清单合并分片为文件dest,逐个校验分片及最终文件的散列值.
+// 合并过程写入临时文件"dest.joining",中断后再次调用时会校验其中已合并的分片并从断点继续;
+// 全部校验通过后才重命名为dest.校验失败时返回ErrChecksumMismatch包装的错误;
+// 清单中的分片名须为不含路径的文件名,否则拒绝合并.
+func (kf *LkkFile) Join(manifestPath string, dest string) (*SplitManifest, error) {
+	data, err := ioutil.ReadFile(manifestPath)
+	if err != nil {
+		return nil, err
+	}
+
+	manifest := &SplitManifest{}
+	if err = json.Unmarshal(data, manifest); err != nil {
+		return nil, err
+	} else if newShaX(manifest.ShaX) == nil {
+		return nil, fmt.Errorf("Unsupported shaX: %d", manifest.ShaX)
+	}
+
+	//清单可能不可信,分片只能是清单所在目录中的文件
+	for _, part := range manifest.Parts {
+		if part.Name == "" || part.Name == "." || part.Name == ".." || filepath.Base(part.Name) != part.Name {
+			return nil, fmt.Errorf("Invalid part name: %s", part.Name)
+		}
+	}
+
+	if dir := filepath.Dir(dest); !kf.IsDir(dir) {
+		if err = os.MkdirAll(dir, 0755); err != nil {
+			return nil, err
+		}
+	}
+
+	tmp := dest + ".joining"
+	out, err := os.OpenFile(tmp, os.O_RDWR|os.O_CREATE, 0644)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = out.Close()
+	}()
+
+	partDir := filepath.Dir(manifestPath)
+	whole := newShaX(manifest.ShaX)
+	var offset int64
+	for _, part := range manifest.Parts {
+		//断点续传:校验临时文件中已合并的分片
+		if ok, err := verifyJoined(out, offset, part, manifest.ShaX, whole); err != nil {
+			return nil, err
+		} else if ok {
+			offset += part.Size
+			continue
+		}
+
+		if err = joinPart(out, offset, filepath.Join(partDir, part.Name), part, manifest.ShaX, whole); err != nil {
+			return nil, err
+		}
+		offset += part.Size
+	}
+
+	if err = out.Truncate(offset); err != nil {
+		return nil, err
+	} else if offset != manifest.Size {
+		return nil, fmt.Errorf("%w: size %d, want %d", ErrChecksumMismatch, offset, manifest.Size)
+	} else if sum := hex.EncodeToString(whole.Sum(nil)); sum != manifest.Sha {
+		_ = out.Close()
+		_ = os.Remove(tmp)
+		return nil, fmt.Errorf("%w: file %s", ErrChecksumMismatch, manifest.Name)
+	}
+
+	if err = out.Sync(); err != nil {
+		return nil, err
+	} else if err = out.Close(); err != nil {
+		return nil, err
+	} else if err = os.Rename(tmp, dest); err != nil {
+		return nil, err
+	}
+
+	return manifest, nil
+}
+
+// writeSplitPart 从r读取最多size字节写入分片文件,返回写入的字节数及其散列值.
+func writeSplitPart(fpath string, r io.Reader, size int64, shaX uint16) (int64, string, error) {
+	file, err := os.OpenFile(fpath, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0644)
+	if err != nil {
+		return 0, "", err
+	}
+	defer func() {
+		_ = file.Close()
+	}()
+
+	h := newShaX(shaX)
+	n, err := io.Copy(io.MultiWriter(file, h), io.LimitReader(r, size))
+	if err == nil {
+		err = file.Close()
+	}
+
+	return n, hex.EncodeToString(h.Sum(nil)), err
+}
+
+// verifyJoined 检查临时文件中offset处是否已是完整且正确的分片,是则将其计入整体散列.
+func verifyJoined(out *os.File, offset int64, part SplitPart, shaX uint16, whole hash.Hash) (bool, error) {
+	info, err := out.Stat()
+	if err != nil {
+		return false, err
+	} else if info.Size() < offset+part.Size {
+		return false, nil
+	}
+
+	h := newShaX(shaX)
+	if _, err = io.Copy(h, io.NewSectionReader(out, offset, part.Size)); err != nil {
+		return false, err
+	} else if hex.EncodeToString(h.Sum(nil)) != part.Sha {
+		return false, nil
+	}
+
+	_, err = io.Copy(whole, io.NewSectionReader(out, offset, part.Size))
+	return err == nil, err
+}
+
+// joinPart 校验分片并写入临时文件的offset处.
+func joinPart(out *os.File, offset int64, fpath string, part SplitPart, shaX uint16, whole hash.Hash) error {
+	file, err := os.Open(fpath)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = file.Close()
+	}()
+
+	//先校验分片,避免写入损坏的数据
+	h := newShaX(shaX)
+	if n, err := io.Copy(h, file); err != nil {
+		return err
+	} else if n != part.Size || hex.EncodeToString(h.Sum(nil)) != part.Sha {
+		return fmt.Errorf("%w: part %s", ErrChecksumMismatch, part.Name)
+	}
+
+	if err = out.Truncate(offset); err != nil {
+		return err
+	} else if _, err = out.Seek(offset, io.SeekStart); err != nil {
+		return err
+	} else if _, err = file.Seek(0, io.SeekStart); err != nil {
+		return err
+	}
+
+	_, err = io.Copy(io.MultiWriter(out, whole), file)
+	return err
+}
@@ -0,0 +1,167 @@
+package kgo
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+func TestSplitJoin(t *testing.T) {
+	ts, _ := KOS.NewTempSpace(nil)
+	defer func() {
+		_ = ts.Close()
+	}()
+
+	dir, _ := ts.Dir("split-*")
+
+	src := "./testdata/gopher10th-large.jpg"
+	size := KFile.FileSize(src)
+	manifest, err := KFile.Split(src, 10240, dir)
+	if err != nil || manifest.Size != size || len(manifest.Parts) != int((size+10239)/10240) {
+		t.Error("Split fail")
+		return
+	}
+	sha, _ := KFile.ShaX(src, 256)
+	partSha, _ := KFile.ShaX(dir+"/gopher10th-large.jpg.001", 256)
+	if manifest.Sha != sha || manifest.Parts[0].Sha != partSha || !KFile.IsFile(dir+"/gopher10th-large.jpg.manifest.json") {
+		t.Error("Split manifest fail")
+		return
+	}
+
+	manifestPath := dir + "/gopher10th-large.jpg.manifest.json"
+	dest := dir + "/joined/large.jpg"
+	if _, err = KFile.Join(manifestPath, dest); err != nil {
+		t.Error("Join fail")
+		return
+	}
+	sha2, _ := KFile.ShaX(dest, 256)
+	if sha2 != sha || KFile.IsExist(dest+".joining") {
+		t.Error("Join content fail")
+		return
+	}
+
+	//续传:临时文件中已有前两个分片及部分损坏数据
+	orig, _ := KFile.ReadFile(src)
+	partial := append([]byte{}, orig[:20480]...)
+	partial = append(partial, bytes.Repeat([]byte{'x'}, 100)...)
+	_ = KFile.WriteFile(dir+"/resume.jpg.joining", partial)
+	if _, err = KFile.Join(manifestPath, dir+"/resume.jpg"); err != nil {
+		t.Error("Join resume fail")
+		return
+	}
+	sha3, _ := KFile.ShaX(dir+"/resume.jpg", 256)
+	if sha3 != sha {
+		t.Error("Join resume content fail")
+		return
+	}
+
+	//损坏的分片
+	_ = KFile.WriteFile(dir+"/gopher10th-large.jpg.002", []byte("broken"))
+	_, err = KFile.Join(manifestPath, dir+"/broken.jpg")
+	if !errors.Is(err, ErrChecksumMismatch) || KFile.IsExist(dir+"/broken.jpg") {
+		t.Error("Join broken part fail")
+		return
+	}
+
+	//整数倍及sha512
+	manifest, err = KFile.Split("./testdata/dante.txt", KFile.FileSize("./testdata/dante.txt"), dir+"/exact", 512)
+	if err != nil || len(manifest.Parts) != 1 || len(manifest.Sha) != 128 || KFile.IsExist(dir+"/exact/dante.txt.002") {
+		t.Error("Split exact fail")
+		return
+	}
+	if _, err = KFile.Join(dir+"/exact/dante.txt.manifest.json", dir+"/exact/dante.txt"); err != nil {
+		t.Error("Join exact fail")
+		return
+	}
+
+	//空文件
+	_ = KFile.WriteFile(dir+"/empty/empty.txt", []byte{})
+	manifest, _ = KFile.Split(dir+"/empty/empty.txt", 100, "")
+	if len(manifest.Parts) != 1 {
+		t.Error("Split empty fail")
+		return
+	} else if _, err = KFile.Join(dir+"/empty/empty.txt.manifest.json", dir+"/empty/joined.txt"); err != nil || KFile.FileSize(dir+"/empty/joined.txt") != 0 {
+		t.Error("Join empty fail")
+		return
+	}
+
+	if _, err = KFile.Split(src, 0, dir); err == nil {
+		t.Error("Split partSize fail")
+		return
+	} else if _, err = KFile.Split(src, 100, dir, 3); err == nil {
+		t.Error("Split shaX fail")
+		return
+	} else if _, err = KFile.Split("./hello", 100, dir); err == nil {
+		t.Error("Split not exist fail")
+		return
+	} else if _, err = KFile.Split("./testdata", 100, dir); err == nil {
+		t.Error("Split dir fail")
+		return
+	} else if _, err = KFile.Join("./hello", dir+"/x"); err == nil {
+		t.Error("Join not exist fail")
+		return
+	} else if _, err = KFile.Join("./testdata/dante.txt", dir+"/x"); err == nil {
+		t.Error("Join bad manifest fail")
+		return
+	}
+}
+
+func TestJoinPartName(t *testing.T) {
+	ts, _ := KOS.NewTempSpace(nil)
+	defer func() {
+		_ = ts.Close()
+	}()
+
+	//清单中的分片名指向清单目录之外的文件
+	_ = KFile.WriteFile(ts.Join("secret.txt"), []byte("secret"))
+	sha, _ := KFile.ShaX(ts.Join("secret.txt"), 256)
+	for _, name := range []string{"../secret.txt", "sub/../../secret.txt", ts.Join("secret.txt"), "..", ""} {
+		manifest := &SplitManifest{Name: "secret.txt", Size: 6, PartSize: 6, ShaX: 256, Sha: sha, Parts: []SplitPart{{Name: name, Size: 6, Sha: sha}}}
+		data, _ := json.Marshal(manifest)
+		_ = KFile.WriteFile(ts.Join("parts", "secret.txt.manifest.json"), data)
+		if _, err := KFile.Join(ts.Join("parts", "secret.txt.manifest.json"), ts.Join("joined.txt")); err == nil || KFile.IsExist(ts.Join("joined.txt")) {
+			t.Errorf("Join part name %q fail", name)
+			return
+		}
+	}
+}
+
+func BenchmarkJoinPartName(b *testing.B) {
+	ts, _ := KOS.NewTempSpace(nil)
+	defer func() {
+		_ = ts.Close()
+	}()
+	data, _ := json.Marshal(&SplitManifest{ShaX: 256, Parts: []SplitPart{{Name: "../secret.txt"}}})
+	fpath, _ := ts.WriteFile("*.manifest.json", data)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, _ = KFile.Join(fpath, ts.Join("joined.txt"))
+	}
+}
+
+func BenchmarkSplit(b *testing.B) {
+	ts, _ := KOS.NewTempSpace(nil)
+	defer func() {
+		_ = ts.Close()
+	}()
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, _ = KFile.Split("./testdata/gopher10th-large.jpg", 65536, ts.Join("bench"))
+	}
+}
+
+func BenchmarkJoin(b *testing.B) {
+	ts, _ := KOS.NewTempSpace(nil)
+	defer func() {
+		_ = ts.Close()
+	}()
+
+	b.ResetTimer()
+	_, _ = KFile.Split("./testdata/gopher10th-large.jpg", 65536, ts.Join("bench"))
+	for i := 0; i < b.N; i++ {
+		_, _ = KFile.Join(ts.Join("bench/gopher10th-large.jpg.manifest.json"), ts.Join("bench/joined.jpg"))
+	}
+}
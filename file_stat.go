@@ -0,0 +1,243 @@
+package kgo
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"os"
+	"os/user"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"syscall"
+	"time"
+)
+
+// FileStat 文件的详细元数据
+type FileStat struct {
+	Path       string      `json:"path"`                  // 路径
+	Name       string      `json:"name"`                  // 文件名
+	Size       int64       `json:"size"`                  // 大小(字节)
+	Mode       os.FileMode `json:"mode"`                  // 模式
+	Perm       string      `json:"perm"`                  // 模式的字符串形式,如"-rw-r--r--"
+	IsDir      bool        `json:"is_dir"`                // 是否目录
+	IsLink     bool        `json:"is_link"`               // 是否符号链接
+	LinkTarget string      `json:"link_target,omitempty"` // 符号链接的目标
+	Uid        uint32      `json:"uid"`                   // 所有者ID
+	Gid        uint32      `json:"gid"`                   // 所属组ID
+	Owner      string      `json:"owner"`                 // 所有者名称,无法获取时为ID
+	Group      string      `json:"group"`                 // 所属组名称,无法获取时为ID
+	Atime      time.Time   `json:"atime"`                 // 最后访问时间
+	Mtime      time.Time   `json:"mtime"`                 // 最后修改时间
+	Ctime      time.Time   `json:"ctime"`                 // 最后状态变更时间
+	Dev        uint64      `json:"dev"`                   // 所在设备号
+	Inode      uint64      `json:"inode"`                 // inode号
+	Nlink      uint64      `json:"nlink"`                 // 硬链接数
+	Mime       string      `json:"mime,omitempty"`        // mime类型,仅常规文件
+	IsBinary   bool        `json:"is_binary"`             // 是否二进制文件,仅常规文件
+	IsImage    bool        `json:"is_image"`              // 是否图片文件,仅常规文件
+	Error      string      `json:"error,omitempty"`       // 获取部分信息失败时的错误
+}
+
+// statNames 用户名和组名缓存,避免遍历时重复查询
+var statNames = struct {
+	sync.RWMutex
+	users  map[uint32]string
+	groups map[uint32]string
+}{users: make(map[uint32]string), groups: make(map[uint32]string)}
+
+// Stat 获取文件的详细元数据,包括大小、模式、所有者、时间、inode、链接目标、mime类型等.
+// 不跟随符号链接,即获取的是链接本身的信息.
+func (kf *LkkFile) Stat(fpath string) (*FileStat, error) {
+	info, err := os.Lstat(fpath)
+	if err != nil {
+		return nil, err
+	}
+
+	return newFileStat(fpath, info), nil
+}
+
+// StatTree 遍历目录root(含自身)并以流的形式发送每一项的元数据,用于清点文件等任务.
+// filters为路径过滤器,须全部通过才会发送,未通过的目录不再进入;可通过ctx取消.
+// 返回的元数据通道在遍历结束后关闭;错误通道最多接收一个错误(根目录无效或ctx被取消),随后关闭.
+// 单项的读取错误(包括遍历期间被删除而无法获取信息的项)记录在其Error字段中,不会中止遍历.
+func (kf *LkkFile) StatTree(ctx context.Context, root string, filters ...FileFilter) (<-chan *FileStat, <-chan error) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	stats := make(chan *FileStat, 64)
+	errs := make(chan error, 1)
+	go func() {
+		defer func() {
+			close(stats)
+			close(errs)
+		}()
+
+		err := filepath.Walk(root, func(fpath string, info os.FileInfo, err error) error {
+			if ctxErr := ctx.Err(); ctxErr != nil {
+				return ctxErr
+			} else if info == nil && fpath == root {
+				return err
+			} else if info == nil {
+				//遍历期间被删除等,仅发送带有错误的条目,继续遍历
+				select {
+				case stats <- &FileStat{Path: fpath, Name: filepath.Base(fpath), Error: err.Error()}:
+					return nil
+				case <-ctx.Done():
+					return ctx.Err()
+				}
+			}
+
+			for _, filter := range filters {
+				if fpath != root && !filter(fpath) {
+					if info.IsDir() {
+						return filepath.SkipDir
+					}
+					return nil
+				}
+			}
+
+			stat := newFileStat(fpath, info)
+			if err != nil && stat.Error == "" {
+				stat.Error = err.Error()
+			}
+
+			select {
+			case stats <- stat:
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+
+			if err != nil && info.IsDir() {
+				return filepath.SkipDir
+			}
+			return nil
+		})
+
+		if err != nil {
+			errs <- err
+		}
+	}()
+
+	return stats, errs
+}
+
+// newFileStat 根据Lstat的结果创建文件元数据.
+func newFileStat(fpath string, info os.FileInfo) *FileStat {
+	mode := info.Mode()
+	stat := &FileStat{
+		Path:   fpath,
+		Name:   info.Name(),
+		Size:   info.Size(),
+		Mode:   mode,
+		Perm:   mode.String(),
+		IsDir:  info.IsDir(),
+		IsLink: mode&os.ModeSymlink != 0,
+		Mtime:  info.ModTime(),
+	}
+
+	if st, ok := info.Sys().(*syscall.Stat_t); ok {
+		stat.Uid = st.Uid
+		stat.Gid = st.Gid
+		stat.Owner = lookupUserName(st.Uid)
+		stat.Group = lookupGroupName(st.Gid)
+		stat.Atime = time.Unix(st.Atim.Unix())
+		stat.Ctime = time.Unix(st.Ctim.Unix())
+		stat.Dev = uint64(st.Dev)
+		stat.Inode = uint64(st.Ino)
+		stat.Nlink = uint64(st.Nlink)
+	}
+
+	var err error
+	if stat.IsLink {
+		stat.LinkTarget, err = os.Readlink(fpath)
+	} else if mode.IsRegular() {
+		err = stat.sniff(fpath)
+	}
+	if err != nil {
+		stat.Error = err.Error()
+	}
+
+	return stat
+}
+
+// sniff 读取文件头,检测mime类型及是否二进制、图片文件.
+func (fs *FileStat) sniff(fpath string) error {
+	fs.Mime = KFile.MimeByExt(filepath.Ext(fpath))
+	fs.IsImage = KFile.IsImg(fpath)
+	if fs.Size == 0 {
+		return nil
+	}
+
+	f, err := os.Open(fpath)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = f.Close()
+	}()
+
+	//与git相同,在前8000字节中查找空字节判断是否二进制
+	head := make([]byte, mimeSniffLen)
+	n, err := io.ReadFull(f, head)
+	if err != nil && err != io.ErrUnexpectedEOF {
+		return err
+	}
+	head = head[:n]
+
+	fs.IsBinary = bytes.IndexByte(head[:KNum.MinInt(n, 8000)], 0) != -1
+	detected := KFile.DetectMimeBytes(head)
+	if fs.Mime == "" || (detected != "application/octet-stream" && detected != "text/plain") {
+		fs.Mime = detected
+	}
+	if isImageMime(fs.Mime) {
+		fs.IsImage = true
+	}
+
+	return nil
+}
+
+// lookupUserName 获取用户ID对应的用户名,无法获取时返回ID.
+func lookupUserName(uid uint32) string {
+	statNames.RLock()
+	name, ok := statNames.users[uid]
+	statNames.RUnlock()
+	if ok {
+		return name
+	}
+
+	id := strconv.FormatUint(uint64(uid), 10)
+	name = id
+	if u, err := user.LookupId(id); err == nil {
+		name = u.Username
+	}
+
+	statNames.Lock()
+	statNames.users[uid] = name
+	statNames.Unlock()
+
+	return name
+}
+
+// lookupGroupName 获取组ID对应的组名,无法获取时返回ID.
+func lookupGroupName(gid uint32) string {
+	statNames.RLock()
+	name, ok := statNames.groups[gid]
+	statNames.RUnlock()
+	if ok {
+		return name
+	}
+
+	id := strconv.FormatUint(uint64(gid), 10)
+	name = id
+	if g, err := user.LookupGroupId(id); err == nil {
+		name = g.Name
+	}
+
+	statNames.Lock()
+	statNames.groups[gid] = name
+	statNames.Unlock()
+
+	return name
+}
@@ -0,0 +1,155 @@
+package kgo
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"strings"
+	"testing"
+)
+
+func TestStat(t *testing.T) {
+	stat, err := KFile.Stat("./testdata/diglett.png")
+	if err != nil || stat.Size != KFile.FileSize("./testdata/diglett.png") || stat.Name != "diglett.png" {
+		t.Error("Stat fail")
+		return
+	} else if stat.Mime != "image/png" || !stat.IsImage || !stat.IsBinary {
+		t.Error("Stat mime fail")
+		return
+	} else if stat.Inode == 0 || stat.Nlink == 0 || stat.Owner == "" || stat.Mtime.IsZero() || stat.Ctime.IsZero() {
+		t.Error("Stat sys fail")
+		return
+	}
+
+	stat, _ = KFile.Stat("./testdata/dante.txt")
+	if stat.IsBinary || stat.IsImage || !strings.HasPrefix(stat.Mime, "text/plain") || stat.Perm != stat.Mode.String() {
+		t.Error("Stat text fail")
+		return
+	}
+
+	stat, _ = KFile.Stat("./testdata")
+	if !stat.IsDir || stat.Mime != "" {
+		t.Error("Stat dir fail")
+		return
+	}
+
+	ts, _ := KOS.NewTempSpace(nil)
+	defer func() {
+		_ = ts.Close()
+	}()
+
+	link := ts.Join("link")
+	_ = os.Symlink("../../testdata/dante.txt", link)
+	stat, _ = KFile.Stat(link)
+	if !stat.IsLink || stat.LinkTarget != "../../testdata/dante.txt" {
+		t.Error("Stat link fail")
+		return
+	}
+
+	js, err := json.Marshal(stat)
+	if err != nil || !strings.Contains(string(js), `"link_target":"../../testdata/dante.txt"`) {
+		t.Error("Stat json fail")
+		return
+	}
+
+	_, err = KFile.Stat("./hello")
+	if err == nil {
+		t.Error("Stat not exist fail")
+		return
+	}
+}
+
+func TestStatTree(t *testing.T) {
+	var num int
+	stats, errs := KFile.StatTree(context.Background(), "./testdata")
+	for stat := range stats {
+		if stat.Path == "" || stat.Error != "" {
+			t.Error("StatTree fail")
+			return
+		}
+		num++
+	}
+	if err := <-errs; err != nil || num != len(KFile.FileTree("./testdata", FILE_TREE_ALL, true))+1 {
+		t.Error("StatTree fail")
+		return
+	}
+
+	//过滤
+	num = 0
+	stats, _ = KFile.StatTree(nil, "./testdata", func(fpath string) bool {
+		return !strings.HasSuffix(fpath, "rsa")
+	})
+	for stat := range stats {
+		if strings.Contains(stat.Path, "rsa") {
+			t.Error("StatTree filter fail")
+			return
+		}
+		num++
+	}
+	if num != len(KFile.FileTree("./testdata", FILE_TREE_ALL, false)) {
+		t.Error("StatTree filter fail")
+		return
+	}
+
+	//取消
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	stats, errs = KFile.StatTree(ctx, "./testdata")
+	for range stats {
+	}
+	if err := <-errs; err == nil {
+		t.Error("StatTree cancel fail")
+		return
+	}
+
+	//遍历期间被删除的文件
+	ts, _ := KOS.NewTempSpace(nil)
+	defer func() {
+		_ = ts.Close()
+	}()
+	for _, name := range []string{"a.txt", "b.txt", "c.txt"} {
+		_ = KFile.WriteFile(ts.Join("vanish", name), []byte(name))
+	}
+	var vanished []string
+	stats, errs = KFile.StatTree(context.Background(), ts.Join("vanish"), func(fpath string) bool {
+		if strings.HasSuffix(fpath, "a.txt") {
+			_ = KFile.Unlink(ts.Join("vanish", "b.txt"))
+		}
+		return true
+	})
+	num = 0
+	for stat := range stats {
+		if stat.Error != "" {
+			vanished = append(vanished, stat.Name)
+		}
+		num++
+	}
+	if err := <-errs; err != nil || num != 4 || len(vanished) != 1 || vanished[0] != "b.txt" {
+		t.Error("StatTree vanished file fail")
+		return
+	}
+
+	stats, errs = KFile.StatTree(context.Background(), "./hello")
+	for range stats {
+	}
+	if err := <-errs; err == nil {
+		t.Error("StatTree not exist fail")
+		return
+	}
+}
+
+func BenchmarkStat(b *testing.B) {
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, _ = KFile.Stat("./testdata/diglett.png")
+	}
+}
+
+func BenchmarkStatTree(b *testing.B) {
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		stats, _ := KFile.StatTree(context.Background(), "./testdata")
+		for range stats {
+		}
+	}
+}
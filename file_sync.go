@@ -0,0 +1,222 @@
+package kgo
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// SyncDirOptions 目录同步选项
+type SyncDirOptions struct {
+	Checksum string       // 内容校验算法,为空时仅比较大小和修改时间;可选"md5","sha1","sha256","sha512"
+	Delete   bool         // 是否删除目标目录中源目录已不存在的文件
+	DryRun   bool         // 是否仅返回计划,不实际执行
+	Excludes []FileFilter // 排除过滤器,任一命中则跳过;参数为源或目标中的路径
+}
+
+// SyncItem 目录同步项
+type SyncItem struct {
+	Action LkkSyncAction // 同步动作
+	Source string        // 源路径,删除动作时为空
+	Dest   string        // 目标路径
+	IsDir  bool          // 是否目录
+	Size   int64         // 源文件大小
+	Err    error         // 执行失败时的错误
+}
+
+// String 获取同步动作的名称.
+func (sa LkkSyncAction) String() string {
+	switch sa {
+	case SYNC_ACTION_ADD:
+		return "add"
+	case SYNC_ACTION_UPDATE:
+		return "update"
+	case SYNC_ACTION_DELETE:
+		return "delete"
+	default:
+		return "unknown"
+	}
+}
+
+// isExcluded 路径是否被排除.
+func (so *SyncDirOptions) isExcluded(fpath string) bool {
+	for _, filter := range so.Excludes {
+		if filter(fpath) {
+			return true
+		}
+	}
+	return false
+}
+
+// SyncDir 增量同步源目录到目标目录(类似rsync),仅拷贝新增或变更的文件.
+// 默认按文件大小和修改时间判断变更,opts.Checksum不为空时大小相同的文件再比较内容散列值.
+// opts.Delete为true时删除目标中多余的文件;opts.DryRun为true时仅返回同步计划.
+// 返回同步项列表,单项执行失败时记录在该项的Err中.
+func (kf *LkkFile) SyncDir(source string, dest string, opts *SyncDirOptions) ([]*SyncItem, error) {
+	if opts == nil {
+		opts = &SyncDirOptions{}
+	}
+
+	switch opts.Checksum {
+	case "", "md5", "sha1", "sha256", "sha512":
+	default:
+		return nil, fmt.Errorf("Unsupported checksum: %s", opts.Checksum)
+	}
+
+	sourceInfo, err := os.Stat(source)
+	if err != nil {
+		return nil, err
+	} else if !sourceInfo.IsDir() {
+		return nil, fmt.Errorf("%s is not a directory", source)
+	}
+
+	var items []*SyncItem
+	seen := make(map[string]bool)
+	err = filepath.Walk(source, func(fpath string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+
+		rel, _ := filepath.Rel(source, fpath)
+		if fpath == source {
+			if !kf.IsDir(dest) {
+				items = append(items, &SyncItem{Action: SYNC_ACTION_ADD, Source: fpath, Dest: dest, IsDir: true})
+			}
+			return nil
+		} else if opts.isExcluded(fpath) {
+			if info.IsDir() {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+
+		destPath := filepath.Join(dest, rel)
+		seen[rel] = true
+		destInfo, derr := os.Lstat(destPath)
+		if derr != nil {
+			items = append(items, &SyncItem{Action: SYNC_ACTION_ADD, Source: fpath, Dest: destPath, IsDir: info.IsDir(), Size: fileSizeOf(info)})
+		} else if kf.syncChanged(fpath, destPath, info, destInfo, opts.Checksum) {
+			items = append(items, &SyncItem{Action: SYNC_ACTION_UPDATE, Source: fpath, Dest: destPath, IsDir: info.IsDir(), Size: fileSizeOf(info)})
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if opts.Delete && kf.IsDir(dest) {
+		//多余的目录整个删除,不再列出其子项
+		var dels []*SyncItem
+		_ = filepath.Walk(dest, func(fpath string, info os.FileInfo, err error) error {
+			if err != nil || fpath == dest {
+				return nil
+			}
+
+			rel, _ := filepath.Rel(dest, fpath)
+			if !seen[rel] && !opts.isExcluded(fpath) {
+				dels = append(dels, &SyncItem{Action: SYNC_ACTION_DELETE, Dest: fpath, IsDir: info.IsDir()})
+				if info.IsDir() {
+					return filepath.SkipDir
+				}
+			}
+			return nil
+		})
+		items = append(items, dels...)
+	}
+
+	if !opts.DryRun {
+		kf.applySyncItems(items)
+	}
+
+	return items, nil
+}
+
+// fileSizeOf 获取常规文件的大小,其他类型返回0.
+func fileSizeOf(info os.FileInfo) int64 {
+	if info.Mode().IsRegular() {
+		return info.Size()
+	}
+	return 0
+}
+
+// syncChanged 检查源路径和目标路径是否不同.
+func (kf *LkkFile) syncChanged(src, dst string, srcInfo, dstInfo os.FileInfo, checksum string) bool {
+	if srcInfo.Mode()&os.ModeType != dstInfo.Mode()&os.ModeType {
+		return true
+	}
+
+	if srcInfo.IsDir() {
+		return false
+	} else if srcInfo.Mode()&os.ModeSymlink != 0 {
+		l1, _ := os.Readlink(src)
+		l2, _ := os.Readlink(dst)
+		return l1 != l2
+	} else if srcInfo.Size() != dstInfo.Size() {
+		return true
+	} else if checksum == "" {
+		return srcInfo.ModTime().Unix() != dstInfo.ModTime().Unix()
+	}
+
+	h1, err1 := kf.fileChecksum(src, checksum)
+	h2, err2 := kf.fileChecksum(dst, checksum)
+	return err1 != nil || err2 != nil || h1 != h2
+}
+
+// fileChecksum 按算法名计算文件的散列值.
+func (kf *LkkFile) fileChecksum(fpath, algo string) (string, error) {
+	switch algo {
+	case "sha1":
+		return kf.ShaX(fpath, 1)
+	case "sha256":
+		return kf.ShaX(fpath, 256)
+	case "sha512":
+		return kf.ShaX(fpath, 512)
+	default:
+		return kf.Md5(fpath, 32)
+	}
+}
+
+// applySyncItems 执行同步计划.
+func (kf *LkkFile) applySyncItems(items []*SyncItem) {
+	var dirs []*SyncItem
+	for _, item := range items {
+		switch item.Action {
+		case SYNC_ACTION_DELETE:
+			item.Err = os.RemoveAll(item.Dest)
+		case SYNC_ACTION_ADD, SYNC_ACTION_UPDATE:
+			info, err := os.Lstat(item.Source)
+			if err != nil {
+				item.Err = err
+				continue
+			}
+
+			//类型不同时先移除旧的目标
+			if item.Action == SYNC_ACTION_UPDATE {
+				if dstInfo, err := os.Lstat(item.Dest); err == nil && dstInfo.Mode()&os.ModeType != info.Mode()&os.ModeType {
+					_ = os.RemoveAll(item.Dest)
+				}
+			}
+
+			if info.IsDir() {
+				item.Err = os.MkdirAll(item.Dest, info.Mode().Perm()|0700)
+				dirs = append(dirs, item)
+			} else if info.Mode()&os.ModeSymlink != 0 {
+				item.Err = kf.CopyLink(item.Source, item.Dest)
+			} else if !info.Mode().IsRegular() {
+				item.Err = fmt.Errorf("%s is not a regular file", item.Source)
+			} else {
+				_, item.Err = copyFileContext(context.Background(), item.Source, item.Dest, info, nil)
+			}
+		}
+	}
+
+	//最后恢复目录的权限和修改时间
+	for i := len(dirs) - 1; i >= 0; i-- {
+		if info, err := os.Stat(dirs[i].Source); err == nil && dirs[i].Err == nil {
+			_ = os.Chmod(dirs[i].Dest, info.Mode())
+			_ = os.Chtimes(dirs[i].Dest, info.ModTime(), info.ModTime())
+		}
+	}
+}
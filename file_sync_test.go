@@ -0,0 +1,92 @@
+package kgo
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestSyncDir(t *testing.T) {
+	ts, _ := KOS.NewTempSpace(nil)
+	defer func() {
+		_ = ts.Close()
+	}()
+
+	src := ts.Join("syncsrc")
+	des := ts.Join("syncdes")
+	_, _ = KFile.CopyDir("./testdata/rsa", src, FILE_COVER_ALLOW)
+	_ = KFile.WriteFile(src+"/sub/hello.txt", []byte("hello"))
+
+	//预览
+	items, err := KFile.SyncDir(src, des, &SyncDirOptions{DryRun: true})
+	if err != nil || len(items) != 5 || KFile.IsExist(des) {
+		t.Error("SyncDir dry-run fail")
+		return
+	} else if items[0].Action.String() != "add" {
+		t.Error("SyncDir action fail")
+		return
+	}
+
+	//首次同步
+	items, err = KFile.SyncDir(src, des, nil)
+	if err != nil || len(items) != 5 || !KFile.IsFile(des+"/sub/hello.txt") {
+		t.Error("SyncDir fail")
+		return
+	}
+
+	//无变更
+	items, _ = KFile.SyncDir(src, des, &SyncDirOptions{Checksum: "sha256"})
+	if len(items) != 0 {
+		t.Error("SyncDir unchanged fail")
+		return
+	}
+
+	//修改和删除
+	_ = KFile.WriteFile(src+"/sub/hello.txt", []byte("world"))
+	_ = KFile.Unlink(src + "/private_key.pem")
+	_ = KFile.WriteFile(des+"/extra/world.txt", []byte("world"))
+	items, _ = KFile.SyncDir(src, des, &SyncDirOptions{Checksum: "md5", Delete: true})
+	var actions []string
+	for _, item := range items {
+		if item.Err != nil {
+			t.Error("SyncDir apply fail")
+			return
+		}
+		actions = append(actions, item.Action.String())
+	}
+	cont, _ := KFile.ReadFile(des + "/sub/hello.txt")
+	if strings.Join(actions, ",") != "update,delete,delete" || string(cont) != "world" || KFile.IsExist(des+"/extra") {
+		t.Error("SyncDir update/delete fail")
+		return
+	}
+
+	//排除
+	items, _ = KFile.SyncDir("./testdata", des+"2", &SyncDirOptions{DryRun: true, Excludes: []FileFilter{func(fpath string) bool {
+		return KFile.IsDir(fpath)
+	}}})
+	for _, item := range items[1:] {
+		if item.IsDir {
+			t.Error("SyncDir exclude fail")
+			return
+		}
+	}
+
+	_, _ = KFile.SyncDir(src, des, &SyncDirOptions{Checksum: "crc"})
+	_, _ = KFile.SyncDir("./hello", des, nil)
+	_, _ = KFile.SyncDir("./file.go", des, nil)
+	_ = LkkSyncAction(0).String()
+}
+
+func BenchmarkSyncDir(b *testing.B) {
+	ts, _ := KOS.NewTempSpace(nil)
+	defer func() {
+		_ = ts.Close()
+	}()
+
+	b.ResetTimer()
+	src := "./testdata"
+	for i := 0; i < b.N; i++ {
+		des := ts.Join(fmt.Sprintf("sync_%d", i%10))
+		_, _ = KFile.SyncDir(src, des, nil)
+	}
+}
@@ -0,0 +1,292 @@
+package kgo
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"io"
+	"os"
+	"strings"
+	"time"
+)
+
+// FollowOptions 文件跟踪选项
+type FollowOptions struct {
+	Lines    int           // 开始时先发送文件末尾的行数,默认0即仅发送之后追加的行
+	Interval time.Duration // 检查文件变化的间隔,默认250毫秒
+}
+
+// fileFollower 文件跟踪器
+type fileFollower struct {
+	fpath  string
+	opts   FollowOptions
+	file   *os.File
+	reader *bufio.Reader
+	offset int64
+	lines  chan string
+	errs   chan error
+}
+
+// 文件跟踪时检查到的文件状态
+const (
+	followNormal    = iota // 文件无变化
+	followRotated          // 文件已被替换
+	followTruncated        // 文件已被截断
+)
+
+// Tail 读取文件末尾的n行,从文件尾部按块向前查找,无须读取整个文件.
+// 返回的行不包括换行符;文件不足n行时返回全部行.
+func (kf *LkkFile) Tail(fpath string, n int) ([]string, error) {
+	file, err := os.Open(fpath)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = file.Close()
+	}()
+
+	info, err := file.Stat()
+	if err != nil {
+		return nil, err
+	}
+
+	return tailLines(file, info.Size(), n)
+}
+
+// tailLines 从size处向前按块读取file的最后n行.
+func tailLines(file io.ReaderAt, size int64, n int) ([]string, error) {
+	if n <= 0 || size <= 0 {
+		return []string{}, nil
+	}
+
+	const blockSize = 4096
+	var blocks [][]byte
+	start := size
+	found := 0
+	for start > 0 && found <= n {
+		readSize := int64(blockSize)
+		if start < readSize {
+			readSize = start
+		}
+		start -= readSize
+
+		block := make([]byte, readSize)
+		if _, err := file.ReadAt(block, start); err != nil && err != io.EOF {
+			return nil, err
+		}
+
+		//末尾的换行符不算作新行的开始
+		if len(blocks) == 0 && block[len(block)-1] == '\n' {
+			found--
+		}
+		found += bytes.Count(block, []byte{'\n'})
+		blocks = append(blocks, block)
+	}
+
+	//块是从后向前读取的,倒序后一次拼接
+	for i, j := 0, len(blocks)-1; i < j; i, j = i+1, j-1 {
+		blocks[i], blocks[j] = blocks[j], blocks[i]
+	}
+	data := bytes.Join(blocks, nil)
+
+	text := strings.TrimSuffix(string(data), "\n")
+	lines := strings.Split(text, "\n")
+	if len(lines) > n {
+		lines = lines[len(lines)-n:]
+	}
+	for i, line := range lines {
+		lines[i] = strings.TrimSuffix(line, "\r")
+	}
+
+	return lines, nil
+}
+
+// Follow 跟踪文件新追加的行(类似tail -F),通过通道发送,不包括换行符.
+// 文件被重命名/删除(如logrotate)后会重新打开同名的新文件,被截断后从头读取;文件不存在时等待其创建.
+// opts为跟踪选项,可为nil;ctx结束时关闭行通道和错误通道,错误通道仅接收无法恢复的错误.
+func (kf *LkkFile) Follow(ctx context.Context, fpath string, opts *FollowOptions) (<-chan string, <-chan error) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	if opts == nil {
+		opts = &FollowOptions{}
+	}
+	o := *opts
+	if o.Interval <= 0 {
+		o.Interval = 250 * time.Millisecond
+	}
+
+	ff := &fileFollower{
+		fpath: fpath,
+		opts:  o,
+		lines: make(chan string, 64),
+		errs:  make(chan error, 1),
+	}
+	go ff.run(ctx)
+
+	return ff.lines, ff.errs
+}
+
+// run 跟踪文件直到ctx结束.
+func (ff *fileFollower) run(ctx context.Context) {
+	defer func() {
+		if ff.file != nil {
+			_ = ff.file.Close()
+		}
+		close(ff.lines)
+		close(ff.errs)
+	}()
+
+	first := true
+	var partial []byte
+	for {
+		if ff.file == nil {
+			err := ff.open(first)
+			if err != nil && !os.IsNotExist(err) {
+				ff.errs <- err
+				return
+			} else if err == nil && !ff.sendTail(ctx, first) {
+				return
+			}
+			first = false
+		}
+
+		if ff.file != nil {
+			if !ff.readLines(ctx, &partial) {
+				return
+			}
+
+			switch ff.check() {
+			case followRotated:
+				if !ff.closeRotated(ctx, &partial) {
+					return
+				}
+				continue
+			case followTruncated:
+				partial = partial[:0]
+				ff.offset = 0
+				if _, err := ff.file.Seek(0, io.SeekStart); err != nil {
+					ff.errs <- err
+					return
+				}
+				ff.reader.Reset(ff.file)
+				continue
+			}
+		}
+
+		timer := time.NewTimer(ff.opts.Interval)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+	}
+}
+
+// readLines 读取至文件末尾并发送其中完整的行,末尾未以换行结尾的内容保留在partial中.
+// ctx结束或读取出错时返回false,错误已发送到错误通道.
+func (ff *fileFollower) readLines(ctx context.Context, partial *[]byte) bool {
+	for {
+		line, err := ff.reader.ReadBytes('\n')
+		ff.offset += int64(len(line))
+		*partial = append(*partial, line...)
+		if err == nil {
+			if !ff.send(ctx, string(*partial)) {
+				return false
+			}
+			*partial = (*partial)[:0]
+			continue
+		}
+
+		if err != io.EOF {
+			ff.errs <- err
+			return false
+		}
+		return true
+	}
+}
+
+// closeRotated 关闭已被替换的旧文件.
+// 关闭前再次读取至末尾,以免丢失上次读取之后、文件被替换之前写入的行;末尾未以换行结尾的内容也一并发送.
+func (ff *fileFollower) closeRotated(ctx context.Context, partial *[]byte) bool {
+	if !ff.readLines(ctx, partial) {
+		return false
+	}
+	if len(*partial) > 0 {
+		if !ff.send(ctx, string(*partial)) {
+			return false
+		}
+		*partial = (*partial)[:0]
+	}
+
+	_ = ff.file.Close()
+	ff.file = nil
+	return true
+}
+
+// check 检查文件是否被替换或截断.
+func (ff *fileFollower) check() int {
+	info, err := os.Stat(ff.fpath)
+	if err != nil {
+		//新文件尚未创建,继续读取旧文件
+		return followNormal
+	}
+
+	cur, err := ff.file.Stat()
+	if err != nil || !os.SameFile(info, cur) {
+		return followRotated
+	} else if cur.Size() < ff.offset {
+		return followTruncated
+	}
+	return followNormal
+}
+
+// open 打开文件;first为true时定位到文件末尾,否则从头读取新文件.
+func (ff *fileFollower) open(first bool) error {
+	file, err := os.Open(ff.fpath)
+	if err != nil {
+		return err
+	}
+
+	ff.offset = 0
+	if first {
+		if ff.offset, err = file.Seek(0, io.SeekEnd); err != nil {
+			_ = file.Close()
+			return err
+		}
+	}
+	ff.file = file
+	ff.reader = bufio.NewReader(file)
+
+	return nil
+}
+
+// sendTail 首次打开文件时发送末尾的若干行.
+func (ff *fileFollower) sendTail(ctx context.Context, first bool) bool {
+	if !first || ff.opts.Lines <= 0 {
+		return true
+	}
+
+	lines, err := tailLines(ff.file, ff.offset, ff.opts.Lines)
+	if err != nil {
+		return true
+	}
+	for _, line := range lines {
+		if !ff.send(ctx, line) {
+			return false
+		}
+	}
+	return true
+}
+
+// send 发送一行,ctx结束时返回false.
+func (ff *fileFollower) send(ctx context.Context, line string) bool {
+	line = strings.TrimSuffix(strings.TrimSuffix(line, "\n"), "\r")
+	select {
+	case ff.lines <- line:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
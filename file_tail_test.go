@@ -0,0 +1,198 @@
+package kgo
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestTail(t *testing.T) {
+	ts, _ := KOS.NewTempSpace(nil)
+	defer func() {
+		_ = ts.Close()
+	}()
+
+	fpath := ts.Join("lines.log")
+
+	var sb strings.Builder
+	for i := 1; i <= 2000; i++ {
+		sb.WriteString(fmt.Sprintf("this is line %d\r\n", i))
+	}
+	_ = KFile.WriteFile(fpath, []byte(sb.String()))
+
+	lines, err := KFile.Tail(fpath, 3)
+	if err != nil || len(lines) != 3 || lines[0] != "this is line 1998" || lines[2] != "this is line 2000" {
+		t.Error("Tail fail")
+		return
+	}
+
+	lines, _ = KFile.Tail(fpath, 1500)
+	if len(lines) != 1500 || lines[0] != "this is line 501" {
+		t.Error("Tail multi block fail")
+		return
+	}
+
+	lines, _ = KFile.Tail(fpath, 5000)
+	if len(lines) != 2000 || lines[0] != "this is line 1" {
+		t.Error("Tail all fail")
+		return
+	}
+
+	//末行无换行
+	_ = KFile.WriteFile(fpath, []byte("a\nb\n\nc"))
+	lines, _ = KFile.Tail(fpath, 2)
+	if len(lines) != 2 || lines[0] != "" || lines[1] != "c" {
+		t.Error("Tail no newline fail")
+		return
+	}
+
+	lines, _ = KFile.Tail(fpath, 0)
+	if len(lines) != 0 {
+		t.Error("Tail zero fail")
+		return
+	}
+
+	_, err = KFile.Tail("./hello", 3)
+	if err == nil {
+		t.Error("Tail not exist fail")
+		return
+	}
+}
+
+// recvLine 在限定时间内接收一行.
+func recvLine(lines <-chan string) (string, bool) {
+	select {
+	case line, ok := <-lines:
+		return line, ok
+	case <-time.After(2 * time.Second):
+		return "", false
+	}
+}
+
+func TestFollow(t *testing.T) {
+	ts, _ := KOS.NewTempSpace(nil)
+	defer func() {
+		_ = ts.Close()
+	}()
+
+	fpath := ts.Join("follow.log")
+	_ = KFile.WriteFile(fpath, []byte("old1\nold2\n"))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	lines, errs := KFile.Follow(ctx, fpath, &FollowOptions{Lines: 1, Interval: 10 * time.Millisecond})
+
+	if line, _ := recvLine(lines); line != "old2" {
+		t.Error("Follow tail lines fail")
+		return
+	}
+
+	//追加
+	_ = KFile.AppendFile(fpath, []byte("new1\nnew"))
+	_ = KFile.AppendFile(fpath, []byte("2\n"))
+	if line, _ := recvLine(lines); line != "new1" {
+		t.Error("Follow append fail")
+		return
+	} else if line, _ = recvLine(lines); line != "new2" {
+		t.Error("Follow partial line fail")
+		return
+	}
+
+	//轮转
+	_ = os.Rename(fpath, fpath+".1")
+	_ = KFile.AppendFile(fpath+".1", []byte("last\n"))
+	time.Sleep(50 * time.Millisecond)
+	_ = KFile.WriteFile(fpath, []byte("rotated\n"))
+	if line, _ := recvLine(lines); line != "last" {
+		t.Error("Follow rotate drain fail")
+		return
+	} else if line, _ = recvLine(lines); line != "rotated" {
+		t.Error("Follow rotate fail")
+		return
+	}
+
+	//截断
+	time.Sleep(50 * time.Millisecond)
+	_ = os.Truncate(fpath, 0)
+	time.Sleep(50 * time.Millisecond)
+	_ = KFile.AppendFile(fpath, []byte("truncated\n"))
+	if line, _ := recvLine(lines); line != "truncated" {
+		t.Error("Follow truncate fail")
+		return
+	}
+
+	cancel()
+	for range lines {
+	}
+	if err := <-errs; err != nil {
+		t.Error("Follow cancel fail")
+		return
+	}
+
+	//等待文件创建
+	ctx2, cancel2 := context.WithCancel(context.Background())
+	defer cancel2()
+	lines, _ = KFile.Follow(ctx2, ts.Join("later.log"), &FollowOptions{Interval: 10 * time.Millisecond})
+	time.Sleep(30 * time.Millisecond)
+	_ = KFile.WriteFile(ts.Join("later.log"), []byte("hello\n"))
+	if line, _ := recvLine(lines); line != "hello" {
+		t.Error("Follow wait create fail")
+		return
+	}
+}
+
+func TestFollowRotatedDrain(t *testing.T) {
+	ts, _ := KOS.NewTempSpace(nil)
+	defer func() {
+		_ = ts.Close()
+	}()
+
+	fpath := ts.Join("rotate.log")
+	_ = KFile.WriteFile(fpath, []byte("one\n"))
+	ff := &fileFollower{fpath: fpath, lines: make(chan string, 8), errs: make(chan error, 1)}
+	if err := ff.open(false); err != nil {
+		t.Error("Follow open fail")
+		return
+	}
+
+	var partial []byte
+	if !ff.readLines(context.Background(), &partial) || <-ff.lines != "one" {
+		t.Error("Follow readLines fail")
+		return
+	}
+
+	//读取到末尾之后、检查之前,旧文件写入新行后被替换
+	_ = KFile.AppendFile(fpath, []byte("two\nthr"))
+	_ = os.Rename(fpath, fpath+".1")
+	_ = KFile.WriteFile(fpath, []byte("new\n"))
+	if ff.check() != followRotated || !ff.closeRotated(context.Background(), &partial) || ff.file != nil {
+		t.Error("Follow closeRotated fail")
+		return
+	}
+	if len(ff.lines) != 2 || <-ff.lines != "two" || <-ff.lines != "thr" {
+		t.Error("Follow rotated drain fail")
+		return
+	}
+}
+
+func BenchmarkTail(b *testing.B) {
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, _ = KFile.Tail("./testdata/dante.txt", 10)
+	}
+}
+
+func BenchmarkFollow(b *testing.B) {
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		ctx, cancel := context.WithCancel(context.Background())
+		lines, _ := KFile.Follow(ctx, "./testdata/dante.txt", &FollowOptions{Lines: 10})
+		for j := 0; j < 10; j++ {
+			<-lines
+		}
+		cancel()
+	}
+}
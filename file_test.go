@@ -65,6 +65,62 @@ func BenchmarkWriteFile(b *testing.B) {
 	}
 }
 
+func TestWriteFileAtomic(t *testing.T) {
+	ts, _ := KOS.NewTempSpace(nil)
+	defer func() {
+		_ = ts.Close()
+	}()
+
+	dir, _ := ts.Dir("atomic-*")
+	pth := dir + "/conf.ini"
+	err := KFile.WriteFileAtomic(pth, []byte("version=1"), false, 0640)
+	if err != nil {
+		t.Error("WriteFileAtomic fail")
+		return
+	}
+
+	err = KFile.WriteFileAtomic(pth, []byte("version=2"), true)
+	cont, _ := KFile.ReadFile(pth)
+	bak, _ := KFile.ReadFile(pth + ".bak")
+	mode, _ := KFile.GetFileMode(pth)
+	if err != nil || string(cont) != "version=2" || string(bak) != "version=1" || mode.Perm() != 0640 {
+		t.Error("WriteFileAtomic fail")
+		return
+	}
+
+	files := KFile.FileTree(dir, FILE_TREE_FILE, false)
+	if len(files) != 2 {
+		t.Error("WriteFileAtomic leave temp file")
+		return
+	}
+
+	//默认权限
+	err = KFile.WriteFileAtomic(dir+"/data.txt", []byte("hello"), false)
+	mode, _ = KFile.GetFileMode(dir + "/data.txt")
+	if err != nil || mode.Perm() != 0644 {
+		t.Error("WriteFileAtomic default perm fail")
+		return
+	}
+
+	_ = KFile.WriteFileAtomic("", []byte("hello"), false)
+	_ = KFile.WriteFileAtomic("./testdata", []byte("hello"), false)
+	_ = KFile.WriteFileAtomic(pth+"/world", []byte("hello"), true)
+}
+
+func BenchmarkWriteFileAtomic(b *testing.B) {
+	ts, _ := KOS.NewTempSpace(nil)
+	defer func() {
+		_ = ts.Close()
+	}()
+
+	b.ResetTimer()
+	str := []byte("Hello World!")
+	for i := 0; i < b.N; i++ {
+		filename := ts.Join(fmt.Sprintf("putfile_%d", i))
+		_ = KFile.WriteFileAtomic(filename, str, false)
+	}
+}
+
 func TestGetMime(t *testing.T) {
 	filename := "./testdata/diglett.png"
 	mime1 := KFile.GetMime(filename, true)
@@ -74,6 +130,13 @@ func TestGetMime(t *testing.T) {
 		return
 	}
 
+	//文本保留charset
+	filename = "./testdata/dante.txt"
+	if KFile.GetMime(filename, true) != "text/plain; charset=utf-8" || KFile.GetMime(filename, false) != "text/plain; charset=utf-8" {
+		t.Error("GetMime charset fail")
+		return
+	}
+
 	KFile.GetMime("./testdata/diglett-lnk", false)
 	KFile.GetMime("./", false)
 }
@@ -300,6 +363,12 @@ func TestIsImg(t *testing.T) {
 		t.Error("file isn`t img")
 		return
 	}
+	for _, name := range []string{"a.tiff", "a.psd", "a.heic", "a.avif", "a.txt"} {
+		if KFile.IsImg(name) {
+			t.Error("IsImg fail")
+			return
+		}
+	}
 	KFile.IsImg("./hello")
 }
 
@@ -699,6 +768,45 @@ func BenchmarkFileTree(b *testing.B) {
 	}
 }
 
+func TestFileTreeFilter(t *testing.T) {
+	//过滤器作用于子目录中的文件
+	noPem := func(fpath string) bool {
+		return !strings.HasSuffix(fpath, ".pem")
+	}
+	tree := KFile.FileTree("./testdata", FILE_TREE_FILE, true, noPem)
+	if len(tree) == 0 || len(tree) != len(KFile.FileTree("./testdata", FILE_TREE_FILE, true))-2 {
+		t.Error("FileTree filter fail")
+		return
+	}
+	for _, fpath := range tree {
+		if strings.HasSuffix(fpath, ".pem") {
+			t.Error("FileTree recursive filter fail")
+			return
+		}
+	}
+
+	//未通过的目录连同其内容一起跳过
+	noRsa := func(fpath string) bool {
+		return KFile.Basename(fpath) != "rsa"
+	}
+	for _, fpath := range KFile.FileTree("./testdata", FILE_TREE_ALL, true, noRsa) {
+		if strings.Contains(fpath, "rsa") {
+			t.Error("FileTree dir filter fail")
+			return
+		}
+	}
+}
+
+func BenchmarkFileTreeFilter(b *testing.B) {
+	b.ResetTimer()
+	filter := func(fpath string) bool {
+		return !strings.HasSuffix(fpath, ".pem")
+	}
+	for i := 0; i < b.N; i++ {
+		_ = KFile.FileTree("./testdata", FILE_TREE_FILE, true, filter)
+	}
+}
+
 func TestFormatDir(t *testing.T) {
 	dir := `/usr\bin\\golang//fmt/\test\/hehe`
 	res := KFile.FormatDir(dir)
@@ -1208,8 +1316,20 @@ func TestAppendFile(t *testing.T) {
 		return
 	}
 
+	err = KFile.AppendFile(pth, []byte("fine, thank you."), true)
+	if err != nil {
+		t.Error("AppendFile durable fail")
+		return
+	}
+
 	_ = KFile.AppendFile("/root/hello/world", []byte("how are you?"))
 	_ = KFile.AppendFile(pth, []byte(""))
+
+	ts, _ := KOS.NewTempSpace(nil)
+	defer func() {
+		_ = ts.Close()
+	}()
+	_ = KFile.AppendFile(ts.Join("append_durable.txt"), []byte("hello"), true)
 }
 
 func BenchmarkAppendFile(b *testing.B) {
@@ -0,0 +1,326 @@
+package kgo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"sort"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+)
+
+// WalkOptions 并发遍历选项
+type WalkOptions struct {
+	Workers        int  // 并发遍历的协程数,默认为CPU核数的4倍
+	MaxDepth       int  // 最大深度,根目录的直接子项为1;<=0时不限
+	FollowSymlinks bool // 是否进入指向目录的符号链接,进入时检测链接循环
+}
+
+// WalkVisitor 遍历访问函数,会被多个协程并发调用.
+// depth为深度,根目录为0;err不为nil时为访问该路径的错误(info可能为nil),返回nil则继续遍历.
+// 对目录返回filepath.SkipDir时跳过该目录;返回其他错误时停止遍历.
+type WalkVisitor func(fpath string, info os.FileInfo, depth int, err error) error
+
+// DirSizeReport 目录占用报告
+type DirSizeReport struct {
+	Root     string                 `json:"root"`     // 根目录
+	Size     int64                  `json:"size"`     // 文件总大小,硬链接只计算一次
+	Files    int64                  `json:"files"`    // 文件数,不包括目录和符号链接,硬链接只计算一次
+	Dirs     int64                  `json:"dirs"`     // 目录数,不包括根目录
+	Symlinks int64                  `json:"symlinks"` // 符号链接数
+	Exts     map[string]*DirExtStat `json:"exts"`     // 按扩展名(小写,含".")统计,无扩展名的键为空
+	Largest  []*DirReportFile       `json:"largest"`  // 最大的若干文件,按大小降序
+	Oldest   *DirReportFile         `json:"oldest"`   // 修改时间最早的文件
+	Newest   *DirReportFile         `json:"newest"`   // 修改时间最晚的文件
+	Errors   []error                `json:"-"`        // 遍历中遇到的错误
+	inodes   map[[2]uint64]struct{} // 已统计的硬链接
+	mu       sync.Mutex
+}
+
+// DirExtStat 某扩展名文件的统计
+type DirExtStat struct {
+	Files int64 `json:"files"` // 文件数
+	Size  int64 `json:"size"`  // 总大小
+}
+
+// DirReportFile 目录报告中的文件
+type DirReportFile struct {
+	Path    string    `json:"path"`     // 路径
+	Size    int64     `json:"size"`     // 大小
+	ModTime time.Time `json:"mod_time"` // 修改时间
+}
+
+// walkAncestor 遍历路径上的目录,用于检测链接循环
+type walkAncestor struct {
+	dev    uint64
+	ino    uint64
+	parent *walkAncestor
+}
+
+// walker 并发遍历器
+type walker struct {
+	ctx    context.Context
+	cancel context.CancelFunc
+	opts   WalkOptions
+	fn     WalkVisitor
+	sem    chan struct{}
+	wg     sync.WaitGroup
+	once   sync.Once
+	err    error
+}
+
+// ErrSymlinkLoop 符号链接循环
+var ErrSymlinkLoop = errors.New("Symlink loop detected")
+
+// WalkParallel 以有限的并发数遍历目录树,对每个文件和目录(包括根目录)调用fn,顺序不确定.
+// opts为遍历选项,可为nil;跟随符号链接时,指向上级目录的链接以ErrSymlinkLoop包装的错误传给fn,不会进入.
+// ctx结束时停止遍历并返回ctx.Err();fn返回除filepath.SkipDir外的错误时停止遍历并返回该错误.
+func (kf *LkkFile) WalkParallel(ctx context.Context, root string, opts *WalkOptions, fn WalkVisitor) error {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	if opts == nil {
+		opts = &WalkOptions{}
+	}
+
+	w := &walker{opts: *opts, fn: fn}
+	if w.opts.Workers <= 0 {
+		w.opts.Workers = runtime.NumCPU() * 4
+	}
+	w.sem = make(chan struct{}, w.opts.Workers-1)
+	w.ctx, w.cancel = context.WithCancel(ctx)
+	defer w.cancel()
+
+	info, err := os.Lstat(root)
+	if err == nil && info.Mode()&os.ModeSymlink != 0 {
+		//根路径为链接时总是跟随
+		info, err = os.Stat(root)
+	}
+	if err != nil {
+		return fn(root, nil, 0, err)
+	}
+
+	if err = fn(root, info, 0, nil); err != nil {
+		if err == filepath.SkipDir {
+			return nil
+		}
+		return err
+	}
+
+	if info.IsDir() {
+		w.walkDir(root, info, 0, newWalkAncestor(info, nil))
+	}
+	w.wg.Wait()
+
+	if w.err != nil {
+		return w.err
+	}
+	return ctx.Err()
+}
+
+// newWalkAncestor 创建目录的遍历祖先节点.
+func newWalkAncestor(info os.FileInfo, parent *walkAncestor) *walkAncestor {
+	res := &walkAncestor{parent: parent}
+	if st, ok := info.Sys().(*syscall.Stat_t); ok {
+		res.dev, res.ino = uint64(st.Dev), uint64(st.Ino)
+	}
+	return res
+}
+
+// isLoop 检查目录是否为某个上级目录,即形成循环.
+func (wa *walkAncestor) isLoop() bool {
+	if wa.ino == 0 {
+		return false
+	}
+	for p := wa.parent; p != nil; p = p.parent {
+		if p.dev == wa.dev && p.ino == wa.ino {
+			return true
+		}
+	}
+	return false
+}
+
+// fail 记录第一个错误并停止遍历.
+func (w *walker) fail(err error) {
+	w.once.Do(func() {
+		w.err = err
+		w.cancel()
+	})
+}
+
+// descend 进入子目录,有空闲协程时并发处理,否则在当前协程处理.
+func (w *walker) descend(dir string, info os.FileInfo, depth int, ancestor *walkAncestor) {
+	select {
+	case w.sem <- struct{}{}:
+		w.wg.Add(1)
+		go func() {
+			defer func() {
+				<-w.sem
+				w.wg.Done()
+			}()
+			w.walkDir(dir, info, depth, ancestor)
+		}()
+	default:
+		w.walkDir(dir, info, depth, ancestor)
+	}
+}
+
+// walkDir 读取目录并访问其中的各项.
+func (w *walker) walkDir(dir string, dirInfo os.FileInfo, depth int, parents *walkAncestor) {
+	if w.ctx.Err() != nil {
+		return
+	}
+
+	infos, err := readDirInfos(dir)
+	if err != nil {
+		if err = w.fn(dir, dirInfo, depth, err); err != nil && err != filepath.SkipDir {
+			w.fail(err)
+		}
+		return
+	}
+
+	for _, info := range infos {
+		if w.ctx.Err() != nil {
+			return
+		}
+
+		fpath := filepath.Join(dir, info.Name())
+		if info.Mode()&os.ModeSymlink != 0 && w.opts.FollowSymlinks {
+			if st, err := os.Stat(fpath); err != nil {
+				if err = w.fn(fpath, info, depth+1, err); err != nil && err != filepath.SkipDir {
+					w.fail(err)
+					return
+				}
+				continue
+			} else if st.IsDir() {
+				info = st
+			}
+		}
+
+		var ancestor *walkAncestor
+		var loopErr error
+		if info.IsDir() {
+			ancestor = newWalkAncestor(info, parents)
+			if ancestor.isLoop() {
+				loopErr = fmt.Errorf("%w: %s", ErrSymlinkLoop, fpath)
+			}
+		}
+
+		if err = w.fn(fpath, info, depth+1, loopErr); err == filepath.SkipDir {
+			continue
+		} else if err != nil {
+			w.fail(err)
+			return
+		}
+
+		if !info.IsDir() || loopErr != nil || (w.opts.MaxDepth > 0 && depth+1 >= w.opts.MaxDepth) {
+			continue
+		}
+		w.descend(fpath, info, depth+1, ancestor)
+	}
+}
+
+// readDirInfos 读取目录中各项的信息(不跟随链接).
+func readDirInfos(dir string) ([]os.FileInfo, error) {
+	file, err := os.Open(dir)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = file.Close()
+	}()
+
+	return file.Readdir(-1)
+}
+
+// DirReport 一次遍历生成类似du的目录占用报告,包括总大小、文件数、按扩展名的统计、最大的topN个文件及最早/最晚修改的文件.
+// topN默认为10;opts为遍历选项,可为nil.遍历中的错误记录在报告的Errors中,不会中止遍历.
+func (kf *LkkFile) DirReport(ctx context.Context, root string, topN int, opts *WalkOptions) (*DirSizeReport, error) {
+	if topN <= 0 {
+		topN = 10
+	}
+
+	report := &DirSizeReport{
+		Root:   root,
+		Exts:   make(map[string]*DirExtStat),
+		inodes: make(map[[2]uint64]struct{}),
+	}
+	err := kf.WalkParallel(ctx, root, opts, func(fpath string, info os.FileInfo, depth int, err error) error {
+		if err != nil {
+			report.mu.Lock()
+			report.Errors = append(report.Errors, err)
+			report.mu.Unlock()
+			return nil
+		} else if depth > 0 || !info.IsDir() {
+			report.add(fpath, info, topN)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return report, nil
+}
+
+// add 将文件计入报告;同一文件的多个硬链接只统计第一个.
+func (dr *DirSizeReport) add(fpath string, info os.FileInfo, topN int) {
+	dr.mu.Lock()
+	defer dr.mu.Unlock()
+
+	if info.IsDir() {
+		dr.Dirs++
+		return
+	} else if info.Mode()&os.ModeSymlink != 0 {
+		dr.Symlinks++
+		return
+	} else if !info.Mode().IsRegular() {
+		return
+	}
+
+	if st, ok := info.Sys().(*syscall.Stat_t); ok && st.Nlink > 1 {
+		key := [2]uint64{uint64(st.Dev), uint64(st.Ino)}
+		if _, ok := dr.inodes[key]; ok {
+			return
+		}
+		dr.inodes[key] = struct{}{}
+	}
+
+	dr.Files++
+	dr.Size += info.Size()
+
+	ext := strings.ToLower(filepath.Ext(info.Name()))
+	stat, ok := dr.Exts[ext]
+	if !ok {
+		stat = &DirExtStat{}
+		dr.Exts[ext] = stat
+	}
+	stat.Files++
+	stat.Size += info.Size()
+
+	file := &DirReportFile{Path: fpath, Size: info.Size(), ModTime: info.ModTime()}
+	if dr.Oldest == nil || file.ModTime.Before(dr.Oldest.ModTime) {
+		dr.Oldest = file
+	}
+	if dr.Newest == nil || file.ModTime.After(dr.Newest.ModTime) {
+		dr.Newest = file
+	}
+
+	//按大小降序插入
+	if len(dr.Largest) < topN || file.Size > dr.Largest[len(dr.Largest)-1].Size {
+		pos := sort.Search(len(dr.Largest), func(i int) bool {
+			return dr.Largest[i].Size < file.Size
+		})
+		dr.Largest = append(dr.Largest, nil)
+		copy(dr.Largest[pos+1:], dr.Largest[pos:])
+		dr.Largest[pos] = file
+		if len(dr.Largest) > topN {
+			dr.Largest = dr.Largest[:topN]
+		}
+	}
+}
@@ -0,0 +1,203 @@
+package kgo
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// makeWalkTree 生成遍历测试目录.
+func makeWalkTree(root string) {
+	_ = os.RemoveAll(root)
+	_ = KFile.WriteFile(root+"/a.txt", []byte("12345"))
+	_ = KFile.WriteFile(root+"/b.LOG", []byte("1234567890"))
+	_ = KFile.WriteFile(root+"/noext", []byte("1"))
+	_ = KFile.WriteFile(root+"/sub/c.txt", []byte("123"))
+	_ = KFile.WriteFile(root+"/sub/deep/d.go", []byte("1234567"))
+	_ = KFile.WriteFile(root+"/skip/e.txt", []byte("12"))
+	_ = os.Link(root+"/a.txt", root+"/sub/a_link.txt")
+	_ = os.Symlink("..", root+"/sub/deep/up")
+	_ = os.Symlink("sub", root+"/sublink")
+	old := time.Now().Add(-48 * time.Hour)
+	_ = os.Chtimes(root+"/noext", old, old)
+}
+
+func TestWalkParallel(t *testing.T) {
+	ts, _ := KOS.NewTempSpace(nil)
+	defer func() {
+		_ = ts.Close()
+	}()
+
+	root := ts.Join("tree")
+	makeWalkTree(root)
+
+	var mu sync.Mutex
+	var paths []string
+	visitor := func(fpath string, info os.FileInfo, depth int, err error) error {
+		mu.Lock()
+		defer mu.Unlock()
+		rel, _ := filepath.Rel(root, fpath)
+		if err != nil {
+			rel += "!"
+		}
+		paths = append(paths, rel)
+		if info != nil && info.IsDir() && info.Name() == "skip" {
+			return filepath.SkipDir
+		}
+		return nil
+	}
+
+	//默认不跟随链接
+	err := KFile.WalkParallel(context.Background(), root, &WalkOptions{Workers: 2}, visitor)
+	sort.Strings(paths)
+	expected := ".,a.txt,b.LOG,noext,skip,sub,sub/a_link.txt,sub/c.txt,sub/deep,sub/deep/d.go,sub/deep/up,sublink"
+	if err != nil || strings.Join(paths, ",") != expected {
+		t.Errorf("WalkParallel fail: %v %v", paths, err)
+		return
+	}
+
+	//跟随链接并检测循环
+	paths = nil
+	err = KFile.WalkParallel(nil, root, &WalkOptions{FollowSymlinks: true}, visitor)
+	sort.Strings(paths)
+	if err != nil || !KArr.InArray("sub/deep/up!", paths) || !KArr.InArray("sublink/deep/d.go", paths) || !KArr.InArray("sublink/deep/up!", paths) {
+		t.Errorf("WalkParallel follow fail: %v %v", paths, err)
+		return
+	}
+
+	//最大深度
+	paths = nil
+	err = KFile.WalkParallel(nil, root, &WalkOptions{MaxDepth: 1}, visitor)
+	sort.Strings(paths)
+	if err != nil || strings.Join(paths, ",") != ".,a.txt,b.LOG,noext,skip,sub,sublink" {
+		t.Errorf("WalkParallel max depth fail: %v %v", paths, err)
+		return
+	}
+
+	//停止遍历
+	stop := errors.New("stop")
+	err = KFile.WalkParallel(nil, root, nil, func(fpath string, info os.FileInfo, depth int, err error) error {
+		if depth > 0 {
+			return stop
+		}
+		return nil
+	})
+	if err != stop {
+		t.Error("WalkParallel stop fail")
+		return
+	}
+
+	//根目录跳过
+	num := 0
+	err = KFile.WalkParallel(nil, root, nil, func(fpath string, info os.FileInfo, depth int, err error) error {
+		num++
+		return filepath.SkipDir
+	})
+	if err != nil || num != 1 {
+		t.Error("WalkParallel skip root fail")
+		return
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	err = KFile.WalkParallel(ctx, root, nil, func(fpath string, info os.FileInfo, depth int, err error) error {
+		return nil
+	})
+	if err != context.Canceled {
+		t.Error("WalkParallel cancel fail")
+		return
+	}
+
+	err = KFile.WalkParallel(nil, "./hello", nil, func(fpath string, info os.FileInfo, depth int, err error) error {
+		return err
+	})
+	if err == nil {
+		t.Error("WalkParallel not exist fail")
+		return
+	}
+}
+
+func TestDirReport(t *testing.T) {
+	ts, _ := KOS.NewTempSpace(nil)
+	defer func() {
+		_ = ts.Close()
+	}()
+
+	root := ts.Join("report")
+	makeWalkTree(root)
+
+	report, err := KFile.DirReport(context.Background(), root, 2, nil)
+	if err != nil {
+		t.Error("DirReport fail")
+		return
+	}
+	//a.txt与其硬链接只计算一次
+	if report.Files != 6 || report.Size != 28 || report.Dirs != 3 || report.Symlinks != 2 || len(report.Errors) != 0 {
+		t.Errorf("DirReport totals fail: %+v", report)
+		return
+	}
+	if report.Exts[".txt"].Files != 3 || report.Exts[".log"].Size != 10 || report.Exts[""].Files != 1 {
+		t.Error("DirReport exts fail")
+		return
+	}
+	if len(report.Largest) != 2 || report.Largest[0].Size != 10 || report.Largest[1].Size != 7 {
+		t.Error("DirReport largest fail")
+		return
+	}
+	if !strings.HasSuffix(report.Oldest.Path, "noext") || report.Newest.ModTime.Before(report.Oldest.ModTime) {
+		t.Error("DirReport oldest fail")
+		return
+	}
+
+	report, err = KFile.DirReport(nil, root, 0, &WalkOptions{FollowSymlinks: true})
+	if err != nil || len(report.Errors) != 2 || report.Files != 8 || len(report.Largest) != 8 {
+		t.Errorf("DirReport follow fail: %v %v", report, err)
+		return
+	}
+
+	report, err = KFile.DirReport(nil, root+"/a.txt", 0, nil)
+	if err != nil || report.Files != 1 || report.Size != 5 {
+		t.Error("DirReport file fail")
+		return
+	}
+
+	report, _ = KFile.DirReport(nil, "./hello", 0, nil)
+	if len(report.Errors) != 1 || report.Files != 0 {
+		t.Error("DirReport not exist fail")
+		return
+	}
+
+	if KFile.DirSize(root) != 38 {
+		t.Error("DirSize fail")
+		return
+	}
+
+	//根路径为链接时不跟随
+	_ = os.Symlink(root, ts.Join("rootlink"))
+	if KFile.DirSize(ts.Join("rootlink")) != int64(len(root)) {
+		t.Error("DirSize symlink root fail")
+		return
+	}
+}
+
+func BenchmarkWalkParallel(b *testing.B) {
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = KFile.WalkParallel(nil, "./testdata", nil, func(fpath string, info os.FileInfo, depth int, err error) error {
+			return nil
+		})
+	}
+}
+
+func BenchmarkDirReport(b *testing.B) {
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, _ = KFile.DirReport(nil, "./testdata", 10, nil)
+	}
+}
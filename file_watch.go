@@ -0,0 +1,416 @@
+package kgo
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+	"unsafe"
+)
+
+// WatchOptions 文件监视选项
+type WatchOptions struct {
+	Recursive bool          // 是否递归监视子目录(包括之后新建的子目录)
+	Debounce  time.Duration // 防抖间隔,同一路径在该时间内的多次事件合并为一次;为0时不合并
+	Ops       LkkWatchOp    // 关注的事件类型,默认WATCH_OP_ALL
+	Filters   []FileFilter  // 事件路径过滤器,须全部通过才会发送事件
+	Buffer    int           // 事件通道的缓冲长度,默认64
+}
+
+// WatchEvent 文件监视事件
+type WatchEvent struct {
+	Name string     // 发生事件的路径
+	Op   LkkWatchOp // 事件类型,防抖合并后可能包含多个
+}
+
+// FileWatcher 基于inotify的文件监视器(仅支持linux)
+type FileWatcher struct {
+	Events chan WatchEvent // 事件通道,Close后关闭
+	Errors chan error      // 错误通道,Close后关闭
+
+	opts    WatchOptions
+	fd      int
+	file    *os.File
+	mu      sync.Mutex
+	watches map[string]int // 路径 => 监视描述符
+	paths   map[int]string // 监视描述符 => 路径
+	done    chan struct{}
+	wg      sync.WaitGroup
+	closed  bool
+}
+
+// inotifyMask 监视器使用的inotify事件掩码
+const inotifyMask = syscall.IN_CREATE | syscall.IN_MODIFY | syscall.IN_DELETE | syscall.IN_DELETE_SELF |
+	syscall.IN_MOVED_FROM | syscall.IN_MOVED_TO | syscall.IN_MOVE_SELF | syscall.IN_ATTRIB
+
+// String 获取事件类型的名称,多个以"|"连接.
+func (op LkkWatchOp) String() string {
+	var names []string
+	if op&WATCH_OP_CREATE != 0 {
+		names = append(names, "CREATE")
+	}
+	if op&WATCH_OP_WRITE != 0 {
+		names = append(names, "WRITE")
+	}
+	if op&WATCH_OP_REMOVE != 0 {
+		names = append(names, "REMOVE")
+	}
+	if op&WATCH_OP_RENAME != 0 {
+		names = append(names, "RENAME")
+	}
+	if op&WATCH_OP_CHMOD != 0 {
+		names = append(names, "CHMOD")
+	}
+	return strings.Join(names, "|")
+}
+
+// Watch 监视文件或目录的变化,返回监视器;使用完毕须调用其Close方法.
+// paths为要监视的文件或目录;opts为监视选项,可为nil.
+func (kf *LkkFile) Watch(paths []string, opts *WatchOptions) (*FileWatcher, error) {
+	if opts == nil {
+		opts = &WatchOptions{}
+	}
+	o := *opts
+	if o.Ops == 0 {
+		o.Ops = WATCH_OP_ALL
+	}
+	if o.Buffer <= 0 {
+		o.Buffer = 64
+	}
+
+	fd, err := syscall.InotifyInit1(syscall.IN_CLOEXEC | syscall.IN_NONBLOCK)
+	if err != nil {
+		return nil, err
+	}
+
+	fw := &FileWatcher{
+		Events:  make(chan WatchEvent, o.Buffer),
+		Errors:  make(chan error, 1),
+		opts:    o,
+		fd:      fd,
+		file:    os.NewFile(uintptr(fd), "inotify"),
+		watches: make(map[string]int),
+		paths:   make(map[int]string),
+		done:    make(chan struct{}),
+	}
+
+	for _, fpath := range paths {
+		if err = fw.Add(fpath); err != nil {
+			_ = fw.file.Close()
+			return nil, err
+		}
+	}
+
+	raw := make(chan WatchEvent, o.Buffer)
+	fw.wg.Add(2)
+	go fw.readEvents(raw)
+	go fw.dispatch(raw)
+
+	return fw, nil
+}
+
+// Add 添加要监视的路径;开启递归时同时监视其下所有子目录.
+func (fw *FileWatcher) Add(fpath string) error {
+	fpath = filepath.Clean(fpath)
+	info, err := os.Stat(fpath)
+	if err != nil {
+		return err
+	}
+
+	fw.mu.Lock()
+	defer fw.mu.Unlock()
+	if fw.closed {
+		return errors.New("Watcher already closed")
+	}
+
+	if err = fw.addWatch(fpath); err != nil {
+		return err
+	}
+
+	if info.IsDir() && fw.opts.Recursive {
+		for _, dir := range KFile.FileTree(fpath, FILE_TREE_DIR, true) {
+			if err = fw.addWatch(dir); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// Remove 移除对路径的监视,开启递归时同时移除其下所有子目录.
+func (fw *FileWatcher) Remove(fpath string) error {
+	fpath = filepath.Clean(fpath)
+	fw.mu.Lock()
+	defer fw.mu.Unlock()
+
+	wd, ok := fw.watches[fpath]
+	if !ok {
+		return errors.New("Path is not watched: " + fpath)
+	}
+	fw.rmWatch(fpath, wd)
+
+	if fw.opts.Recursive {
+		prefix := fpath + string(os.PathSeparator)
+		for p, wd := range fw.watches {
+			if strings.HasPrefix(p, prefix) {
+				fw.rmWatch(p, wd)
+			}
+		}
+	}
+
+	return nil
+}
+
+// WatchList 获取当前已监视的路径列表.
+func (fw *FileWatcher) WatchList() []string {
+	fw.mu.Lock()
+	defer fw.mu.Unlock()
+
+	res := make([]string, 0, len(fw.watches))
+	for p := range fw.watches {
+		res = append(res, p)
+	}
+	return res
+}
+
+// Close 关闭监视器,释放所有监视描述符,并关闭事件通道.
+func (fw *FileWatcher) Close() error {
+	fw.mu.Lock()
+	if fw.closed {
+		fw.mu.Unlock()
+		return nil
+	}
+	fw.closed = true
+	for p, wd := range fw.watches {
+		fw.rmWatch(p, wd)
+	}
+	fw.mu.Unlock()
+
+	close(fw.done)
+	err := fw.file.Close()
+	fw.wg.Wait()
+	close(fw.Errors)
+
+	return err
+}
+
+// addWatch 添加单个inotify监视,须持有锁.
+func (fw *FileWatcher) addWatch(fpath string) error {
+	if _, ok := fw.watches[fpath]; ok {
+		return nil
+	}
+
+	wd, err := syscall.InotifyAddWatch(fw.fd, fpath, inotifyMask)
+	if err != nil {
+		return &os.PathError{Op: "inotify_add_watch", Path: fpath, Err: err}
+	}
+
+	//同一inode可能以不同路径添加
+	if old, ok := fw.paths[wd]; ok {
+		delete(fw.watches, old)
+	}
+	fw.watches[fpath] = wd
+	fw.paths[wd] = fpath
+
+	return nil
+}
+
+// rmWatch 移除单个inotify监视,须持有锁.
+func (fw *FileWatcher) rmWatch(fpath string, wd int) {
+	_, _ = syscall.InotifyRmWatch(fw.fd, uint32(wd))
+	delete(fw.watches, fpath)
+	delete(fw.paths, wd)
+}
+
+// readEvents 读取并解析inotify事件.
+func (fw *FileWatcher) readEvents(raw chan<- WatchEvent) {
+	defer func() {
+		close(raw)
+		fw.wg.Done()
+	}()
+
+	var buf [syscall.SizeofInotifyEvent * 4096]byte
+	for {
+		n, err := fw.file.Read(buf[:])
+		if err != nil {
+			select {
+			case <-fw.done:
+			default:
+				fw.sendError(err)
+			}
+			return
+		} else if n < syscall.SizeofInotifyEvent {
+			continue
+		}
+
+		var offset uint32
+		for offset <= uint32(n-syscall.SizeofInotifyEvent) {
+			ev := (*syscall.InotifyEvent)(unsafe.Pointer(&buf[offset]))
+			nameLen := ev.Len
+			mask := ev.Mask
+			wd := int(ev.Wd)
+
+			var name string
+			if nameLen > 0 {
+				bs := buf[offset+syscall.SizeofInotifyEvent : offset+syscall.SizeofInotifyEvent+nameLen]
+				name = strings.TrimRight(string(bs), "\x00")
+			}
+			offset += syscall.SizeofInotifyEvent + nameLen
+
+			if mask&syscall.IN_Q_OVERFLOW != 0 {
+				fw.sendError(errors.New("Inotify queue overflow"))
+				continue
+			}
+
+			fw.mu.Lock()
+			dir, ok := fw.paths[wd]
+			if mask&syscall.IN_IGNORED != 0 {
+				if ok {
+					delete(fw.watches, dir)
+					delete(fw.paths, wd)
+				}
+				fw.mu.Unlock()
+				continue
+			}
+			fw.mu.Unlock()
+			if !ok {
+				continue
+			}
+
+			fpath := dir
+			if name != "" {
+				fpath = filepath.Join(dir, name)
+			}
+
+			//递归模式下自动监视新建的子目录
+			if fw.opts.Recursive && mask&syscall.IN_ISDIR != 0 && mask&(syscall.IN_CREATE|syscall.IN_MOVED_TO) != 0 {
+				_ = fw.Add(fpath)
+			}
+
+			if op := inotifyOp(mask); op&fw.opts.Ops != 0 {
+				select {
+				case raw <- WatchEvent{Name: fpath, Op: op & fw.opts.Ops}:
+				case <-fw.done:
+					return
+				}
+			}
+		}
+	}
+}
+
+// inotifyOp 将inotify掩码转换为事件类型.
+func inotifyOp(mask uint32) (op LkkWatchOp) {
+	if mask&(syscall.IN_CREATE|syscall.IN_MOVED_TO) != 0 {
+		op |= WATCH_OP_CREATE
+	}
+	if mask&syscall.IN_MODIFY != 0 {
+		op |= WATCH_OP_WRITE
+	}
+	if mask&(syscall.IN_DELETE|syscall.IN_DELETE_SELF) != 0 {
+		op |= WATCH_OP_REMOVE
+	}
+	if mask&(syscall.IN_MOVED_FROM|syscall.IN_MOVE_SELF) != 0 {
+		op |= WATCH_OP_RENAME
+	}
+	if mask&syscall.IN_ATTRIB != 0 {
+		op |= WATCH_OP_CHMOD
+	}
+	return
+}
+
+// dispatch 过滤、防抖并发送事件.
+func (fw *FileWatcher) dispatch(raw <-chan WatchEvent) {
+	defer func() {
+		close(fw.Events)
+		fw.wg.Done()
+	}()
+
+	debounce := fw.opts.Debounce
+	var tick <-chan time.Time
+	if debounce > 0 {
+		interval := debounce / 2
+		if interval < 10*time.Millisecond {
+			interval = 10 * time.Millisecond
+		}
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		tick = ticker.C
+	}
+
+	type pendingEvent struct {
+		op   LkkWatchOp
+		last time.Time
+	}
+	pending := make(map[string]*pendingEvent)
+	var order []string
+
+	send := func(ev WatchEvent) bool {
+		select {
+		case fw.Events <- ev:
+			return true
+		case <-fw.done:
+			return false
+		}
+	}
+
+	for {
+		select {
+		case ev, ok := <-raw:
+			if !ok {
+				return
+			} else if !fw.accept(ev.Name) {
+				continue
+			}
+
+			if debounce <= 0 {
+				if !send(ev) {
+					return
+				}
+			} else if pe, ok := pending[ev.Name]; ok {
+				pe.op |= ev.Op
+				pe.last = time.Now()
+			} else {
+				pending[ev.Name] = &pendingEvent{op: ev.Op, last: time.Now()}
+				order = append(order, ev.Name)
+			}
+		case now := <-tick:
+			var rest []string
+			for _, name := range order {
+				pe := pending[name]
+				if now.Sub(pe.last) < debounce {
+					rest = append(rest, name)
+					continue
+				}
+				delete(pending, name)
+				if !send(WatchEvent{Name: name, Op: pe.op}) {
+					return
+				}
+			}
+			order = rest
+		case <-fw.done:
+			return
+		}
+	}
+}
+
+// accept 检查路径是否通过过滤器.
+func (fw *FileWatcher) accept(fpath string) bool {
+	for _, filter := range fw.opts.Filters {
+		if !filter(fpath) {
+			return false
+		}
+	}
+	return true
+}
+
+// sendError 发送错误,错误通道已满时丢弃.
+func (fw *FileWatcher) sendError(err error) {
+	select {
+	case fw.Errors <- err:
+	default:
+	}
+}
@@ -0,0 +1,148 @@
+package kgo
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+// waitWatchEvent 等待监视事件,超时返回false.
+func waitWatchEvent(w *FileWatcher, name string, op LkkWatchOp) bool {
+	timeout := time.After(2 * time.Second)
+	for {
+		select {
+		case ev, ok := <-w.Events:
+			if !ok {
+				return false
+			} else if strings.HasSuffix(ev.Name, name) && ev.Op&op != 0 {
+				return true
+			}
+		case <-timeout:
+			return false
+		}
+	}
+}
+
+func TestWatch(t *testing.T) {
+	ts, _ := KOS.NewTempSpace(nil)
+	defer func() {
+		_ = ts.Close()
+	}()
+
+	dir, _ := ts.Dir("watch-*")
+	_ = KFile.Mkdir(dir+"/sub", 0766)
+
+	w, err := KFile.Watch([]string{dir}, &WatchOptions{Recursive: true})
+	if err != nil {
+		t.Error("Watch fail")
+		return
+	}
+	if len(w.WatchList()) != 2 {
+		t.Error("Watch recursive fail")
+		return
+	}
+
+	_ = KFile.WriteFile(dir+"/sub/hello.txt", []byte("hello"))
+	if !waitWatchEvent(w, "sub/hello.txt", WATCH_OP_CREATE) {
+		t.Error("Watch create event fail")
+		return
+	}
+
+	//新建的子目录也被监视
+	_ = KFile.Mkdir(dir+"/new", 0766)
+	if !waitWatchEvent(w, "new", WATCH_OP_CREATE) {
+		t.Error("Watch create dir event fail")
+		return
+	}
+	time.Sleep(50 * time.Millisecond)
+	_ = KFile.WriteFile(dir+"/new/world.txt", []byte("world"))
+	if !waitWatchEvent(w, "new/world.txt", WATCH_OP_CREATE) {
+		t.Error("Watch new dir fail")
+		return
+	}
+
+	_ = KFile.Rename(dir+"/sub/hello.txt", dir+"/sub/hi.txt")
+	if !waitWatchEvent(w, "sub/hello.txt", WATCH_OP_RENAME) {
+		t.Error("Watch rename event fail")
+		return
+	}
+
+	_ = KFile.Unlink(dir + "/sub/hi.txt")
+	if !waitWatchEvent(w, "sub/hi.txt", WATCH_OP_REMOVE) {
+		t.Error("Watch remove event fail")
+		return
+	}
+
+	_ = w.Remove(dir + "/sub")
+	if err = w.Remove(dir + "/hello"); err == nil {
+		t.Error("Watch Remove fail")
+		return
+	}
+
+	if err = w.Close(); err != nil {
+		t.Error("Watch Close fail")
+		return
+	}
+	if _, ok := <-w.Events; ok || len(w.WatchList()) != 0 {
+		t.Error("Watch Close fail")
+		return
+	}
+	_ = w.Close()
+	_ = w.Add(dir)
+
+	_, _ = KFile.Watch([]string{"./hello"}, nil)
+}
+
+func TestWatchDebounce(t *testing.T) {
+	ts, _ := KOS.NewTempSpace(nil)
+	defer func() {
+		_ = ts.Close()
+	}()
+
+	dir, _ := ts.Dir("watch-*")
+	fpath := dir + "/debounce.txt"
+	_ = KFile.WriteFile(fpath, []byte(""))
+
+	filter := func(fpath string) bool {
+		return strings.HasSuffix(fpath, ".txt")
+	}
+	w, err := KFile.Watch([]string{dir}, &WatchOptions{Debounce: 100 * time.Millisecond, Ops: WATCH_OP_WRITE, Filters: []FileFilter{filter}})
+	if err != nil {
+		t.Error("Watch fail")
+		return
+	}
+	defer func() {
+		_ = w.Close()
+	}()
+
+	for i := 0; i < 10; i++ {
+		_ = KFile.AppendFile(fpath, []byte("hello\n"))
+	}
+	_ = KFile.WriteFile(dir+"/skip.log", []byte("hello"))
+
+	var events []WatchEvent
+	timeout := time.After(500 * time.Millisecond)
+loop:
+	for {
+		select {
+		case ev := <-w.Events:
+			events = append(events, ev)
+		case <-timeout:
+			break loop
+		}
+	}
+
+	if len(events) != 1 || events[0].Op.String() != "WRITE" {
+		t.Error("Watch debounce fail")
+		return
+	}
+	_ = WATCH_OP_ALL.String()
+}
+
+func BenchmarkWatch(b *testing.B) {
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		w, _ := KFile.Watch([]string{"./testdata"}, nil)
+		_ = w.Close()
+	}
+}
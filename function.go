@@ -39,20 +39,24 @@ func md5Str(str []byte, length uint8) []byte {
 	return res
 }
 
-// shaXStr 计算字符串的 shaX 散列值,x为1/256/512.
-func shaXStr(str []byte, x uint16) []byte {
-	var h hash.Hash
+// newShaX 创建shaX散列器,x不为1/256/512时返回nil.
+func newShaX(x uint16) hash.Hash {
 	switch x {
 	case 1:
-		h = sha1.New()
-		break
+		return sha1.New()
 	case 256:
-		h = sha256.New()
-		break
+		return sha256.New()
 	case 512:
-		h = sha512.New()
-		break
+		return sha512.New()
 	default:
+		return nil
+	}
+}
+
+// shaXStr 计算字符串的 shaX 散列值,x为1/256/512.
+func shaXStr(str []byte, x uint16) []byte {
+	h := newShaX(x)
+	if h == nil {
 		panic("[shaXStr] x must be in [1, 256, 512]")
 	}
 
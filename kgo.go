@@ -32,12 +32,24 @@ type (
 	LkkFileType uint8
 	// LkkFileTree 枚举类型,文件树查找类型
 	LkkFileTree uint8
+	// LkkSyncAction 枚举类型,目录同步动作
+	LkkSyncAction uint8
+	// LkkWatchOp 枚举类型,文件监视事件类型
+	LkkWatchOp uint32
+	// LkkDupeAction 枚举类型,重复文件处理动作
+	LkkDupeAction uint8
+	// LkkImageFit 枚举类型,图片缩放方式
+	LkkImageFit uint8
 	// LkkRandString 枚举类型,随机字符串类型
 	LkkRandString uint8
 	// LkkCaseSwitch 枚举类型,大小写开关
 	LkkCaseSwitch uint8
 	// LkkPadType 枚举类型,字符串填充类型
 	LkkPadType uint8
+	// LkkPinyinStyle 枚举类型,拼音风格
+	LkkPinyinStyle uint8
+	// LkkChineseMode 枚举类型,简繁转换方式
+	LkkChineseMode uint8
 	// LkkPKCSType 枚举类型,PKCS填充类型
 	LkkPKCSType int8
 
@@ -95,6 +107,40 @@ const (
 	// FILE_TREE_FILE 文件树,仅查找文件
 	FILE_TREE_FILE LkkFileTree = 1
 
+	// SYNC_ACTION_ADD 目录同步,新增
+	SYNC_ACTION_ADD LkkSyncAction = 1
+	// SYNC_ACTION_UPDATE 目录同步,更新
+	SYNC_ACTION_UPDATE LkkSyncAction = 2
+	// SYNC_ACTION_DELETE 目录同步,删除
+	SYNC_ACTION_DELETE LkkSyncAction = 3
+
+	// WATCH_OP_CREATE 文件监视事件,创建
+	WATCH_OP_CREATE LkkWatchOp = 1
+	// WATCH_OP_WRITE 文件监视事件,写入
+	WATCH_OP_WRITE LkkWatchOp = 2
+	// WATCH_OP_REMOVE 文件监视事件,删除
+	WATCH_OP_REMOVE LkkWatchOp = 4
+	// WATCH_OP_RENAME 文件监视事件,重命名或移动
+	WATCH_OP_RENAME LkkWatchOp = 8
+	// WATCH_OP_CHMOD 文件监视事件,属性变更
+	WATCH_OP_CHMOD LkkWatchOp = 16
+	// WATCH_OP_ALL 文件监视事件,全部
+	WATCH_OP_ALL = WATCH_OP_CREATE | WATCH_OP_WRITE | WATCH_OP_REMOVE | WATCH_OP_RENAME | WATCH_OP_CHMOD
+
+	// DUPE_ACTION_NONE 重复文件处理动作,仅查找
+	DUPE_ACTION_NONE LkkDupeAction = 0
+	// DUPE_ACTION_HARDLINK 重复文件处理动作,将副本替换为保留文件的硬链接
+	DUPE_ACTION_HARDLINK LkkDupeAction = 1
+	// DUPE_ACTION_DELETE 重复文件处理动作,删除副本
+	DUPE_ACTION_DELETE LkkDupeAction = 2
+
+	// IMAGE_FIT_CONTAIN 图片缩放方式,等比缩放至目标框内
+	IMAGE_FIT_CONTAIN LkkImageFit = 0
+	// IMAGE_FIT_COVER 图片缩放方式,等比缩放至覆盖目标框,并居中裁剪为目标尺寸
+	IMAGE_FIT_COVER LkkImageFit = 1
+	// IMAGE_FIT_STRETCH 图片缩放方式,拉伸为目标尺寸
+	IMAGE_FIT_STRETCH LkkImageFit = 2
+
 	// RAND_STRING_ALPHA 随机字符串类型,字母
 	RAND_STRING_ALPHA LkkRandString = 0
 	// RAND_STRING_NUMERIC 随机字符串类型,数值
@@ -120,6 +166,28 @@ const (
 	// PAD_BOTH 两侧填充
 	PAD_BOTH LkkPadType = 2
 
+	// PINYIN_STYLE_NORMAL 拼音风格,不带声调,如"zhong",ü写作v
+	PINYIN_STYLE_NORMAL LkkPinyinStyle = 0
+	// PINYIN_STYLE_TONE 拼音风格,声调符号标在韵母上,如"zhōng"
+	PINYIN_STYLE_TONE LkkPinyinStyle = 1
+	// PINYIN_STYLE_TONE_NUM 拼音风格,声调数字在末尾,如"zhong1",轻声不带数字
+	PINYIN_STYLE_TONE_NUM LkkPinyinStyle = 2
+	// PINYIN_STYLE_FIRST_LETTER 拼音风格,仅首字母,如"z"
+	PINYIN_STYLE_FIRST_LETTER LkkPinyinStyle = 3
+
+	// CHINESE_S2T 简繁转换,简体到繁体
+	CHINESE_S2T LkkChineseMode = 0
+	// CHINESE_T2S 简繁转换,繁体到简体
+	CHINESE_T2S LkkChineseMode = 1
+	// CHINESE_S2TW 简繁转换,简体到台湾繁体
+	CHINESE_S2TW LkkChineseMode = 2
+	// CHINESE_TW2S 简繁转换,台湾繁体到简体
+	CHINESE_TW2S LkkChineseMode = 3
+	// CHINESE_S2HK 简繁转换,简体到香港繁体
+	CHINESE_S2HK LkkChineseMode = 4
+	// CHINESE_HK2S 简繁转换,香港繁体到简体
+	CHINESE_HK2S LkkChineseMode = 5
+
 	// PKCS_NONE 不进行填充
 	PKCS_NONE LkkPKCSType = -1
 	// PKCS_ZERO PKCS 0值填充
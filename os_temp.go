@@ -0,0 +1,257 @@
+package kgo
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"sync"
+	"syscall"
+)
+
+// TempOptions 临时工作区选项
+type TempOptions struct {
+	Dir      string      // 工作区所在的父目录,默认为KOS.GetTempDir()
+	Prefix   string      // 工作区及其中临时文件/目录的名称前缀,默认"kgo-"
+	FilePerm os.FileMode // 临时文件的权限,默认0600
+	DirPerm  os.FileMode // 临时目录的权限,默认0700
+}
+
+// TempSpace 临时工作区,所有临时文件和目录都创建在其根目录下,Close时全部删除
+type TempSpace struct {
+	mu       sync.Mutex
+	root     string
+	prefix   string
+	filePerm os.FileMode
+	dirPerm  os.FileMode
+	paths    []string
+	closed   bool
+	sigCh    chan os.Signal
+	sigDone  chan struct{}
+}
+
+// ErrTempSpaceClosed 临时工作区已关闭
+var ErrTempSpaceClosed = errors.New("Temp space already closed")
+
+// NewTempSpace 创建临时工作区,即在父目录下创建唯一命名的根目录.opts可为nil.
+// 使用完毕须调用Close删除其创建的全部文件和目录.
+func (ko *LkkOS) NewTempSpace(opts *TempOptions) (*TempSpace, error) {
+	if opts == nil {
+		opts = &TempOptions{}
+	}
+
+	ts := &TempSpace{
+		prefix:   opts.Prefix,
+		filePerm: opts.FilePerm,
+		dirPerm:  opts.DirPerm,
+	}
+	if ts.prefix == "" {
+		ts.prefix = "kgo-"
+	}
+	if ts.filePerm == 0 {
+		ts.filePerm = 0600
+	}
+	if ts.dirPerm == 0 {
+		ts.dirPerm = 0700
+	}
+
+	parent := opts.Dir
+	if parent == "" {
+		parent = ko.GetTempDir()
+	} else if err := os.MkdirAll(parent, 0755); err != nil {
+		return nil, err
+	}
+
+	parent, err := filepath.Abs(parent)
+	if err != nil {
+		return nil, err
+	}
+
+	root, err := ioutil.TempDir(parent, ts.prefix)
+	if err != nil {
+		return nil, err
+	} else if err = os.Chmod(root, ts.dirPerm|0700); err != nil {
+		_ = os.Remove(root)
+		return nil, err
+	}
+	ts.root = root
+
+	return ts, nil
+}
+
+// Root 获取工作区的根目录.
+func (ts *TempSpace) Root() string {
+	return ts.root
+}
+
+// Join 拼接工作区根目录下的路径,不会创建文件.
+func (ts *TempSpace) Join(elem ...string) string {
+	return filepath.Join(append([]string{ts.root}, elem...)...)
+}
+
+// File 在工作区中创建唯一命名的空临时文件,返回其路径.
+// pattern为名称模式,其中最后一个"*"替换为随机串,不含"*"时随机串追加在末尾;为空时使用工作区前缀.
+func (ts *TempSpace) File(pattern string) (string, error) {
+	file, err := ts.CreateFile(pattern)
+	if err != nil {
+		return "", err
+	}
+	defer func() {
+		_ = file.Close()
+	}()
+
+	return file.Name(), nil
+}
+
+// CreateFile 在工作区中创建唯一命名的临时文件并以读写方式打开,调用方负责关闭文件.
+// pattern同File.
+func (ts *TempSpace) CreateFile(pattern string) (*os.File, error) {
+	ts.mu.Lock()
+	defer ts.mu.Unlock()
+
+	if ts.closed {
+		return nil, ErrTempSpaceClosed
+	}
+
+	file, err := ioutil.TempFile(ts.root, ts.pattern(pattern))
+	if err != nil {
+		return nil, err
+	} else if err = file.Chmod(ts.filePerm); err != nil {
+		_ = file.Close()
+		_ = os.Remove(file.Name())
+		return nil, err
+	}
+	ts.paths = append(ts.paths, file.Name())
+
+	return file, nil
+}
+
+// WriteFile 在工作区中创建唯一命名的临时文件并写入data,返回其路径.pattern同File.
+func (ts *TempSpace) WriteFile(pattern string, data []byte) (string, error) {
+	file, err := ts.CreateFile(pattern)
+	if err != nil {
+		return "", err
+	}
+
+	_, err = file.Write(data)
+	if cerr := file.Close(); err == nil {
+		err = cerr
+	}
+
+	return file.Name(), err
+}
+
+// Dir 在工作区中创建唯一命名的临时目录,返回其路径.pattern同File.
+func (ts *TempSpace) Dir(pattern string) (string, error) {
+	ts.mu.Lock()
+	defer ts.mu.Unlock()
+
+	if ts.closed {
+		return "", ErrTempSpaceClosed
+	}
+
+	dir, err := ioutil.TempDir(ts.root, ts.pattern(pattern))
+	if err != nil {
+		return "", err
+	} else if err = os.Chmod(dir, ts.dirPerm); err != nil {
+		_ = os.Remove(dir)
+		return "", err
+	}
+	ts.paths = append(ts.paths, dir)
+
+	return dir, nil
+}
+
+// Track 将工作区之外创建的文件或目录纳入跟踪,Close时一并删除.
+func (ts *TempSpace) Track(fpath string) error {
+	ts.mu.Lock()
+	defer ts.mu.Unlock()
+
+	if ts.closed {
+		return ErrTempSpaceClosed
+	}
+
+	abs, err := filepath.Abs(fpath)
+	if err != nil {
+		return err
+	}
+	ts.paths = append(ts.paths, abs)
+
+	return nil
+}
+
+// Paths 获取工作区已创建及跟踪的路径,按创建顺序排列.
+func (ts *TempSpace) Paths() []string {
+	ts.mu.Lock()
+	defer ts.mu.Unlock()
+
+	return append([]string{}, ts.paths...)
+}
+
+// CleanOnSignal 在收到sigs信号时删除工作区,默认为SIGINT和SIGTERM.
+// 清理后停止监听并向本进程重发该信号,以执行其默认动作(通常为退出);Close时停止监听.
+func (ts *TempSpace) CleanOnSignal(sigs ...os.Signal) {
+	ts.mu.Lock()
+	defer ts.mu.Unlock()
+
+	if ts.closed || ts.sigCh != nil {
+		return
+	}
+	if len(sigs) == 0 {
+		sigs = []os.Signal{syscall.SIGINT, syscall.SIGTERM}
+	}
+
+	ts.sigCh = make(chan os.Signal, 1)
+	ts.sigDone = make(chan struct{})
+	signal.Notify(ts.sigCh, sigs...)
+
+	go func(sigCh chan os.Signal, done chan struct{}) {
+		select {
+		case sig := <-sigCh:
+			_ = ts.Close()
+			if proc, err := os.FindProcess(os.Getpid()); err == nil {
+				_ = proc.Signal(sig)
+			}
+		case <-done:
+		}
+	}(ts.sigCh, ts.sigDone)
+}
+
+// Close 删除工作区创建及跟踪的全部文件和目录,并停止信号监听;可重复调用.
+// 返回删除过程中遇到的第一个错误.
+func (ts *TempSpace) Close() error {
+	ts.mu.Lock()
+	defer ts.mu.Unlock()
+
+	if ts.closed {
+		return nil
+	}
+	ts.closed = true
+
+	if ts.sigCh != nil {
+		signal.Stop(ts.sigCh)
+		close(ts.sigDone)
+	}
+
+	var firstErr error
+	for i := len(ts.paths) - 1; i >= 0; i-- {
+		if err := os.RemoveAll(ts.paths[i]); err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+	if err := os.RemoveAll(ts.root); err != nil && firstErr == nil {
+		firstErr = err
+	}
+	ts.paths = nil
+
+	return firstErr
+}
+
+// pattern 获取临时文件/目录的名称模式.
+func (ts *TempSpace) pattern(pattern string) string {
+	if pattern == "" {
+		return ts.prefix
+	}
+	return pattern
+}
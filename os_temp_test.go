@@ -0,0 +1,147 @@
+package kgo
+
+import (
+	"os"
+	"os/signal"
+	"path/filepath"
+	"strings"
+	"syscall"
+	"testing"
+	"time"
+)
+
+func TestNewTempSpace(t *testing.T) {
+	ts, err := KOS.NewTempSpace(nil)
+	if err != nil || !KFile.IsDir(ts.Root()) || !strings.HasPrefix(KFile.Basename(ts.Root()), "kgo-") {
+		t.Error("NewTempSpace fail")
+		return
+	}
+	_ = ts.Close()
+
+	base, _ := KOS.NewTempSpace(nil)
+	defer func() {
+		_ = base.Close()
+	}()
+
+	ts, err = KOS.NewTempSpace(&TempOptions{Dir: base.Root(), Prefix: "work-", FilePerm: 0640, DirPerm: 0750})
+	if err != nil || !strings.HasPrefix(KFile.Basename(ts.Root()), "work-") {
+		t.Error("NewTempSpace options fail")
+		return
+	}
+	defer func() {
+		_ = ts.Close()
+	}()
+
+	fpath, err := ts.File("data-*.txt")
+	info, _ := os.Stat(fpath)
+	if err != nil || info.Mode().Perm() != 0640 || !strings.HasSuffix(fpath, ".txt") {
+		t.Error("TempSpace File fail")
+		return
+	}
+
+	dir, err := ts.Dir("")
+	info, _ = os.Stat(dir)
+	if err != nil || info.Mode().Perm() != 0750 || !strings.HasPrefix(KFile.Basename(dir), "work-") {
+		t.Error("TempSpace Dir fail")
+		return
+	}
+
+	wpath, err := ts.WriteFile("", []byte("hello"))
+	content, _ := KFile.ReadFile(wpath)
+	if err != nil || string(content) != "hello" {
+		t.Error("TempSpace WriteFile fail")
+		return
+	}
+
+	file, err := ts.CreateFile("open-*")
+	if err != nil {
+		t.Error("TempSpace CreateFile fail")
+		return
+	}
+	_ = file.Close()
+
+	outside := base.Join("outside.txt")
+	_ = KFile.WriteFile(outside, []byte("x"))
+	if err = ts.Track(outside); err != nil || len(ts.Paths()) != 5 {
+		t.Error("TempSpace Track fail")
+		return
+	}
+	if ts.Join("a", "b") != ts.Root()+"/a/b" || !filepath.IsAbs(ts.Root()) {
+		t.Error("TempSpace Join fail")
+		return
+	}
+
+	if err = ts.Close(); err != nil || KFile.IsExist(ts.Root()) || KFile.IsExist(outside) || len(ts.Paths()) != 0 {
+		t.Error("TempSpace Close fail")
+		return
+	}
+	if err = ts.Close(); err != nil {
+		t.Error("TempSpace Close twice fail")
+		return
+	}
+
+	_, err = ts.File("")
+	if err != ErrTempSpaceClosed {
+		t.Error("TempSpace File closed fail")
+		return
+	}
+	_, err = ts.Dir("")
+	if err != ErrTempSpaceClosed {
+		t.Error("TempSpace Dir closed fail")
+		return
+	}
+	if err = ts.Track(outside); err != ErrTempSpaceClosed {
+		t.Error("TempSpace Track closed fail")
+		return
+	}
+}
+
+func TestTempSpaceCleanOnSignal(t *testing.T) {
+	//接收重发的信号,避免测试进程退出
+	caught := make(chan os.Signal, 2)
+	signal.Notify(caught, syscall.SIGUSR1)
+	defer signal.Stop(caught)
+
+	ts, _ := KOS.NewTempSpace(nil)
+	_, _ = ts.File("")
+	ts.CleanOnSignal(syscall.SIGUSR1)
+	ts.CleanOnSignal(syscall.SIGUSR1)
+
+	_ = syscall.Kill(os.Getpid(), syscall.SIGUSR1)
+	deadline := time.Now().Add(3 * time.Second)
+	for KFile.IsExist(ts.Root()) && time.Now().Before(deadline) {
+		time.Sleep(10 * time.Millisecond)
+	}
+	if KFile.IsExist(ts.Root()) {
+		t.Error("CleanOnSignal fail")
+		return
+	}
+
+	//Close后停止监听
+	ts2, _ := KOS.NewTempSpace(nil)
+	ts2.CleanOnSignal(syscall.SIGUSR1)
+	_ = ts2.Close()
+	ts2.CleanOnSignal()
+}
+
+func BenchmarkTempSpaceFile(b *testing.B) {
+	ts, _ := KOS.NewTempSpace(nil)
+	defer func() {
+		_ = ts.Close()
+	}()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, _ = ts.File("")
+	}
+}
+
+func BenchmarkTempSpaceDir(b *testing.B) {
+	ts, _ := KOS.NewTempSpace(nil)
+	defer func() {
+		_ = ts.Close()
+	}()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, _ = ts.Dir("")
+	}
+}
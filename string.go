@@ -7,7 +7,6 @@ import (
 	"crypto/rsa"
 	"crypto/x509"
 	"encoding/base64"
-	"encoding/hex"
 	"encoding/json"
 	"encoding/pem"
 	"errors"
@@ -525,7 +524,7 @@ func (ks *LkkString) Rtrim(str string, characterMask ...string) string {
 
 // Chr 返回相对应于 ascii 所指定的单个字符.
 func (ks *LkkString) Chr(ascii int) string {
-	return string(ascii)
+	return string(rune(ascii))
 }
 
 // Ord 将首字符转换为rune.
@@ -1095,95 +1094,31 @@ func (ks *LkkString) Big5ToUtf8(s []byte) ([]byte, error) {
 	return d, e
 }
 
-// FirstLetter 获取字符串首字母.
+// FirstLetter 获取字符串首字母;首字符为汉字时返回其拼音的大写首字母,多音字优先按词组读音.
+// 拼音字典未收录的汉字(如扩展A/B区的生僻字"㐀","𠀀")返回空字符串.
 func (ks *LkkString) FirstLetter(str string) string {
 	if str != "" {
 		// 获取字符串第一个字符
-		_, size := utf8.DecodeRuneInString(str)
+		r, size := utf8.DecodeRuneInString(str)
 		firstChar := str[:size]
 
 		if ks.IsLetters(firstChar) {
 			return firstChar
-		} else if ks.IsChinese(firstChar) {
-			// Utf8 转 GBK2312
-			firstCharGbk, _ := ks.Utf8ToGbk([]byte(firstChar))
-
-			// 获取第一个字符的16进制
-			firstCharHex := hex.EncodeToString(firstCharGbk)
-
-			// 16进制转十进制
-			firstCharDec, _ := strconv.ParseInt(firstCharHex, 16, 0)
-
-			// 十进制落在GB 2312的某个拼音区间即为某个字母
-			firstCharDecimalRelative := firstCharDec - 65536
-			if firstCharDecimalRelative >= -20319 && firstCharDecimalRelative <= -20284 {
-				return "A"
-			}
-			if firstCharDecimalRelative >= -20283 && firstCharDecimalRelative <= -19776 {
-				return "B"
-			}
-			if firstCharDecimalRelative >= -19775 && firstCharDecimalRelative <= -19219 {
-				return "C"
-			}
-			if firstCharDecimalRelative >= -19218 && firstCharDecimalRelative <= -18711 {
-				return "D"
-			}
-			if firstCharDecimalRelative >= -18710 && firstCharDecimalRelative <= -18527 {
-				return "E"
-			}
-			if firstCharDecimalRelative >= -18526 && firstCharDecimalRelative <= -18240 {
-				return "F"
-			}
-			if firstCharDecimalRelative >= -18239 && firstCharDecimalRelative <= -17923 {
-				return "G"
-			}
-			if firstCharDecimalRelative >= -17922 && firstCharDecimalRelative <= -17418 {
-				return "H"
-			}
-			if firstCharDecimalRelative >= -17417 && firstCharDecimalRelative <= -16475 {
-				return "J"
-			}
-			if firstCharDecimalRelative >= -16474 && firstCharDecimalRelative <= -16213 {
-				return "K"
-			}
-			if firstCharDecimalRelative >= -16212 && firstCharDecimalRelative <= -15641 {
-				return "L"
-			}
-			if firstCharDecimalRelative >= -15640 && firstCharDecimalRelative <= -15166 {
-				return "M"
-			}
-			if firstCharDecimalRelative >= -15165 && firstCharDecimalRelative <= -14923 {
-				return "N"
-			}
-			if firstCharDecimalRelative >= -14922 && firstCharDecimalRelative <= -14915 {
-				return "O"
-			}
-			if firstCharDecimalRelative >= -14914 && firstCharDecimalRelative <= -14631 {
-				return "P"
-			}
-			if firstCharDecimalRelative >= -14630 && firstCharDecimalRelative <= -14150 {
-				return "Q"
-			}
-			if firstCharDecimalRelative >= -14149 && firstCharDecimalRelative <= -14091 {
-				return "R"
-			}
-			if firstCharDecimalRelative >= -14090 && firstCharDecimalRelative <= -13319 {
-				return "S"
-			}
-			if firstCharDecimalRelative >= -13318 && firstCharDecimalRelative <= -12839 {
-				return "T"
-			}
-			if firstCharDecimalRelative >= -12838 && firstCharDecimalRelative <= -12557 {
-				return "W"
-			}
-			if firstCharDecimalRelative >= -12556 && firstCharDecimalRelative <= -11848 {
-				return "X"
-			}
-			if firstCharDecimalRelative >= -11847 && firstCharDecimalRelative <= -11056 {
-				return "Y"
+		} else if pt := getPinyinTable(); pt.isHan(r) {
+			// 取开头的若干汉字,以便按词组确定多音字读音
+			pt.RLock()
+			maxLen := pt.maxPhrase
+			pt.RUnlock()
+
+			var hans []rune
+			for _, c := range str {
+				if !pt.isHan(c) || (len(hans) > 0 && len(hans) >= maxLen) {
+					break
+				}
+				hans = append(hans, c)
 			}
-			if firstCharDecimalRelative >= -11055 && firstCharDecimalRelative <= -10247 {
-				return "Z"
+			if pys := pt.convert(hans); len(pys) > 0 {
+				return strings.ToUpper(pinyinFormat(pys[0], PINYIN_STYLE_FIRST_LETTER))
 			}
 		}
 	}
@@ -1371,7 +1306,7 @@ func (ks *LkkString) Jsonp2Json(str string) (string, error) {
 
 // CountWords 统计字符串中单词的使用情况.
 // 返回结果:单词总数;和一个字典,包含每个单词的单独统计.
-// 因为没有分词,对中文尚未很好支持.
+// 连续的汉字会先进行中文分词,见Segment;其他部分按空白和标点分割,保持原样.
 func (ks *LkkString) CountWords(str string) (int, map[string]int) {
 	//过滤标点符号
 	var buffer bytes.Buffer
@@ -1386,9 +1321,32 @@ func (ks *LkkString) CountWords(str string) (int, map[string]int) {
 	var total int
 	mp := make(map[string]int)
 	words := strings.Fields(buffer.String())
-	for _, word := range words {
-		mp[word] += 1
-		total++
+	for _, field := range words {
+		if !ks.HasChinese(field) {
+			mp[field] += 1
+			total++
+			continue
+		}
+
+		//仅对连续的汉字分词,夹杂的其他字符整体作为一个词
+		runes := []rune(field)
+		for i := 0; i < len(runes); {
+			isHan := unicode.Is(unicode.Han, runes[i])
+			j := i + 1
+			for j < len(runes) && unicode.Is(unicode.Han, runes[j]) == isHan {
+				j++
+			}
+			if isHan {
+				for _, word := range segmentText(string(runes[i:j]), false) {
+					mp[word] += 1
+					total++
+				}
+			} else {
+				mp[string(runes[i:j])] += 1
+				total++
+			}
+			i = j
+		}
 	}
 
 	return total, mp
@@ -1444,6 +1402,7 @@ func (ks *LkkString) HasLetter(str string) bool {
 }
 
 // IsUtf8 字符串是否UTF-8编码.
+// 非UTF-8时无法区分GBK、Big5等编码,无BOM的UTF-16拉丁文本也可能是有效的UTF-8,可使用DetectCharset检测具体编码.
 func (ks *LkkString) IsUtf8(str string) bool {
 	return str != "" && utf8.ValidString(str)
 }
@@ -0,0 +1,325 @@
+package kgo
+
+import (
+	"bytes"
+	"errors"
+	"golang.org/x/text/encoding"
+	"golang.org/x/text/encoding/htmlindex"
+	"golang.org/x/text/encoding/ianaindex"
+	"golang.org/x/text/encoding/japanese"
+	"golang.org/x/text/encoding/korean"
+	"golang.org/x/text/encoding/simplifiedchinese"
+	"golang.org/x/text/encoding/traditionalchinese"
+	xunicode "golang.org/x/text/encoding/unicode"
+	"golang.org/x/text/transform"
+	"io"
+	"io/ioutil"
+	"math"
+	"strings"
+	"sync"
+	"unicode"
+	"unicode/utf8"
+)
+
+// charsetCommonHans 简体中文最常用的汉字,按字频排列;检测编码时以其繁体转换结果作为繁体常用字
+const charsetCommonHans = "的一是不了在人有我他这个们中来上大为和国地到以说时要就出会可也你对生能而子那得于着下自之年过发后作里用道行所然家种事成方多经么去法学如都同现当没动面起看定天分还进好小部其些主样理心她本前开但因只从想实" +
+	"日军者意无力它与长把机十民第公此已工使情明性知全三又关点正业外将两高间由问很最重并物手应战向头文体政美相见被利什二等产或新己制身果加西斯月话合回特代内信表化老给世位次度门任常先海通教儿原东声提立及比员" +
+	"解水名真论处走义各入几口认条平系气题活尔更别打女变四神总何电数安少报才结反受目太量再感建务做接必场件计管期市直德资命山金指克许统区保至队形社便空决治展马科司五基眼书非则听白却界达光放强即像难且权思王象" +
+	"完设式色路记南品住告类求据程北边死张该交规万取拉格望觉术领共确传师观清今切院让识候带导争运笑飞风步改收根干造言联持组每济车亲极林服快办议往元英士证近失转夫令准布始怎呢存未远叫台单影具罗字爱击流备兵连调" +
+	"深商算质团集百需价花党华城石级整府离况亚请技际约示复病息究线似官火断精满支视消越器容照须九增研写称企八功吗包片史委乎查轻易早曾除农找装广显吧阿李标谈吃图念六引历首医局突专费号尽另周较注语仅考落青随选列" +
+	"武红响虽推势参希古众构房半节土投某案黑维革划敌致陈律足态护七兴派孩验责营星够章音跟志底站严巴例防族供效续施留讲型料终答紧黄绝奇察母京段依批群项故按河米围江织害斗双境客纪采举杀攻父苏密低朝友诉止细愿千值"
+
+// charsetCommonHangul 韩文最常用的音节
+const charsetCommonHangul = "이다는의에을를하고가로지한기서사어리자도있대나수들정시인일보게요것해아그과부제주상면적만전라국으구여장연우생원동성경학년중위소신세내무비문방조개공화후회관분실용" +
+	"마오저니없되었습합할했또더때같러모두많않바람간각말씀계미본역업치교육족친머버선님름얼디엇누언왜떻좋감녕까네터처럼큼든거던데입"
+
+// charsetSniffLen 检测编码时最多检查的字节数
+const charsetSniffLen = 64 * 1024
+
+// charsetCandidate 检测编码时的多字节候选编码
+type charsetCandidate struct {
+	name string
+	enc  encoding.Encoding
+	good func(r rune) bool // 是否该编码所属语言的常用字符
+}
+
+var (
+	charsetOnce       sync.Once
+	charsetCommon     map[rune]bool // 常用汉字(简体和繁体)及韩文音节
+	charsetCandidates []charsetCandidate
+)
+
+// charsetInit 初始化检测编码用的常用字符表和候选编码,候选编码按优先级排列.
+func charsetInit() {
+	charsetOnce.Do(func() {
+		charsetCommon = make(map[rune]bool, 1500)
+		s2t := getChineseDict(CHINESE_S2T)
+		for _, r := range charsetCommonHans {
+			charsetCommon[r] = true
+			for _, t := range s2t.char(string(r)) {
+				charsetCommon[t] = true
+			}
+		}
+		for _, r := range charsetCommonHangul {
+			charsetCommon[r] = true
+		}
+
+		isChinese := func(r rune) bool {
+			return charsetCommon[r] && unicode.Is(unicode.Han, r)
+		}
+		isJapanese := func(r rune) bool {
+			return unicode.Is(unicode.Hiragana, r) || (r >= 0x30A1 && r <= 0x30FE) || isChinese(r)
+		}
+		isKorean := func(r rune) bool {
+			return charsetCommon[r] && unicode.Is(unicode.Hangul, r)
+		}
+		isCJK := func(r rune) bool {
+			return isJapanese(r) || isKorean(r)
+		}
+		charsetCandidates = []charsetCandidate{
+			{"GBK", simplifiedchinese.GBK, isChinese},
+			{"GB18030", simplifiedchinese.GB18030, isChinese},
+			{"Big5", traditionalchinese.Big5, isChinese},
+			{"Shift_JIS", japanese.ShiftJIS, isJapanese},
+			{"EUC-JP", japanese.EUCJP, isJapanese},
+			{"EUC-KR", korean.EUCKR, isKorean},
+			{"UTF-16LE", xunicode.UTF16(xunicode.LittleEndian, xunicode.IgnoreBOM), isCJK},
+			{"UTF-16BE", xunicode.UTF16(xunicode.BigEndian, xunicode.IgnoreBOM), isCJK},
+		}
+	})
+}
+
+// charsetEncoding 根据名称获取编码,名称不区分大小写,支持IANA及WHATWG中的名称和别名.
+func charsetEncoding(name string) (encoding.Encoding, error) {
+	name = strings.TrimSpace(name)
+	if enc, err := ianaindex.IANA.Encoding(name); err == nil && enc != nil {
+		return enc, nil
+	}
+	if enc, err := htmlindex.Get(name); err == nil && enc != nil {
+		return enc, nil
+	}
+	return nil, errors.New("Unsupported charset: " + name)
+}
+
+// charsetTransformer 创建将from编码转换为to编码的转换器.
+// from为UTF-8或UTF-16时,按数据开头的BOM确定实际编码,并去掉BOM.
+func charsetTransformer(from, to string) (transform.Transformer, error) {
+	fromEnc, err := charsetEncoding(from)
+	if err != nil {
+		return nil, err
+	}
+	toEnc, err := charsetEncoding(to)
+	if err != nil {
+		return nil, err
+	}
+
+	var dec transform.Transformer = fromEnc.NewDecoder()
+	if strings.HasPrefix(strings.ToLower(strings.TrimSpace(from)), "utf") {
+		dec = xunicode.BOMOverride(dec)
+	}
+
+	return transform.Chain(dec, toEnc.NewEncoder()), nil
+}
+
+// charsetValidUtf8 数据是否有效的UTF-8编码,允许末尾有被截断的多字节字符.
+func charsetValidUtf8(data []byte) bool {
+	if utf8.Valid(data) {
+		return true
+	}
+	for i := 1; i <= 3 && i <= len(data); i++ {
+		if tail := data[len(data)-i:]; utf8.RuneStart(tail[0]) {
+			return !utf8.FullRune(tail) && utf8.Valid(data[:len(data)-i])
+		}
+	}
+	return false
+}
+
+// charsetScore 按候选编码解码数据,返回常用字符在非ASCII字符(不含标点和符号)中的比例,以及非ASCII字符数.
+func charsetScore(data []byte, cand charsetCandidate) (float64, int) {
+	text, err := ioutil.ReadAll(transform.NewReader(bytes.NewReader(data), cand.enc.NewDecoder()))
+	if err != nil {
+		return 0, 0
+	}
+
+	var total, good, invalid int
+	for _, r := range string(text) {
+		if r < utf8.RuneSelf || unicode.IsPunct(r) || unicode.IsSymbol(r) || unicode.IsSpace(r) {
+			continue
+		}
+		total++
+		if r == utf8.RuneError {
+			invalid++
+		} else if cand.good(r) {
+			good++
+		}
+	}
+
+	//无效字节过多,不是该编码
+	if total == 0 || invalid*20 > total {
+		return 0, total
+	}
+
+	return float64(good) / float64(total), total
+}
+
+// detectUtf16 根据零字节的分布检测无BOM的UTF-16编码,适用于以拉丁字母为主的文本;
+// 以中日韩文字为主的UTF-16文本,由常用字比例检测.
+func detectUtf16(data []byte) (string, float64) {
+	pairs := len(data) / 2
+	if pairs < 2 {
+		return "", 0
+	}
+
+	var evenZero, oddZero int
+	for i := 0; i+1 < len(data); i += 2 {
+		if data[i] == 0 {
+			evenZero++
+		}
+		if data[i+1] == 0 {
+			oddZero++
+		}
+	}
+
+	even, odd := float64(evenZero)/float64(pairs), float64(oddZero)/float64(pairs)
+	if odd > 0.3 && even < 0.05 {
+		return "UTF-16LE", math.Min(0.95, odd)
+	} else if even > 0.3 && odd < 0.05 {
+		return "UTF-16BE", math.Min(0.95, even)
+	}
+	return "", 0
+}
+
+// detectSingleByte 检测单字节编码,对非ASCII字节中拉丁字母的比例评分.
+func detectSingleByte(data []byte) (string, float64) {
+	name := "ISO-8859-1"
+	var high, letters int
+	for _, b := range data {
+		if b < 0x80 {
+			continue
+		}
+		high++
+		if b <= 0x9F {
+			//ISO-8859-1中为控制字符,windows-1252中为可打印字符
+			name = "windows-1252"
+		} else if b >= 0xC0 && b != 0xD7 && b != 0xF7 {
+			letters++
+		}
+	}
+	if high == 0 {
+		return name, 0
+	}
+
+	return name, 0.5 * float64(letters) / float64(high)
+}
+
+// DetectCharset 检测数据最可能的字符编码,返回编码名称及置信度(0~1);数据为空时返回空名称.
+// 可识别带BOM的UTF-8/UTF-16、ASCII、UTF-8、GBK、GB18030、Big5、Shift_JIS、EUC-JP、EUC-KR,
+// 其他情况返回ISO-8859-1或windows-1252.多字节编码按解码后常用字的比例判断,因此能区分GBK和Big5.
+// 返回的名称可直接用于Convert.
+func (ks *LkkString) DetectCharset(data []byte) (string, float64) {
+	if len(data) == 0 {
+		return "", 0
+	} else if len(data) > charsetSniffLen {
+		data = data[:charsetSniffLen]
+	}
+
+	switch {
+	case bytes.HasPrefix(data, []byte("\xEF\xBB\xBF")):
+		return "UTF-8", 1
+	case bytes.HasPrefix(data, []byte("\xFF\xFE")):
+		return "UTF-16LE", 1
+	case bytes.HasPrefix(data, []byte("\xFE\xFF")):
+		return "UTF-16BE", 1
+	}
+
+	if name, confidence := detectUtf16(data); name != "" {
+		return name, confidence
+	}
+
+	var multi int
+	ascii := true
+	for _, b := range data {
+		if b >= utf8.RuneSelf {
+			ascii = false
+			if utf8.RuneStart(b) {
+				multi++
+			}
+		}
+	}
+	if ascii {
+		return "ASCII", 1
+	} else if charsetValidUtf8(data) {
+		return "UTF-8", math.Min(0.99, 1-math.Pow(0.5, float64(multi+1)))
+	}
+
+	charsetInit()
+	var bestName string
+	var bestScore float64
+	var bestTotal int
+	for _, cand := range charsetCandidates {
+		score, total := charsetScore(data, cand)
+		if score > bestScore {
+			bestName, bestScore, bestTotal = cand.name, score, total
+		}
+	}
+	if bestScore >= 0.2 {
+		//样本字符较少时降低置信度
+		return bestName, bestScore * math.Min(1, 0.5+float64(bestTotal)/20)
+	}
+
+	return detectSingleByte(data)
+}
+
+// Convert 将数据从from编码转换为to编码.编码名称不区分大小写,如"GBK"、"GB18030"、"Big5"、"Shift_JIS"、
+// "EUC-KR"、"ISO-8859-2"、"UTF-16LE"等;from为UTF-8或UTF-16时会识别并去掉BOM.
+// from为空时,先使用DetectCharset检测数据的编码.
+func (ks *LkkString) Convert(data []byte, from, to string) ([]byte, error) {
+	if from == "" {
+		if from, _ = ks.DetectCharset(data); from == "" {
+			from = "UTF-8"
+		}
+	}
+
+	tr, err := charsetTransformer(from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	res, _, err := transform.Bytes(tr, data)
+	return res, err
+}
+
+// ConvertReader 返回一个读取器,从r中读取from编码的数据并转换为to编码.
+// from为空时,先使用DetectCharset检测r开头数据的编码.
+func (ks *LkkString) ConvertReader(r io.Reader, from, to string) (io.Reader, error) {
+	if from == "" {
+		head := make([]byte, 4096)
+		n, err := io.ReadFull(r, head)
+		if err != nil && err != io.ErrUnexpectedEOF && err != io.EOF {
+			return nil, err
+		}
+		head = head[:n]
+		if from, _ = ks.DetectCharset(head); from == "" {
+			from = "UTF-8"
+		}
+		r = io.MultiReader(bytes.NewReader(head), r)
+	}
+
+	tr, err := charsetTransformer(from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	return transform.NewReader(r, tr), nil
+}
+
+// ConvertWriter 返回一个写入器,将写入的from编码数据转换为to编码后写入w.
+// 写入完毕后须调用Close,以刷新缓冲中剩余的数据;Close不会关闭w.
+func (ks *LkkString) ConvertWriter(w io.Writer, from, to string) (io.WriteCloser, error) {
+	tr, err := charsetTransformer(from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	return transform.NewWriter(w, tr), nil
+}
@@ -0,0 +1,217 @@
+package kgo
+
+import (
+	"bytes"
+	"io/ioutil"
+	"strings"
+	"testing"
+)
+
+func TestConvert(t *testing.T) {
+	str := "你好，世界！Hello"
+	var tests = []struct {
+		charset string
+		encoded string
+	}{
+		{"GBK", "\xc4\xe3\xba\xc3\xa3\xac\xca\xc0\xbd\xe7\xa3\xa1Hello"},
+		{"gb18030", "\xc4\xe3\xba\xc3\xa3\xac\xca\xc0\xbd\xe7\xa3\xa1Hello"},
+		{"big5", "\xa7\x41\xa6\x6e\xa1\x41\xa5\x40\xac\xc9\xa1\x49Hello"},
+		{"UTF-16LE", "\x60\x4f\x7d\x59\x0c\xff\x16\x4e\x4c\x75\x01\xffH\x00e\x00l\x00l\x00o\x00"},
+	}
+	for _, test := range tests {
+		actual, err := KStr.Convert([]byte(str), "utf-8", test.charset)
+		if err != nil || string(actual) != test.encoded {
+			t.Errorf("Expected Convert(%s, utf-8, %s) to be %q, got %q", str, test.charset, test.encoded, actual)
+			return
+		}
+		back, err := KStr.Convert(actual, test.charset, "UTF-8")
+		if err != nil || string(back) != str {
+			t.Errorf("Expected Convert(%q, %s, UTF-8) to be %s, got %s", actual, test.charset, str, back)
+			return
+		}
+	}
+
+	//UTF-16带BOM
+	res, err := KStr.Convert([]byte("\xff\xfeH\x00i\x00"), "utf-16", "utf-8")
+	if err != nil || string(res) != "Hi" {
+		t.Error("Convert utf-16 bom fail")
+		return
+	}
+
+	//ISO-8859-2
+	res, err = KStr.Convert([]byte("Łódź"), "UTF-8", "ISO-8859-2")
+	if err != nil || string(res) != "\xa3\xf3d\xbc" {
+		t.Error("Convert iso-8859-2 fail")
+		return
+	}
+
+	//Shift_JIS,自动检测
+	sjis, _ := KStr.Convert([]byte("今日は良い天気です。"), "UTF-8", "Shift_JIS")
+	res, err = KStr.Convert(sjis, "", "UTF-8")
+	if err != nil || string(res) != "今日は良い天気です。" {
+		t.Error("Convert detect fail")
+		return
+	}
+
+	_, err = KStr.Convert([]byte(str), "utf-8", "hello")
+	if err == nil {
+		t.Error("Convert unsupported charset fail")
+		return
+	}
+	_, err = KStr.Convert([]byte(str), "hello", "utf-8")
+	if err == nil {
+		t.Error("Convert unsupported charset fail")
+		return
+	}
+	_, err = KStr.Convert([]byte(str), "utf-8", "iso-8859-1")
+	if err == nil {
+		t.Error("Convert unencodable fail")
+		return
+	}
+}
+
+func BenchmarkConvert(b *testing.B) {
+	b.ResetTimer()
+	str := []byte("你好，世界！Hello")
+	for i := 0; i < b.N; i++ {
+		_, _ = KStr.Convert(str, "utf-8", "gbk")
+	}
+}
+
+func TestConvertReader(t *testing.T) {
+	str := strings.Repeat("中华人民共和国,", 1000)
+	big5, _ := KStr.Convert([]byte(str), "UTF-8", "Big5")
+
+	r, err := KStr.ConvertReader(bytes.NewReader(big5), "big5", "utf-8")
+	if err != nil {
+		t.Error("ConvertReader fail")
+		return
+	}
+	res, err := ioutil.ReadAll(r)
+	if err != nil || string(res) != str {
+		t.Error("ConvertReader fail")
+		return
+	}
+
+	//自动检测
+	r, err = KStr.ConvertReader(bytes.NewReader(big5), "", "utf-8")
+	if err != nil {
+		t.Error("ConvertReader detect fail")
+		return
+	}
+	res, err = ioutil.ReadAll(r)
+	if err != nil || string(res) != str {
+		t.Error("ConvertReader detect fail")
+		return
+	}
+
+	_, err = KStr.ConvertReader(bytes.NewReader(big5), "big5", "hello")
+	if err == nil {
+		t.Error("ConvertReader unsupported charset fail")
+		return
+	}
+}
+
+func BenchmarkConvertReader(b *testing.B) {
+	b.ResetTimer()
+	str := []byte("你好，世界！Hello")
+	for i := 0; i < b.N; i++ {
+		r, _ := KStr.ConvertReader(bytes.NewReader(str), "utf-8", "gbk")
+		_, _ = ioutil.ReadAll(r)
+	}
+}
+
+func TestConvertWriter(t *testing.T) {
+	var buf bytes.Buffer
+	w, err := KStr.ConvertWriter(&buf, "utf-8", "euc-kr")
+	if err != nil {
+		t.Error("ConvertWriter fail")
+		return
+	}
+	_, _ = w.Write([]byte("안녕"))
+	_, _ = w.Write([]byte("하세요"))
+	_ = w.Close()
+	if buf.String() != "\xbe\xc8\xb3\xe7\xc7\xcf\xbc\xbc\xbf\xe4" {
+		t.Error("ConvertWriter fail")
+		return
+	}
+
+	_, err = KStr.ConvertWriter(&buf, "", "utf-8")
+	if err == nil {
+		t.Error("ConvertWriter unsupported charset fail")
+		return
+	}
+}
+
+func BenchmarkConvertWriter(b *testing.B) {
+	b.ResetTimer()
+	str := []byte("你好，世界！Hello")
+	for i := 0; i < b.N; i++ {
+		w, _ := KStr.ConvertWriter(ioutil.Discard, "utf-8", "gbk")
+		_, _ = w.Write(str)
+		_ = w.Close()
+	}
+}
+
+func TestDetectCharset(t *testing.T) {
+	simplified := "我们今天去公园散步，天气很好，大家都很开心。"
+	traditional := "我們今天去公園散步，天氣很好，大家都很開心。"
+	var tests = []struct {
+		str      string
+		charset  string
+		expected string
+	}{
+		{simplified, "GBK", "GBK"},
+		{traditional, "Big5", "Big5"},
+		{traditional, "GBK", "GBK"},
+		{"今日は公園を散歩しました。天気がとても良かったです。", "Shift_JIS", "Shift_JIS"},
+		{"今日は公園を散歩しました。天気がとても良かったです。", "EUC-JP", "EUC-JP"},
+		{"오늘은 공원에서 산책을 했습니다.", "EUC-KR", "EUC-KR"},
+		{simplified, "UTF-8", "UTF-8"},
+		{simplified, "UTF-16LE", "UTF-16LE"},
+		{"Hello world", "UTF-16BE", "UTF-16BE"},
+		{"Hello world", "UTF-8", "ASCII"},
+		{"Größe Übung Äpfel", "ISO-8859-1", "ISO-8859-1"},
+		{"Le cœur déçu", "windows-1252", "windows-1252"},
+	}
+	for _, test := range tests {
+		data, _ := KStr.Convert([]byte(test.str), "UTF-8", test.charset)
+		actual, confidence := KStr.DetectCharset(data)
+		if actual != test.expected || confidence <= 0 || confidence > 1 {
+			t.Errorf("Expected DetectCharset(%s in %s) to be %s, got %s %f", test.str, test.charset, test.expected, actual, confidence)
+			return
+		}
+	}
+
+	res, confidence := KStr.DetectCharset([]byte("\xef\xbb\xbfhello"))
+	if res != "UTF-8" || confidence != 1 {
+		t.Error("DetectCharset bom fail")
+		return
+	}
+	res, confidence = KStr.DetectCharset([]byte("\xfe\xff\x00h"))
+	if res != "UTF-16BE" || confidence != 1 {
+		t.Error("DetectCharset bom fail")
+		return
+	}
+
+	//截断的UTF-8
+	res, _ = KStr.DetectCharset([]byte(simplified)[:10])
+	if res != "UTF-8" {
+		t.Error("DetectCharset truncated fail")
+		return
+	}
+
+	res, confidence = KStr.DetectCharset(nil)
+	if res != "" || confidence != 0 {
+		t.Error("DetectCharset empty fail")
+		return
+	}
+}
+
+func BenchmarkDetectCharset(b *testing.B) {
+	b.ResetTimer()
+	data, _ := KStr.Convert([]byte("我們今天去公園散步，天氣很好，大家都很開心。"), "UTF-8", "Big5")
+	for i := 0; i < b.N; i++ {
+		KStr.DetectCharset(data)
+	}
+}
@@ -0,0 +1,170 @@
+package kgo
+
+import (
+	"strings"
+	"sync"
+	"unicode/utf8"
+)
+
+// chineseDict 简繁转换字典,键为单字或词组
+type chineseDict struct {
+	words  map[string]string
+	maxLen int // 键的最大字数
+}
+
+var (
+	chineseOnce  sync.Once
+	chineseDicts map[LkkChineseMode]*chineseDict
+)
+
+// newChineseDict 创建简繁转换字典.
+func newChineseDict() *chineseDict {
+	return &chineseDict{words: make(map[string]string, 4096)}
+}
+
+// add 添加对照关系,已存在的键不覆盖.
+func (cd *chineseDict) add(from, to string) {
+	if _, ok := cd.words[from]; ok {
+		return
+	}
+	cd.words[from] = to
+	if n := utf8.RuneCountInString(from); n > cd.maxLen {
+		cd.maxLen = n
+	}
+}
+
+// load 从"源 目标"格式的对照数据中加载,reverse为是否以"目标 源"方向加载.
+func (cd *chineseDict) load(data string, reverse bool) {
+	for _, line := range strings.Split(data, "\n") {
+		fields := strings.Fields(line)
+		if len(fields) != 2 {
+			continue
+		}
+		if reverse {
+			cd.add(fields[1], fields[0])
+		} else {
+			cd.add(fields[0], fields[1])
+		}
+	}
+}
+
+// char 转换单个字,无对照时返回原字.
+func (cd *chineseDict) char(c string) string {
+	if to, ok := cd.words[c]; ok {
+		return to
+	}
+	return c
+}
+
+// convert 按正向最长匹配转换字符串.
+func (cd *chineseDict) convert(str string) string {
+	runes := []rune(str)
+	var buf strings.Builder
+	buf.Grow(len(str))
+
+	for i := 0; i < len(runes); {
+		//非中日韩文字直接输出
+		if runes[i] < 0x2E80 {
+			buf.WriteRune(runes[i])
+			i++
+			continue
+		}
+
+		l := cd.maxLen
+		if l > len(runes)-i {
+			l = len(runes) - i
+		}
+		for ; l > 0; l-- {
+			if to, ok := cd.words[string(runes[i:i+l])]; ok {
+				buf.WriteString(to)
+				i += l
+				break
+			}
+		}
+		if l == 0 {
+			buf.WriteRune(runes[i])
+			i++
+		}
+	}
+
+	return buf.String()
+}
+
+// withVariants 以本字典为基础,将转换结果中的字替换为地区异体字,生成新字典.
+func (cd *chineseDict) withVariants(variants *chineseDict) *chineseDict {
+	res := &chineseDict{words: make(map[string]string, len(cd.words)), maxLen: cd.maxLen}
+	for from, to := range cd.words {
+		var buf strings.Builder
+		for _, r := range to {
+			buf.WriteString(variants.char(string(r)))
+		}
+		res.words[from] = buf.String()
+	}
+	for from, to := range variants.words {
+		if _, ok := res.words[from]; !ok {
+			res.words[from] = to
+		}
+	}
+	return res
+}
+
+// getChineseDict 获取简繁转换字典,首次调用时解析内置数据.
+func getChineseDict(mode LkkChineseMode) *chineseDict {
+	chineseOnce.Do(func() {
+		s2t := newChineseDict()
+		s2t.load(chineseBothData, false)
+		s2t.load(chineseS2TData, false)
+
+		t2s := newChineseDict()
+		t2s.load(chineseBothData, true)
+		t2s.load(chineseT2SData, false)
+
+		tw := newChineseDict()
+		tw.load(chineseTWVariantData, false)
+		hk := newChineseDict()
+		hk.load(chineseHKVariantData, false)
+
+		//地区异体字及其对应的通用繁体,都转换为同一简体
+		for _, variants := range []*chineseDict{tw, hk} {
+			for std, variant := range variants.words {
+				target, ok := t2s.words[std]
+				if !ok {
+					target = t2s.char(variant)
+				}
+				t2s.add(std, target)
+				t2s.add(variant, target)
+			}
+		}
+
+		chineseDicts = map[LkkChineseMode]*chineseDict{
+			CHINESE_S2T:  s2t,
+			CHINESE_T2S:  t2s,
+			CHINESE_S2TW: s2t.withVariants(tw),
+			CHINESE_TW2S: t2s,
+			CHINESE_S2HK: s2t.withVariants(hk),
+			CHINESE_HK2S: t2s,
+		}
+	})
+
+	return chineseDicts[mode]
+}
+
+// ChineseConvert 简繁体中文转换,按词组最长匹配优先,以正确处理一简对多繁的情况(如"头发"转为"頭髮").
+// mode为转换方式,如CHINESE_S2T、CHINESE_S2TW;繁体转简体时,可识别台湾和香港的异体字.
+func (ks *LkkString) ChineseConvert(str string, mode LkkChineseMode) string {
+	cd := getChineseDict(mode)
+	if cd == nil || str == "" {
+		return str
+	}
+	return cd.convert(str)
+}
+
+// Simplified2Traditional 简体中文转繁体.
+func (ks *LkkString) Simplified2Traditional(str string) string {
+	return ks.ChineseConvert(str, CHINESE_S2T)
+}
+
+// Traditional2Simplified 繁体中文转简体.
+func (ks *LkkString) Traditional2Simplified(str string) string {
+	return ks.ChineseConvert(str, CHINESE_T2S)
+}
@@ -0,0 +1,4190 @@
+package kgo
+
+// chineseBothData 简繁转换的双向对照表,每行为"简体 繁体",包括单字和词组.
+// 词组用于处理一简对多繁的情况,如"头发 頭髮"、"发展 發展".
+const chineseBothData = `
+于飞 于飛
+于归 于歸
+于思 于思
+单于 單于
+鲜于 鮮于
+姜片 薑片
+姜末 薑末
+生姜 生薑
+鬼子姜 鬼子薑
+姜 姜
+赤皮仑 赤皮崙
+金仑溪 金崙溪
+下仑 下崙
+中仑 中崙
+仑顶 崙頂
+昆山 崑山
+昆仑 崑崙
+昆曲 崑曲
+仑 侖
+曲 曲
+茶余饭后 茶餘飯後
+余怒未消 餘怒未消
+余音绕梁 餘音繞梁
+余勇可贾 餘勇可賈
+余波 餘波
+余地 餘地
+余额 餘額
+余角 餘角
+余烬 餘燼
+余款 餘款
+余量 餘量
+余粮 餘糧
+余年 餘年
+余力 餘力
+余生 餘生
+余兴 餘興
+余切 餘切
+余庆 餘慶
+余裕 餘裕
+余杭 餘杭
+余孽 餘孽
+余音 餘音
+余钱 餘錢
+余震 餘震
+结余 結餘
+多余 多餘
+有余 有餘
+残余 殘餘
+剩余 剩餘
+其余 其餘
+业余 業餘
+盈余 盈餘
+余 余
+什么 甚麼
+么 麼
+复数 複數
+复分数 複分數
+复杂 複雜
+复制 複製
+复 復
+了然 瞭然
+了解 瞭解
+了望 瞭望
+明了 明瞭
+了 了
+解铃系铃 解鈴繫鈴
+系词 繫詞
+系辞 繫辭
+系念 繫念
+关系 關係
+联系 聯繫
+系于 繫於
+维系 維繫
+连系 連繫
+系 系
+糊里糊涂 糊裡糊塗
+稀里糊涂 稀裡糊塗
+蒙在鼓里 蒙在鼓裡
+怪里怪气 怪裡怪氣
+傻里傻气 傻裡傻氣
+俗里俗气 俗裡俗氣
+嗲里嗲气 嗲裡嗲氣
+女里女气 女裡女氣
+妖里妖气 妖裡妖氣
+娇里娇气 嬌裡嬌氣
+洋里洋气 洋裡洋氣
+宝里宝气 寶裡寶氣
+土里土气 土裡土氣
+稀里哗啦 稀裡嘩啦
+由表及里 由表及裡
+百里挑一 百裡挑一
+白里透红 白裡透紅
+私下里 私下裡
+骨子里 骨子裡
+窝里斗 窩裡鬥
+忙里 忙裡
+闻里 聞裡
+死里 死裡
+海里 海裡
+浪里 浪裡
+山里 山裡
+地里 地裡
+花里 花裡
+田里 田裡
+沟里 溝裡
+河里 河裡
+江里 江裡
+沙里 沙裡
+雪里 雪裡
+风里 風裡
+雨里 雨裡
+雾里 霧裡
+夜里 夜裡
+省里 省裡
+镇里 鎮裡
+城里 城裡
+市里 市裡
+村里 村裡
+站里 站裡
+段里 段裡
+厂里 廠裡
+店里 店裡
+馆里 館裡
+部里 部裡
+队里 隊裡
+班里 班裡
+关里 關裡
+门里 門裡
+家里 家裡
+屋里 屋裡
+房里 房裡
+连里 連裡
+院里 院裡
+行里 行裡
+园里 園裡
+苑里 苑裡
+明里 明裡
+暗里 暗裡
+字里 字裡
+表里 表裡
+內里 內裡
+手里 手裡
+眼里 眼裡
+嘴里 嘴裡
+口里 口裡
+心里 心裡
+头里 頭裡
+怀里 懷裡
+肚里 肚裡
+这里 這裡
+那里 那裡
+哪里 哪裡
+入里 入裡
+里里外外 裡裡外外
+里应外合 裡應外合
+里通外国 裡通外國
+里衬 裡襯
+里子 裡子
+里海 裡海
+里手 裡手
+里快 裡快
+里面 裡面
+里层 裡層
+里间 裡間
+里屋 裡屋
+里头 裡頭
+里边 裡邊
+里外 裡外
+干什么 幹甚麼
+干部 幹部
+干才 幹才
+干道 幹道
+干劲 幹勁
+干练 幹練
+干吗 幹嗎
+干事 幹事
+干线 幹線
+包干 包幹
+才干 才幹
+高干 高幹
+骨干 骨幹
+苦干 苦幹
+脑干 腦幹
+能干 能幹
+强干 強幹
+实干 實幹
+树干 樹幹
+调干 單幹
+枝干 枝幹
+主干 主幹
+干贝 干貝
+干犯 干犯
+干戈 干戈
+干涉 干涉
+干支 干支
+干连 干連
+干扰 干擾
+干预 干預
+干系 干系
+若干 若干
+天干 天干
+无干 無干
+相干 相干
+干面 乾麵
+乾坤 乾坤
+乾隆 乾隆
+乾元 乾元
+乾卦 乾卦
+乾嘉 乾嘉
+乾陵 乾陵
+乾县 乾縣
+乾清宫 乾清宮
+干 乾
+划时代 劃時代
+划分 劃分
+划开 劃開
+划一 劃一
+划界 劃界
+划策 劃策
+划归 劃歸
+划清 劃清
+规划 規劃
+策划 策劃
+筹划 籌劃
+计划 計劃
+比划 比劃
+谋划 謀劃
+区划 區劃
+划 划
+画 畫
+斗量 斗量
+斗胆 斗胆
+斗室 斗室
+斗城 斗城
+斗拱 斗拱
+斗子 斗子
+阿斗 阿斗
+北斗 北斗
+漏斗 漏斗
+熨斗 熨斗
+南斗 南斗
+墨斗 墨斗
+市斗 市斗
+泰斗 泰斗
+星斗 星斗
+斗 鬥
+周末 週末
+周刊 週刊
+周期 週期
+周岁 週歲
+周年 週年
+周游 周遊
+周转 週轉
+本周 本週
+周 周
+松球 松毬
+球花 毬花
+球果 毬果
+松动 鬆動
+松紧 鬆緊
+松散 鬆散
+松手 鬆手
+松软 鬆軟
+松绑 鬆綁
+松弛 鬆弛
+疏松 酥鬆
+放松 放鬆
+轻松 輕鬆
+肉松 肉鬆
+松 松
+果 果
+老板 老闆
+板 板
+面条 麵條
+面粉 麵粉
+面包 麵包
+面筋 麵筋
+面食 麵食
+炸酱面 炸醬麵
+担担面 擔擔麵
+刀削面 刀削麵
+空心面 空心麵
+阳春面 陽春麵
+甜面酱 甜麵醬
+炒面 炒麵
+擀面 擀麵
+拉面 拉麵
+凉面 涼麵
+汤面 湯麵
+寿面 壽麵
+碱面 鹼麵
+发面 發麵
+白面 白麵
+面 面
+防御 防禦
+御敌 禦敵
+御寒 禦寒
+御 御
+腼腆 靦腆
+宫商角徵羽 宮商角徵羽
+征伐 征伐
+征服 征服
+征途 征途
+征讨 征討
+长征 長征
+出征 出征
+亲征 親征
+征 徵
+台风 颱風
+写字台 寫字檯
+台 台
+胡同 衚衕
+胡子 鬍子
+胡须 鬍鬚
+胡 胡
+须根 鬚根
+须鲸 鬚鯨
+须眉 鬚眉
+龙须 龍鬚
+触须 觸鬚
+须 須
+形单影只 形單影隻
+只身 隻身
+只眼 隻眼
+船只 船隻
+舰只 艦隻
+祇 祇
+只 只
+并发 併發
+并拢 併攏
+并入 併入
+并吞 併吞
+并力 併力
+合并 合併
+吞并 吞併
+并 並
+当 當
+药 藥
+布道 佈道
+布景 佈景
+布局 佈局
+布雷 佈雷
+布施 佈施
+布置 佈置
+布谷 布穀
+发布 發佈
+公布 公佈
+宣布 宣佈
+布 布
+开天辟地 開天闢地
+开辟 開闢
+辟邪 闢邪
+辟 辟
+人言藉藉 人言藉藉
+借口 藉口
+借故 藉故
+借使 藉使
+凭藉 憑藉
+骀藉 駘藉
+慰借 慰藉
+狼藉 狼藉
+蕴藉 藴藉
+枕藉 枕藉
+借 借
+尽管 儘管
+尽 盡
+叶韵 叶韻
+叶 葉
+伙计 夥計
+伙伴 夥伴
+伙 伙
+家具 傢具
+家伙 傢伙
+家 家
+奸夫 姦夫
+奸妇 姦婦
+奸情 姦情
+奸污 姦污
+奸淫 姦淫
+鸡奸 雞姦
+轮奸 輪姦
+强奸 強姦
+通奸 通姦
+诱奸 誘姦
+奸 奸
+历书 曆書
+历法 曆法
+公历 公曆
+旧历 舊曆
+黄历 黃曆
+日历 日曆
+西历 西曆
+夏历 夏曆
+新历 新曆
+阳历 陽曆
+阴历 陰曆
+月历 月曆
+游历 遊歷
+万年历 萬年曆
+万历 萬曆
+历 歷
+万俟 万俟
+气冲冲 氣沖沖
+气焰 氣燄
+气 氣
+细致 細緻
+精致 精緻
+标致 標緻
+别致 別緻
+致 致
+制版 製版
+制成 製成
+制品 製品
+制片 製片
+制造 製造
+制图 製圖
+制作 製作
+缝制 縫製
+巨制 巨製
+炼制 煉製
+酿制 釀製
+炮制 炮製
+特制 特製
+预制 預製
+制 制
+谷贱伤农 穀賤傷農
+谷神星 穀神星
+鬼谷子 鬼谷子
+谷子 穀子
+打谷 打穀
+谷场 穀場
+谷物 穀物
+谷粒 穀粒
+谷类 穀類
+谷草 穀草
+谷仓 穀倉
+谷苗 穀苗
+谷种 穀種
+谷穗 穀穗
+谷壳 穀殻
+包谷 包穀
+稻谷 稻穀
+五谷 五穀
+米谷 米穀
+秕谷 秕穀
+晒谷 曬穀
+谷 谷
+后妃 后妃
+后稷 后稷
+后土 后土
+后羿 后羿
+皇后 皇后
+母后 母后
+王后 王后
+太后 太后
+后 後
+地方志 地方誌
+标志 標誌
+墓志 墓誌
+日志 日誌
+碑志 碑誌
+县志 縣誌
+杂志 雜誌
+志 志
+别扭 彆扭
+别 別
+汇报 彙報
+词汇 詞彙
+字汇 字彙
+汇 匯
+辞 辭
+词 詞
+机 機
+发廊 髮廊
+发妻 髮妻
+发型 髮型
+发困 發睏
+卷土重来 捲土重來
+卷心菜 捲心菜
+卷铺盖 捲鋪蓋
+卷尺 捲尺
+卷入 捲入
+卷动 捲動
+卷成 捲成
+卷曲 捲曲
+卷款 捲款
+卷帘 捲簾
+卷纸 捲紙
+卷缩 捲縮
+卷舌 捲舌
+卷袖 捲袖
+卷走 捲走
+卷起 捲起
+卷门 捲門
+卷云 捲雲
+卷须 捲鬚
+春卷 春捲
+烟卷 煙捲
+纸卷 紙捲
+卷轴 捲軸
+席卷 席捲
+舒卷 舒捲
+风卷残云 風捲殘雲
+风驰电卷 風馳電捲
+龙卷风 龍捲風
+蛋卷 蛋捲
+朱卷 硃卷
+怒发冲冠 怒髮衝冠
+长发 長髮
+短发 短髮
+白发 白髮
+黑发 黑髮
+金发 金髮
+红发 紅髮
+銀发 銀髮
+染发 染髮
+编发 編髮
+毫发 毫髮
+护发 護髮
+假发 假髮
+结发 結髮
+卷发 捲髮
+理发 理髮
+落发 落髮
+毛发 毛髮
+美发 美髮
+散发 散髮
+烫发 燙髮
+势头 勢頭
+头发 頭髮
+秀发 秀髮
+剃髮 剃发
+一发 一髮
+发 發
+人云亦云 人云亦云
+不知所云 不知所云
+云游 雲遊
+云 雲
+子丑寅卯 子丑寅卯
+生旦淨末丑 生旦净末丑
+丑时 丑時
+丑旦 丑旦
+丑角 丑角
+小丑 小丑
+丑 醜
+萝卜 蘿蔔
+卜 卜
+冲茶 沖茶
+冲淡 沖淡
+冲服 沖服
+冲积 沖積
+冲凉 沖涼
+冲天 沖天
+冲绳 沖繩
+冲洗 沖洗
+冲毁 沖毀
+喜冲冲 喜沖沖
+冲 衝
+出游 出遊
+出 出
+线 線
+核实 覈實
+核算 覈算
+核 核
+回路 迴路
+回廊 迴廊
+回游 回遊
+萦回 縈迴
+迂回 迂迴
+回 回
+冬冬 鼕鼕
+冬 冬
+咸菜 鹹菜
+咸 咸
+清心寡欲 清心寡慾
+克欲修行 克慾修行
+欲不可纵 慾不可縱
+人之大欲 人之大慾
+求知欲 求知慾
+欲火 慾火
+欲望 慾望
+禁欲 禁慾
+利欲 利慾
+情欲 情慾
+肉欲 肉慾
+色欲 色慾
+食欲 食慾
+私欲 私慾
+兽欲 獸慾
+纵欲 縱慾
+性欲 性慾
+六欲 六慾
+嗜欲 嗜慾
+欲 欲
+准绳 準繩
+准时 準時
+准头 準頭
+准备 準備
+准确 準確
+为准 為準
+标准 標準
+标签 標籤
+水准 水準
+基准 基準
+对准 對準
+准 准
+标 標
+注册 註冊
+注销 註銷
+注解 註解
+注疏 註疏
+评注 評註
+附注 附註
+加注 加註
+注 注
+凶暴 兇暴
+凶器 兇器
+凶手 兇手
+元凶 元兇
+正凶 正兇
+逞凶 逞兇
+凶 凶
+扬 揚
+飏 颺
+宴 宴
+䜩 讌
+咬 咬
+豆 豆
+韭 韭
+笺 箋
+团 團
+卤鸡 滷雞
+卤味 滷味
+卤菜 滷菜
+茶卤 茶滷
+盐卤 鹽滷
+卤 鹵
+呆 呆
+泛 泛
+妫 媯
+众 眾
+钩 鈎
+绱 緔
+锐 銳
+赝 贋
+赃 贓
+粗 粗
+关 關
+饥 飢
+款 款
+胧 朧
+蒙 蒙
+骂 罵
+脏 髒
+鳄 鰐
+凫 鳧
+鸡 雞
+赍 賫
+筘 筘
+吣 唚
+群 群
+叹 嘆
+剃 剃
+颓 頹
+颜 顏
+炮 炮
+启 啓
+茶几 茶几
+几 幾
+德 德
+悫 愨
+克 克
+坛坛罐罐 罈罈罐罐
+瓶瓶坛坛 瓶瓶罈罈
+醋坛 醋罈
+坛子 罈子
+酒坛 酒罈
+坛 壇
+升华 昇華
+毕升 畢昇
+高升 高昇
+歌舞升平 歌舞昇平
+升 升
+伪 偽
+获 獲
+绦 縧
+绣 繡
+钵 鉢
+蜡 蠟
+采薪之忧 采薪之憂
+兴高采烈 興高采烈
+无精打采 無精打采
+采风 采風
+风采 風采
+精采 精采
+神采 神采
+多采 多采
+喝采 喝采
+采缉 采緝
+彩 彩
+采 採
+厕 廁
+捣 搗
+沩 溈
+为 為
+产 產
+瘘 瘻
+灶 灶
+绝 絕
+绿 綠
+绷 繃
+凼 凼
+床 床
+墙 牆
+奖 獎
+眦 眥
+秆 稈
+耻 恥
+苧 薴
+苹 蘋
+蕴 蘊
+说 說
+谣 謠
+谫 謭
+竖 竪
+酝 醖
+录 錄
+锈 鏽
+镢 鐝
+阅 閱
+妆 妝
+闲静 閑靜
+闲居 閑居
+闲 閒
+游山玩水 遊山玩水
+游伴 遊伴
+游程 遊程
+游春 遊春
+游方 遊方
+游记 遊記
+游街 遊街
+游客 遊客
+游乐 遊樂
+游廊 遊廊
+游牧 遊牧
+游人 遊人
+游子 遊子
+游侠 遊俠
+游民 遊民
+游荡 遊蕩
+游说 遊說
+游仙 遊仙
+游憩 遊憩
+游闲 遊閑
+游戏 遊戲
+游手 遊手
+游魂 遊魂
+游猎 遊獵
+游玩 遊玩
+游园 遊園
+游遍 遊遍
+游兴 遊興
+游舫 遊舫
+游艇 遊艇
+游艺 遊藝
+游行 遊行
+游览 遊覽
+游逛 遊逛
+游医 遊醫
+游学 遊學
+畅游 暢遊
+串游 串遊
+春游 春遊
+导游 導遊
+交游 交遊
+郊游 郊遊
+倦游 倦遊
+冶游 冶遊
+漫游 漫遊
+梦游 夢遊
+嬉游 嬉遊
+巡游 巡遊
+环游 環遊
+旅游 旅遊
+浪游 浪遊
+神游 神遊
+秋游 秋遊
+仙游 仙遊
+遨游 遨遊
+野游 野遊
+夜游 夜遊
+游 游
+表蒙子 錶蒙子
+表带 錶帶
+表链 錶鏈
+表盘 錶盤
+表针 錶針
+电子表 電子錶
+电度表 電鍍錶
+防水表 防水錶
+马蹄表 馬蹄錶
+夜光表 夜光錶
+挂表 掛錶
+怀表 懷錶
+秒表 秒錶
+马表 馬錶
+钟表 鐘錶
+跑表 跑錶
+手表 手錶
+停表 停錶
+表 表
+症结 癥結
+症 症
+痴 痴
+白洋淀 白洋淀
+荷花淀 荷花淀
+水淀 水淀
+海淀 海淀
+东淀 東淀
+淀 澱
+向导 嚮導
+向往 嚮往
+向 向
+扎营 紮營
+驻扎 駐紮
+扎 扎
+占卜 占卜
+占卦 占卦
+占梦 占夢
+占星 占星
+占 佔
+托名 託名
+托收 託收
+信托 信託
+委托 委託
+拜托 拜託
+付托 付託
+寄托 寄託
+请托 請託
+受托 受託
+依托 依託
+嘱托 囑託
+转托 轉託
+托 托
+涌 湧
+累 累
+困惫 睏憊
+困乏 睏乏
+困 困
+左邻右舍 左鄰右舍
+舍利 舍利
+舍弟 舍弟
+宿舍 宿舍
+屋舍 屋舍
+田舍 田舍
+校舍 校舍
+民舍 民舍
+茅舍 茅舍
+老舍 老舍
+房舍 房舍
+农舍 農舍
+猪舍 豬舍
+舍 捨
+杠 槓
+雇员 僱員
+雇 雇
+刮倒 颳倒
+刮 刮
+狸 狸
+跌交 跌跤
+交 交
+侄媳妇 姪媳婦
+侄女 姪女
+侄孙 姪孫
+侄 侄
+勋 勳
+秋千 鞦韆
+荡秋千 盪鞦韆
+荡 蕩
+秋 秋
+不寒而栗 不寒而慄
+颤栗 顫慄
+战栗 戰慄
+栗 栗
+细嚼慢咽 細嚼慢嚥
+狼吞虎咽 狼吞虎嚥
+咽气 嚥氣
+下咽 下嚥
+咽 咽
+吊民伐罪 弔民伐罪
+形影相吊 形影相弔
+提心吊胆 提心弔膽
+吊丧 弔喪
+吊慰 弔慰
+吊唁 弔唁
+吊 吊
+英寸 英吋
+方腊 方腊
+腊 臘
+乡愿 鄉愿
+愿 願
+古迹 古蹟
+史迹 史蹟
+迹 跡
+净 淨
+侥幸 僥倖
+侥 僥
+幸 幸
+蚝 蠔
+柜柳 柜柳
+柜 櫃
+拉纤 拉縴
+纤夫 縴夫
+纤路 縴路
+纤绳 縴繩
+纤 纖
+厚朴 厚朴
+朴刀 朴刀
+朴硝 朴硝
+朴 樸
+钟灵毓秀 鍾靈毓秀
+一见钟情 一見鍾情
+千钟粟 千鍾粟
+龙钟 龍鍾
+独钟 獨鍾
+汉钟离 漢鍾離
+所钟 所鍾
+钟离 鍾離
+钟爱 鍾愛
+钟馗 鍾馗
+钟山 鍾山
+钟 鐘
+沾恩 霑恩
+沾霈 霑霈
+沾濡 霑濡
+沾渥 霑渥
+沾衣 霑衣
+沾醉 霑醉
+著名 著名
+著称 著稱
+著述 著述
+著作 著作
+著书 著書
+著绩 著績
+著录 著錄
+著文 著文
+著有 著有
+著者 著者
+见微知著 見微知著
+信义素著 信義素著
+显著 顯著
+论著 論著
+编著 編著
+炳著 炳著
+昭著 昭著
+大著 大著
+合著 合著
+巨著 巨著
+钜著 鉅著
+较著 較著
+旧著 舊著
+毛著 毛著
+名著 名著
+暴著 暴著
+卓著 卓著
+土著 土著
+新著 新著
+玄著 玄著
+遗著 遺著
+译著 譯著
+原著 原著
+专著 專著
+撰著 撰著
+拙著 拙著
+着 著
+扭转乾坤 扭轉乾坤
+旋乾转坤 旋乾轉坤
+朗朗乾坤 朗朗乾坤
+搜罗 蒐羅
+搜集 蒐集
+搜录 蒐錄
+搜 搜
+抽签 抽籤
+签诗 籤詩
+签条 籤條
+签筒 籤筒
+签文 籤文
+签语 籤語
+求签 求籤
+竹签 竹籤
+芸签缥带 芸籤縹帶
+芸 芸
+万签插架 萬籤插架
+万 萬
+解签 解籤
+签谱 籤譜
+中签 中籤
+签 簽
+炼石补天 鍊石補天
+炼而愈精 鍊而愈精
+久炼成钢 久鍊成鋼
+千锤百炼 千錘百鍊
+百炼 百鍊
+炼铝 鍊鋁
+炼铜 鍊銅
+炼句 鍊句
+淬炼 焠鍊
+锻炼 鍛鍊
+锤炼 錘鍊
+锤 錘
+金链 金鍊
+链子 鍊子
+拉链 拉鍊
+手链 手鍊
+铁链 鐵鍊
+项链 項鍊
+炼 煉
+练 練
+链 鏈
+荧光 螢光
+荧幕 螢幕
+荧屏 螢屏
+荧 熒
+萤 螢
+霉 霉
+艳 艷
+证 證
+尝 嘗
+吃 吃
+铺 鋪
+唇 唇
+壳 殼
+遁 遁
+姐 姐
+污 污
+个 個
+哗 嘩
+焊 焊
+馈 饋
+梁 梁
+具 具
+私 私
+鉴 鑒
+湿 濕
+局 局
+钜 鉅
+啰 囉
+袅 裊
+叠 疊
+钳 鉗
+镕 鎔
+沙金 砂金
+沙土 砂土
+相片 像片
+毁 毀
+虱目鱼 虱目魚
+虱 蝨
+赞 贊
+绔 絝
+绉褶 縐摺
+皱褶 皺摺
+折纸 摺紙
+绉 縐
+五岳 五嶽
+东岳 東嶽
+南岳 南嶽
+西岳 西嶽
+北岳 北嶽
+山岳 山嶽
+中岳 中嶽
+岱岳 岱嶽
+奇岩 奇巖
+峭壁巉岩 峭壁巉巖
+岩穴 巖穴
+凿岩成室 鑿巖成室
+凿通岩洞 鑿通巖洞
+掸 撣
+久病不愈 久病不癒
+不药而愈 不藥而癒
+固疾痊愈 固疾痊癒
+疗愈 療癒
+愈复 癒復
+病愈 病癒
+痊愈 痊癒
+治愈 治癒
+棂 櫺
+呼吁 呼籲
+吁求 籲求
+吁请 籲請
+吁防 籲防
+叮当 叮噹
+叮叮当当 叮叮噹噹
+疏浚 疏濬
+铲 鏟
+炭烟 碳煙
+烟熏 煙薰
+烟 煙
+羡 羨
+徭役 繇役
+弥漫 瀰漫
+弥 彌
+谘 諮
+缰 繮
+昵 暱
+瓮 甕
+名噪一时 名譟一時
+鼓噪 鼓譟
+礴 礡
+膻 羶
+蝎 蠍
+棱 稜
+喂 餵
+腌 醃
+排泄 排泄
+泄 洩
+昌言 倡言
+依傍 依徬
+彷 徬
+仿如 彷如
+仿佛 彷彿
+仿 仿
+抚恤 撫卹
+恤养 卹養
+啮 嚙
+敛 斂
+埙 塤
+构 構
+鳌 鰲
+珐琅 琺瑯
+谥 謚
+蓝 藍
+缕 縷
+褴 襤
+褛 褸
+挂碍 罣礙
+挂 掛
+积肴于案 積餚於案
+佳肴 佳餚
+酒肴 酒餚
+肴馔 餚饌
+菜肴 菜餚
+枪 槍
+鳖 鱉
+睾 睪
+镌 鐫
+于 於
+亘 亙
+铝 鋁
+极 極
+锨 鍁
+咏 詠
+琼 瓊
+莼 蒓
+鲞 鮝
+鹚 鷀
+种 種
+妒 妒
+和 和
+傥 儻
+倘 倘
+硷 礆
+鲇 鮎
+㟆 㠏
+㨫 㩜
+䌶 䊷
+䌺 䋙
+䌾 䋻
+䞍 䝼
+䯅 䯀
+䲝 䱽
+鲃 䰾
+鳚 䲁
+丢 丟
+乱 亂
+亚 亞
+卧 臥
+伫 佇
+来 來
+侣 侶
+俣 俁
+伣 俔
+侠 俠
+伥 倀
+俩 倆
+俫 倈
+仓 倉
+们 們
+伦 倫
+伟 偉
+侧 側
+侦 偵
+杰 傑
+伧 傖
+伞 傘
+备 備
+佣 傭
+偬 傯
+传 傳
+伛 傴
+债 債
+伤 傷
+倾 傾
+偻 僂
+仅 僅
+佥 僉
+侨 僑
+仆 僕
+偾 僨
+价 價
+仪 儀
+侬 儂
+亿 億
+侩 儈
+俭 儉
+傧 儐
+俦 儔
+侪 儕
+偿 償
+优 優
+储 儲
+俪 儷
+㑩 儸
+傩 儺
+俨 儼
+丰 豐
+兑 兌
+儿 兒
+兖 兗
+内 內
+两 兩
+册 冊
+幂 冪
+冻 凍
+凛 凜
+凯 凱
+删 刪
+刭 剄
+则 則
+刹 剎
+刬 剗
+刚 剛
+剥 剝
+剐 剮
+剀 剴
+创 創
+剧 劇
+刘 劉
+刽 劊
+刿 劌
+剑 劍
+㓥 劏
+剂 劑
+㔉 劚
+劲 勁
+动 動
+务 務
+胜 勝
+劳 勞
+势 勢
+勚 勩
+劢 勱
+励 勵
+劝 勸
+匀 勻
+匦 匭
+匮 匱
+区 區
+协 協
+却 卻
+厍 厙
+厌 厭
+厉 厲
+厣 厴
+参 參
+叁 叄
+丛 叢
+咤 吒
+吴 吳
+呐 吶
+吕 呂
+呙 咼
+员 員
+呗 唄
+问 問
+哑 啞
+唡 啢
+㖞 喎
+唤 喚
+丧 喪
+乔 喬
+单 單
+哟 喲
+呛 嗆
+啬 嗇
+唝 嗊
+吗 嗎
+呜 嗚
+唢 嗩
+哔 嗶
+喽 嘍
+呕 嘔
+啧 嘖
+唛 嘜
+唠 嘮
+啸 嘯
+叽 嘰
+哓 嘵
+呒 嘸
+啴 嘽
+嘘 噓
+㖊 噚
+咝 噝
+哒 噠
+哝 噥
+哕 噦
+嗳 噯
+哙 噲
+喷 噴
+吨 噸
+咛 嚀
+吓 嚇
+哜 嚌
+噜 嚕
+呖 嚦
+咙 嚨
+亸 嚲
+喾 嚳
+严 嚴
+嘤 嚶
+啭 囀
+嗫 囁
+嚣 囂
+冁 囅
+呓 囈
+嘱 囑
+囱 囪
+囵 圇
+国 國
+围 圍
+园 園
+圆 圓
+图 圖
+埯 垵
+垭 埡
+执 執
+坚 堅
+垩 堊
+垴 堖
+埚 堝
+尧 堯
+报 報
+场 場
+块 塊
+茔 塋
+垲 塏
+埘 塒
+涂 塗
+坞 塢
+尘 塵
+堑 塹
+垫 墊
+坠 墜
+堕 墮
+坟 墳
+垦 墾
+垱 壋
+压 壓
+垒 壘
+圹 壙
+垆 壚
+坏 壞
+垄 壟
+垅 壠
+坜 壢
+坝 壩
+壮 壯
+壶 壺
+壸 壼
+寿 壽
+够 夠
+梦 夢
+夹 夾
+奂 奐
+奥 奧
+奁 奩
+夺 奪
+奋 奮
+姹 奼
+姗 姍
+娱 娛
+娄 婁
+妇 婦
+娅 婭
+娲 媧
+媪 媼
+妈 媽
+妪 嫗
+妩 嫵
+娴 嫻
+婳 嫿
+娆 嬈
+婵 嬋
+娇 嬌
+嫱 嬙
+嫒 嬡
+嬷 嬤
+嫔 嬪
+婴 嬰
+婶 嬸
+娈 孌
+孙 孫
+学 學
+孪 孿
+宫 宮
+寝 寢
+实 實
+宁 寧
+审 審
+写 寫
+宽 寬
+宠 寵
+宝 寶
+将 將
+专 專
+寻 尋
+对 對
+导 導
+尴 尷
+届 屆
+尸 屍
+屃 屓
+屉 屜
+屡 屢
+层 層
+屦 屨
+属 屬
+冈 岡
+岘 峴
+岛 島
+峡 峽
+崃 崍
+岗 崗
+峥 崢
+岽 崬
+岚 嵐
+嵝 嶁
+崭 嶄
+岖 嶇
+嵚 嶔
+崂 嶗
+峤 嶠
+峣 嶢
+峄 嶧
+崄 嶮
+岙 嶴
+嵘 嶸
+岭 嶺
+屿 嶼
+岿 巋
+峦 巒
+巅 巔
+巯 巰
+帅 帥
+师 師
+帐 帳
+带 帶
+帧 幀
+帏 幃
+帼 幗
+帻 幘
+帜 幟
+币 幣
+帮 幫
+帱 幬
+库 庫
+厢 廂
+厩 廄
+厦 廈
+厨 廚
+厮 廝
+庙 廟
+厂 廠
+庑 廡
+废 廢
+广 廣
+廪 廩
+庐 廬
+厅 廳
+弑 弒
+弪 弳
+张 張
+强 強
+弹 彈
+弯 彎
+彦 彥
+径 徑
+从 從
+徕 徠
+彻 徹
+恒 恆
+悦 悅
+悮 悞
+怅 悵
+闷 悶
+恶 惡
+恼 惱
+恽 惲
+恻 惻
+爱 愛
+惬 愜
+怆 愴
+恺 愷
+忾 愾
+态 態
+愠 慍
+惨 慘
+惭 慚
+恸 慟
+惯 慣
+怄 慪
+怂 慫
+虑 慮
+悭 慳
+庆 慶
+惫 憊
+怜 憐
+凭 憑
+愦 憒
+惮 憚
+愤 憤
+悯 憫
+怃 憮
+宪 憲
+忆 憶
+恳 懇
+应 應
+怿 懌
+懔 懍
+怼 懟
+懑 懣
+恹 懨
+惩 懲
+懒 懶
+怀 懷
+悬 懸
+忏 懺
+惧 懼
+慑 懾
+恋 戀
+戆 戇
+戋 戔
+戗 戧
+戬 戩
+战 戰
+戯 戱
+戏 戲
+户 戶
+抛 拋
+捝 挩
+挟 挾
+扪 捫
+扫 掃
+抡 掄
+挜 掗
+挣 掙
+拣 揀
+换 換
+挥 揮
+损 損
+摇 搖
+揾 搵
+抢 搶
+掴 摑
+掼 摜
+搂 摟
+挚 摯
+抠 摳
+抟 摶
+掺 摻
+捞 撈
+挦 撏
+撑 撐
+挠 撓
+㧑 撝
+挢 撟
+拨 撥
+抚 撫
+扑 撲
+揿 撳
+挞 撻
+挝 撾
+捡 撿
+拥 擁
+掳 擄
+择 擇
+击 擊
+挡 擋
+㧟 擓
+担 擔
+据 據
+挤 擠
+拟 擬
+摈 擯
+拧 擰
+搁 擱
+掷 擲
+扩 擴
+撷 擷
+摆 擺
+擞 擻
+撸 擼
+扰 擾
+摅 攄
+撵 攆
+拢 攏
+拦 攔
+撄 攖
+搀 攙
+撺 攛
+携 攜
+摄 攝
+攒 攢
+挛 攣
+摊 攤
+搅 攪
+揽 攬
+败 敗
+叙 敘
+敌 敵
+数 數
+毙 斃
+斓 斕
+斩 斬
+断 斷
+时 時
+晋 晉
+昼 晝
+晕 暈
+晖 暉
+旸 暘
+畅 暢
+暂 暫
+晔 曄
+昙 曇
+晓 曉
+暧 曖
+旷 曠
+昽 曨
+晒 曬
+书 書
+会 會
+东 東
+栅 柵
+杆 桿
+栀 梔
+枧 梘
+条 條
+枭 梟
+棁 梲
+弃 棄
+枨 棖
+枣 棗
+栋 棟
+栈 棧
+栖 棲
+梾 棶
+桠 椏
+杨 楊
+枫 楓
+桢 楨
+业 業
+杩 榪
+荣 榮
+榅 榲
+桤 榿
+梿 槤
+椠 槧
+椁 槨
+桨 槳
+桩 樁
+乐 樂
+枞 樅
+楼 樓
+枢 樞
+样 樣
+树 樹
+桦 樺
+桡 橈
+桥 橋
+椭 橢
+横 橫
+檩 檁
+柽 檉
+档 檔
+桧 檜
+槚 檟
+检 檢
+樯 檣
+梼 檮
+槟 檳
+柠 檸
+槛 檻
+橹 櫓
+榈 櫚
+栉 櫛
+椟 櫝
+橼 櫞
+栎 櫟
+橱 櫥
+槠 櫧
+栌 櫨
+枥 櫪
+橥 櫫
+榇 櫬
+蘖 櫱
+栊 櫳
+榉 櫸
+樱 櫻
+栏 欄
+权 權
+椤 欏
+栾 欒
+榄 欖
+钦 欽
+欧 歐
+欤 歟
+欢 歡
+岁 歲
+归 歸
+殁 歿
+残 殘
+殒 殞
+殇 殤
+㱮 殨
+殚 殫
+殓 殮
+殡 殯
+㱩 殰
+歼 殲
+杀 殺
+殴 毆
+毵 毿
+牦 氂
+毡 氈
+氇 氌
+氢 氫
+氩 氬
+氲 氳
+郁金香 鬱金香
+郁闷 鬱悶
+郁郁 鬱鬱
+阴郁 陰鬱
+沉郁 沈鬱
+苍郁 蒼鬱
+忧郁 憂鬱
+悒郁 悒鬱
+抑郁 抑鬱
+积郁 積鬱
+忧 憂
+沈大铁路 瀋大鐵路
+沈大高速 瀋大高速
+沈大线 瀋大線
+沈吉铁路 瀋吉鐵路
+沈吉高速 瀋吉高速
+沈吉线 瀋吉線
+沈山高速 瀋山高速
+沈山铁路 瀋山鐵路
+沈山线 瀋山線
+沈阳 瀋陽
+沈哈 瀋哈
+京沈 京瀋
+辽沈 遼瀋
+墨渖未干 墨瀋未乾
+石沉大海 石沈大海
+鱼沉雁杳 魚沈雁杳
+破釜沉舟 破釜沈舟
+沉沉浮浮 沈沈浮浮
+沉浮 沈浮
+沉默 沈默
+沉重 沈重
+沉思 沈思
+沉淀 沈澱
+沉稳 沈穩
+沉浸 沈浸
+沉闷 沈悶
+沉静 沈靜
+沉醉 沈醉
+沉迷 沈迷
+沉寂 沈寂
+沉入 沈入
+沉沉 沈沈
+沉落 沈落
+沉睡 沈睡
+沉潜 沈潛
+沉沦 沈淪
+沉吟 沈吟
+沉积 沈積
+沉着 沈著
+沉没 沈沒
+低沉 低沈
+消沉 消沈
+深沉 深沈
+浮沉 浮沈
+决 決
+没 沒
+况 況
+汹 洶
+浃 浹
+泾 涇
+凄 淒
+凉 涼
+泪 淚
+渌 淥
+沦 淪
+渊 淵
+涞 淶
+浅 淺
+涣 渙
+减 減
+涡 渦
+测 測
+浑 渾
+凑 湊
+浈 湞
+汤 湯
+沟 溝
+温 溫
+沧 滄
+灭 滅
+涤 滌
+荥 滎
+沪 滬
+滞 滯
+渗 滲
+浒 滸
+浐 滻
+滚 滾
+满 滿
+渔 漁
+沤 漚
+汉 漢
+涟 漣
+渍 漬
+涨 漲
+溆 漵
+渐 漸
+浆 漿
+颍 潁
+泼 潑
+洁 潔
+潜 潛
+润 潤
+浔 潯
+溃 潰
+滗 潷
+涠 潿
+涩 澀
+浇 澆
+涝 澇
+涧 澗
+渑 澠
+泽 澤
+滪 澦
+泶 澩
+浍 澮
+浊 濁
+浓 濃
+泞 濘
+济 濟
+涛 濤
+滥 濫
+潍 濰
+滨 濱
+溅 濺
+泺 濼
+滤 濾
+滢 瀅
+渎 瀆
+㲿 瀇
+泻 瀉
+浏 瀏
+濒 瀕
+泸 瀘
+沥 瀝
+潇 瀟
+潆 瀠
+潴 瀦
+泷 瀧
+濑 瀨
+潋 瀲
+澜 瀾
+沣 灃
+滠 灄
+洒 灑
+漓 灕
+滩 灘
+灏 灝
+漤 灠
+湾 灣
+滦 灤
+滟 灧
+灾 災
+乌 烏
+烃 烴
+无 無
+炜 煒
+茕 煢
+焕 煥
+烦 煩
+炀 煬
+㶽 煱
+煴 熅
+炝 熗
+热 熱
+颎 熲
+炽 熾
+烨 燁
+灯 燈
+炖 燉
+烧 燒
+烫 燙
+焖 燜
+营 營
+灿 燦
+烛 燭
+烩 燴
+㶶 燶
+烬 燼
+焘 燾
+烁 爍
+炉 爐
+烂 爛
+争 爭
+爷 爺
+尔 爾
+牍 牘
+牵 牽
+荦 犖
+犊 犢
+牺 犧
+状 狀
+狭 狹
+狈 狽
+狰 猙
+犹 猶
+狲 猻
+犸 獁
+狱 獄
+狮 獅
+独 獨
+狯 獪
+猃 獫
+狝 獮
+狞 獰
+㺍 獱
+猎 獵
+犷 獷
+兽 獸
+獭 獺
+献 獻
+猕 獼
+猡 玀
+现 現
+珐 琺
+珲 琿
+玮 瑋
+玚 瑒
+琐 瑣
+瑶 瑤
+莹 瑩
+玛 瑪
+玱 瑲
+琏 璉
+玑 璣
+瑷 璦
+珰 璫
+环 環
+玺 璽
+珑 瓏
+璎 瓔
+瓒 瓚
+瓯 甌
+亩 畝
+毕 畢
+异 異
+畴 疇
+痉 痙
+疴 痾
+痖 瘂
+疯 瘋
+疡 瘍
+痪 瘓
+瘗 瘞
+疮 瘡
+疟 瘧
+瘆 瘮
+疭 瘲
+疗 療
+痨 癆
+痫 癇
+瘅 癉
+疠 癘
+瘪 癟
+痒 癢
+疖 癤
+疬 癧
+癞 癩
+癣 癬
+瘿 癭
+瘾 癮
+痈 癰
+瘫 癱
+癫 癲
+皑 皚
+疱 皰
+皲 皸
+皱 皺
+盗 盜
+盏 盞
+监 監
+盘 盤
+卢 盧
+睁 睜
+睐 睞
+眍 瞘
+䁖 瞜
+瞒 瞞
+瞆 瞶
+睑 瞼
+眬 矓
+瞩 矚
+矫 矯
+硁 硜
+硖 硤
+砗 硨
+砚 硯
+硕 碩
+砀 碭
+砜 碸
+确 確
+码 碼
+硙 磑
+砖 磚
+碜 磣
+碛 磧
+矶 磯
+硗 磽
+础 礎
+碍 礙
+矿 礦
+砺 礪
+砾 礫
+矾 礬
+砻 礱
+禄 祿
+祸 禍
+祯 禎
+祎 禕
+祃 禡
+禅 禪
+礼 禮
+祢 禰
+祷 禱
+秃 禿
+籼 秈
+税 稅
+䅉 稏
+禀 稟
+称 稱
+稣 穌
+积 積
+颖 穎
+秾 穠
+穑 穡
+秽 穢
+稳 穩
+稆 穭
+窝 窩
+洼 窪
+穷 窮
+窑 窯
+窎 窵
+窭 窶
+窥 窺
+窜 竄
+窍 竅
+窦 竇
+窃 竊
+竞 競
+笔 筆
+笋 筍
+笕 筧
+䇲 筴
+筝 箏
+节 節
+范 範
+筑 築
+箧 篋
+筼 篔
+笃 篤
+筛 篩
+筚 篳
+箦 簀
+篓 簍
+箪 簞
+简 簡
+篑 簣
+箫 簫
+筜 簹
+帘 簾
+篮 籃
+筹 籌
+箓 籙
+箨 籜
+籁 籟
+笼 籠
+笾 籩
+簖 籪
+篱 籬
+箩 籮
+粤 粵
+糁 糝
+粪 糞
+粮 糧
+粝 糲
+籴 糴
+粜 糶
+纟 糹
+纠 糾
+纪 紀
+纣 紂
+约 約
+红 紅
+纡 紆
+纥 紇
+纨 紈
+纫 紉
+纹 紋
+纳 納
+纽 紐
+纾 紓
+纯 純
+纰 紕
+纼 紖
+纱 紗
+纮 紘
+纸 紙
+级 級
+纷 紛
+纭 紜
+纴 紝
+纺 紡
+䌷 紬
+细 細
+绂 紱
+绁 紲
+绅 紳
+纻 紵
+绍 紹
+绀 紺
+绋 紼
+绐 紿
+绌 絀
+终 終
+组 組
+䌹 絅
+绊 絆
+绗 絎
+结 結
+绞 絞
+络 絡
+绚 絢
+给 給
+绒 絨
+绖 絰
+统 統
+丝 絲
+绛 絳
+绢 絹
+绑 綁
+绡 綃
+绠 綆
+绨 綈
+绤 綌
+绥 綏
+䌼 綐
+经 經
+综 綜
+缍 綞
+绸 綢
+绻 綣
+绶 綬
+维 維
+绹 綯
+绾 綰
+纲 綱
+网 網
+缀 綴
+纶 綸
+绺 綹
+绮 綺
+绽 綻
+绰 綽
+绫 綾
+绵 綿
+绲 緄
+缁 緇
+紧 緊
+绯 緋
+绪 緒
+绬 緓
+缃 緗
+缄 緘
+缂 緙
+缉 緝
+缎 緞
+缔 締
+缗 緡
+缘 緣
+缌 緦
+编 編
+缓 緩
+缅 緬
+纬 緯
+缑 緱
+缈 緲
+缏 緶
+缇 緹
+萦 縈
+缙 縉
+缢 縊
+缒 縋
+缣 縑
+缊 縕
+缞 縗
+缚 縛
+缜 縝
+缟 縞
+缛 縟
+县 縣
+缝 縫
+缡 縭
+缩 縮
+纵 縱
+缧 縲
+䌸 縳
+缦 縵
+絷 縶
+缥 縹
+总 總
+绩 績
+缫 繅
+缪 繆
+缯 繒
+织 織
+缮 繕
+缭 繚
+绕 繞
+缋 繢
+绳 繩
+绘 繪
+茧 繭
+缳 繯
+缲 繰
+缴 繳
+䍁 繸
+绎 繹
+继 繼
+缤 繽
+缱 繾
+䍀 繿
+缬 纈
+纩 纊
+续 續
+缠 纏
+缨 纓
+缵 纘
+缆 纜
+罂 罌
+罚 罰
+罢 罷
+罗 羅
+罴 羆
+羁 羈
+芈 羋
+羟 羥
+义 義
+习 習
+翘 翹
+耧 耬
+耢 耮
+圣 聖
+闻 聞
+联 聯
+聪 聰
+声 聲
+耸 聳
+聩 聵
+聂 聶
+职 職
+聍 聹
+听 聽
+聋 聾
+肃 肅
+胁 脅
+脉 脈
+胫 脛
+脱 脫
+胀 脹
+肾 腎
+胨 腖
+脶 腡
+脑 腦
+肿 腫
+脚 腳
+肠 腸
+腽 膃
+肤 膚
+胶 膠
+腻 膩
+胆 膽
+脍 膾
+脓 膿
+脸 臉
+脐 臍
+膑 臏
+胪 臚
+脔 臠
+臜 臢
+临 臨
+与 與
+兴 興
+举 舉
+旧 舊
+舱 艙
+舣 艤
+舰 艦
+舻 艫
+艰 艱
+刍 芻
+苎 苧
+兹 茲
+荆 荊
+庄 莊
+茎 莖
+荚 莢
+苋 莧
+华 華
+苌 萇
+莱 萊
+莴 萵
+荭 葒
+荮 葤
+苇 葦
+荤 葷
+莳 蒔
+莅 蒞
+苍 蒼
+荪 蓀
+盖 蓋
+莲 蓮
+苁 蓯
+荜 蓽
+蒌 蔞
+蒋 蔣
+葱 蔥
+茑 蔦
+荫 蔭
+荨 蕁
+蒇 蕆
+荞 蕎
+荬 蕒
+莸 蕕
+荛 蕘
+蒉 蕢
+芜 蕪
+萧 蕭
+蓣 蕷
+蕰 薀
+荟 薈
+蓟 薊
+芗 薌
+蔷 薔
+荙 薘
+莶 薟
+荐 薦
+萨 薩
+䓕 薳
+荠 薺
+荩 藎
+艺 藝
+薮 藪
+苈 藶
+蔼 藹
+蔺 藺
+蕲 蘄
+芦 蘆
+苏 蘇
+藓 蘚
+蔹 蘞
+茏 蘢
+兰 蘭
+蓠 蘺
+萝 蘿
+蔂 虆
+处 處
+虚 虛
+虏 虜
+号 號
+亏 虧
+虬 虯
+蛱 蛺
+蜕 蛻
+蚬 蜆
+蚀 蝕
+猬 蝟
+虾 蝦
+蜗 蝸
+蛳 螄
+蚂 螞
+䗖 螮
+蝼 螻
+螀 螿
+蛰 蟄
+蝈 蟈
+螨 蟎
+虮 蟣
+蝉 蟬
+蛲 蟯
+虫 蟲
+蛏 蟶
+蚁 蟻
+蝇 蠅
+虿 蠆
+蛴 蠐
+蝾 蠑
+蛎 蠣
+蟏 蠨
+蛊 蠱
+蚕 蠶
+蛮 蠻
+术 術
+卫 衛
+衮 袞
+补 補
+装 裝
+裈 褌
+袆 褘
+裤 褲
+裢 褳
+亵 褻
+裥 襇
+袯 襏
+袄 襖
+裣 襝
+裆 襠
+袜 襪
+䙓 襬
+衬 襯
+袭 襲
+见 見
+觃 覎
+规 規
+觅 覓
+视 視
+觇 覘
+觋 覡
+觍 覥
+觎 覦
+亲 親
+觊 覬
+觏 覯
+觐 覲
+觑 覷
+觉 覺
+览 覽
+觌 覿
+观 觀
+觞 觴
+觯 觶
+触 觸
+讠 訁
+订 訂
+讣 訃
+计 計
+讯 訊
+讧 訌
+讨 討
+讦 訐
+讱 訒
+训 訓
+讪 訕
+讫 訖
+记 記
+讹 訛
+讶 訝
+讼 訟
+䜣 訢
+诀 訣
+讷 訥
+讻 訩
+访 訪
+设 設
+许 許
+诉 訴
+诃 訶
+诊 診
+诂 詁
+诋 詆
+讵 詎
+诈 詐
+诒 詒
+诏 詔
+评 評
+诐 詖
+诇 詗
+诎 詘
+诅 詛
+诩 詡
+询 詢
+诣 詣
+试 試
+诗 詩
+诧 詫
+诟 詬
+诡 詭
+诠 詮
+诘 詰
+话 話
+该 該
+详 詳
+诜 詵
+诙 詼
+诖 詿
+诔 誄
+诛 誅
+诓 誆
+夸 誇
+认 認
+诳 誑
+诶 誒
+诞 誕
+诱 誘
+诮 誚
+语 語
+诚 誠
+诫 誡
+诬 誣
+误 誤
+诰 誥
+诵 誦
+诲 誨
+谁 誰
+课 課
+谇 誶
+诽 誹
+谊 誼
+訚 誾
+调 調
+谄 諂
+谆 諄
+谈 談
+诿 諉
+请 請
+诤 諍
+诹 諏
+诼 諑
+谅 諒
+论 論
+谂 諗
+谀 諛
+谍 諜
+谞 諝
+谝 諞
+诨 諢
+谔 諤
+谛 諦
+谐 諧
+谏 諫
+谕 諭
+讳 諱
+谙 諳
+谌 諶
+讽 諷
+诸 諸
+谚 諺
+谖 諼
+诺 諾
+谋 謀
+谒 謁
+谓 謂
+誊 謄
+诌 謅
+谎 謊
+谜 謎
+谧 謐
+谑 謔
+谡 謖
+谤 謗
+谦 謙
+讲 講
+谢 謝
+谟 謨
+谪 謫
+谬 謬
+讴 謳
+谨 謹
+谩 謾
+䜧 譅
+谲 譎
+讥 譏
+谮 譖
+识 識
+谯 譙
+谭 譚
+谱 譜
+谵 譫
+译 譯
+议 議
+谴 譴
+护 護
+诪 譸
+誉 譽
+读 讀
+变 變
+雠 讎
+谗 讒
+让 讓
+谰 讕
+谶 讖
+谠 讜
+谳 讞
+岂 豈
+猪 豬
+豮 豶
+猫 貓
+䝙 貙
+贝 貝
+贞 貞
+贠 貟
+负 負
+财 財
+贡 貢
+贫 貧
+货 貨
+贩 販
+贪 貪
+贯 貫
+责 責
+贮 貯
+贳 貰
+赀 貲
+贰 貳
+贵 貴
+贬 貶
+买 買
+贷 貸
+贶 貺
+费 費
+贴 貼
+贻 貽
+贸 貿
+贺 賀
+贲 賁
+赂 賂
+赁 賃
+贿 賄
+赅 賅
+资 資
+贾 賈
+贼 賊
+赈 賑
+赊 賒
+宾 賓
+赇 賕
+赒 賙
+赉 賚
+赐 賜
+赏 賞
+赔 賠
+赓 賡
+贤 賢
+卖 賣
+贱 賤
+赋 賦
+赕 賧
+质 質
+账 賬
+赌 賭
+䞐 賰
+赖 賴
+赗 賵
+赚 賺
+赙 賻
+购 購
+赛 賽
+赜 賾
+贽 贄
+赘 贅
+赟 贇
+赠 贈
+赡 贍
+赢 贏
+赆 贐
+赑 贔
+赎 贖
+赣 贛
+赪 赬
+赶 趕
+赵 趙
+趋 趨
+趱 趲
+践 踐
+踊 踴
+跄 蹌
+跸 蹕
+蹒 蹣
+踪 蹤
+跷 蹺
+跶 躂
+趸 躉
+踌 躊
+跻 躋
+跃 躍
+踯 躑
+跞 躒
+踬 躓
+蹰 躕
+跹 躚
+蹑 躡
+蹿 躥
+躜 躦
+躏 躪
+躯 軀
+车 車
+轧 軋
+轨 軌
+军 軍
+轪 軑
+轩 軒
+轫 軔
+轭 軛
+软 軟
+轷 軤
+轸 軫
+轱 軲
+轴 軸
+轵 軹
+轺 軺
+轲 軻
+轶 軼
+轼 軾
+较 較
+辂 輅
+辁 輇
+辀 輈
+载 載
+轾 輊
+辄 輒
+挽 輓
+辅 輔
+轻 輕
+辆 輛
+辎 輜
+辉 輝
+辋 輞
+辍 輟
+辊 輥
+辇 輦
+辈 輩
+轮 輪
+辌 輬
+辑 輯
+辏 輳
+输 輸
+辐 輻
+辗 輾
+舆 輿
+辒 轀
+毂 轂
+辖 轄
+辕 轅
+辘 轆
+转 轉
+辙 轍
+轿 轎
+辚 轔
+轰 轟
+辔 轡
+轹 轢
+轳 轤
+办 辦
+辫 辮
+辩 辯
+农 農
+迳 逕
+这 這
+连 連
+进 進
+运 運
+过 過
+达 達
+违 違
+遥 遙
+逊 遜
+递 遞
+远 遠
+适 適
+迟 遲
+迁 遷
+选 選
+遗 遺
+辽 遼
+迈 邁
+还 還
+迩 邇
+边 邊
+逻 邏
+逦 邐
+郏 郟
+邮 郵
+郓 鄆
+乡 鄉
+邹 鄒
+邬 鄔
+郧 鄖
+邓 鄧
+郑 鄭
+邻 鄰
+郸 鄲
+邺 鄴
+郐 鄶
+邝 鄺
+酂 酇
+郦 酈
+医 醫
+酱 醬
+酦 醱
+酿 釀
+衅 釁
+酾 釃
+酽 釅
+释 釋
+厘 釐
+钅 釒
+钆 釓
+钇 釔
+钌 釕
+钊 釗
+钉 釘
+钋 釙
+针 針
+钓 釣
+钐 釤
+钏 釧
+钒 釩
+钗 釵
+钍 釷
+钕 釹
+钎 釺
+钯 鈀
+钫 鈁
+钘 鈃
+钭 鈄
+钚 鈈
+钠 鈉
+钝 鈍
+钤 鈐
+钣 鈑
+钑 鈒
+钞 鈔
+钮 鈕
+钧 鈞
+钙 鈣
+钬 鈥
+钛 鈦
+钪 鈧
+铌 鈮
+铈 鈰
+钶 鈳
+铃 鈴
+钴 鈷
+钹 鈸
+铍 鈹
+钰 鈺
+钸 鈽
+铀 鈾
+钿 鈿
+钾 鉀
+铊 鉈
+铉 鉉
+铇 鉋
+铋 鉍
+铂 鉑
+钷 鉕
+铆 鉚
+铅 鉛
+钺 鉞
+钲 鉦
+钼 鉬
+钽 鉭
+铏 鉶
+铰 鉸
+铒 鉺
+铬 鉻
+铪 鉿
+银 銀
+铳 銃
+铜 銅
+铚 銍
+铣 銑
+铨 銓
+铢 銖
+铭 銘
+铫 銚
+铦 銛
+衔 銜
+铑 銠
+铷 銣
+铱 銥
+铟 銦
+铵 銨
+铥 銩
+铕 銪
+铯 銫
+铐 銬
+铞 銱
+销 銷
+锑 銻
+锉 銼
+锒 鋃
+锌 鋅
+钡 鋇
+铤 鋌
+铗 鋏
+锋 鋒
+铻 鋙
+锊 鋝
+锓 鋟
+铘 鋣
+锄 鋤
+锃 鋥
+锔 鋦
+锇 鋨
+铓 鋩
+铖 鋮
+锆 鋯
+锂 鋰
+铽 鋱
+锍 鋶
+锯 鋸
+钢 鋼
+锞 錁
+锖 錆
+锫 錇
+锩 錈
+铔 錏
+锥 錐
+锕 錒
+锟 錕
+锱 錙
+铮 錚
+锛 錛
+锬 錟
+锭 錠
+锜 錡
+钱 錢
+锦 錦
+锚 錨
+锠 錩
+锡 錫
+锢 錮
+错 錯
+锰 錳
+铼 錸
+锝 鍀
+锪 鍃
+钔 鍆
+锴 鍇
+锳 鍈
+锅 鍋
+镀 鍍
+锷 鍔
+铡 鍘
+钖 鍚
+锻 鍛
+锽 鍠
+锸 鍤
+锲 鍥
+锘 鍩
+锹 鍬
+锾 鍰
+键 鍵
+锶 鍶
+锗 鍺
+镁 鎂
+锿 鎄
+镅 鎇
+镑 鎊
+锁 鎖
+镉 鎘
+镈 鎛
+镃 鎡
+钨 鎢
+蓥 鎣
+镏 鎦
+铠 鎧
+铩 鎩
+锼 鎪
+镐 鎬
+镇 鎮
+镒 鎰
+镋 鎲
+镍 鎳
+镓 鎵
+镎 鎿
+镞 鏃
+镟 鏇
+镆 鏌
+镙 鏍
+镠 鏐
+镝 鏑
+铿 鏗
+锵 鏘
+镗 鏜
+镘 鏝
+镛 鏞
+镜 鏡
+镖 鏢
+镂 鏤
+錾 鏨
+镚 鏰
+铧 鏵
+镤 鏷
+镪 鏹
+铙 鐃
+铴 鐋
+镣 鐐
+铹 鐒
+镦 鐓
+镡 鐔
+镫 鐙
+镨 鐠
+锎 鐦
+锏 鐧
+镄 鐨
+镰 鐮
+镯 鐲
+镭 鐳
+铁 鐵
+镮 鐶
+铎 鐸
+铛 鐺
+镱 鐿
+铸 鑄
+镬 鑊
+镔 鑌
+镲 鑔
+锧 鑕
+镴 鑞
+铄 鑠
+镳 鑣
+镥 鑥
+镧 鑭
+钥 鑰
+镵 鑱
+镶 鑲
+镊 鑷
+镩 鑹
+锣 鑼
+钻 鑽
+銮 鑾
+凿 鑿
+长 長
+门 門
+闩 閂
+闪 閃
+闫 閆
+闬 閈
+闭 閉
+开 開
+闶 閌
+闳 閎
+闰 閏
+间 間
+闵 閔
+闸 閘
+阂 閡
+阁 閣
+阀 閥
+闺 閨
+闽 閩
+阃 閫
+阆 閬
+闾 閭
+阊 閶
+阉 閹
+阎 閻
+阏 閼
+阍 閽
+阈 閾
+阌 閿
+阒 闃
+闱 闈
+阔 闊
+阕 闋
+阑 闌
+阇 闍
+阗 闐
+阘 闒
+闿 闓
+阖 闔
+阙 闕
+闯 闖
+阚 闞
+阓 闠
+阐 闡
+阛 闤
+闼 闥
+坂 阪
+陉 陘
+陕 陝
+阵 陣
+阴 陰
+陈 陳
+陆 陸
+阳 陽
+陧 隉
+队 隊
+阶 階
+陨 隕
+际 際
+随 隨
+险 險
+隐 隱
+陇 隴
+隶 隸
+隽 雋
+虽 雖
+双 雙
+雏 雛
+杂 雜
+离 離
+难 難
+电 電
+霡 霢
+雾 霧
+霁 霽
+雳 靂
+霭 靄
+灵 靈
+靓 靚
+静 靜
+靥 靨
+鼗 鞀
+巩 鞏
+鞒 鞽
+鞑 韃
+鞯 韉
+韦 韋
+韧 韌
+韨 韍
+韩 韓
+韪 韙
+韬 韜
+韫 韞
+韵 韻
+响 響
+页 頁
+顶 頂
+顷 頃
+项 項
+顺 順
+顸 頇
+顼 頊
+颂 頌
+颀 頎
+颃 頏
+预 預
+顽 頑
+颁 頒
+顿 頓
+颇 頗
+领 領
+颌 頜
+颉 頡
+颐 頤
+颏 頦
+头 頭
+颒 頮
+颊 頰
+颋 頲
+颕 頴
+颔 頷
+颈 頸
+频 頻
+颗 顆
+题 題
+额 額
+颚 顎
+颙 顒
+颛 顓
+颡 顙
+颠 顛
+类 類
+颟 顢
+颢 顥
+顾 顧
+颤 顫
+颥 顬
+显 顯
+颦 顰
+颅 顱
+颞 顳
+颧 顴
+风 風
+飐 颭
+飑 颮
+飒 颯
+飓 颶
+飔 颸
+飖 颻
+飕 颼
+飗 飀
+飘 飄
+飙 飆
+飚 飈
+飞 飛
+饣 飠
+饤 飣
+饦 飥
+饨 飩
+饪 飪
+饫 飫
+饬 飭
+饭 飯
+饮 飲
+饴 飴
+饲 飼
+饱 飽
+饰 飾
+饳 飿
+饺 餃
+饸 餄
+饼 餅
+饷 餉
+养 養
+饵 餌
+饹 餎
+饻 餏
+饽 餑
+馁 餒
+饿 餓
+馂 餕
+饾 餖
+馄 餛
+馃 餜
+饯 餞
+馅 餡
+馆 館
+糇 餱
+饧 餳
+馉 餶
+馇 餷
+馎 餺
+饩 餼
+馏 餾
+馊 餿
+馌 饁
+馍 饃
+馒 饅
+馐 饈
+馑 饉
+馓 饊
+馔 饌
+饶 饒
+飨 饗
+餍 饜
+馋 饞
+馕 饢
+马 馬
+驭 馭
+冯 馮
+驮 馱
+驰 馳
+驯 馴
+驲 馹
+驳 駁
+驻 駐
+驽 駑
+驹 駒
+驵 駔
+驾 駕
+骀 駘
+驸 駙
+驶 駛
+驼 駝
+驷 駟
+骈 駢
+骇 駭
+骃 駰
+骆 駱
+骎 駸
+骏 駿
+骋 騁
+骍 騂
+骓 騅
+骔 騌
+骒 騍
+骑 騎
+骐 騏
+骛 騖
+骗 騙
+骙 騤
+䯄 騧
+骞 騫
+骘 騭
+骝 騮
+腾 騰
+驺 騶
+骚 騷
+骟 騸
+骡 騾
+蓦 驀
+骜 驁
+骖 驂
+骠 驃
+骢 驄
+驱 驅
+骅 驊
+骕 驌
+骁 驍
+骣 驏
+骄 驕
+验 驗
+惊 驚
+驿 驛
+骤 驟
+驴 驢
+骧 驤
+骥 驥
+骦 驦
+骊 驪
+骉 驫
+肮 骯
+髅 髏
+体 體
+髌 髕
+髋 髖
+鬓 鬢
+闹 鬧
+阋 鬩
+阄 鬮
+魉 魎
+魇 魘
+鱼 魚
+鱽 魛
+鱾 魢
+鲀 魨
+鲁 魯
+鲂 魴
+鱿 魷
+鲄 魺
+鲅 鮁
+鲆 鮃
+鲌 鮊
+鲉 鮋
+鲏 鮍
+鲐 鮐
+鲍 鮑
+鲋 鮒
+鲊 鮓
+鲒 鮚
+鲘 鮜
+鲕 鮞
+鲖 鮦
+鲔 鮪
+鲛 鮫
+鲑 鮭
+鲜 鮮
+鲓 鮳
+鲪 鮶
+鲝 鮺
+鲧 鯀
+鲠 鯁
+鲩 鯇
+鲤 鯉
+鲨 鯊
+鲬 鯒
+鲻 鯔
+鲯 鯕
+鲭 鯖
+鲷 鯛
+鲴 鯝
+鲱 鯡
+鲵 鯢
+鲲 鯤
+鲳 鯧
+鲸 鯨
+鲮 鯪
+鲰 鯫
+鲶 鯰
+鲺 鯴
+鳀 鯷
+鲫 鯽
+鳊 鯿
+鳈 鰁
+鲗 鰂
+鳂 鰃
+鲽 鰈
+鳇 鰉
+鳅 鰍
+鲾 鰏
+鳆 鰒
+鳃 鰓
+鳒 鰜
+鳑 鰟
+鳋 鰠
+鲥 鰣
+鳏 鰥
+鳎 鰨
+鳐 鰩
+鳍 鰭
+鳁 鰮
+鲢 鰱
+鳓 鰳
+鳘 鰵
+鲦 鰷
+鲣 鰹
+鲹 鰺
+鳗 鰻
+鳛 鰼
+鳔 鰾
+鳉 鱂
+鳙 鱅
+鳕 鱈
+鳟 鱒
+鳝 鱔
+鳜 鱖
+鳞 鱗
+鲟 鱘
+鲼 鱝
+鲎 鱟
+鲙 鱠
+鳣 鱣
+鳡 鱤
+鳢 鱧
+鲿 鱨
+鲚 鱭
+鳠 鱯
+鲈 鱸
+鲡 鱺
+鸟 鳥
+鸠 鳩
+鸤 鳲
+凤 鳳
+鸣 鳴
+鸢 鳶
+䴓 鳾
+鸩 鴆
+鸨 鴇
+鸦 鴉
+鸰 鴒
+鸵 鴕
+鸳 鴛
+鸲 鴝
+鸮 鴞
+鸱 鴟
+鸪 鴣
+鸯 鴦
+鸭 鴨
+鸸 鴯
+鸹 鴰
+鸻 鴴
+䴕 鴷
+鸿 鴻
+鸽 鴿
+䴔 鵁
+鸺 鵂
+鸼 鵃
+鹀 鵐
+鹃 鵑
+鹆 鵒
+鹁 鵓
+鹈 鵜
+鹅 鵝
+鹄 鵠
+鹉 鵡
+鹌 鵪
+鹏 鵬
+鹐 鵮
+鹎 鵯
+鹊 鵲
+鹓 鵷
+鹍 鵾
+䴖 鶄
+鸫 鶇
+鹑 鶉
+鹒 鶊
+鹋 鶓
+鹙 鶖
+鹕 鶘
+鹗 鶚
+鹖 鶡
+鹛 鶥
+鹜 鶩
+䴗 鶪
+鸧 鶬
+莺 鶯
+鹟 鶲
+鹤 鶴
+鹠 鶹
+鹡 鶺
+鹘 鶻
+鹣 鶼
+鹢 鷁
+鹞 鷂
+䴘 鷈
+鹝 鷊
+鹧 鷓
+鹥 鷖
+鸥 鷗
+鸷 鷙
+鹨 鷚
+鸶 鷥
+鹪 鷦
+鹔 鷫
+鹩 鷯
+鹫 鷲
+鹇 鷳
+鹬 鷸
+鹰 鷹
+鹭 鷺
+鸴 鷽
+䴙 鷿
+㶉 鸂
+鹯 鸇
+鹱 鸌
+鹲 鸏
+鸬 鸕
+鹴 鸘
+鹦 鸚
+鹳 鸛
+鹂 鸝
+鸾 鸞
+鹾 鹺
+碱 鹼
+盐 鹽
+丽 麗
+麦 麥
+麸 麩
+黄 黃
+黉 黌
+点 點
+党 黨
+黪 黲
+黡 黶
+黩 黷
+黾 黽
+鼋 黿
+鼍 鼉
+鼹 鼴
+齐 齊
+斋 齋
+齑 齏
+齿 齒
+龀 齔
+龁 齕
+龂 齗
+龅 齙
+龇 齜
+龃 齟
+龆 齠
+龄 齡
+龈 齦
+龊 齪
+龉 齬
+龋 齲
+腭 齶
+龌 齷
+龙 龍
+厐 龎
+庞 龐
+龚 龔
+龛 龕
+龟 龜
+`
+
+// chineseS2TData 仅用于简体转繁体的对照表,每行为"简体 繁体".
+const chineseS2TData = `
+馀 餘
+版画 版畫
+䩄 靦
+藉 藉
+五脏 五臟
+六脏 六臟
+内脏 內臟
+心脏 心臟
+牝脏 牝臟
+肝脏 肝臟
+肺脏 肺臟
+肾脏 腎臟
+胃脏 胃臟
+胰脏 胰臟
+脏器 臟器
+脏毒 臟毒
+脏气 臟氣
+脏症 臟症
+脏腑 臟腑
+脏象 臟象
+脏躁 臟躁
+脾脏 脾臟
+收获 收穫
+䌽 綵
+讬 託
+锺 鍾
+著 著
+丰标不凡 丰標不凡
+丰仪 丰儀
+丰韵 丰韻
+张三丰 張三丰
+渖 瀋
+沉 沈
+零余 零餘
+一余 一餘
+二余 二餘
+三余 三餘
+四余 四餘
+五余 五餘
+六余 六餘
+七余 七餘
+八余 八餘
+九余 九餘
+十余 十餘
+百余 百餘
+千余 千餘
+万余 萬餘
+亿余 億餘
+两余 兩餘
+零海里 零海里
+一海里 一海里
+二海里 二海里
+三海里 三海里
+四海里 四海里
+五海里 五海里
+六海里 六海里
+七海里 七海里
+八海里 八海里
+九海里 九海里
+十海里 十海里
+百海里 百海里
+千海里 千海里
+万海里 萬海里
+亿海里 億海里
+两海里 兩海里
+零斗 零斗
+一斗 一斗
+二斗 二斗
+三斗 三斗
+四斗 四斗
+五斗 五斗
+六斗 六斗
+七斗 七斗
+八斗 八斗
+九斗 九斗
+十斗 十斗
+百斗 百斗
+千斗 千斗
+万斗 萬斗
+亿斗 億斗
+两斗 兩斗
+周零 週零
+周一 週一
+周二 週二
+周三 週三
+周四 週四
+周五 週五
+周六 週六
+周七 週七
+周八 週八
+周九 週九
+周十 週十
+周百 週百
+周千 週千
+周万 週萬
+周亿 週億
+周两 週兩
+零只 零隻
+一只 一隻
+二只 二隻
+三只 三隻
+四只 四隻
+五只 五隻
+六只 六隻
+七只 七隻
+八只 八隻
+九只 九隻
+十只 十隻
+百只 百隻
+千只 千隻
+万只 萬隻
+亿只 億隻
+两只 兩隻
+零出戏 零齣戲
+一出戏 一齣戲
+二出戏 二齣戲
+三出戏 三齣戲
+四出戏 四齣戲
+五出戏 五齣戲
+六出戏 六齣戲
+七出戏 七齣戲
+八出戏 八齣戲
+九出戏 九齣戲
+十出戏 十齣戲
+百出戏 百齣戲
+千出戏 千齣戲
+万出戏 萬齣戲
+亿出戏 億齣戲
+两出戏 兩齣戲
+丰度 丰度
+丰情 丰情
+丰茸 丰茸
+丰姿 丰姿
+丰神 丰神
+丰采 丰採
+`
+
+// chineseT2SData 仅用于繁体转简体的对照表,每行为"繁体 简体".
+const chineseT2SData = `
+薑 姜
+崑 昆
+崙 仑
+麯 曲
+餘 余
+麽 么
+么 么
+複 复
+瞭 了
+係 系
+繫 系
+裡 里
+裏 里
+幹 干
+干 干
+畫分 划分
+畫開 划开
+畫一 划一
+畫界 划界
+畫策 划策
+畫歸 划归
+畫清 划清
+規畫 规划
+策畫 策划
+籌畫 筹划
+計畫 计划
+比畫 比划
+謀畫 谋划
+區畫 区划
+板畫 版画
+刻劃 刻画
+劃 划
+斗 斗
+闘 斗
+鬭 斗
+週 周
+鬆 松
+菓 果
+闆 板
+麵 面
+禦 御
+靦 腼
+征 征
+颱 台
+臺 台
+檯 台
+鬍 胡
+衚 胡
+鬚 须
+祇賀新禧 只贺新禧
+祇請政安 只请政安
+祇管 只管
+祇好 只好
+祇要 只要
+祇有 只有
+祇得 只得
+隻 只
+併 并
+噹 当
+葯 药
+佈 布
+闢 辟
+藉 借
+儘 尽
+叶 叶
+夥 伙
+傢 家
+姦 奸
+曆 历
+燄 焰
+气 气
+緻 致
+製 制
+穀 谷
+榖 谷
+后 后
+誌 志
+彆 别
+彙 汇
+辞 辞
+机 机
+髮 发
+捲 卷
+云 云
+丑 丑
+蔔 卜
+沖 冲
+冲 冲
+齣 出
+綫 线
+覈 核
+迴 回
+廻 回
+鼕 冬
+鹹 咸
+慾 欲
+準 准
+註 注
+兇 凶
+䬗 扬
+醼 宴
+齩 咬
+䶧 咬
+荳 豆
+韮 韭
+牋 笺
+糰 团
+滷 卤
+獃 呆
+氾 泛
+汎 泛
+嬀 妫
+衆 众
+鉤 钩
+鞝 绱
+鋭 锐
+贗 赝
+贜 赃
+麤 粗
+関 关
+饑 饥
+欵 款
+懞 蒙
+駡 骂
+臟 脏
+鱷 鳄
+鳬 凫
+齎 赍
+簆 筘
+吢 吣
+羣 群
+歎 叹
+鬀 剃
+鷄 鸡
+頽 颓
+顔 颜
+砲 炮
+礮 炮
+啟 启
+几 几
+悳 德
+慤 悫
+剋 克
+尅 克
+壜 坛
+罎 坛
+罈 坛
+昇 升
+陞 升
+僞 伪
+穫 获
+絛 绦
+綉 绣
+缽 钵
+蜡 蜡
+綵 彩
+埰 采
+厠 厕
+擣 捣
+潙 沩
+爲 为
+産 产
+瘺 瘘
+竈 灶
+絶 绝
+緑 绿
+綳 绷
+氹 凼
+牀 床
+墻 墙
+奬 奖
+眦 眦
+秆 秆
+耻 耻
+苎 苧
+苹 苹
+藴 蕴
+説 说
+謡 谣
+譾 谫
+豎 竖
+醞 酝
+録 录
+銹 锈
+钁 䦆
+閲 阅
+粧 妆
+閑 闲
+遊 游
+錶 表
+癥 症
+癡 痴
+淀 淀
+嚮應 响应
+嚮 向
+曏 向
+紮 扎
+占 占
+託 托
+涌 涌
+纍 累
+睏 困
+舍 舍
+杠 杠
+僱 雇
+颳 刮
+貍 狸
+跤 交
+姪 侄
+勛 勋
+盪 荡
+慄 栗
+嚥 咽
+弔 吊
+愿 愿
+蹟 迹
+凈 净
+倖 幸
+蚝 蚝
+縴 纤
+朴 朴
+鍾 钟
+霑 沾
+着 着
+蒐 搜
+蕓 芸
+籤 签
+捶鍊 锤炼
+鎚 锤
+磨鍊 磨练
+鍊氣 练气
+洗鍊 洗练
+鍊 炼
+巡察 巡查
+稽察 稽查
+詢察 询查
+細察 细查
+黴 霉
+豔 艳
+闇 暗
+証 证
+嚐 尝
+喫 吃
+舖 铺
+脣 唇
+遯 遁
+姊 姐
+汙 污
+箇 个
+絃 弦
+譁 哗
+銲 焊
+餽 馈
+樑 梁
+俱 具
+俬 私
+鑑 鉴
+盃 杯
+溼 湿
+侷 局
+跼 局
+綑 捆
+嫋 袅
+嬝 袅
+慼 戚
+褶疊 折叠
+褶紙 折纸
+撚 捻
+箝 钳
+鎔爐 熔炉
+鎔劑 熔剂
+鎔融 熔融
+鎔銷 熔销
+鎔鑄 熔铸
+搧 扇
+燬 毁
+讚 赞
+纔 才
+藷 薯
+搾 榨
+衊 蔑
+痠 酸
+袴 绔
+摺 折
+巖 岩
+撢 掸
+癒 愈
+欞 棂
+籲 吁
+濬 浚
+剷 铲
+燻 熏
+菸 烟
+烟 烟
+翫 玩
+瀰 弥
+慇 殷
+懃 勤
+韁 缰
+塚 冢
+燿 耀
+蓆 席
+譟 噪
+閧 哄
+鬨 哄
+份子 分子
+沍 冱
+釦 扣
+籐 藤
+燐 磷
+簷 檐
+悽 凄
+毬 球
+懮 忧
+棱 棱
+摀 捂
+孃 娘
+勗 勖
+枒 丫
+瞇 眯
+佛佗 佛陀
+近傍 近旁
+倣 仿
+彿 佛
+依杖 依仗
+僇 戮
+卹 恤
+齧 啮
+囓 啮
+唸 念
+喨 亮
+咷 啕
+槖 橐
+靷 纼
+搥 捶
+怵目 触目
+歛 敛
+餬 糊
+壎 埙
+搆和 媾和
+搆 构
+搨 拓
+轝 舆
+檝 楫
+賸 剩
+皁 皂
+鼇 鳌
+瑯 琅
+蔴 麻
+痀 佝
+囍 禧
+諡 谥
+蹧 糟
+罣 挂
+餚 肴
+翺 翱
+蠧 蠹
+踡 蜷
+踰 逾
+鎗 枪
+阨 厄
+隄 堤
+鼈 鳖
+箎 篪
+彞 彝
+鎸 镌
+鬱 郁
+瀋 沈
+祕 秘
+眞 真
+硏 研
+`
+
+// chineseTWVariantData 台湾常用异体字表,每行为"通用繁体 台湾用字".
+const chineseTWVariantData = `
+僞 偽
+啓 啟
+喫 吃
+嫺 嫻
+嬀 媯
+峯 峰
+擡 抬
+棱 稜
+檐 簷
+污 汙
+泄 洩
+潙 溈
+爲 為
+牀 床
+痹 痺
+癡 痴
+皁 皂
+着 著
+睾 睪
+祕 秘
+竈 灶
+糉 粽
+繮 韁
+纔 才
+羣 群
+脣 唇
+蔘 參
+衆 眾
+裏 裡
+覈 核
+踊 踴
+鉢 缽
+鍼 針
+鮎 鯰
+麪 麵
+齶 顎
+`
+
+// chineseHKVariantData 香港常用异体字表,每行为"通用繁体 香港用字".
+const chineseHKVariantData = `
+裡 裏
+衛 衞
+說 説
+溫 温
+戶 户
+兌 兑
+悅 悦
+稅 税
+脫 脱
+銳 鋭
+閱 閲
+臥 卧
+線 綫
+啟 啓
+鉤 鈎
+蔥 葱
+檯 枱
+擡 抬
+敘 敍
+柺 枴
+溼 濕
+脣 唇
+蛻 蜕
+蘊 藴
+醞 醖
+搵 揾
+慍 愠
+氳 氲
+鍼 針
+鉢 缽
+媼 媪
+嬀 媯
+爲 為
+僞 偽
+衆 眾
+癡 痴
+竈 灶
+糉 粽
+纔 才
+祕 秘
+`
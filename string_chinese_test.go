@@ -0,0 +1,77 @@
+package kgo
+
+import (
+	"testing"
+)
+
+func TestChineseConvert(t *testing.T) {
+	var tests = []struct {
+		str      string
+		mode     LkkChineseMode
+		expected string
+	}{
+		{"头发很长,发展很快", CHINESE_S2T, "頭髮很長,發展很快"},
+		{"皇后在后面", CHINESE_S2T, "皇后在後面"},
+		{"三只猫只有一只眼", CHINESE_S2T, "三隻貓只有一隻眼"},
+		{"人云亦云,白云", CHINESE_S2T, "人云亦云,白雲"},
+		{"启动线路说明", CHINESE_S2TW, "啟動線路說明"},
+		{"里面的线路说明", CHINESE_S2HK, "裏面的綫路説明"},
+		{"頭髮很長,發展很快", CHINESE_T2S, "头发很长,发展很快"},
+		{"啟動線路說明,山峯", CHINESE_TW2S, "启动线路说明,山峰"},
+		{"裏面的綫路説明", CHINESE_HK2S, "里面的线路说明"},
+		{"乾隆在乾清宮,扭轉乾坤,衣服乾了", CHINESE_T2S, "乾隆在乾清宫,扭转乾坤,衣服干了"},
+		{"乾隆在乾清宫,衣服干了", CHINESE_S2T, "乾隆在乾清宮,衣服乾了"},
+		{"Hello 123", CHINESE_S2T, "Hello 123"},
+		{"", CHINESE_T2S, ""},
+	}
+	for _, test := range tests {
+		actual := KStr.ChineseConvert(test.str, test.mode)
+		if actual != test.expected {
+			t.Errorf("Expected ChineseConvert(%s, %d) to be %s, got %s", test.str, test.mode, test.expected, actual)
+			return
+		}
+	}
+
+	res := KStr.ChineseConvert("头发", LkkChineseMode(99))
+	if res != "头发" {
+		t.Error("ChineseConvert unknown mode fail")
+		return
+	}
+}
+
+func BenchmarkChineseConvert(b *testing.B) {
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		KStr.ChineseConvert("头发很长,发展很快", CHINESE_S2TW)
+	}
+}
+
+func TestSimplified2Traditional(t *testing.T) {
+	res := KStr.Simplified2Traditional("中华人民共和国")
+	if res != "中華人民共和國" {
+		t.Error("Simplified2Traditional fail")
+		return
+	}
+}
+
+func BenchmarkSimplified2Traditional(b *testing.B) {
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		KStr.Simplified2Traditional("中华人民共和国")
+	}
+}
+
+func TestTraditional2Simplified(t *testing.T) {
+	res := KStr.Traditional2Simplified("中華人民共和國")
+	if res != "中华人民共和国" {
+		t.Error("Traditional2Simplified fail")
+		return
+	}
+}
+
+func BenchmarkTraditional2Simplified(b *testing.B) {
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		KStr.Traditional2Simplified("中華人民共和國")
+	}
+}
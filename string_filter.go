@@ -0,0 +1,336 @@
+package kgo
+
+import (
+	"bufio"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"unicode"
+	"unicode/utf8"
+)
+
+// WordFilter 敏感词过滤器,基于Aho-Corasick自动机,一次扫描即可找出所有敏感词;
+// 匹配前统一全角/半角和大小写,并忽略夹杂在词中的空白、符号及"*-."等干扰字符;
+// 匹配不跨越逗号、句号等断句标点;英文字母之间的空白和符号视为单词分隔,须与词语中的分隔对应,
+// 如"ab"不匹配"a bc","free money"可匹配"free-money";可并发使用,支持热更新词库
+type WordFilter struct {
+	mu     sync.RWMutex
+	update sync.Mutex // 串行化词库的更新
+	words  []string   // 词库中的原始词语
+	ac     *acMachine // 当前的自动机,热更新时整体替换
+}
+
+// WordMatch 敏感词的匹配结果
+type WordMatch struct {
+	Word  string // 词库中的敏感词
+	Text  string // 原文中匹配的内容,可能包含干扰字符
+	Start int    // 在原文中的起始字节位置
+	End   int    // 在原文中的结束字节位置(不含)
+}
+
+// acNode 自动机的节点
+type acNode struct {
+	next map[rune]int // 子节点
+	fail int          // 失配时跳转的节点
+	word int          // 以该节点结尾的词语序号,无则为-1
+	dict int          // 沿失配路径最近的有词语的节点,无则为-1
+}
+
+// acMachine Aho-Corasick自动机,创建后只读
+type acMachine struct {
+	nodes []acNode
+	words []string // 词语序号 => 原始词语
+	sizes []int    // 词语序号 => 规范化后的字数
+}
+
+// 字符在匹配时的类别
+const (
+	filterNoise = iota // 干扰字符,匹配时跳过
+	filterWord         // 组成词语的字符
+	filterBreak        // 断句标点,匹配不跨越
+)
+
+// filterBreaks 断句标点,全角标点先转换为半角
+var filterBreaks = map[rune]bool{
+	',': true, ';': true, ':': true, '!': true, '?': true, '\n': true, '\r': true,
+	'。': true, '、': true, '…': true, '｡': true, '､': true,
+}
+
+// NewWordFilter 根据词库创建敏感词过滤器,空词语及全部由干扰字符组成的词语被忽略;词语中的断句标点也被忽略.
+func (ks *LkkString) NewWordFilter(words ...string) *WordFilter {
+	wf := &WordFilter{}
+	wf.Reload(words...)
+	return wf
+}
+
+// filterLatin 规范化后的字符是否为英文字母.
+func filterLatin(r rune) bool {
+	return r >= 'a' && r <= 'z'
+}
+
+// filterNormalize 规范化单个字符:全角转半角并转为小写,同时返回字符的类别.
+func filterNormalize(r rune) (rune, int) {
+	if r >= 0xFF01 && r <= 0xFF5E {
+		r -= 0xFEE0
+	} else if r == 0x3000 {
+		r = ' '
+	}
+
+	if unicode.IsLetter(r) || unicode.IsDigit(r) {
+		return unicode.ToLower(r), filterWord
+	} else if filterBreaks[r] {
+		return r, filterBreak
+	}
+	return r, filterNoise
+}
+
+// newAcMachine 根据词库创建自动机.
+func newAcMachine(words []string) *acMachine {
+	ac := &acMachine{nodes: []acNode{{next: make(map[rune]int), word: -1, dict: -1}}}
+
+	//构建字典树
+	for _, word := range words {
+		cur, size := 0, 0
+		insert := func(r rune) {
+			nxt, has := ac.nodes[cur].next[r]
+			if !has {
+				nxt = len(ac.nodes)
+				ac.nodes = append(ac.nodes, acNode{next: make(map[rune]int), word: -1, dict: -1})
+				ac.nodes[cur].next[r] = nxt
+			}
+			cur = nxt
+			size++
+		}
+
+		var prev rune
+		gap := false
+		for _, r := range word {
+			r, class := filterNormalize(r)
+			if class != filterWord {
+				gap = true
+				continue
+			}
+			//英文单词之间的分隔作为一个空格
+			if gap && filterLatin(prev) && filterLatin(r) {
+				insert(' ')
+			}
+			insert(r)
+			prev, gap = r, false
+		}
+		if size > 0 && ac.nodes[cur].word == -1 {
+			ac.nodes[cur].word = len(ac.words)
+			ac.words = append(ac.words, word)
+			ac.sizes = append(ac.sizes, size)
+		}
+	}
+
+	//按广度优先设置失配跳转
+	queue := make([]int, 0, len(ac.nodes))
+	for _, child := range ac.nodes[0].next {
+		queue = append(queue, child)
+	}
+	for len(queue) > 0 {
+		cur := queue[0]
+		queue = queue[1:]
+		for r, child := range ac.nodes[cur].next {
+			fail := ac.nodes[cur].fail
+			for fail > 0 {
+				if _, ok := ac.nodes[fail].next[r]; ok {
+					break
+				}
+				fail = ac.nodes[fail].fail
+			}
+			if nxt, ok := ac.nodes[fail].next[r]; ok {
+				fail = nxt
+			} else {
+				fail = 0
+			}
+			ac.nodes[child].fail = fail
+			if ac.nodes[fail].word != -1 {
+				ac.nodes[child].dict = fail
+			} else {
+				ac.nodes[child].dict = ac.nodes[fail].dict
+			}
+			queue = append(queue, child)
+		}
+	}
+
+	return ac
+}
+
+// scan 扫描文本,每找到一个敏感词调用一次fn,fn返回false时停止扫描.
+func (ac *acMachine) scan(text string, fn func(m WordMatch) bool) {
+	if len(ac.words) == 0 {
+		return
+	}
+
+	//starts为词语字符在原文中的起始位置
+	var starts []int
+	cur := 0
+	step := func(r rune, start, end int) bool {
+		starts = append(starts, start)
+		for cur > 0 {
+			if _, ok := ac.nodes[cur].next[r]; ok {
+				break
+			}
+			cur = ac.nodes[cur].fail
+		}
+		cur = ac.nodes[cur].next[r]
+
+		//当前节点及其失配路径上的词语都以该字符结尾
+		for node := cur; node > 0; node = ac.nodes[node].dict {
+			if idx := ac.nodes[node].word; idx != -1 {
+				start := starts[len(starts)-ac.sizes[idx]]
+				if !fn(WordMatch{Word: ac.words[idx], Text: text[start:end], Start: start, End: end}) {
+					return false
+				}
+			}
+		}
+		return true
+	}
+
+	var prev rune
+	gap := -1 // 上一个词语字符之后的干扰字符位置,无则为-1
+	for i, c := range text {
+		r, class := filterNormalize(c)
+		if class == filterBreak {
+			cur, prev, gap = 0, 0, -1
+			continue
+		} else if class == filterNoise {
+			if gap == -1 {
+				gap = i
+			}
+			continue
+		}
+
+		//英文单词之间的分隔作为一个空格,与词语中的分隔对应
+		if gap != -1 && filterLatin(prev) && filterLatin(r) && !step(' ', gap, i) {
+			return
+		}
+		_, size := utf8.DecodeRuneInString(text[i:])
+		if !step(r, i, i+size) {
+			return
+		}
+		prev, gap = r, -1
+	}
+}
+
+// machine 获取当前的自动机.
+func (wf *WordFilter) machine() *acMachine {
+	wf.mu.RLock()
+	defer wf.mu.RUnlock()
+	return wf.ac
+}
+
+// Reload 以新词库替换原有词库,新的自动机创建完成后才替换,替换期间不影响正在进行的匹配.
+func (wf *WordFilter) Reload(words ...string) {
+	wf.update.Lock()
+	defer wf.update.Unlock()
+	wf.reload(words)
+}
+
+// reload 重建自动机并替换,须持有更新锁.
+func (wf *WordFilter) reload(words []string) {
+	list := make([]string, 0, len(words))
+	for _, word := range words {
+		if word = strings.TrimSpace(word); word != "" {
+			list = append(list, word)
+		}
+	}
+	ac := newAcMachine(list)
+
+	wf.mu.Lock()
+	wf.words, wf.ac = list, ac
+	wf.mu.Unlock()
+}
+
+// ReloadFile 从文件中读取词库并替换原有词库,每行一个词语,空行及"#"开头的注释行被忽略.
+func (wf *WordFilter) ReloadFile(fpath string) error {
+	file, err := os.Open(fpath)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = file.Close()
+	}()
+
+	var words []string
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line != "" && !strings.HasPrefix(line, "#") {
+			words = append(words, line)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return err
+	}
+
+	wf.Reload(words...)
+	return nil
+}
+
+// Add 向词库中添加词语并重建自动机.
+func (wf *WordFilter) Add(words ...string) {
+	wf.update.Lock()
+	defer wf.update.Unlock()
+	wf.reload(append(append([]string{}, wf.words...), words...))
+}
+
+// Len 获取词库中有效词语的数量.
+func (wf *WordFilter) Len() int {
+	return len(wf.machine().words)
+}
+
+// FindAll 查找文本中的所有敏感词(包括相互重叠的),按结束位置排序.
+func (wf *WordFilter) FindAll(text string) []WordMatch {
+	var res []WordMatch
+	wf.machine().scan(text, func(m WordMatch) bool {
+		res = append(res, m)
+		return true
+	})
+	return res
+}
+
+// Contains 文本中是否包含敏感词,找到第一个即返回.
+func (wf *WordFilter) Contains(text string) bool {
+	var res bool
+	wf.machine().scan(text, func(m WordMatch) bool {
+		res = true
+		return false
+	})
+	return res
+}
+
+// Replace 将文本中的敏感词(包括其中夹杂的干扰字符)逐字替换为mask,mask为空时默认为"*".
+func (wf *WordFilter) Replace(text string, mask string) string {
+	matches := wf.FindAll(text)
+	if len(matches) == 0 {
+		return text
+	}
+	if mask == "" {
+		mask = "*"
+	}
+
+	sort.Slice(matches, func(i, j int) bool {
+		return matches[i].Start < matches[j].Start
+	})
+
+	var buf strings.Builder
+	buf.Grow(len(text))
+	pos := 0
+	for _, m := range matches {
+		if m.End <= pos {
+			continue
+		} else if m.Start > pos {
+			buf.WriteString(text[pos:m.Start])
+			pos = m.Start
+		}
+		buf.WriteString(strings.Repeat(mask, utf8.RuneCountInString(text[pos:m.End])))
+		pos = m.End
+	}
+	buf.WriteString(text[pos:])
+
+	return buf.String()
+}
@@ -0,0 +1,199 @@
+package kgo
+
+import (
+	"strings"
+	"sync"
+	"testing"
+)
+
+func TestNewWordFilter(t *testing.T) {
+	wf := KStr.NewWordFilter("坏人", " ", "", "...", "Bad", "坏人")
+	if wf.Len() != 2 {
+		t.Error("NewWordFilter fail")
+		return
+	}
+
+	wf = KStr.NewWordFilter()
+	if wf.Len() != 0 || wf.Contains("坏人") || len(wf.FindAll("坏人")) != 0 {
+		t.Error("NewWordFilter empty fail")
+		return
+	}
+}
+
+func BenchmarkNewWordFilter(b *testing.B) {
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		KStr.NewWordFilter("坏人", "坏蛋", "bad", "evil")
+	}
+}
+
+func TestWordFilterFindAll(t *testing.T) {
+	wf := KStr.NewWordFilter("he", "she", "his", "hers", "坏人", "坏蛋")
+	res := wf.FindAll("ushers")
+	var words []string
+	for _, m := range res {
+		words = append(words, m.Word+":"+m.Text)
+	}
+	if strings.Join(words, ",") != "she:she,he:he,hers:hers" || res[0].Start != 1 || res[0].End != 4 {
+		t.Error("FindAll fail")
+		return
+	}
+
+	//全角、大小写和干扰字符
+	str := "你是个坏*人, ＳＨＥ说他是坏 蛋"
+	res = wf.FindAll(str)
+	if len(res) != 4 || res[0].Text != "坏*人" || str[res[0].Start:res[0].End] != "坏*人" ||
+		res[1].Word != "she" || res[1].Text != "ＳＨＥ" || res[2].Word != "he" || res[3].Text != "坏 蛋" {
+		t.Error("FindAll normalize fail")
+		return
+	}
+
+	if len(wf.FindAll("好人好事")) != 0 || len(wf.FindAll("")) != 0 {
+		t.Error("FindAll fail")
+		return
+	}
+}
+
+func BenchmarkWordFilterFindAll(b *testing.B) {
+	b.ResetTimer()
+	wf := KStr.NewWordFilter("he", "she", "his", "hers", "坏人", "坏蛋")
+	for i := 0; i < b.N; i++ {
+		wf.FindAll("你是个坏*人, ＳＨＥ说他是坏 蛋")
+	}
+}
+
+func TestWordFilterContains(t *testing.T) {
+	wf := KStr.NewWordFilter("赌博", "FREE MONEY")
+	if !wf.Contains("网上赌-博") || !wf.Contains("get free-money now") || wf.Contains("博彩赌场") {
+		t.Error("Contains fail")
+		return
+	}
+}
+
+func BenchmarkWordFilterContains(b *testing.B) {
+	b.ResetTimer()
+	wf := KStr.NewWordFilter("赌博", "FREE MONEY")
+	for i := 0; i < b.N; i++ {
+		wf.Contains("get free-money now")
+	}
+}
+
+func TestWordFilterBreak(t *testing.T) {
+	wf := KStr.NewWordFilter("法轮", "坏人", "bad guy", "ab")
+	var tests = []struct {
+		str      string
+		expected bool
+	}{
+		{"这是个好方法。轮子在转", false},
+		{"方法，轮子", false},
+		{"方法! 轮子", false},
+		{"坏\n人", false},
+		{"坏、人", false},
+		{"法 轮", true},
+		{"法　轮", true},
+		{"坏_人", true},
+		{"ＢＡＤ　ＧＵＹ", true},
+		{"bad. guy", true},
+		{"bad, guy", false},
+		{"bad-guy", true},
+		{"badguy", false},
+		{"a bc", false},
+		{"a-bc", false},
+		{"abc", true},
+	}
+	for _, test := range tests {
+		if actual := wf.Contains(test.str); actual != test.expected {
+			t.Errorf("Expected Contains(%q) to be %v, got %v", test.str, test.expected, actual)
+			return
+		}
+	}
+}
+
+func BenchmarkWordFilterBreak(b *testing.B) {
+	b.ResetTimer()
+	wf := KStr.NewWordFilter("法轮", "坏人", "bad guy")
+	for i := 0; i < b.N; i++ {
+		wf.Contains("这是个好方法。轮子在转,ＢＡＤ　ＧＵＹ")
+	}
+}
+
+func TestWordFilterReplace(t *testing.T) {
+	wf := KStr.NewWordFilter("坏人", "人渣", "bad")
+	var tests = []struct {
+		str      string
+		mask     string
+		expected string
+	}{
+		{"他是坏人", "*", "他是**"},
+		{"他是坏人渣", "*", "他是***"},
+		{"他是坏.人!", "", "他是***!"},
+		{"a BAD day", "#", "a ### day"},
+		{"好人好事", "*", "好人好事"},
+		{"", "*", ""},
+	}
+	for _, test := range tests {
+		actual := wf.Replace(test.str, test.mask)
+		if actual != test.expected {
+			t.Errorf("Expected Replace(%s, %s) to be %s, got %s", test.str, test.mask, test.expected, actual)
+			return
+		}
+	}
+}
+
+func BenchmarkWordFilterReplace(b *testing.B) {
+	b.ResetTimer()
+	wf := KStr.NewWordFilter("坏人", "人渣", "bad")
+	for i := 0; i < b.N; i++ {
+		wf.Replace("他是坏人渣", "*")
+	}
+}
+
+func TestWordFilterReload(t *testing.T) {
+	wf := KStr.NewWordFilter("坏人")
+	wf.Add("坏蛋", "")
+	if wf.Len() != 2 || !wf.Contains("坏蛋") {
+		t.Error("Add fail")
+		return
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			wf.Contains("坏人")
+			wf.Reload("恶人")
+		}()
+	}
+	wg.Wait()
+	if wf.Len() != 1 || wf.Contains("坏人") || !wf.Contains("恶人") {
+		t.Error("Reload fail")
+		return
+	}
+
+	ts, _ := KOS.NewTempSpace(nil)
+	defer func() {
+		_ = ts.Close()
+	}()
+
+	fpath, _ := ts.WriteFile("filter-*.txt", []byte("# comment\n坏人\n\n 坏蛋 \n"))
+	err := wf.ReloadFile(fpath)
+	if err != nil || wf.Len() != 2 || !wf.Contains("坏蛋") || wf.Contains("恶人") || wf.Contains("comment") {
+		t.Error("ReloadFile fail")
+		return
+	}
+
+	err = wf.ReloadFile(ts.Join("none/filter_words.txt"))
+	if err == nil || wf.Len() != 2 {
+		t.Error("ReloadFile fail")
+		return
+	}
+}
+
+func BenchmarkWordFilterReload(b *testing.B) {
+	b.ResetTimer()
+	wf := KStr.NewWordFilter()
+	for i := 0; i < b.N; i++ {
+		wf.Reload("坏人", "坏蛋", "bad", "evil")
+	}
+}
@@ -0,0 +1,285 @@
+package kgo
+
+import (
+	"errors"
+	"sort"
+	"strings"
+	"sync"
+	"unicode"
+	"unicode/utf8"
+)
+
+// pinyinTable 汉字及词组的拼音字典,拼音均为带声调数字的形式,如"zhong1"
+type pinyinTable struct {
+	sync.RWMutex
+	chars     map[rune]string     // 汉字 => 默认读音
+	phrases   map[string][]string // 词组 => 逐字读音
+	maxPhrase int                 // 词组的最大字数
+}
+
+var (
+	pinyinOnce sync.Once
+	pinyinDict *pinyinTable
+)
+
+// pinyinToneMarks 韵母对应的1-4声带声调符号字母
+var pinyinToneMarks = map[rune][4]rune{
+	'a': {'ā', 'á', 'ǎ', 'à'},
+	'e': {'ē', 'é', 'ě', 'è'},
+	'i': {'ī', 'í', 'ǐ', 'ì'},
+	'o': {'ō', 'ó', 'ǒ', 'ò'},
+	'u': {'ū', 'ú', 'ǔ', 'ù'},
+	'ü': {'ǖ', 'ǘ', 'ǚ', 'ǜ'},
+}
+
+// pinyinCombiningMarks 无韵母音节(如"m","n","hm")使用的1-4声组合声调符号
+var pinyinCombiningMarks = [4]rune{'̄', '́', '̌', '̀'}
+
+// getPinyinTable 获取拼音字典,首次调用时解析内置数据.
+func getPinyinTable() *pinyinTable {
+	pinyinOnce.Do(func() {
+		pinyinDict = newPinyinTable()
+	})
+	return pinyinDict
+}
+
+// newPinyinTable 根据内置数据创建拼音字典.
+func newPinyinTable() *pinyinTable {
+	pt := &pinyinTable{
+		chars:   make(map[rune]string, 21000),
+		phrases: make(map[string][]string),
+	}
+	for _, line := range strings.Split(pinyinCharData, "\n") {
+		fields := strings.Fields(line)
+		if len(fields) != 2 {
+			continue
+		}
+		for _, r := range fields[1] {
+			pt.chars[r] = fields[0]
+		}
+	}
+	for _, line := range strings.Split(pinyinPhraseData, "\n") {
+		fields := strings.Fields(line)
+		if len(fields) < 2 {
+			continue
+		}
+		pt.addPhrase(fields[0], fields[1:])
+	}
+
+	return pt
+}
+
+// addPhrase 添加词组读音,须持有写锁.
+func (pt *pinyinTable) addPhrase(phrase string, pys []string) {
+	pt.phrases[phrase] = pys
+	if n := len(pys); n > pt.maxPhrase {
+		pt.maxPhrase = n
+	}
+}
+
+// convert 将汉字序列转换为带声调数字的拼音,按正向最大匹配优先使用词组读音.
+func (pt *pinyinTable) convert(hans []rune) []string {
+	pt.RLock()
+	defer pt.RUnlock()
+
+	res := make([]string, 0, len(hans))
+	for i := 0; i < len(hans); {
+		matched := false
+		for l := pt.maxPhrase; l > 0; l-- {
+			if i+l > len(hans) {
+				continue
+			}
+			if pys, ok := pt.phrases[string(hans[i:i+l])]; ok {
+				res = append(res, pys...)
+				i += l
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			res = append(res, pt.chars[hans[i]])
+			i++
+		}
+	}
+
+	return res
+}
+
+// isHan 字典中是否有该汉字.
+func (pt *pinyinTable) isHan(r rune) bool {
+	_, ok := pt.chars[r]
+	return ok
+}
+
+// pinyinFormat 将带声调数字的拼音py转换为style风格.
+func pinyinFormat(py string, style LkkPinyinStyle) string {
+	if py == "" {
+		return ""
+	}
+
+	tone := 0
+	if last := py[len(py)-1]; last >= '1' && last <= '5' {
+		tone = int(last - '0')
+		py = py[:len(py)-1]
+	}
+
+	switch style {
+	case PINYIN_STYLE_FIRST_LETTER:
+		return py[:1]
+	case PINYIN_STYLE_TONE_NUM:
+		if tone >= 1 && tone <= 4 {
+			return py + string(rune('0'+tone))
+		}
+		return py
+	case PINYIN_STYLE_TONE:
+		return pinyinMarkTone(strings.Replace(py, "v", "ü", -1), tone)
+	default:
+		return py
+	}
+}
+
+// pinyinMarkTone 为不带声调的拼音标注声调符号.
+// 有a或e时标在其上,"ou"标在o上,否则标在最后一个韵母上.
+func pinyinMarkTone(py string, tone int) string {
+	if tone < 1 || tone > 4 {
+		return py
+	}
+
+	runes := []rune(py)
+	pos := -1
+	if i := strings.IndexAny(py, "ae"); i != -1 {
+		pos = utf8.RuneCountInString(py[:i])
+	} else if i := strings.Index(py, "ou"); i != -1 {
+		pos = utf8.RuneCountInString(py[:i])
+	} else {
+		for i := len(runes) - 1; i >= 0; i-- {
+			if _, ok := pinyinToneMarks[runes[i]]; ok {
+				pos = i
+				break
+			}
+		}
+	}
+
+	if pos == -1 {
+		//无韵母的音节,如"m","n","hm",声调标在第一个m或n上
+		for i, r := range runes {
+			if r == 'm' || r == 'n' {
+				return string(runes[:i+1]) + string(pinyinCombiningMarks[tone-1]) + string(runes[i+1:])
+			}
+		}
+		return py
+	}
+
+	runes[pos] = pinyinToneMarks[runes[pos]][tone-1]
+	return string(runes)
+}
+
+// pinyinConvert 将字符串转换为拼音列表.
+func pinyinConvert(str string, style LkkPinyinStyle) []string {
+	pt := getPinyinTable()
+	res := make([]string, 0, utf8.RuneCountInString(str))
+
+	var hans []rune
+	var other strings.Builder
+	flushHans := func() {
+		for _, py := range pt.convert(hans) {
+			res = append(res, pinyinFormat(py, style))
+		}
+		hans = hans[:0]
+	}
+	flushOther := func() {
+		if other.Len() > 0 {
+			res = append(res, other.String())
+			other.Reset()
+		}
+	}
+
+	for _, r := range str {
+		if pt.isHan(r) {
+			flushOther()
+			hans = append(hans, r)
+			continue
+		}
+
+		flushHans()
+		if unicode.IsSpace(r) || unicode.IsPunct(r) || unicode.IsSymbol(r) {
+			flushOther()
+		} else {
+			other.WriteRune(r)
+		}
+	}
+	flushHans()
+	flushOther()
+
+	return res
+}
+
+// Pinyin 将字符串转换为拼音,每个汉字对应结果中的一个元素,多音字优先按词组读音转换.
+// 非汉字部分按空白和标点分割后,每段作为一个元素原样保留,空白和标点被忽略;
+// 拼音字典未收录的汉字(如扩展A/B区的生僻字)视为非汉字部分原样保留.
+func (ks *LkkString) Pinyin(str string, style LkkPinyinStyle) []string {
+	return pinyinConvert(str, style)
+}
+
+// PinyinJoin 将字符串转换为拼音,并以sep连接成字符串.
+// 如PinyinJoin("重庆", PINYIN_STYLE_FIRST_LETTER, "")返回"cq".
+func (ks *LkkString) PinyinJoin(str string, style LkkPinyinStyle, sep string) string {
+	return strings.Join(pinyinConvert(str, style), sep)
+}
+
+// AddPinyinPhrase 添加或覆盖词组的读音,用于纠正多音字.
+// pinyin为以空格分隔的逐字拼音,使用数字1-5表示声调(5为轻声,可省略),ü写作v,如"chong2 qing4".
+func (ks *LkkString) AddPinyinPhrase(phrase, pinyin string) error {
+	pys := strings.Fields(strings.ToLower(pinyin))
+	if phrase == "" || utf8.RuneCountInString(phrase) != len(pys) {
+		return errors.New("The number of syllables does not match the phrase: " + phrase)
+	}
+
+	pt := getPinyinTable()
+	for _, r := range phrase {
+		if !pt.isHan(r) {
+			return errors.New("Phrase contains non-chinese character: " + string(r))
+		}
+	}
+	for i, py := range pys {
+		body := strings.TrimRight(py, "12345")
+		if body == "" || len(py)-len(body) > 1 || strings.Trim(body, "abcdefghijklmnopqrstuvwxyz") != "" {
+			return errors.New("Invalid pinyin syllable: " + py)
+		}
+		if body == py {
+			pys[i] = py + "5"
+		}
+	}
+
+	pt.Lock()
+	pt.addPhrase(phrase, pys)
+	pt.Unlock()
+
+	return nil
+}
+
+// PinyinSort 按拼音对字符串切片进行升序排序(稳定排序),适用于中文姓名等的排序.
+// 先按不带声调的拼音比较,相同时再比较声调.
+func (ks *LkkString) PinyinSort(strs []string) {
+	type sortKey struct {
+		normal, tone string
+	}
+
+	keys := make(map[string]sortKey, len(strs))
+	for _, str := range strs {
+		if _, ok := keys[str]; !ok {
+			keys[str] = sortKey{
+				normal: strings.ToLower(strings.Join(pinyinConvert(str, PINYIN_STYLE_NORMAL), " ")),
+				tone:   strings.Join(pinyinConvert(str, PINYIN_STYLE_TONE_NUM), " "),
+			}
+		}
+	}
+
+	sort.SliceStable(strs, func(i, j int) bool {
+		ki, kj := keys[strs[i]], keys[strs[j]]
+		if ki.normal != kj.normal {
+			return ki.normal < kj.normal
+		}
+		return ki.tone < kj.tone
+	})
+}
@@ -0,0 +1,1678 @@
+package kgo
+
+// pinyinCharData 汉字拼音字典,每行为"带声调数字的拼音 汉字列表",声调5为轻声.
+// 覆盖CJK统一汉字基本区(U+4E00-U+9FA5)及"〇",多音字取其最常用读音.
+const pinyinCharData = `
+a1 啊锕阿
+a2 嗄
+ai1 哀哎唉嗳噯埃娭挨欸溾銰鎄锿
+ai2 啀嘊捱敱敳溰癌皑皚騃
+ai3 娾昹毐濭矮蔼藹躷霭靄
+ai4 伌僾叆嗌塧壒嫒嬡愛懓懝暧曖爱瑷璦皧瞹砹硋碍礙艾薆譪譺鑀閡隘靉餲馤鱫鴱
+an1 侒媕安峖庵桉氨痷盦盫腤菴萻葊蓭誝諳谙鞌鞍韽馣鵪鶕鹌
+an2 儑啽玵雸
+an3 俺唵垵埯揞罯銨铵隌
+an4 堓婩岸按晻暗案洝犴胺荌豻錌闇鮟黯
+ang1 肮骯
+ang2 卬岇昂昻
+ang4 枊盎醠
+ao1 凹柪梎爊軪
+ao2 厫嗷嗸嶅廒摮敖滶熬獒獓璈磝翱翶翺聱蔜螯謷謸遨鏖隞鰲鳌鷔鼇
+ao3 媪媼抝拗芺袄襖镺
+ao4 傲坳垇墺奡奥奧嫯岙岰嶴慠懊扷擙澳鏊隩驁骜
+ba1 仈八叭哵夿岜峇巴巼扒捌朳柭玐疤笆粑羓芭蚆豝釛釟鲃
+ba2 叐坺墢妭抜拔炦犮癹胈茇菝詙跋軷颰魃鼥
+ba3 把鈀钯靶
+ba4 坝垻壩弝欛灞爸矲罢罷耙覇跁霸鮊鲅鲌
+ba5 吧紦魞
+bai1 挀掰擘
+bai2 白
+bai3 佰捭摆擺柏栢瓸百粨絔襬
+bai4 庍拜拝敗猈稗竡粺薭蛽贁败韛
+ban1 扳搬攽斑斒班瘢癍般螌褩辬頒颁鳻
+ban3 坂岅昄板版瓪粄舨蝂鈑钣闆阪魬
+ban4 伴办半坢姅怑扮拌柈湴瓣秚絆绊螁辦鉡靽
+bang1 垹帮幇幚幫捠梆浜縍邦邫鞤
+bang3 榜牓綁绑膀髈
+bang4 傍塝搒棒棓玤磅稖艕蒡蚌蜯謗谤鎊镑
+bao1 勹包孢枹煲笣胞苞蕔褒襃闁齙龅
+bao2 嫑窇薄雹
+bao3 佨保堡堢媬宝宲寚寳寶怉珤緥葆藵褓賲靌飽饱駂鳵鴇鸨
+bao4 儤勽報忁报抱暴曓爆菢虣蚫袌豹趵鉋鑤铇靤骲髱鮑鲍鸔
+bei1 卑悲揹杯桮椑盃碑禆藣錃陂鵯鹎
+bei3 北鉳
+bei4 俻倍偝偹備僃备孛悖惫愂憊昁梖焙牬犕狈狽珼琲碚禙糒背苝蓓被褙誖貝贝軰輩辈邶郥鄁鋇鐾钡鞁鞴骳
+bei5 呗唄
+ben1 奔栟泍犇贲錛锛
+ben3 奙本楍畚翉苯
+ben4 倴坋坌捹撪桳渀獖笨輽逩
+beng1 伻傰嘣奟崩嵭痭祊絣綳閍
+beng2 甭
+beng3 埄埲琣琫繃绷菶鞛
+beng4 塴揼泵甏蠯蹦迸逬鏰镚
+bi1 偪屄楅毴螕豍逼鎞鰏鲾鵖
+bi2 荸鼻
+bi3 佊俾匕吡啚夶妣彼朼柀比沘疕秕笔筆箄粃聛舭貏鄙
+bi4 佖匂哔嗶坒堛壁奰妼婢嬖嬶币幣幤庇庳廦弊弻弼彃必怭怶愊愎敝斃枈柲梐毕毖毙湢滗滭潷濞煏熚狴獘獙珌璧畀畢疪痹痺皕睤碧笓筚箅箆篦篳粊綼縪繴罼腷臂苾荜萆萞蓖蓽蔽薜蜌袐裨襅襞襣觱詖诐貱賁贔赑跸蹕躃躄避邲鄨鄪鉍鏎鐴铋閇閉閟闭陛鞸韠飶饆馝駜驆髀髲魓鮅鷝鷩鼊
+bian1 揙煸牑猵甂砭笾箯籩編编蝙边辺邉邊鍽鞭鯾鯿鳊
+bian3 匾惼扁碥稨窆糄萹藊褊貶贬鴘
+bian4 便匥卞变変峅弁徧忭抃昪汳汴炞玣緶缏艑苄覍變辡辧辨辩辫辮辯遍釆閞
+biao1 儦墂幖彪摽杓标標淲滮瀌灬熛爂猋瘭磦穮脿膘臕蔈藨謤贆鏢鑣镖镳颩颮颷飆飇飈飊飑飙飚驃驫骉骠髟
+biao3 婊檦表裱褾諘錶
+biao4 俵鰾鳔
+bie1 憋虌蟞鱉鳖鼈龞
+bie2 別别咇徶莂蛂襒蹩
+bie3 瘪癟
+bie4 彆
+bin1 傧儐宾彬斌梹椕槟檳氞汃滨濒濱濵瀕玢瑸璸砏繽缤虨豩豳賓賔邠鑌镔霦顮
+bin4 摈擯殡殯膑臏髌髕髩鬂鬓鬢
+bing1 仌仒兵冫冰掤氷
+bing3 丙怲抦摒昞昺柄炳眪禀秉稟窉苪蛃邴鈵鉼陃鞞餅餠饼
+bing4 並併倂偋傡垪寎并幷庰栤棅病竝誁鋲靐鞆鮩
+bo1 僠剝剥哱啵嶓帗拨撥播波玻癶癷盋砵碆紴缽菠袚袰蹳鉢钵餑饽驋鮁鱍
+bo2 亳仢伯侼僰勃博嚗帛愽懪挬搏欂泊浡渤煿牔犦犻狛猼瓝瓟礡礴秡箔簙肑胉脖膊舶艊苩萡葧蔔袯袹襏襮豰踣郣鈸鉑鋍鎛鑮钹铂镈餺馎馛馞駁駮驳髆髉鵓鹁
+bo3 箥簸跛
+bo4 孹檗糪蘗譒
+bu1 峬庯晡誧逋鈽
+bu2 轐醭鳪
+bu3 卜卟哺喸捕补補鵏
+bu4 不佈勏吥咘埗埠布怖悑抪捗柨步歨歩瓿篰簿荹蔀踄部郶钚钸餔餢
+ca1 嚓擦攃
+ca3 礤
+ca4 囃遪
+cai1 偲婇猜
+cai2 才材溨犲纔裁財财
+cai3 倸啋寀彩採毝睬綵跴踩采
+cai4 埰棌縩菜蔡
+can1 傪参參叄叅喰嬠湌飡餐驂骖
+can2 嬱惭慙慚残殘蚕蝅蠶蠺
+can3 惨慘憯朁穇篸黪黲
+can4 儏孱澯灿燦爘璨粲薒謲
+cang1 仓仺伧倉傖嵢沧滄獊舱艙苍蒼螥鶬鸧
+cang2 藏鑶
+cang4 欌濸罉賶
+cao1 撡操糙
+cao2 嘈嶆曹曺槽漕艚蓸螬褿鏪
+cao3 愺懆艸艹草騲
+cao4 肏襙鄵
+ce4 侧側冊册厕厠墄廁恻惻憡拺敇测測畟笧策筞筴箣簎粣萗萴蓛
+cen1 嵾
+cen2 岑梣涔笒
+ceng1 噌曽
+ceng2 层層嶒曾竲驓
+ceng4 蹭
+cha1 偛叉嗏扠挿插揷杈疀肞臿艖銟鍤锸餷馇
+cha2 垞察嵖搽查槎檫猹碴秅茬茶詧靫
+cha3 衩蹅鑔镲
+cha4 侘奼姹岔差汊紁詫诧
+chai1 拆芆釵钗
+chai2 侪儕喍柴祡豺齜
+chai3 茝
+chai4 囆瘥虿蠆袃訍
+chan1 幨掺搀摻攙梴裧襜覘觇辿鉆鋓
+chan2 僝儃儳劖嚵壥婵嬋巉廛棎欃毚湹潹潺澶瀍瀺煘獑磛禅禪緾纏纒缠艬蝉蟬蟾誗讒谗躔鄽酁鋋鑱镡镵饞馋
+chan3 丳产冁刬剗剷啴嘽囅嵼幝摌斺旵浐滻灛燀產産簅繟蒇蕆諂譂讇谄辴鏟铲閳闡阐骣
+chan4 忏懴懺摲硟羼韂顫颤
+chang1 伥倀娼昌晿淐猖琩菖裮錩锠閶阊鯧鲳鼚
+chang2 仧偿償兏嘗嚐塲嫦尝常徜瑺瓺甞肠腸膓苌萇裳鋿鏛長镸长鱨鲿
+chang3 僘厂厰场場廠惝敞昶椙氅蟐鋹
+chang4 倡唱怅悵暢焻玚瑒畅畼誯韔鬯
+chao1 勦弨怊抄欩焯訬超鈔钞
+chao2 嘲巢巣晁朝樔漅潮牊窲罺謿轈鄛鼂鼌
+chao3 吵巐炒焣煼眧麨
+chao4 仦仯耖觘
+che1 伡俥唓砗硨莗蛼車车
+che3 偖扯撦
+che4 勶坼屮彻徹掣撤澈烢爡瞮硩聅迠頙
+chen1 嗔抻捵琛瞋綝縝諃謓賝郴
+chen2 塵宸尘忱愖揨敐晨曟樄沉烥煁瘎臣茞莀莐蔯薼螴訦諶谌軙辰迧鈂陈陳霃鷐麎
+chen3 墋夦硶碜磣贂趻踸醦鍖
+chen4 儬儭嚫榇櫬疢衬襯讖谶趁趂齓齔龀
+cheng1 偁僜憆摚撐撑柽棦橕檉泟浾湞爯牚琤瞠称稱穪竀緽蛏蟶赪赬鏳鏿阷靗頳饓
+cheng2 丞乗乘呈城埕堘塍塖娍宬峸惩憕懲成承挰掁晟朾枨棖椉橙檙洆溗澂澄瀓珵珹畻碀程窚筬絾脀脭荿裎誠诚郕酲鋮铖騬鯎
+cheng3 侱庱徎悜睈逞騁骋
+cheng4 秤
+chi1 侙吃哧喫嗤噄媸彨彲摛瓻痴癡眵瞝笞絺胵蚩螭訵誺魑鴟鸱黐齝
+chi2 匙坻墀岻弛持歭池漦竾筂箎篪茌荎蚳謘貾赿趍踟迟遅遟遲馳驰
+chi3 侈卶叺呎垑尺恥欼歯粎耻胣蚇袲袳裭褫鉹齒齿
+chi4 傺勅勑叱啻妛彳恜慗憏懘抶敕斥杘湁灻炽烾熾痓痸瘈瘛硳翄翅翤翨腟赤趩跮遫鉓銐雴飭饎饬鶒鷘麶
+chong1 充冲嘃徸忡憃憧摏沖浺珫罿翀舂艟茺衝蹖
+chong2 崇崈爞緟虫蝩蟲褈隀
+chong3 埫宠寵
+chong4 揰銃铳
+chou1 婤抽搊犨犫瘳篘
+chou2 仇俦儔嚋嬦帱幬怞惆愁懤栦椆燽畴疇皗稠筹籌紬絒綢绸菗薵裯讎讐踌躊酧酬醻雔雠
+chou3 丑丒侴偢吜杻杽瞅矁醜魗
+chou4 殠臭臰遚
+chu1 出初岀摴樗貙齣
+chu2 刍厨媰幮廚橱櫉櫥滁犓篨耡芻蒢蒭蕏藸蜍豠趎蹰躇躕鉏鋤锄除雏雛鶵
+chu3 储儲処杵椘楚楮榋橻檚濋璴础礎蟵褚鸀齭齼
+chu4 亍俶傗儊嘼埱处怵憷拀搐敊斶柷欪歜滀珿琡畜矗竌竐絀绌臅蓫處触觸諔豖踀鄐閦黜
+chua1 欻歘
+chuai1 揣搋
+chuai2 膗
+chuai4 膪踹
+chuan1 剶巛川氚猭瑏穿
+chuan2 伝传傳圌暷椽篅舡舩船輲遄
+chuan3 僢喘歂舛荈踳
+chuan4 串汌玔賗釧钏鶨
+chuang1 刅摐牎牕疮瘡窓窗窻
+chuang2 噇幢床牀
+chuang3 傸摤磢闖闯
+chuang4 凔创刱剏剙創怆愴
+chui1 吹炊
+chui2 倕垂埀捶搥棰槌箠腄菙錘鎚锤陲顀
+chui4 龡
+chun1 堾媋旾春暙杶椿橁櫄瑃箺膥萅蝽輴鰆鶞
+chun2 唇浱淳湻滣漘犉純纯脣莼蒓蓴醇醕錞陙鯙鶉鹑
+chun3 偆惷睶萶蠢賰
+chuo1 戳踔逴
+chuo4 啜嚽娕娖婼惙擉歠涰磭綽繛绰腏趠輟辍辵辶酫鑡齪龊
+ci1 偨呲疵縒蠀赼趀跐骴髊齹
+ci2 垐堲嬨慈柌濨珁瓷甆磁礠祠糍茈茨薋詞词辝辞辤辭雌飺餈鴜鶿鷀鹚
+ci3 佌此泚玼皉紪鮆
+ci4 佽刺刾庛朿栨次絘茦莿蛓螆賜赐
+cong1 匆囪囱忩怱悤暰枞棇樅樬漗焧熜燪瑽璁瞛篵緫繱聡聦聪聰苁茐葱蓯蔥蟌鍯鏦騘驄骢
+cong2 丛从叢婃孮従徖從悰慒樷欉淙漎潀潨灇爜琮藂誴賨賩
+cong4 憁謥
+cou4 凑湊腠輳辏
+cu1 粗觕麁麄麤
+cu2 徂殂
+cu4 促噈憱猝瘄瘯簇縬脨蔟誎趗踧蹙蹴蹵酢醋顣鼀
+cuan1 撺攛汆蹿躥鋑鑹镩
+cuan2 巑櫕欑穳
+cuan4 殩熶爨窜竄篡簒
+cui1 催凗墔崔嶉慛摧榱槯獕磪縗缞鏙
+cui3 漼璀皠趡
+cui4 乼伜倅啐啛忰悴毳淬濢焠疩瘁竁粋粹紣綷翆翠脃脆脺膬膵臎萃襊顇
+cun1 村澊皴竴踆邨
+cun2 侟存拵
+cun3 刌忖
+cun4 吋寸籿
+cuo1 搓撮瑳磋蹉遳醝
+cuo2 嵯嵳痤睉矬蒫蔖虘躦酂鹺鹾
+cuo3 脞
+cuo4 剉剒厝夎挫措斮棤歵莝莡蓌逪銼錯锉错
+da1 咑哒嗒噠搭撘笚耷荅褡鎝
+da2 剳匒呾妲怛沓炟畗畣笪答羍荙薘蟽詚躂达迖逹達鎉鐽阘靼鞑韃龖龘
+da3 打
+da4 大汏眔
+da5 垯墶燵瘩繨
+dai1 呆呔懛獃
+dai3 傣歹逮
+dai4 代叇垈埭岱帒带帯帶廗待怠戴曃柋殆瀻玳瑇甙簤紿緿绐艜袋襶貸贷蹛軑軚軩轪迨霴靆骀鮘鴏黛黱
+dan1 丹儋勯匰单単單妉媅担擔殚殫甔瘅癉眈砃箪簞耼耽聃聸褝襌躭郸鄲頕
+dan3 亶伔刐抌掸撢撣澸玬瓭疸紞胆膽衴赕黕黮
+dan4 但僤啖啗啿嘾噉嚪帎弹弾彈惮憚憺旦暺柦氮沊泹淡澹狚疍癚禫窞繵腅萏蓞蛋蜑觛誕诞贉霮饏馾駳髧鴠
+dang1 噹当澢珰璫當筜簹艡蟷裆襠鐺铛
+dang3 党挡擋攩欓灙譡讜谠黨
+dang4 儅凼圵垱壋婸宕嵣愓档檔氹潒璗瓽盪瞊砀碭礑簜荡菪蕩蘯趤逿闣雼
+dao1 刀刂叨忉捯朷氘舠釖魛鱽
+dao3 壔导導岛島嶋嶌嶹捣搗擣祷禂禱蹈隝隯
+dao4 倒到噵屶悼椡槝檤焘燾瓙盗盜稲稻箌纛翢翿菿衜衟軇道陦
+de1 嘚
+de2 得徳德恴惪棏淂鍀锝
+de5 的脦
+den4 扥扽
+deng1 噔嬁灯燈璒登竳簦覴豋蹬
+deng3 戥朩等
+deng4 凳墱嶝櫈瞪磴艠邓鄧鐙镫隥
+di1 仾低堤奃彽樀氐滴磾羝袛趆鍉镝隄鞮
+di2 唙嘀嚁嫡廸敌敵梑涤滌狄笛篴籴糴翟苖荻蔋蔐藡覿觌豴蹢迪鏑靮頔馰髢鬄鸐
+di3 厎呧坘埞底弤抵拞掋柢牴砥聜茋菧觝詆诋軧邸阺骶鯳
+di4 俤偙僀啇啲地坔埊墑墬娣媂嶳帝弟怟慸摕旳杕梊棣渧焍玓珶甋眱睇碲祶禘第締缔腣菂蒂蔕蝃螮諦谛踶递逓遞遰釱鉪
+dia3 嗲
+dian1 傎厧嵮巅巓巔掂攧敁槇槙滇甸瘨癫癲蹎顚顛颠齻
+dian3 典嚸奌婰敟点猠碘蒧蕇跕踮點
+dian4 佃坫垫墊壂奠婝店惦扂椣橂橝殿淀澱玷琔电癜簟蜔钿阽電靛驔
+diao1 凋刁叼奝弴彫殦汈琱瞗碉虭蛁貂雕鮉鯛鲷鳭鵰鼦
+diao3 屌扚
+diao4 伄吊弔掉瘹窎窵竨簓蓧藋訋調调釣銱鋽鑃钓铞铫雿魡
+die1 爹褺跌
+die2 叠哋喋垤堞峌嵽幉恎惵戜挕揲昳曡殜氎牃牒瓞畳疂疉疊眣眰碟絰绖耊耋胅臷艓苵蜨蝶褋詄諜谍趃蹀迭镻鰈鲽
+ding1 丁仃叮帄玎疔盯耵虰酊釘钉靪
+ding3 奵嵿濎薡鐤頂顶鼎鼑
+ding4 啶定忊椗矴碇碠磸聢腚萣蝊訂订鋌錠铤锭顁飣饤
+diu1 丟丢銩铥
+dong1 东倲冬咚埬娻岽崠崬徚昸東氡氭涷笗苳菄蝀鮗鯟鴤鶇鶫鸫鼕
+dong3 墥嬞懂箽董蕫諌
+dong4 侗働冻凍动動垌姛峒恫戙挏栋棟洞湩硐絧胨胴腖迵霘駧
+dou1 兜兠吺唗橷篼蔸都
+dou3 唞抖枓枡蚪鈄阧陡
+dou4 乧斗斣梪毭浢痘窦竇脰艔荳豆逗郖酘閗闘餖饾鬥鬦鬪鬬鬭
+du1 剢厾嘟督醏闍阇
+du2 凟匵嬻椟櫝殰毒涜渎瀆牍牘犊犢独獨瓄皾碡蝳裻読讀讟读豄贕錖鑟韇韣韥騳髑黩黷
+du3 堵帾琽睹笃篤覩賭赌
+du4 妒妬度杜殬渡秺簵肚芏荰螙蠧蠹鍍镀靯
+duan1 偳剬媏端耑褍鍴
+duan3 短
+duan4 塅断斷椴段毈煅瑖碫簖籪緞缎腶葮襨躖鍛锻
+dui1 垖堆塠嵟痽磓鐜頧鴭
+dui4 兊兌兑对対對怼憝憞懟濧瀩碓祋綐薱譈鐓镦队陮隊
+dun1 吨噸墩墪惇撉撴敦橔犜獤礅蜳蹲蹾驐
+dun3 盹趸躉
+dun4 伅囤庉楯沌潡炖燉盾砘碷踲逇遁遯鈍钝頓顿
+duo1 剟咄哆嚉多夛崜掇敠毲畓裰
+duo2 凙剫喥夺奪悳敓敚敪痥踱鈬鐸铎鮵
+duo3 亸哚嚲垛垜埵奲憜挅挆朵朶枤椯綞缍趓躱躲軃鍺
+duo4 刴剁堕墮墯尮嶞惰柁柮桗炨舵跢跥跺陊陏飿饳鵽
+e1 妸妿娿婀屙痾钶
+e2 俄吪囮娥峨峩涐珴皒睋磀莪蛾訛誐譌讹迗鈋锇隲頟額额魤鰪鵝鵞鹅
+e3 噁枙砈頋騀
+e4 偔僫匎卾厄呃呝咢咹噩垩堊堮姶屵岋峉崿廅恶悪惡愕戹扼搤搹擜櫮歞歺湂琧砐砨硆礘腭苊萼蕚蚅蝁覨詻諤讍谔豟貖軛軶轭遌遏鄂鈪鍔鑩锷閼阏阨阸頞顎颚餓餩饿魥鰐鱷鳄鵈鶚鹗齃齶
+ei1 誒诶
+en1 奀恩煾蒽
+en3 峎
+en4 摁
+eng1 鞥
+er2 侕儿児兒唲峏栭洏粫而聏胹荋袻輀轜陑隭髵鮞鲕鴯鸸
+er3 厼尒尓尔栮毦洱爾珥耳薾趰迩邇铒餌饵駬
+er4 二佴刵咡弍弐樲衈誀貮貳贰鉺
+fa1 傠发彂沷発發酦醱
+fa2 乏伐垡姂栰橃浌疺瞂砝筏罚罰罸茷藅閥阀
+fa3 佱法灋
+fa4 珐琺蕟鍅髪髮
+fan1 勫噃嬏帆幡憣旙旛番籓繙翻蕃藩訉轓颿飜鱕
+fan2 凡凢凣墦忛杋柉棥樊橎渢瀪瀿烦煩燔璠矾礬笲籵緐繁羳膰舧薠蘩蠜襎蹯鐇鐢钒鷭
+fan3 反払返釩
+fan4 奿婏嬎梵氾汎泛滼犯畈盕笵範舤范販贩軓軬飯飰饭
+fang1 匚坊方枋汸淓牥芳蚄邡鈁钫鴋
+fang2 埅妨房肪防魴鰟鲂
+fang3 仿倣彷旊昉昘瓬眆紡纺舫訪访髣鶭
+fang4 放趽
+fang5 堏錺
+fei1 啡妃婓婔扉暃渄猆緋绯菲蜚裶霏非靟飛飝飞餥馡騑騛鲱
+fei2 淝肥腓蜰蟦
+fei3 匪奜悱斐朏棐榧篚翡蕜誹诽
+fei4 俷剕厞吠屝废廃廢昲曊杮櫠沸濷狒疿痱癈肺胇芾萉費费鐨镄陫靅鯡鼣
+fen1 兝兺分吩哛帉昐朆棻氛燓紛纷翂芬衯訜酚鈖雰餴饙
+fen2 坟墳妢岎幩朌枌梤棼橨汾濆炃焚燌羒羵肦蒶蕡蚠蚡豮豶轒鐼隫馚馩魵黂鼖鼢
+fen3 粉黺
+fen4 份偾僨奋奮弅忿愤憤瀵秎竕粪糞膹躮鱝鲼
+feng1 丰仹偑僼凨凬凮妦寷封峯峰崶枫桻楓檒沣沨灃烽犎猦疯瘋盽砜碸篈葑蘴蜂蠭豐鄷酆鋒鏠锋闏霻靊風飌风麷
+feng2 冯堸夆捀摓浲漨綘艂逢馮
+feng3 唪覂諷讽
+feng4 俸凤奉湗溄焨煈琒甮縫缝蘕賵赗鎽鳯鳳鴌
+fiao4 覅
+fo2 仏佛坲梻
+fou1 紑裦
+fou3 否妚殕缶缹缻雬鴀
+fu1 伕呋垺夫妋姇娐孵尃怤懯敷旉柎玞痡砆稃筟糐紨綒肤膚荂荴衭豧趺跗邞鄜鈇鳺麩麬麱麸
+fu2 乀伏俘冹凫刜匐咈哹垘孚岪巿幅幞弗彿怫扶拂服枎柫栿桴棴榑氟泭洑浮涪澓炥烰玸琈甶畉畐癁砩祓福稪符笰箙粰紱紼絥綍绂绋罘罦翇艀艴芙芣苻茀茯莩菔葍虙蚨蜉蝠袱襆諨踾輻辐郛鉘鉜韍韨颫髴鮄鳧鴔鵩鶝黻
+fu3 乶俌俛俯呒嘸府弣抚拊捬撨撫斧滏焤甫盙簠胕脯腐腑蜅輔辅郙釜釡頫鬴鳬黼
+fu4 付偩傅冨副咐圑坿复妇婦媍嬔富峊復椱父祔秿竎緮縛缚腹萯蕧蚥蚹蛗蝜蝮袝複褔覆訃詂讣負賦賻负赋赙赴輹鍑鍢阜阝附陚馥駙驸鮒鰒鲋鳆
+fu5 椨禣覄酜鮲
+ga1 呷嘎嘠旮
+ga2 噶尜錷钆
+ga3 尕玍
+ga4 尬魀
+gai1 侅垓姟峐晐畡祴絯荄該该豥賅赅郂陔
+gai3 忋改絠
+gai4 丐乢匃匄戤摡杚概槩槪溉漑瓂盖葢蓋賌鈣钙阣隑
+gan1 乹亁凲坩尲尴尶尷干忓攼杆柑泔漧玕甘疳矸竿筸粓肝芉苷迀酐魐鳱
+gan3 仠感扞擀敢桿橄澉皯秆稈笴簳衦赶趕鰔鱤鳡
+gan4 倝凎幹旰榦檊汵淦灨盰紺绀詌贑贛赣骭
+gang1 冈冮刚剛堈堽岡掆杠棡牨犅疘矼綱纲缸罁罓罡肛釭鋼鎠钢
+gang3 岗崗港
+gang4 戅戆槓焵筻
+gao1 槔槹橰櫜滜皋皐睾篙糕羔羙膏臯餻高髙鷎鷱鼛
+gao3 夰搞暠杲槀槁檺稾稿縞缟菒藁藳镐
+gao4 勂叝吿告煰祮祰禞筶誥诰郜鋯锆韟
+ge1 仡割咯哥圪戈戓戨搁擱歌滒牫牱犵疙纥肐胳袼謌鎶鴐鴚鴿鸽
+ge2 佮匌呄嗝塥愅挌搿敋格槅滆獦膈臵茖葛蛒裓觡諽輵轕镉閣閤阁隔革鞈鞷韐韚騔骼鬲鮯
+ge3 哿舸
+ge4 个個各嗰彁櫊硌箇虼铬
+gei3 給给
+gen1 根跟
+gen2 哏
+gen4 亘亙揯艮茛
+geng1 刯庚搄浭焿畊絚緪縆羮羹耕菮賡赓鶊鹒
+geng3 哽埂峺挭梗綆绠耿莄郠骾鯁鲠
+geng4 堩掶暅更椩
+gong1 供公功匑厷塨宫宮工幊弓恭愩慐攻杛熕玜碽糼肱蚣觥觵躬躳髸龏龔龚
+gong3 巩廾拱拲栱汞珙輁鋛鞏
+gong4 共唝羾莻貢贡
+gou1 佝勾沟溝篝緱缑袧褠鈎鉤钩鞲韝
+gou3 岣枸狗玽笱耇耈耉芶苟蚼豿
+gou4 冓坸垢够夠姤媾彀搆撀构構煹茩覯觏訽詬诟購购遘雊
+gu1 估傦呱咕唂姑嫴孤峠柧橭沽泒笟箍箛篐罛苽菇菰蛄觚軱軲轱辜逧酤鈲鮕鴣鸪
+gu2 鶻
+gu3 古唃啒嘏夃尳愲扢榖榾毂汩淈濲瀔牯皷皼盬瞽穀糓縎罟羖股脵臌蓇薣蛊蛌蠱詁诂谷轂鈷钴餶馉骨鹄鹘鼓鼔
+gu4 僱凅固堌崓崮故梏棝牿痼祻稒錮锢雇顧顾鯝鲴
+gua1 刮劀栝歄煱瓜緺聒胍趏踻銽颳騧鴰鸹
+gua3 冎剐剮叧寡
+gua4 卦啩坬挂掛絓罣罫褂詿诖颪
+guai1 乖掴摑
+guai3 拐枴柺箉
+guai4 叏夬怪恠
+guan1 倌关冠官棺瘝癏窤蒄覌観觀观関闗關鰥鱞鳏
+guan3 琯痯筦管舘莞輨錧館馆鳤
+guan4 丱悹悺惯慣掼摜樌毌泴涫潅灌爟瓘盥矔礶祼罆罐貫贯遦鏆鑵雚鱹鸛鹳
+guang1 侊僙光咣垙姯桄洸灮炗炛烡胱茪輄銧黆
+guang3 广広廣犷獷臩
+guang4 俇撗欟炚珖臦逛
+gui1 亀圭妫媯嫢嬀巂帰廆归摫椝槻槼櫷歸珪瑰璝瓌皈硅窐胿膭茥螝袿規规邽郌閨闺騩鬶鬹鮭鲑龜龟
+gui3 佹匦匭厬垝姽宄庋庪恑攱晷朹氿湀癸瞡祪簋蛫蟡觤詭诡軌轨陒鬼
+gui4 刽刿劊劌匱嶡撌攰昋柜桂桧椢槶檜櫃猤癐瞶禬筀簂蓕襘貴贵跪鞼鱖鱥鳜
+gun3 丨惃滚滾磙緄绲蓘蔉衮袞袬輥辊鮌鯀鲧
+gun4 棍璭睔睴謴
+guo1 呙咼嘓埚堝墎崞彉彍濄瘑蝈蟈郭鈛鍋锅
+guo2 囯囶囻国圀國帼幗慖漍聝腘膕蔮虢馘
+guo3 惈果椁槨淉猓粿綶菓蜾裹輠錁鐹餜馃
+guo4 过過
+guo5 啯
+ha1 哈铪
+ha2 蛤
+ha3 奤
+ha4 丷
+hai1 咍嗨
+hai2 孩还還頦骸
+hai3 海烸胲酼醢
+hai4 亥嗐嚡塰妎害氦餀饚駭骇
+han1 佄嫨憨歛炶蚶谽酣頇顸馠鼾
+han2 函凾含咁唅圅娢寒崡嵅晗梒浛涵澏焓琀甝筨肣虷蜬邗邯鋡韓韩魽
+han3 丆厈喊浫罕蔊豃阚鬫
+han4 傼兯哻垾屽岾悍憾捍撖撼旱晘晥暵汉汗涆漢瀚焊熯爳猂皔睅翰莟菡蘫蛿蜭螒譀貋釬銲鋎閈闬雗頷顄颔馯駻鶾
+hang1 夯
+hang2 斻杭珩笐筕絎绗航苀蚢貥迒頏颃魧
+hang4 垳沆
+hao1 嚆茠蒿薅薧
+hao2 儫嗥嘷噑嚎壕椃毜毫濠獆獋獔籇蚝蠔諕譹豪
+hao3 好郝
+hao4 傐号哠峼恏悎昊昦晧暤暭曍浩淏滈澔灏灝皓皜皞皡皥秏竓耗聕薃號鄗鎬顥颢鰝
+he1 呵喝嗬抲欱蠚訶诃
+he2 何劾厒合咊和哬啝姀峆惒敆曷柇核楁毼河涸渮澕熆狢皬盇盉盍盒礉禾秴篕籺紇翮荷菏萂蚵螛覈訸詥貈貉輅郃鉌鑉闔阂阖鞨頜颌饸魺鲄鶡鹖麧齕龁龢
+he4 佫嗃垎壑焃煂熇爀癋碋穒粭翯袔褐謞賀贺赫靍靎靏鶮鶴鸖鹤
+hei1 嘿潶黑黒
+hen2 拫痕鞎
+hen3 佷很狠詪
+hen4 恨
+heng1 亨哼啈悙脝
+heng2 姮恆恒桁横橫烆胻蘅衡鑅鴴鸻
+heng4 堼涥鵆
+hm5 噷
+hong1 叿吽呍哄嚝揈渹灴烘焢硡薨訇谾軣輷轟轰鍧
+hong2 仜吰垬妅娂宏宖峵弘彋汯泓洪浤渱潂玒硔竑竤粠紅紘紭綋红纮翃翝耾苰荭葒葓蕻虹谹谼鈜鉷鋐閎闳霐霟鞃魟鴻鸿黉黌
+hong3 嗊晎
+hong4 撔澋澒訌讧銾閧闂鬨
+hou1 齁
+hou2 侯喉帿猴瘊睺矦篌糇翭翵葔鄇鍭餱骺鯸
+hou3 吼犼
+hou4 候厚后垕堠後洉豞逅郈鮜鱟鲎鲘
+hu1 乎乯匢匫呼唿嘑垀寣幠忽恗惚戯昒曶歑泘淴滹烀膴苸虍虖謼軤轷雐
+hu2 喖嘝囫壶壷壺媩弧抇搰斛楜槲湖瀫焀煳狐猢瑚瓳箶糊絗縠胡葫蔛蝴螜衚觳醐鍸隺頶餬鬍魱鰗鵠鶘鶦鹕
+hu3 乕俿唬汻浒滸琥萀虎虝
+hu4 乥互冱冴嗀嚛婟嫭嫮岵帍弖怘怙戶户戸戽扈护摢昈枑楛槴沍沪滬熩瓠祜笏簄綔芐蔰護鄠鍙雽韄頀鱯鳠鳸鸌鹱
+hu5 粐錿鯱
+hua1 哗嘩埖婲椛璍硴糀花芲蒊誮錵
+hua2 华姡搳撶滑猾磆華蕐螖譁釪釫鋘鏵铧驊骅鷨
+hua4 划劃化夻婳嫿嬅崋摦杹桦槬樺澅画畫畵繣舙觟話諣譮话黊
+huai2 徊怀懐懷槐櫰淮瀤耲蘹褢褱踝
+huai4 咶坏壊壞蘾諙
+huan1 嚾懽欢歓歡犿獾瞣讙貛酄驩鴅鵍
+huan2 圜嬛寏寰峘桓洹澴狟环環瓛糫絙綄繯缳羦荁萈萑豲貆轘郇鉮鍰鐶锾镮闤阛雈鬟鹮
+huan3 攌緩缓
+huan4 唤喚喛奂奐宦嵈幻患愌换換擐梙槵浣涣渙漶澣烉焕煥瑍痪瘓睆肒藧豢逭鯇鰀鲩
+huang1 塃巟慌朚肓荒衁
+huang2 偟兤凰喤堭墴媓崲徨惶楻湟潢煌熿獚瑝璜癀皇磺穔篁篊簧艎葟蝗蟥諻趪遑鍠鐄锽隍韹餭騜鰉鱑鳇鷬黃黄
+huang3 奛宺幌怳恍晄櫎炾熀詤謊谎
+huang4 愰晃曂榥滉皝皩縨鎤
+hui1 咴噅噕婎媈幑徽恢拻挥揮撝晖暉楎洃瀈灰烣煇珲睳禈翚翬蘳虺袆褘詼诙豗輝辉隓隳鰴麾
+hui2 佪囘回囬廻廽恛洄烠痐茴蚘蛔蛕蜖迴逥鮰
+hui3 悔檓毀毁毇燬譭
+hui4 会僡儶匯卉哕喙嘒噦嚖圚嬒孈寭屷彗彙彚徻恚恵惠慧憓懳晦暳會槥橞櫘殨汇泋浍湏滙潓澮濊灳烩燴獩璤璯瘣瞺秽穢篲絵繢繪绘缋翙翽芔荟蔧蕙薈薉藱蟪詯誨諱譓譿讳诲賄贿鏸鐬闠阓靧頮顪颒餯
+hun1 婚惛昏昬棔殙涽睧睯荤葷閽阍
+hun2 堚忶梡浑渾琿繉轋餛馄魂鯶鼲
+hun4 俒倱圂慁掍混溷焝觨諢诨
+huo1 剨劐吙嚄攉耠豁鍃锪騞
+huo2 佸活秮秳
+huo3 伙夥漷火邩鈥钬
+huo4 俰咟嚯嚿奯惑或捇掝旤曤楇檴沎湱濩瀖獲癨眓矆矐砉祸禍穫耯臛艧获蒦藿蠖謋貨货鑊镬閄霍靃
+ji1 丌乩僟击刉刏剞勣叽咭唧喞嗘嘰圾基墼姫姬屐嵆嵇擊敧朞机枅槣機櫅毄激犄玑璣畸畿矶磯禨积稘稽積笄筓箕簊緝績绩缉羁羇羈耭肌芨虀襀覉覊觭譏譤讥賫賷赍跡跻蹟躋躸迹鄿銈錤鐖鑇鑙隮雞鞿韲飢饑饥鳮鶏鷄鸄鸡齎齏齑
+ji2 亟亼亽伋佶偮卙即卽及吉塉姞嫉岌嶯庴廭彶忣急愱戢揤撃擮极棘楫極槉樭橶檝殛汲湒潗濈焏狤疾瘠癪皀皍禝笈箿籍級级耤脊膌艥蒺蕀蕺螏襋觙诘谻趌踖蹐輯轚辑郆銡鍓鏶钑集雦雧霵鶺鷑鹡
+ji3 丮几妀嵴己幾戟挤掎撠擠泲犱穖虮蟣鈘魕魢鱾麂
+ji4 伎偈兾冀剂剤劑哜嚌坖垍塈妓季寂寄峜彐彑徛忌悸惎懻技旡既旣暨暩曁梞檕檵洎济済漃漈濟瀱痵癠祭稩稷穄穊穧紀紒継繋繼纪继罽臮芰茍茤荠葪蓟蔇薊薺蘎蘮蘻裚褀覬觊計記誋諅计记跽际際霁霽驥骥髻鬾鯚鰶鰿鱀鱭鲚鲫鵋齌
+jia1 乫伽佳傢加嘉埉夹夾家抸拁枷梜毠泇浃浹犌猳珈痂笳耞腵葭袈豭貑跏迦鉫鉿鎵镓麚
+jia2 唊圿忦恝戛戞扴荚莢蛱蛺袷裌跲郏郟鋏铗鞂頬頰颊餄鴶鵊
+jia3 仮假叚婽岬徦斚斝椵榎槚檟玾甲瘕胛賈贾鉀钾
+jia4 价價嫁幏架榢稼糘駕驾
+jian1 偂兼冿囏坚堅奸姦姧尖幵惤戋戔搛椷椾樫櫼歼殲湔瀐瀸煎熞熸牋犍猏玪瑊监監睷碊礛笺箋篯緘縑缄缣肩艰艱菅菺葌蒹蕑蕳虃覸豜豣鐧鑯間间鞬鞯韀韉餰馢鰹鲣鳒鳽鵳鶼鹣麉
+jian3 俭倹儉减剪劗囝堿弿戩戬拣挸捡揀揃撿暕枧柬梘检検檢減湕瀽瑐睑瞼硷碱礆笕筧简簡籛絸繭翦茧藆蠒裥襇襉襺詃謇謭譾谫趼蹇鐗锏鬋鰎鹸鹻鹼
+jian4 件俴健僭剑剣剱劍劎劒劔墹寋建彅徤擶旔栫楗榗橺殱毽洊涧渐溅漸澗濺瀳牮珔瞷磵礀箭糋繝腱臶舰艦荐葥蔪薦螹袸見覵见諓諫譼谏賎賤贱趝践踐踺轞釼鉴鋻鍳鍵鏩鐱鑑鑒鑬鑳键餞饯
+jiang1 僵壃姜将將摪橿殭江浆漿畕畺疅疆礓繮缰翞茳葁薑螀螿豇韁鱂鳉
+jiang3 傋奖奨奬桨槳獎耩膙蒋蔣講讲顜
+jiang4 勥匞匠夅嵹弜弶彊摾杢櫤洚滰犟糡糨絳绛袶謽酱醤醬降
+jiao1 交僬嘄姣娇嬌峧嶕嶣憍椒浇澆焦燋礁穚簥胶膠膲艽芁茭茮蕉虠蛟蟭跤轇郊鐎驕骄鮫鲛鵁鷍鷦鷮鹪
+jiao2 嚼
+jiao3 佼侥僥儌剿劋孂徺徼恔憿挢捁搅摷撟撹攪敫敽敿晈暞曒湫湬灚烄煍燞狡璬皎皦矫矯絞繳绞缴脚腳臫蟜角譑賋踋鉸铰隦餃饺鱎
+jiao4 叫呌嘂嘦噍噭嬓峤嶠挍敎教斠櫵滘漖潐獥珓皭窌窖纐藠訆譥趭較轎轿较酵醮釂鵤
+jie1 喈嗟堦媘嫅接掲揭擑椄湝煯疖痎癤皆秸稭脻菨蝔街謯阶階鶛
+jie2 倢偼傑刦刧刼劫劼卩卪喼婕孑尐岊崨嵥巀幯截拮捷昅杰桀楬楶榤櫭洁滐潔疌睫碣竭節結絜结羯节莭蓵蜐蝍蠘蠞蠽衱袺訐詰誱讦踕迼鉣鍻鞊颉魝鮚鲒
+jie3 姐媎桝檞毑解觧飷
+jie4 丯介借吤堺屆届岕庎徣悈戒楐犗玠琾界畍疥砎芥藉蚧蛶衸褯誡诫躤鎅骱魪
+jin1 今兓埐堻壗嶜巾惍斤津珒琻矜砛筋紟荕衿襟觔金釒釿钅鹶黅
+jin3 仅侭僅儘卺厪堇嫤巹廑槿漌瑾盡紧緊菫蓳謹谨錦锦饉馑
+jin4 伒僸凚劤劲勁唫噤嚍墐妗嬧寖尽搢晉晋暜枃歏殣浕浸溍濅濜烬燼琎瑨璡璶祲禁縉缙荩藎覲觐賮贐赆近进進靳齽
+jing1 京亰兢坕坙婛巠惊旌旍晶橸泾涇燝猄睛秔稉粳精経經经聙腈茎荆荊莖菁葏驚鯨鲸鵛鶁鶄麖麠鼱
+jing3 丼井儆刭剄坓宑幜憬憼景暻汫汬燛璟璥穽肼蟼警阱頚頸颈
+jing4 俓倞傹净凈境妌婙婧弪弳径徑敬曔桱梷浄淨濪瀞獍痉痙竞竟竧竫競竸胫脛誩踁迳逕鏡镜靓靖静靚靜
+jiong1 冂冋坰埛扃絅蘏蘔駉駫
+jiong3 侰僒冏囧泂浻澃炅炯烱煚煛熲窘綗褧迥逈颎
+jiu1 丩勼啾揂揪揫摎朻樛牞究糺糾纠萛赳阄鬏鬮鳩鸠
+jiu3 久乆九乣奺灸玖紤舏酒镹韭韮
+jiu4 倃僦匓匛匶厩咎媨就廄廏廐慦捄救旧杦柩柾桕欍殧汣疚臼舅舊鯦鷲鹫麔齨
+ju1 凥刟匊娵婮居崌抅拘挶掬梮椐泃涺狙琚疽痀眗砠罝腒艍苴菹蜛裾趄跔踘踙鋦锔陱雎鞠鞫駒驹鮈鴡鶋
+ju2 侷僪啹婅局巈桔椈橘檋毩毱泦淗湨焗犑狊粷菊蘜諊趜跼蹫躹輂郹閰駶驧鵙鵴鶪鼳
+ju3 举咀弆挙擧椇榉榘櫸欅沮爠矩筥聥舉莒蒟襷踽齟龃
+ju4 乬俱倨倶具冣剧劇勮句埧埾壉姖寠屦屨岠巨巪怇怐怚惧愳懅懼拒拠据據昛歫洰澽炬犋秬窭窶簴粔耟聚苣虡蚷袓詎讵豦貗跙距踞躆遽邭醵鉅鋸鐻钜锯颶飓駏鮔
+juan1 勬姢娟捐涓焆瓹脧蠲裐鎸鐫镌鵑鹃
+juan3 卷呟埍帣捲臇菤錈锩
+juan4 倦劵勌奆巻慻桊淃狷獧眷睊睠絭絹縳绢罥羂蔨鄄隽雋飬餋
+jue1 噘屩撅撧蹻
+jue2 亅倔傕决刔劂勪匷厥噱孒孓屫崛嶥弡彏憠憰戄抉挗捔掘攫斍桷橛橜欔欮殌氒決泬焳熦爑爝爴爵獗玃玦玨珏瑴疦瘚矍矡砄絕絶绝臄芵蕝蕨虳蚗蟨蟩覐覚覺觉觖觼訣譎诀谲貜赽趉趹蹶蹷躩逫鈌鐍鐝钁镢駃鴂鴃鶌鷢龣
+jun1 军君均姰桾汮皲皸皹碅莙菌蚐袀覠軍鈞銁銞鍕钧鮶鲪麇麏麕
+jun4 俊儁呁埈寯峻懏捃攈攟晙棞浚濬焌燇珺畯竣箘箟蜠郡陖餕馂駿骏鵔鵘
+ka1 咔咖喀擖衉
+ka3 佧卡胩鉲
+ka4 垰裃
+kai1 奒开揩鐦锎開
+kai3 凯凱剀剴嘅垲塏嵦恺愷慨暟楷蒈輆鍇鎧铠锴闓闿颽
+kai4 勓忾愒愾欬炌炏烗鎎
+kan1 刊勘堪嵁戡栞龕龛
+kan3 侃偘冚坎埳塪惂槛檻欿歁砍竷莰輡轗顑
+kan4 墈崁看瞰矙磡衎闞
+kang1 嫝嵻康忼慷槺漮砊穅粇糠躿鏮闶鱇
+kang2 扛摃
+kang4 亢伉匟囥抗炕犺邟鈧钪閌
+kao1 尻髛
+kao3 丂拷攷栲洘烤燺稁考鲓
+kao4 犒銬铐靠鮳鯌
+ke1 匼嗑嵙搕柯棵榼樖牁犐珂疴瞌砢磕礚科稞窠胢苛萪薖蝌趷軻轲醘鈳錒顆颏颗髁
+ke2 咳壳揢殼翗
+ke3 可坷岢嵑嶱敤渇渴炣礍
+ke4 克刻剋勀勊堁娔客尅恪愙氪溘碦礊緙缂艐課课锞騍骒
+ken3 啃垦墾恳懇肎肯肻豤錹齦龈
+ken4 掯裉褃
+keng1 劥吭坑妔挳摼牼硁硜硻誙銵鍞鏗铿阬
+kong1 倥埪崆悾涳硿空箜錓鵼
+kong3 孔恐
+kong4 控躻鞚
+kou1 剾彄抠摳眍瞘芤
+kou3 劶口
+kou4 冦叩宼寇扣敂滱瞉窛筘簆蔲蔻釦鷇
+ku1 刳哭圐堀崫扝枯桍矻窟胐跍郀骷鮬
+ku3 狜苦
+ku4 俈喾嚳库庫廤焅瘔秙絝绔袴裤褲趶酷
+kua1 夸姱誇
+kua3 侉咵垮銙
+kua4 挎胯舿跨骻
+kuai3 擓蒯
+kuai4 侩儈凷哙噲圦块塊墤巜廥快旝狯獪筷糩脍膾郐鄶鱠鲙
+kuan1 宽寛寬臗髋髖
+kuan3 欵款歀窽窾鑧
+kuang1 劻匡匩哐恇框洭硄筐誆诓軭邼
+kuang2 忹抂狂誑诳軖鵟
+kuang3 儣夼懭
+kuang4 况卝圹壙岲懬旷昿曠況爌眖眶矌矿砿礦穬筺絋絖纊纩貺贶躀軦邝鄺鉱鑛黋
+kui1 亏刲岿巋悝盔窥窺聧蘬虧闚顝
+kui2 喹夔奎巙戣揆晆暌楏楑櫆犪睽葵藈蘷虁蝰躨逵鄈鍨鍷隗頄頯馗騤骙魁
+kui3 傀煃跬蹞頍
+kui4 匮喟嘳媿嬇尯愦愧憒樻欳溃潰瞆篑簣籄聩聭聵腃蒉蕢謉鐀鑎餽饋馈
+kun1 坤堃婫崐崑昆晜焜猑琨瑻菎蜫裈裩褌貇醌錕锟騉髠髡髨鯤鲲鵾鶤鹍
+kun3 壸壼悃捆梱硱祵稇稛綑裍閫閸阃齫
+kun4 困堒尡涃潉熴睏
+kuo4 廓懖扩拡括挄擴桰濶筈萿葀蛞闊阔霩鞟鞹韕頢髺鬠
+la1 垃拉搚柆翋菈邋
+la2 剌揦旯砬磖
+la3 喇藞
+la4 揧攋楋爉瓎瘌腊臈臘蜡蝋蝲蠟辢辣鑞镴鬎鯻
+la5 啦嚹溂鞡
+lai2 來俫倈婡崃崍庲徕徠来梾棶涞淶猍琜筙箂莱萊逨郲錸铼騋鯠鶆麳
+lai4 唻櫴濑瀨瀬癞癩睐睞籁籟藾襰賚賴赉赖頼顂鵣
+lan2 儖兰厱囒婪岚嵐幱惏懢拦攔斓斕栏欄欗澜瀾灆灡燣燷璼礷篮籃籣繿葻蓝藍蘭褴襕襤襴譋讕谰躝钄镧闌阑韊
+lan3 囕壈嬾孄孏懒懶揽擥攬榄欖浨漤灠纜缆罱覧覽览醂顲
+lan4 嚂滥濫烂燗爁爛爤爦瓓糷襽鑭
+lang1 啷
+lang2 勆嫏廊斏桹榔欴狼琅瑯硠稂筤艆蓈蜋螂躴郎郞鋃鎯锒阆駺
+lang3 塱朖朗朤樃烺蓢誏
+lang4 唥埌崀浪莨蒗郒閬
+lao1 捞撈
+lao2 僗劳労勞哰唠嘮崂嶗憥浶牢痨癆磱窂簩蟧醪鐒铹顟髝
+lao3 佬咾姥恅栳橑狫老耂荖轑銠铑
+lao4 嫪憦朥橯涝澇烙珯硓粩耢耮蛯躼軂酪鮱
+le4 乐仂勒叻忇扐楽樂氻泐玏砳竻簕艻阞韷鰳鳓
+le5 了餎饹
+lei2 儽壨嫘擂檑櫑欙瓃畾礌礧縲纍纝缧罍羸蔂蘲虆蠝轠鐳鑘镭雷靁鼺
+lei3 傫儡厽垒壘樏櫐灅癗磊磥礨絫耒腂蕌蕾藟蘽誄讄诔鑸鸓
+lei4 攂泪洡涙淚禷类累纇肋蘱酹銇錑頛頪類颣
+lei5 嘞塁鱩
+leng2 塄崚棱楞碐稜薐輘
+leng3 冷
+leng4 倰堎愣睖踜
+li2 刕剓剺劙厘喱嚟囄嫠孋孷廲悡攡斄杝梨梩梸棃樆漓灕犁犂狸琍璃瓈盠睝离穲筣篱籬粚糎縭纚缡罹艃荲菞蓠蔾藜蘺蜊蟍蠡褵謧貍邌醨釐鋫錅鏫鑗離騹驪骊鯬鱺鲡鵹鸝鹂黎黧
+li3 俚兣娌峛峢峲李欚浬澧理礼禮粴蟸裏裡豊逦邐醴里鋰锂鯉鱧鲤鳢
+li4 丽例俐俪傈儮儷凓利力励勵历厉厤厯厲吏呖唎唳嚦囇坜塛壢娳婯屴岦巁悧慄戾搮攊攦攭暦曆曞朸枥栎栗栛棙檪櫔櫟櫪欐歴歷沥沴涖溧濿瀝爄爏犡猁珕瑮瓅瓑瓥疠疬痢癘癧皪盭矋砅砺砾磿礪礫礰禲秝立笠篥粒粝糲綟脷苈苙茘荔莅莉蒚蒞藶蚸蛎蛠蜧蝷蠇蠣蠫觻詈讈赲跞躒轢轣轹郦酈鉝鎘隶隷隸雳靂靋鬁鱱鱳鳨鴗鷅麗麜
+li5 哩栃鯏
+lia3 俩倆
+lian2 亷劆匲匳嗹噒奁奩嫾帘廉怜慩憐梿槤櫣涟溓漣濂濓熑燫磏簾籢籨縺翴联聨聫聮聯臁莲蓮薕螊蠊裢褳覝謰蹥连連鎌鐮镰鬑鰱鲢
+lian3 嬚摙敛斂琏璉羷脸臉蔹蘞裣襝鄻
+lian4 僆堜媡恋戀楝殓殮浰湅潋澰瀲炼煉瑓練纞练萰蘝錬鍊鏈链鰊
+liang2 俍凉墚梁椋樑涼粮粱糧綡良踉輬辌
+liang3 両两兩唡啢掚緉脼蜽裲魉魎
+liang4 亮哴喨悢晾湸煷簗諒谅輌輛辆量鍄
+liao1 撩蹽
+liao2 僚嘹嫽寥寮屪嵺嶚嶛廫憀敹暸漻潦燎獠璙疗療簝繚缭聊膋膫藔蟟豂賿蹘辽遼鐐飉髎鷯鹩
+liao3 叾憭曢爒瞭蓼鄝釕钌镽
+liao4 尞尥尦廖撂料炓爎窷镣
+lie3 咧挘毟烮猟
+lie4 儠冽列劣劽哷埒埓姴巤挒捩擸栵洌浖烈煭犣猎獵睙聗脟茢蛚裂趔躐迾颲鬛鬣鮤鱲鴷
+lin1 拎
+lin2 临冧厸啉壣崊嶙斴晽暽林淋潾瀶燐獜琳璘痳瞵矝碄磷箖粦粼繗翷臨轔辚遴邻鄰鏻隣霖驎鱗鳞麐麟
+lin3 亃凛凜廩廪懍懔撛檁檩澟癛癝菻
+lin4 僯吝恡悋橉焛甐疄膦蔺藺賃赁蹸躏躙躪轥閵
+ling2 〇伶凌刢囹坽夌姈婈孁岺彾掕昤朎柃棂櫺欞泠淩澪瀮灵燯爧狑玲琌瓴皊砱祾秢竛笭紷綾绫羚翎聆舲苓菱蓤蔆蕶蘦蛉衑裬詅跉軨酃醽鈴錂铃閝陵零霊霗霛霝靈駖魿鯪鲮鴒鸰鹷麢齡齢龄龗
+ling3 岭嶺袊阾領领
+ling4 令另呤炩
+liu1 溜熘蹓
+liu2 刘劉嚠媹嵧懰旈旒榴橊沠流浏瀏琉瑠瑬璢畄留畱疁瘤癅硫磂蒥蓅藰蟉裗遛鎏鎦鏐镏镠飀飅飗馏駠駵騮驑骝鰡鶹鹠麍
+liu3 嬼柳栁桞桺橮熮珋綹绺罶羀鉚鋶锍飹
+liu4 六塯廇澑畂磟翏鐂雡霤飂餾鬸鷚鹨
+lo5 囖
+long2 咙嚨屸嶐巃巄昽曨朧栊槞櫳泷湰滝漋瀧爖珑瓏癃眬矓砻礱礲窿竜笼篭簼籠聋聾胧茏蕯蘢蠪蠬襱豅躘鏧鑨隆霳靇驡鸗龍龒龙
+long3 儱垄垅壟壠拢攏竉篢陇隴龓
+long4 哢徿挵梇贚
+lou1 瞜
+lou2 偻僂剅娄婁廔慺楼樓溇漊熡耧耬艛蒌蔞蝼螻謱軁遱鞻髅髏
+lou3 塿嵝嶁搂摟甊篓簍
+lou4 屚漏瘘瘺瘻鏤镂陋
+lou5 喽嘍
+lu1 噜撸
+lu2 卢嚧垆壚庐廬攎曥枦栌櫚櫨氇泸澛瀘炉爐獹玈璷瓐盧矑籚纑罏胪臚舮舻艫芦蘆蠦轤轳鈩鑪顱颅髗魲鱸鲈鸕鸬黸
+lu3 卤嚕塷掳擄擼樐橹櫓氌滷瀂硵磠艣艪蓾虏虜鏀鐪鑥镥魯鲁鹵
+lu4 侓僇剹勎勠圥坴塶娽峍廘彔录戮摝椂樚淕淥渌漉潞熝琭璐甪盝睩硉碌祿禄稑穋箓簏簬簶籙粶膔菉蔍蕗虂螰觮賂赂趢路踛蹗轆辂辘逯醁錄録錴鏕鏴陆陸露騄騼鯥鵦鵱鷺鹭鹿麓
+lv2 榈氀膢藘郘閭闾馿驢驴鷜
+lv3 侣侶儢吕呂屡屢履挔捋捛旅梠祣稆穞穭絽縷缕膂膐褛褸鋁铝
+lv4 勴垏寽嵂律慮櫖氯滤濾焒爈率箻綠緑繂绿膟葎虑鑢
+luan2 圝圞奱娈孌孪孿峦巒挛攣曫栾欒滦灓灤癴癵羉脔臠虊銮鑾鵉鸞鸾
+luan3 卵
+luan4 乱亂釠
+lve4 圙掠擽略畧稤鋝鋢锊
+lun1 抡掄
+lun2 仑伦侖倫囵圇婨崘崙惀棆沦淪綸纶腀菕蜦踚輪轮錀陯鯩
+lun3 埨碖稐耣
+lun4 溣磮論论
+luo1 啰囉頱
+luo2 儸攞椤欏猡玀箩籮罖罗羅脶腡萝蘿螺覙覶覼逻邏鏍鑼锣镙饠騾驘骡鸁
+luo3 倮剆曪瘰癳臝蓏蠃裸躶
+luo4 嗠峈摞泺洛洜漯濼犖珞硦笿絡纙络荦落鉻雒駱骆鮥鴼鵅
+m5 呣
+ma1 妈媽嬤嬷孖
+ma2 犘痲蔴蟆蟇麻
+ma3 溤玛瑪码碼蚂螞鎷馬马鰢鷌
+ma4 傌唛嘜杩榪犸獁睰礣祃禡罵閁駡骂鬕
+ma5 亇吗嗎嘛嫲遤
+mai2 埋薶霾
+mai3 买嘪荬蕒買鷶
+mai4 佅劢勱卖売脈脉衇賣迈邁霡霢麥麦
+man1 嫚颟
+man2 僈姏悗慲樠瞒瞞蛮蠻謾谩鞔顢饅馒鬗鬘鰻鳗
+man3 屘満满滿睌矕螨蟎襔鏋
+man4 墁幔慢摱曼槾漫澷熳獌縵缦蔄蔓蘰鄤鏝镘
+mang1 牤
+mang2 吂哤娏尨庬忙恾杗杧氓汒浝牻狵痝盲硭笀芒茫蛖邙釯鋩铓駹
+mang3 壾漭硥茻莽莾蟒蠎
+mao1 猫貓
+mao2 兞堥嫹旄枆毛氂渵牦犛矛罞茅茆蝥蟊軞酕錨锚髦髳鶜
+mao3 乮冇卯夘峁戼昴泖笷蓩铆
+mao4 冃冐冒媢帽愗懋暓柕楙毷瑁皃眊瞀耄芼茂萺蝐袤覒貌貿贸鄚鄮
+me5 么嚒嚜濹癦麼
+mei2 呅坆堳塺娒媒嵋徾攗枚栂梅楣楳槑沒没湄湈煤猸玫珻瑂眉睂矀禖穈脄脢苺莓葿蘪郿酶鋂鎇镅霉鶥鹛黴
+mei3 凂媄媺嬍嵄挴毎每浼渼燘美腜鎂镁黣
+mei4 妹媚寐抺旀昧沬煝痗眛睸祙篃蝞袂跊躾韎鬽魅
+men2 亹扪捫玧璊菛虋鍆钔門閅门
+men4 悶懑懣暪焖燜闷
+men5 们們椚
+meng2 儚冡幪懞曚朦橗檬氋濛甍甿盟瞢矇矒礞艨莔萌萠蒙蕄蘉虻蝱鄳鄸霿靀顭饛鯍鸏鹲鼆
+meng3 勐懜懵猛獴瓾艋蜢蠓錳锰鯭
+meng4 夢夣孟掹擝梦溕霥
+mi1 咪眯瞇
+mi2 冞弥彌戂擟攠瀰爢猕獼瓕祢禰糜縻罙蒾蘼詸謎谜迷醚醾醿釄镾靡鸍麊麋麛
+mi3 侎孊弭敉沵洣渳濔灖眫米羋脒芈葞蔝銤
+mi4 冖冪嘧塓宓宻密峚幂幎幦榓樒櫁汨沕泌淧淿滵漞濗熐祕秘簚糸羃蔤藌蜜覓覔覛觅謐谧鼏
+mian2 婂媔嬵宀棉檰櫋眠矈矊矏綿緜绵臱芇蝒
+mian3 丏偭免冕勉勔喕娩愐汅沔渑湎澠眄絻緬缅腼葂鮸麫黽黾
+mian4 糆面靣麪麵麺
+miao1 喵
+miao2 媌描瞄緢苗鱙鶓鹋
+miao3 杪淼渺眇秒篎緲缈藐邈
+miao4 妙庙庿廟玅竗
+mie1 乜吀咩哶孭
+mie4 幭懱搣櫗滅灭烕篾蔑薎蠛衊覕鑖鱴鴓
+min2 姄岷崏忞怋捪敯旻旼民珉琘瑉痻盿砇碈緍緡缗罠苠鈱錉鍲鴖
+min3 僶冺刡勄垊悯惽愍慜憫抿敃敏暋泯湣潣皿笢笽簢蠠閔閩闵闽鰵鳘
+ming2 冥名嫇明暝朙榠洺溟猽眀眳瞑茗蓂螟覭鄍銘铭鳴鸣
+ming3 佲凕姳慏酩
+ming4 命掵椧詺
+miu4 謬谬
+mo1 摸
+mo2 劘嚤嚩嚰嫫摩摹擵模橅磨糢膜蘑謨谟饃饝馍髍魔麽
+mo3 懡抹
+mo4 劰唜嗼圽塻墨妺嫼寞帓帞昩暯末枺歾歿殁沫湐漠瀎爅獏瘼皌眜眽眿瞐瞙砞礳秣粖絈纆耱茉莈莫蓦藦蛨蟔謩貃貊貘銆鏌镆陌靺驀魩默黙
+mo5 尛庅怽魹麿
+mou1 哞
+mou2 侔劺恈洠牟眸瞴繆缪蛑謀谋踎鉾鍪鴾麰
+mou3 某
+mu2 墲毪氁
+mu3 亩凩坶姆峔拇母牡牳畆畒畝畞畮砪胟踇鉧
+mu4 仫募墓幕幙慔慕暮木朰楘毣沐炑牧狇目睦穆縸艒苜莯蚞鉬钼雮霂鞪
+n2 嗯
+na2 嗱拏拿挐鎿镎
+na3 乸哪雫
+na4 吶呐妠娜捺笝納纳肭蒳衲袦豽貀軜那鈉钠靹魶
+na5 孻摨熋腉
+nai3 乃倷奶妳嬭廼氖疓艿迺釢
+nai4 奈柰渿耏耐萘螚褦錼鼐
+nan1 囡
+nan2 侽南喃娚暔枏枬柟楠男畘莮諵难難
+nan3 戁揇湳腩萳蝻赧
+nan4 婻遖
+nang1 囔
+nang2 乪嚢囊欜蠰譨饢馕鬞
+nang3 擃攮曩灢
+nang4 儾齉
+nao1 孬
+nao2 呶夒峱嶩巎怓憹挠撓猱硇碙蛲蟯詉譊鐃铙
+nao3 匘垴堖嫐恼悩惱獶獿瑙碯脑脳腦
+nao4 婥淖臑閙闹鬧
+ne4 抐疒眲訥讷
+ne5 呢
+nei3 娞脮腇餒馁鮾鯘
+nei4 內内氝錗
+nen4 嫩嫰恁
+neng2 能
+ni1 妮
+ni2 倪坭埿婗尼屔怩棿泥淣猊秜籾聣腝臡蚭蜺觬貎跜輗郳铌霓鯓鯢鲵麑齯
+ni3 伱你儗儞孴抳拟擬旎晲柅檷狔聻苨薿袮鈮隬馜
+ni4 伲匿堄嫟嬺屰惄愵昵暱氼溺眤睨縌胒腻膩誽迡逆
+nian1 拈蔫
+nian2 哖年秊秥鮎鯰鲇鲶鵇黏
+nian3 捻撚撵攆涊淰焾碾簐跈蹍蹨躎輦辇
+nian4 卄唸埝姩廿念艌鼰
+niang2 娘嬢孃
+niang4 酿醸釀
+niao3 嫋嬝嬲樢茑蔦袅裊褭鳥鸟
+niao4 尿脲
+nie1 捏揑
+nie2 苶
+nie4 啮喦嗫噛嚙囁囓圼孼孽嵲嶭巕帇惗摰敜枿槷櫱涅湼痆篞籋糱糵聂聶臬臲菍蘖蠥讘踂踗蹑躡錜鎳鑈鑷钀镊镍闑陧隉顳颞齧
+nin2 囜您
+nin3 拰
+nin4 脌
+ning2 儜凝咛嚀嬣宁寍寕寗寜寧拧擰柠檸狞獰甯聍聹苧薴鑏鬡鸋
+ning3 橣矃
+ning4 佞侫泞澝濘
+niu1 妞
+niu2 汼牛牜
+niu3 忸扭炄狃紐纽莥鈕钮靵
+niu4 衂
+nong2 侬儂农哝噥檂欁浓濃燶禯秾穠繷脓膿蕽襛農辳醲
+nong4 弄挊癑齈
+nou2 羺
+nou3 啂
+nou4 槈檽獳耨譳鎒鐞
+nu2 奴孥笯駑驽
+nu3 伮努弩砮胬
+nu4 傉怒搙
+nv3 女籹釹钕
+nv4 恧朒沑衄
+nuan2 奻
+nuan3 暖渜煖煗餪
+nve4 疟瘧硸虐
+nun2 黁
+nuo2 傩儺挪梛郍
+nuo3 橠
+nuo4 喏愞懦懧掿搦搻榒稬穤糑糥糯諾诺蹃逽锘
+o1 喔噢
+o2 哦
+o3 筽
+ou1 塸櫙欧歐殴毆沤漚熰瓯甌謳讴鏂鴎鷗鸥
+ou2 膒齵
+ou3 偶吘呕嘔耦腢蕅藕
+ou4 怄慪藲
+pa1 啪妑皅舥葩趴
+pa2 掱杷潖爬琶筢
+pa4 帊帕怕袙
+pai1 拍
+pai2 俳徘排棑牌犤猅簰簲輫
+pai3 廹
+pai4 哌派湃蒎鎃
+pan1 攀潘畨眅砙
+pan2 媻幋搫槃洀瀊爿盘盤磐磻縏蒰蟠跘蹒蹣鎜鞶
+pan4 冸判叛拚沜泮溿炍牉畔盼聁袢襻詊鋬鑻頖鵥
+pang1 乓沗滂胮膖雱霶
+pang2 厐厖嫎庞徬旁舽螃逄鳑龎龐
+pang3 嗙耪覫
+pang4 炐肨胖
+pao1 抛拋脬
+pao2 刨匏咆垉庖炰爮狍袍軳鞄麃麅
+pao3 跑
+pao4 奅泡炮疱皰砲礟礮萢褜麭
+pei1 呸怌柸肧胚衃醅
+pei2 培毰裴裵賠赔锫阫陪駍
+pei3 俖
+pei4 伂佩姵嶏帔斾旆沛浿珮笩蓜轡辔配霈馷
+pen1 喷噴歕
+pen2 湓瓫盆葐
+pen3 呠翸
+pen4 喯
+peng1 匉嘭怦恲抨梈漰澎烹砰硑磞軯閛
+peng2 倗堋塳弸彭憉挷朋棚椖槰樥熢硼稝竼篣篷纄膨芃莑蓬蟚蟛輣錋鑝韸韼騯髼鬅鬔鵬鹏
+peng3 剻捧淎皏
+peng4 掽椪碰踫
+pi1 丕伓伾劈噼坯悂憵批披抷旇炋狉砒磇礔礕秛秠紕纰翍耚豾邳鈈鈚鈹鉟銔錍铍霹駓髬魾鮍
+pi2 啤埤壀岯崥朇枇毗毘毞焷狓琵疲皮篺罴羆肶脾腗膍芘蚍蚽蚾蜱螷豼貔郫阰陴魮鲏鵧鼙
+pi3 仳匹噽嚭圮庀擗疋痞癖脴苉諀銢鴄
+pi4 僻嚊媲嫓屁揊榌淠渒潎澼甓疈睥稫譬辟釽闢鷿鸊
+pian1 偏囨媥犏篇翩鍂鶣
+pian2 楄楩胼腁諚賆跰蹁駢騈骈骿
+pian3 覑諞谝貵
+pian4 片騗騙骗魸
+piao1 剽慓旚犥缥翲螵飃飄飘魒
+piao2 嫖瓢竂薸闝
+piao3 彯殍皫瞟篻縹醥顠
+piao4 僄勡嘌徱漂票
+pie1 撆撇暼氕瞥
+pie3 丿苤鐅
+pie4 嫳
+pin1 姘拼礗穦馪驞
+pin2 嚬娦嫔嬪獱玭琕矉薲蠙貧贫頻顰频颦
+pin3 品榀
+pin4 汖牝聘
+ping1 乒俜娉涄甹砯竮聠艵頩
+ping2 凭凴呯坪塀屏屛岼帡帲幈平慿憑枰檘泙洴淜焩玶瓶甁箳簈缾胓苹荓萍蓱蘋蚲蛢評评軿輧郱鮃鲆
+po1 坡岥桲泼溌潑鉕鏺钋頗颇
+po2 嘙婆櫇皤蔢謈鄱
+po3 叵尀笸钷駊
+po4 岶敀昢洦炇烞珀破砶粕蒪迫醗釙魄
+pou1 剖娝
+pou2 抔抙捊掊箁裒錇
+pou3 咅哣婄廍犃
+pu1 仆噗扑撲擈攴潽陠鯆
+pu2 僕匍墣濮獛璞瞨穙纀莆菐菩葡蒱蒲襥酺鏷镤
+pu3 圃圤埔普朴樸檏氆浦溥烳諩譜谱蹼鐠镨
+pu4 巬巭曝瀑舖舗贌鋪铺駇
+qi1 七倛僛凄嘁妻娸悽慼慽戚攲期柒栖桤桼棲榿槭欺沏淒渏漆簯簱籏緀緕缼萋蛣螧褄諆諿蹊迉郪鏚霋魌鶈
+qi2 亓亝俟其剘圻埼奇岐岓崎帺忯愭懠掑斉斊旂旗棊棋檱櫀歧淇濝猉玂琦琪璂畁畦疧碁碕祁祇祈祺禥竒粸綥綦綨纃耆肵脐臍艩芪萁萕蕲藄蘄蚑蚔蚚蛴蜝蜞蠐跂踑軝釮錡锜頎颀騎騏骐骑鬐鬿鯕鰭鲯鳍鵸鶀麒麡齊齐
+qi3 乞企启呇唘啓啔啟婍屺岂晵杞棨玘盀綮綺绮芑諬豈起邔闙
+qi4 呮咠唭噐器夡契弃忔憇憩摖暣栔棄欫气気氣汔汽泣湆湇炁甈盵矵砌碛碶磜磧磩罊芞葺蟿訖讫迄鼜
+qia1 掐葜
+qia2 拤
+qia3 跒酠
+qia4 冾圶帢恰愘殎洽硈鞐髂
+qian1 仟佥僉兛千圱圲奷婜孅孯岍悭愆慳扦拪掔搴撁攐攑攓杄檶櫏欦汘汧牵牽瓩竏签箞簽籖籤粁臤芊茾蚈褰諐謙谦谸迁遷釺鈆鉛鎆鏲鑓钎铅阡雃韆顅騫骞鬜鬝鵮鹐
+qian2 乾仱前墘媊岒忴扲拑掮揵榩橬歬潛潜濳灊箝羬蕁虔軡鈐鉗銭錢钤钱钳靬騚騝鰬黔黚
+qian3 凵嗛嵰槏浅淺繾缱肷脥膁蜸譴谴遣
+qian4 俔倩傔儙刋堑塹壍嵌悓慊棈椠槧欠歉皘篏篟綪縴芡茜蒨蔳輤鰜
+qiang1 呛嗆嶈戕戗戧斨枪椌槍溬牄猐玱瑲篬羌羗羫腔蜣謒跄蹌蹡錆鎗鏘锖锵镪
+qiang2 丬墙墻嫱嬙廧強强樯檣漒牆艢蔃蔷薔蘠
+qiang3 墏抢搶繈繦羟羥襁鏹
+qiang4 唴嗴炝熗獇羻
+qiao1 劁墝墽嵪幧悄敲橇毃燆硗磽繑缲趬跷踍蹺郻鄡鄥鍫鍬鐰锹頝骹
+qiao2 乔侨僑喬嘺嫶憔桥槗樵橋犞癄瞧硚礄荍荞菬蕎藮谯趫鐈鞒鞽顦
+qiao3 巧愀釥髜
+qiao4 俏僺峭帩撬撽殻窍竅翘翹誚譙诮躈陗鞘韒髚
+qie1 切
+qie2 癿聺茄
+qie3 且
+qie4 倿匧妾媫怯悏惬愜挈朅洯淁穕窃竊笡箧篋籡緁苆藒蛪踥郄鍥鐑锲鯜
+qin1 亲侵媇寴嵚嶔欽綅衾親誛钦顉駸骎鮼
+qin2 勤嗪噙埁嫀庈慬懃懄捦擒斳檎溱澿珡琴琹瘽禽秦耹芩芹菦菳蚙螓蠄鈙雂靲鬵鳹鵭
+qin3 坅寑寝寢昑梫笉螼赾鋟锓
+qin4 吢吣唚抋揿搇撳沁瀙菣藽
+qing1 倾傾卿圊埥寈氢氫淸清狅蜻輕轻郬鑋靑青鲭
+qing2 剠勍夝情擎擏晴暒棾樈檠殑氰甠葝黥
+qing3 庼廎檾漀苘請请頃顷
+qing4 凊庆慶掅櫦殸硘碃磘磬箐罄謦靘
+qiong1 匔芎
+qiong2 儝卭宆惸憌桏橩焪焭煢琼璚瓊瓗睘瞏穷穹窮竆笻筇舼茕藑藭蛩蛬赹跫邛銎
+qiong4 熍
+qiu1 丘丠坵媝恘楸秋秌穐篍緧萩蓲蚯蝵蟗蠤趥邱鞦鞧鰌鰍鳅鶖鹙龝
+qiu2 俅叴唒囚崷巯巰扏梂殏毬求汓泅浗渞湭煪犰玌球璆皳盚紌絿肍莍虬虯蛷蝤裘觓觩訄訅賕赇逎逑遒酋醔釓釚銶鮂鯄鰽鼽
+qiu3 搝糗
+qiu4 蘒釻
+qu1 伹佉匤区區坥屈岖岨岴嶇憈抾敺曲浀祛筁粬紶胠蛆蛐袪覰覻詘誳诎趋趨躯軀镼阹駆駈驅驱髷魼鰸鱋麯麴麹黢
+qu2 佢劬忂戵斪朐欋氍淭渠灈璖璩癯瞿磲籧絇翑胊臞菃葋蕖蘧螶蟝蠷蠼衢躣軥鑺鴝鸜鸲鼩
+qu3 取娶竘竬蝺詓齲龋
+qu4 刞厺去呿唟耝衐覷觑趣迲閴闃阒麮鼁
+quan1 圈圏峑弮恮悛棬鐉駩
+quan2 佺全啳埢姾婘孉巏惓拳搼权権權泉洤湶牷犈瑔痊硂筌絟縓荃葲蜷蠸觠詮诠跧踡輇辁醛銓铨顴颧騡鬈鰁鳈齤
+quan3 椦楾汱烇犬犭畎綣绻虇闎
+quan4 券劝勧勸牶韏
+que1 炔缺蒛阙
+que2 瘸
+que4 却卻埆塙墧寉崅悫愨慤搉榷灍燩琷皵硞确碏確碻礐礭趞闋闕阕雀鵲鹊
+qun1 囷夋峮逡
+qun2 宭帬羣群裙裠
+ran2 呥嘫然燃繎肰蚦蚺衻袇袡髥髯
+ran3 冄冉姌媣染橪珃苒
+ran4 蒅
+rang2 儴勷瀼獽瓤禳穣穰蘘躟鬤
+rang3 嚷壌壤攘爙纕
+rang4 懹譲讓让
+rao2 娆嬈桡橈荛蕘襓饒饶
+rao3 扰擾隢
+rao4 繞绕遶
+re3 惹
+re4 热熱
+ren2 人亻仁壬忈忎朲秂芢鈓銋魜鵀
+ren3 忍栠栣棯秹稔荏荵
+ren4 仞仭任刃刄妊姙屻岃扨杒梕牣祍紉紝絍綛纫纴肕腍葚衽袵訒認认讱躵軔軠轫靭靱韌韧飪餁饪
+reng1 扔
+reng2 仍礽辸陾
+reng4 芿
+ri4 囸日釰鈤馹驲
+rong2 媶嫆嬫容嵘嵤嶸巆戎搈搑曧栄榕榮榵毧溶瀜烿熔爃狨瑢穁絨縙绒羢肜茙茸荣蓉蝾融螎蠑褣鎔镕駥髶
+rong3 傇冗坈宂氄軵
+rong4 鴧
+rou1 穃
+rou2 厹媃揉柔渘煣瑈瓇禸糅葇蝚蹂輮鍒鞣騥鰇鶔
+rou3 楺粈韖
+rou4 宍肉腬
+ru2 侞儒嚅如嬬孺帤曘桇渪濡燸筎茹蒘蕠薷蝡蠕袽襦邚醹銣铷顬颥鱬鴑鴽
+ru3 乳擩汝肗辱鄏
+ru4 入嗕媷嶿扖杁洳溽縟缛蓐褥込鳰
+rua2 挼
+ruan2 堧壖撋
+ruan3 偄媆朊瑌瓀碝礝緛耎軟輭软阮
+rui2 婑桵甤緌蕤
+rui3 橤繠蕊蕋蘂蘃
+rui4 叡壡枘汭瑞睿芮蚋蜹銳鋭锐
+run2 瞤
+run4 橍润潤膶閏閠闰
+ruo2 捼
+ruo4 偌叒嵶弱楉渃焫爇箬篛若蒻鄀鰙鰯鶸
+sa1 仨挱挲撒
+sa3 洒潵灑訯躠靸
+sa4 卅摋櫒泧脎萨薩虄鈒隡颯飒馺
+sai1 噻塞愢揌毢毸腮顋鰓鳃
+sai4 僿嗮簺賽赛
+sai5 嘥
+san1 三叁弎毵毿犙鬖
+san3 仐伞傘糁糂糝糣糤繖鏒鏾霰饊馓
+san4 俕厁壭帴悷散橵毶閐
+sang1 桑桒槡
+sang3 嗓搡磉褬鎟顙颡
+sang4 丧喪
+sao1 慅掻搔溞繅缫臊騒騷骚鰠鱢鳋
+sao3 嫂扫掃
+sao4 埽氉瘙矂螦髞
+se1 閪
+se4 啬嗇懎擌栜歮歰洓涩渋澀澁濇濏瀒琗瑟璱瘷穑穡穯繬色譅轖銫鏼铯雭飋
+sen1 森椮槮襂
+seng1 僧鬙
+sha1 乷刹剎唦杀桬榝樧殺毮沙煞猀痧砂硰粆紗纱莎蔱裟鎩铩魦鯊鯋鲨
+sha3 傻儍
+sha4 倽厦唼啑啥喢帹廈歃箑繌翜翣萐閯霎
+shai1 筛篩簁簛酾釃
+shai3 繺
+shai4 晒曬閷
+shan1 删刪剼嘇埏姍姗山幓彡挻搧杉柵檆潸澘煽狦珊痁笘縿羴羶脠膻舢芟苫衫跚軕邖钐鯅
+shan3 晱煔熌睒覢閃闪陕陝
+shan4 傓僐剡善圸墠墡嬗扇掞擅敾杣樿歚汕潬灗疝磰繕缮膳蟮蟺訕謆譱讪贍赡赸鄯釤銏鐥閊饍騸骟鱓鱔鳝
+shang1 伤傷商墒慯殇殤滳漡熵蔏螪觞觴謪鬺
+shang3 垧扄晌賞贘赏鑜
+shang4 丄上尙尚恦緔绱鞝
+shang5 仩
+shao1 弰捎旓梢烧焼燒稍筲艄莦蛸輎颵髾鮹
+shao2 勺柖玿竰芍苕韶
+shao3 少
+shao4 劭卲哨娋潲睄紹綤绍蕱袑邵
+she1 奢檨猞畬畲賒賖赊輋
+she2 佘舌虵蛇蛥
+she3 捨舍
+she4 厍厙射弽慑慴懾摂摄摵攝欇歙涉涻渉滠灄社舎蔎蠂設设赦韘騇麝
+shen1 伸侁兟呻妽姺娠屾峷扟敒曑柛棽氠深燊珅甡甧申眒砷穼籶籸紳绅莘葠蓡蔘薓裑訷詵诜身駪鯵鰺鲹鵢
+shen2 什甚神
+shen3 哂婶嬸审宷審弞曋沈渖瀋瞫矤矧覾訠諗讅谂谉邥頣頥魫
+shen4 侺堔愼慎昚椹榊涁渗滲瘆瘮眘祳罧肾胂脤腎蜃蜄鋠鰰
+sheng1 升呏声斘昇栍殅泩湦焺牲狌珄生甥笙聲苼鉎阩陞陹鵿鼪
+sheng2 憴繩绳譝
+sheng3 偗渻省眚
+sheng4 剩剰勝圣墭嵊晠曻榺橳琞盛竔聖胜蕂貹賸
+shi1 呞失尸屍师師施浉湤湿溮溼濕狮獅瑡絁葹蒒蓍虱蝨褷襹詩诗邿釶鈟鉇鉈鍦鯴鰤鲺鳲鳾鶳鸤
+shi2 乭十埘塒姼实実寔實峕拾时旹時榯湜溡炻石祏莳蒔蚀蝕識识辻遈鉐食飠饣鰣鲥鼫鼭
+shi3 乨使兘史始宩屎矢笶豕鉂駛驶
+shi4 世丗亊事仕侍冟势勢卋叓呩嗜噬士奭媞嬕室崼市式弑弒徥忕恀恃戺拭揓是昰枾柹柿栻氏澨烒眂眎眡睗示礻筮簭舐舓螫襫視视觢試誓諟諡謚试谥豉貰贳軾轼适逝適遾釈释釋鈰鉃鉽銴铈飾餙餝饰
+shi5 佦嵵榁煶竍篒籂鮖鰘
+shou1 収收
+shou3 垨守手艏首
+shou4 兽受售壽夀寿扌授涭狩獣獸痩瘦綬绶鏉
+shu1 书倏倐儵叔姝尗抒掓摅攄書杸枢梳樞橾殊殳毹淑焂瑹疎疏紓綀纾舒菽蔬跾踈軗輸输鄃陎鮛鵨
+shu2 塾婌孰熟璹秫贖赎
+shu3 属屬暏暑曙潻癙署薥薯藷蜀襡襩钃黍鼠鼡
+shu4 侸凁咰墅尌庶庻怷恕戍捒数數朮术束树樹沭漱潄澍濖瀭竖竪糬絉腧荗蒁虪蠴術裋豎述鉥錰鏣隃鱪鱰鶐
+shua1 刷唰
+shua3 耍
+shua4 誜
+shuai1 摔衰
+shuai3 甩
+shuai4 卛帅帥蟀
+shuan1 拴栓閂闩
+shuan4 涮腨
+shuang1 双孀孇欆礵艭雙霜騻驦骦鷞鸘鹴
+shuang3 塽慡樉漺爽縔
+shuang4 灀鏯
+shui2 脽誰谁
+shui3 水氵氺閖
+shui4 帨涗涚睡瞓祱稅税裞
+shun3 吮
+shun4 橓瞚瞬舜蕣順顺鬊
+shuo1 哾說説说
+shuo4 妁搠朔槊欶烁爍獡矟硕碩箾蒴鎙鑠铄
+si1 丝俬凘厮厶司咝嘶噝媤廝思恖撕斯楒榹泀澌燍磃禗禠私籭糹絲緦纟缌罳蕬虒蛳蜤螄蟖蟴鉰銯鋖鐁锶颸飔騦鷥鸶鼶
+si3 死
+si4 亖伺似佀価儩兕嗣四姒娰孠寺巳杫柶汜泗泤洍涘瀃牭祀禩竢笥耜肂肆蕼覗貄釲鈶鈻飤飼饲駟驷
+song1 倯凇娀崧嵩庺忪憽松枀枩柗梥檧淞濍硹菘蜙鍶鎹鬆
+song3 傱嵷怂悚愯慫楤竦耸聳駷
+song4 宋訟誦讼诵送頌颂餸
+sou1 嗖廀廋捜搜摉摗溲獀艘蒐蓃螋鄋醙鎪锼颼颾飕餿馊騪
+sou3 傁叜叟嗾擞擻櫢瞍籔薮藪
+sou4 嗽瘶膄
+su1 囌櫯甦稣穌窣苏蘇蘓酥
+su2 俗
+su4 傃僳嗉塐塑夙嫊宿愫愬憟梀榡樎樕橚殐泝洬涑溯溸潚潥玊珟璛碿簌粛粟素縤肃肅膆莤蔌藗觫訴謖诉谡趚蹜速遡遬鋉餗驌骕鯂鱐鷫鹔
+suan1 狻痠酸
+suan3 匴
+suan4 祘笇筭算蒜
+sui1 倠哸夊攵浽滖濉熣眭睢綏芕荽荾葰虽雖鞖
+sui2 瓍绥遀隋随隨
+sui3 瀡膸髄髓
+sui4 亗埣嬘岁嵗旞檅檖歲歳澻煫燧璲睟砕碎祟禭穂穗穟繀繐繸襚誶譢谇賥遂邃鐆鐩隧韢
+sun1 孙孫搎槂狲猻荪蓀蕵薞飧飱
+sun3 损損榫笋筍箰簨鎨隼鶽
+suo1 傞唆嗍嗦娑摍桫梭睃簑簔縮缩羧莏蓑趖髿鮻
+suo3 乺唢嗩惢所暛溑琐瑣璅索褨鎈鎍鎖鎻鏁锁
+suo4 溹蜶逤
+suo5 琑
+ta1 他嚃塌她它榙溻牠祂褟趿铊闧
+ta2 蹹
+ta3 塔墖溚獭獺鰨鳎
+ta4 亣侤咜嚺崉挞搨撻榻橽毾涾澾濌狧禢誻譶跶踏蹋躢遝遢錔闒闥闼鞜鞳鮙
+tai1 囼孡胎
+tai2 儓冭台坮嬯抬擡旲枱檯炱炲箈籉臺苔菭薹跆邰颱駘鮐鲐
+tai4 太夳忲态態汰泰溙燤粏肽舦酞鈦钛
+tan1 坍怹抩摊擹攤滩灘痑瘫癱舑貪贪
+tan2 倓坛墰墵壇壜婒惔憛昙曇榃檀潭燂痰磹罈罎藫覃談譚譠谈谭貚郯醈醰錟锬顃餤
+tan3 嗿坦忐憳憻毯璮菼袒襢醓鉭钽
+tan4 傝僋叹嘆埮探歎湠炭碳舕賧
+tang1 劏嘡坣汤湯羰耥薚蝪蹚鏜鐋铴镗鞺鼞
+tang2 傏唐啺堂塘搪棠榶樘橖溏漟煻瑭磄禟篖糃糖糛膅膛蓎螗螳赯踼鄌醣鎕闛隚餳餹饄饧鶶
+tang3 伖倘偒傥儻帑戃曭淌爣矘躺鎲钂镋
+tang4 摥烫燙趟
+tao1 夲嫍幍弢慆掏搯槄涛滔濤瑫絛縚縧绦詜謟轁鞱韜韬飸饕
+tao2 匋咷啕桃梼檮洮淘祹綯绹萄蜪裪迯逃醄鋾錭陶鞀鞉饀駣騊鼗
+tao3 討讨
+tao4 套
+te4 忑忒慝特蚮螣蟘貣鋱铽
+teng1 熥膯鼟
+teng2 儯幐滕漛疼痋籐籘縢腾藤虅誊謄邆駦騰驣鰧
+teng4 霯
+ti1 剔擿梯踢锑鷈鷉
+ti2 偍厗啼嗁崹徲惿提漽瑅碮禵稊綈緹绨缇罤苐荑蕛蝭褆謕趧蹄蹏遆醍銻鍗題题騠鮷鯷鳀鴺鵜鶗鶙鷤鹈
+ti3 体挮躰軆骵體鮧
+ti4 倜剃嚏嚔屉屜嵜悌悐惕惖戻掦揥替朑楴歒殢洟涕瓋笹籊薙裼褅趯迏逖逷髰鬀
+tian1 兲天婖添酟靔靝黇
+tian2 塡填屇恬搷沺湉璳甛甜田畋畑畠盷磌窴緂胋菾鈿闐阗鷆鷏
+tian3 倎唺忝悿晪殄淟琠痶睓腆舔覥觍賟錪鍩靦餂
+tian4 掭睼碵舚鴫
+tiao1 佻庣恌挑旫祧聎
+tiao2 岧岹条條樤祒笤芀萔蓚蓨蜩螩趒迢鋚鎥鞗髫鯈鰷鲦齠龆
+tiao3 嬥宨斢晀朓窕窱脁誂
+tiao4 眺粜糶絩覜跳
+tie1 帖怗聑萜貼贴
+tie3 僣蛈銕鋨鐡鐵铁驖鴩
+tie4 呫飻餮
+ting1 厅厛听庁廰廳桯汀烃烴町綎耓聴聼聽艼鞓
+ting2 亭停婷嵉庭廷楟榳渟筳聤莛葶蜓蝏諪邒閮霆鼮
+ting3 侹圢娗挺梃涏烶珽甼脡艇誔頲颋
+tong1 嗵囲炵痌蓪通
+tong2 仝佟僮勭同哃峂峝庝彤晍曈朣桐橦氃浵潼烔燑犝狪獞眮瞳砼秱童筩粡膧茼蚒詷赨酮鉖鉵銅铜餇鮦鲖
+tong3 捅桶樋筒統綂统
+tong4 恸慟憅痛衕
+tou1 偷偸婾媮鋀鍮
+tou2 亠头投緰頭骰
+tou3 妵敨紏蘣钭飳黈
+tou4 綉透
+tu1 凸唋堗宊嶀怢捸涋湥痜禿秃突葖鋵鵚鼵
+tu2 凃図图圕圖圗塗屠峹嵞庩廜徒悇捈揬梌汢涂潳瘏稌筡腯荼莵菟蒤跿途酴鈯鍎馟駼鵌鶟鷋鷵
+tu3 吐土圡釷钍
+tu4 兎兔堍迌鵵
+tuan1 湍煓猯貒
+tuan2 剸团団團慱抟摶槫檲漙篿糰鏄鷒鷻
+tuan3 疃
+tuan4 彖湪褖
+tui1 推蓷藬
+tui2 尵弚穨蘈蹪隤頹頺頽颓魋
+tui3 俀僓腿蹆骽
+tui4 侻娧煺蛻蜕褪退駾
+tun1 吞呑啍噋暾朜涒焞黗
+tun2 坉屯忳臀臋芚豘豚軘霕飩饨魨鲀
+tun3 氽畽
+tun4 旽
+tuo1 乇仛侂咃托扡拕拖挩捝杔汑沰涶脫脱莌袥託讬飥饦驝魠
+tuo2 佗坨堶岮槖橐沱沲狏砣砤碢紽袉跎迱酡陀陁馱駄駝駞騨驒驮驼鮀鴕鸵鼉鼍鼧
+tuo3 妥媠嫷庹彵椭楕橢鬌鰖鵎
+tuo4 唾拓柝毤毻箨籜萚蘀跅
+wa1 劸哇嗗娲媧挖搲攨洼溛漥畖穵窊窪蛙鼃
+wa2 娃
+wa3 佤咓瓦邷
+wa4 嗢聉腽膃袜襪韈韤
+wa5 屲瓲
+wai1 喎歪竵
+wai3 崴
+wai4 外夞顡
+wan1 剜塆壪婠帵弯彎湾潫灣蜿豌
+wan2 丸刓完岏抏捖汍烷玩琓紈纨翫芄頑顽
+wan3 倇唍埦婉宛惋挽晚晩晼梚椀琬畹皖盌睕碗綩綰绾脘菀萖踠輓鋄鋔
+wan4 万卍卐妧忨捥杤澫笂脕腕萬薍蟃貦贃贎輐邜錽鎫
+wang1 尣尩尪尫汪
+wang2 亡亾仼兦彺王莣蚟
+wang3 往徃徍惘暀枉棢瀇焹網网罒罔菵蛧蝄誷輞辋魍
+wang4 妄忘旺望朢盳迋
+wei1 偎危喴威媙巍微愄揋揻椳楲渨溦烓煨燰萎葨葳薇蜲蝛覣詴逶隇隈鰃鰄鳂
+wei2 为唯喡囗围圍圩媁峗峞嵬帏帷幃惟桅欈沩洈涠湋溈潍潙潿濰為犩琟癓硙磑維维蓶覹违違鄬醀鍏闈闱霺韋韦鮠
+wei3 伟伪偉偽僞儰厃壝委娓寪尾屗崣嵔徫愇撱斖暐梶椲洧浘濻瀢炜煒猥玮瑋痏痿硊磈緯纬腲艉芛苇荱葦蒍蔿薳蘤諉诿踓鍡韑韙韡韪頠颹骩骪骫鮪鲔
+wei4 位卫叞味喂墛媦尉嶶慰懀捤未渭煀煟熭爲犚猬璏畏碨緭縅罻胃苿菋蔚藯蘶蜼蝟螱衛衞褽謂讆讏谓躗躛軎轊鏏霨餧餵饖魏鮇鳚
+wen1 塭昷榅殟温溫瑥瘟蕰豱輼轀辒鞰鰛鰮鳁
+wen2 匁彣文炆玟珳琝瘒紋纹聞芠蚉蚊螡蟁閺閿闅闦闻阌雯馼魰鳼鴍鼤
+wen3 刎吻呡忟抆桽稳穏穩紊肳脗
+wen4 呚問妏揾搵汶渂璺莬鈫鎾问顐
+weng1 嗡滃翁螉鎓鶲鹟
+weng3 勜塕奣嵡暡瞈聬蓊
+weng4 瓮甕罋蕹齆
+wo1 倭唩挝撾涡涹渦猧窝窩莴萵蜗蝸踒
+wo3 婐我捰
+wo4 仴偓卧媉幄捾握擭斡枂楃沃涴渥濣焥瓁瞃硪肟腛臒臥雘齷龌
+wu1 乌剭呜嗚圬屋巫弙杇歍汙汚污洿烏窏箼螐誣诬邬鄔鎢钨鰞鴮
+wu2 吳吴吾呉唔娪无梧毋洖浯無珸璑祦禑芜茣莁蕪蜈蟱誈譕郚铻鯃鵐鷡鹀鼯
+wu3 五仵伍侮俉倵儛午啎妩娬嫵庑廡忤怃憮捂摀旿橆武潕熓牾玝珷瑦甒碔舞躌鵡鹉
+wu4 乄伆兀务務勿卼坞塢奦婺寤屼岉嵍嵨忢悞悟悮戊扤敄晤杌溩焐熃物痦矹窹粅芴蘁誤误迕逜遻鋈錻阢隖雺雾霚霧靰騖骛鶩鹜鼿齀
+xi1 俙傒僖兮凞卥厀吸唏唽嘻噏夕奚嬆嬉屖嵠嶲巇希徆徯忚怸恓息悉悕惁惜憙扱扸捿昔晞晰晳曦析桸榽樨橀橲欷氥汐浠淅渓溪潝烯焁焈焟焬煕熄熈熙熹熺熻燨爔牺犀犠犧狶琋瘜皙睎瞦硒磎礂稀穸窸粞糦緆縘繥羲翕肸肹膝舾莃菥蒠蜥螅螇蟋蠵西覀觹觽觿譆谿豀豨豯貕赥郗鄎酅醯釸錫鏭鑴锡隵雟餏饻鯑鵗鸂鼷
+xi2 习媳嶍席椺槢檄漝習蒵蓆薂袭襲覡觋謵趘郋鎴隰霫飁騱騽驨鰼鳛
+xi3 喜囍壐屣徙憘暿枲歖洗漇玺璽矖禧縰葈葸蓰蟢諰謑蹝躧鈢鉨鉩铣鱚
+xi4 係匸卌呬咥喺嚱墍屃屭忥怬恄慀戏戱戲椞欯滊潟澙熂犔盻矽磶禊稧系細綌繫细绤翖舃舄蕮虩衋覤赩趇郤釳闟阋隙隟霼餼饩鬩黖
+xia1 傄煆煵疨瞎虲虾蝦谺閕颬鰕
+xia2 侠俠匣峡峽敮暇柙炠烚狎狭狹珨瑕硖硤碬磍祫筪縀縖翈舝舺蕸赮轄辖遐鍜鎋陜陿霞騢魻鶷黠
+xia3 閜
+xia4 丅下乤吓嚇圷夏夓懗梺溊疜睱罅鎼鏬
+xian1 仙仚佡僊僲先嘕奾嬐屳廯忺憸掀攕暹杴枮氙珗祆秈籼繊纎纖纤苮莶薟褼襳訮跹蹮躚酰銛鍁鑦铦锨韯韱馦鮮鱻鲜鶱
+xian2 伭咸唌啣妶娴娹婱嫌嫺嫻弦憪挦撏涎澖燅甉痫癇癎瞯礥稴絃胘舷藖蚿蛝衔衘誸諴賢贒贤輱醎銜閑閒闲鷳鷴鷼鹇鹹麙
+xian3 冼尟尠崄嶮幰搟攇显櫶毨灦烍燹狝猃獫獮玁禒筅箲藓蘚蚬譣赻跣銑鍌险険險韅顕顯
+xian4 伣僩僴县咞哯垷壏姭娊娨宪岘峴憲撊晛橌涀瀗献獻现現県睍硍粯糮絤綫線縣线缐羡羨腺臔臽苋莧蜆誢豏鋧錎限陥陷餡馅麲鼸
+xiang1 乡厢啌廂忀楿欀湘瓖相稥箱緗缃膷芗葙薌襄郷鄉鄊鄕鑲镶香驤骧鱜麘
+xiang2 佭庠栙瓨祥絴翔詳详跭
+xiang3 享亯响想晑曏蠁銄響飨餉饗饟饷鮝鯗鱶鲞
+xiang4 像勨向嚮塂姠嶑巷橡珦缿萫蚃蟓衖襐象銗鐌闀項项鱌
+xiao1 侾削呺哓哮嘋嘐嘵嚣嚻囂婋宯宵庨彇憢揱枭枵梟櫹歊毊消潇瀟灱灲焇猇獢痚痟硝硣穘窙箫簘簫綃绡翛膮萧萷蕭藃虈虓蟂蟏蟰蠨踃逍銷销霄驍骁髇髐魈鴞鴵鸮
+xiao2 崤殽洨淆笅筊訤誵郩
+xiao3 小晓暁曉皛皢筱筿篠謏
+xiao4 俲傚効咲啸嘨嘯孝恷效敩斅斆校歗涍滧熽笑肖詨誟鞩
+xie1 些揳楔歇猲蝎蠍
+xie2 偕劦勰协協嗋垥夑奊峫恊愶拹挟挾携撷擕擷攜斜旪熁燲瑎綊緳纈缬翓胁脅脇脋膎蝢衺襭諧讗谐邪鞋鞵頡龤
+xie3 写冩寫藛
+xie4 亵伳偞偰僁卨卸噧塮娎媟屑屓屟屧嶰廨徢懈暬械榍榭泄泻洩渫澥瀉瀣灺炧烲焎燮爕獬祄禼糏紲絏絬緤繲绁缷薢薤蟹蠏褉褻謝谢躞邂鞢韰駴齂齘齛齥
+xin1 俽噺妡嬜廞心忄忻惞新昕杺欣歆炘盺芯薪訢辛邤鈊鋅鑫锌馨馫
+xin2 枔襑鐔
+xin3 伈
+xin4 伩信囟孞焮煡脪舋衅訫軐釁阠顖馸
+xing1 兴垶惺星曐煋猩瑆皨箵篂腥興蛵觪觲鍟騂骍鮏鯹
+xing2 侀刑哘型娙形擤洐滎睲硎荥行裄謃邢郉醒鈃鉶銒鋞钘铏陉陘
+xing4 倖姓婞嬹幸性悻杏涬緈臖荇莕
+xiong1 兄兇凶匈哅忷恟汹洶胷胸訩詾讻賯
+xiong2 熊雄
+xiong3 焽
+xiong4 夐敻焸詗诇
+xiu1 休俢修咻庥樇烋烌羞脙脩臹貅銝鎀鏅飍饈馐髤髹鮴鱃鵂鸺
+xiu2 苬
+xiu3 朽滫糔綇
+xiu4 嗅岫峀溴珛琇璓秀繍繡绣螑袖褎褏銹鏥鏽锈齅
+xu1 吁嘘噓墟媭嬃幁戌揟旴晇楈欨歔湑疞盱窢縃繻胥蕦虗虚虛蝑裇訏諝譃谞鑐需須頊须顼驉鬚魆魖
+xu2 俆徐蒣
+xu3 偦冔呴姁暊栩珝盨稰糈許詡许诩鄦醑
+xu4 伵侐勖勗卹叙喣垿壻婿序怴恤慉敍敘旭昫朂槒欰殈汿沀洫溆漵潊烅烼煦獝珬盢瞁瞲稸絮続緒緖續绪续聓聟芧蓄蓿藇藚訹賉酗銊魣鱮
+xuan1 儇吅喧塇媗宣弲愃愋懁揎昍暄梋煊瑄睻矎禤箮縇翧翾萱萲蓒蕿藼蘐蝖蠉諠諼譞谖軒轩鋗鍹駽
+xuan2 嫙悬懸旋暶檈漩玄玹琁璇璿痃蜁
+xuan3 咺晅烜癣癬选選顈
+xuan4 怰昡楥楦泫渲炫琄眩眴碹絢縼繏绚蔙衒袨讂贙鉉鏇铉镟鞙颴鰚
+xue1 疶蒆薛辥辪靴鞾
+xue2 乴壆学學岤峃嶨斈泶澩燢穴茓袕觷踅雤鷽鸴
+xue3 樰膤艝轌雪鱈鳕
+xue4 吷坹桖瀥狘血謔谑趐
+xun1 勋勛勲勳坃埙塤壎壦曛焄熏燻獯矄窨纁臐蔒薫薰蘍醺駨
+xun2 偱噚寻尋峋巡廵循恂揗攳旬杊栒桪槆樳毥洵浔潃潯灥燖珣璕畃紃荀荨蟳詢询鄩馴驯鱏鱘鲟
+xun4 伨侚卂噀嚑奞巺巽徇愻殉殾汛潠爋狥稄蕈訊訓訙训讯賐迅迿逊遜鑂顨鵕
+ya1 丫压吖圧垭埡壓孲庘押枒桠椏錏鐚鴉鴨鵶鸦鸭
+ya2 伢厑厓堐岈崕崖涯漄牙猚玡琊瑘睚笌芽蚜衙齖
+ya3 厊哑唖啞庌痖瘂蕥雅
+ya4 亚亜亞俹劜圔圠娅婭挜掗揠氩氬犽猰砑稏窫聐襾訝讶軋轧迓铔齾
+ya5 乛呀
+yan1 偣剦啱嫣嬮崦恹懕懨淊淹湮漹烟焉焑煙猒珚硽篶胭腌臙菸鄢醃閹阉黫
+yan2 严厳嚴塩壛壧妍姸娫娮孍岩嵒嵓巌巖巗延揅昖楌樮檐櫩欕沿湺炎熖狿琂盐研硏碞礹筵簷綖芫莚蔅虤蜒言訁詽讠軅郔閆閻阎顏顔颜鹽麣黬
+yan3 乵俨偃儼兖兗匽厣厴噞夵奄嵃嶖巘巚弇愝戭扊抁掩揜曮棪椼檿沇渰渷演琰甗眼縯罨萒蝘衍裺褗躽遃郾酓隒顩験魇魘鰋鶠黡黤黭黶鼴鼹齞齴龑
+yan4 偐傿厌厭咽唁喭嚥堰墕妟姲嬊嬿宴彥彦敥晏暥曕曣椻溎滟灎灔灧灩烻焔焰焱燄燕爓牪砚硯艳艶艷葕覎觃觾諺讌讞谚谳豓豔贋贗赝酀酽醶醼釅闫隁雁餍饜騐騴驗驠验鬳鳫鴈鴳鷃鷰
+yang1 咉央姎抰殃泱眏秧胦鉠雵鞅鴦鸯
+yang2 佯劷垟崵崸徉扬揚敭旸昜暘杨楊氜洋炀烊煬珜疡瘍眻禓羊羏蛘諹輰鍚鐊钖阦阳陽霷颺飏鰑鴹鸉
+yang3 仰佒傟养坱奍岟慃懩攁柍楧氧氱炴痒癢礢紻羪蝆軮養駚
+yang4 怏恙样様樣漾瀁羕詇
+yao1 吆喓夭妖幺枖楆殀祅腰葽訞邀鴁
+yao2 倄傜嗂垚堯姚媱尧尭峣嶢嶤徭愮揺搖摇暚榣烑爻猺珧瑤瑶窑窯窰繇肴蘨謠謡谣軺轺遙遥邎銚鎐顤颻飖餆餚鰩鳐
+yao3 仸偠咬婹宎岆崾抭杳柼榚溔狕眑窅窈舀苭蓔闄騕鴢鷕鼼齩
+yao4 曜熎燿獟矅穾窔筄纅耀艞药葯薬藥袎要覞詏讑鑰钥靿鷂鹞
+ye1 倻噎掖暍椰潱蠮
+ye2 亪捓揶擨爷爺耶釾鋣鎁铘
+ye3 也冶吔嘢埜壄漜野
+ye4 业亱僷叶啘嚈堨墷夜嶪嶫抴擛擪擫晔曄曅曗曳曵枼枽楪業歋殗液澲烨燁爗皣瞱瞸礏腋葉謁谒邺鄓鄴鍱鎑鐷靥靨頁页餣饁馌驜鵺鸈
+yi1 一乊伊依医吚咿噫壱壹夁嫛嬄弌悘揖檹欹毉洢漪猗瑿祎禕稦繄蛜衣譩郼醫銥铱鷖鹥黟黳
+yi2 乁仪侇儀冝凒匇匜咦圯夷姨媐宐宜宧寲峓嶬嶷巸弬彛彜彝彞怡恞扅拸暆柂栘桋椬椸沂沶熪狋珆瓵畩疑痍眙移箷簃籎羠耛胰萓萟蛦螔衤衪袘觺訑詑詒誃謻讉诒貤貽贻跠辷迆迤迻遗遺鏔頉頤顊颐飴饴鶍鸃
+yi3 乙以佁倚偯崺已庡扆攺敼旑旖椅檥矣礒笖肔舣艤苡苢蚁螘蟻裿踦輢轙逘酏釔鉯钇顗鳦齮
+yi4 乂义亄亦亿伇伿佚佾俋億兿刈劓劮勚勩呓呭呹唈囈圛坄垼埶埸墿奕嫕嬑嬟寱屹峄嶧帟帠幆廙异弈弋役忆怈怿悒悥意憶懌懿抑挹捙掜撎敡斁易晹曀曎杙枍枻栧栺棭榏槸檍欥欭歝殔殪殹毅泆洂浂浥浳湙溢潩澺瀷炈焲熠熤熼燚燡燱獈玴異疫痬瘗瘞瘱癔益睪瞖硛秇穓竩縊繶繹绎缢羛義羿翊翌翳翼耴肄肊膉臆艗艺芅苅蓺薏藙藝蘙虉蛡蜴螠衵袣裔裛褹襼訲訳詍詣誼譯議讛议译诣谊豙豛豷賹贀跇軼轶逸邑醳醷釴鈠鎰鐿镒镱陭隿霬靾饐駅驛驿骮鮨鯣鶂鶃鷁鷊鷧鷾鹝鹢黓齸
+yin1 侌凐喑噾囙因垔堙姻婣愔慇摿栶歅殷氤洇溵瘖禋秵筃絪緸茵荫蔭裀諲銦铟闉阥阴陰陻隂霒霠鞇音韾駰骃
+yin2 乑冘吟噖嚚圁垠夤婬寅峾崟崯斦檭殥泿淫滛烎犾狺珢璌碒苂荶蔩蟫訔訚訡誾鄞鈝銀银霪鷣齗龂
+yin3 乚吲尹嶾廴引朄檃櫽淾濥濦瘾癮磤蘟蚓螾讔赺趛輑鈏隐隠隱靷飮飲饮
+yin4 印垽堷廕慭憖憗懚檼洕湚猌癊粌胤茚蒑酳鮣
+ying1 偀啨嘤嚶婴媖嫈嬰孆孾应応應撄攖朠桜樱櫻渶煐瑛璎瓔甇甖碤礯緓纓绬缨罂罃罌膺英莺蘡蝧蠳褮譍譻賏鍈鑍锳霙韺鴬鶑鶧鶯鷪鷹鸎鸚鹦鹰
+ying2 僌営塋嬴愥攍攚楹櫿溁溋滢潆濙濚濴瀅瀛瀠瀯瀴灐灜熒營珱瑩盁盈籝籯縄縈茔荧莹萤营萦萾蓥藀蛍蝇蝿螢蠅覮謍贏赢軈迎鎣
+ying3 巊廮影摬梬浧潁璄瘿癭矨穎郢頴颍颕颖
+ying4 噟媵映暎硬膡鐛鞕鱦
+yo1 哟唷喲
+yong1 佣傭嗈噰墉壅嫞庸廱慵拥擁槦滽澭灉痈癕癰臃邕郺鄘鏞镛雍雝饔鱅鳙鷛
+yong2 喁揘牅顒颙鰫
+yong3 俑傛勇勈咏埇塎嵱彮怺恿悀惥愑愹慂柡栐永泳涌湧甬砽硧禜蛹詠踊踴鯒鲬
+yong4 用苚醟
+you1 优優呦嚘幽忧怮悠憂攸櫌泑滺瀀纋耰逌鄾麀
+you2 偤尢尤峳怣斿楢櫾沋油浟游犹猶猷由疣秞肬莜莸蕕蚰蝣訧輏輶逰遊邮郵鈾铀駀魷鮋鱿鲉
+you3 丣卣友孧庮懮有栯梄槱湵牖牗禉羐羑聈脜苃莠蒏蜏酉銪铕黝
+you4 亴佑侑又右哊唀囿姷宥峟幼柚牰狖祐糿蚴誘诱貁迶酭釉鼬
+yu1 唹扜毺淤瘀盓穻箊紆纡虶迂迃陓
+yu2 乻于亐伃余俞兪堣堬妤娛娯娱嬩崳嵎嵛愉愚扵揄於旕旟杅桙楡楰榆欤歈歟歶渔渝湡漁澞牏狳玗玙瑜璵畭盂睮硢禺窬竽籅羭腴臾舁舆艅茰萮萸蕍蘛虞蝓螸衧褕覦觎諛謣谀踰輿逾邘酑鍝隅雓雩餘馀騟骬髃魚鮽鰅鱼鷠鸆
+yu3 与予伛俁俣偊傴匬噳圄圉宇寙屿峿嶼庾懙挧敔斔斞楀澚瑀瘐祤禹窳羽與荢萭蘌語语貐鄅鋙雨頨鯲麌齬龉
+yu4 俼儥喅喐喩喻噊圫域堉妪媀嫗寓峪嶎庽彧御忬悆惐愈慾戫昱棛棜棫櫲欎欝欲毓浴淢淯滪潏澦灪焴煜燏燠爩狱獄玉琙瘉癒矞砡硲礇礖礜禦秗稢稶穥篽籞籲緎繘罭聿肀育艈芋芌茟蒮蓣蓹蕷薁蜟蜮裕誉諭譽谕豫軉輍轝逳遇遹郁醧鈺銉鋊錥鐭钰閾阈霱預预飫饇饫馭驈驭鬰鬱鬻魊鱊鳿鴥鴪鵒鷸鸒鹆鹬龥
+yuan1 冤剈囦嬽寃悁惌棩淵渁渆渊渕灁眢箢葾蒬蜎蜵裷駌鳶鴛鵷鸢鸳鹓鼘鼝
+yuan2 元円原厡厵员員园圆圎園圓垣塬媴嫄援杬榞榬橼櫞沅湲源溒爰猨猿獂笎緣縁缘羱茒蒝薗蚖蝝蝯螈袁謜貟贠轅辕邍邧酛鈨鎱騵魭鶢鶰黿鼋
+yuan3 盶远逺遠鋺
+yuan4 傆噮垸夗妴媛怨愿掾瑗禐肙苑衏裫褑褤院願
+yue1 彟彠曰曱矱箹約约
+yue4 刖妜嬳岄岳嶽恱悅悦戉抈捳月樾瀹爚玥礿禴篗籆籥籰粤粵蘥蚎蚏越跀跃躍軏鈅鉞钺閱閲阅鸑鸙黦龠
+yun1 奫晕暈氲氳煴縕缊蒀蒕蝹贇赟頵馧
+yun2 云勻匀囩妘愪抣昀榲橒沄涢溳澐熉畇眃秐筠筼篔紜縜繧纭耘耺芸蒷蕓郧鄖鋆雲饂
+yun3 允喗夽抎殒殞狁磒荺褞賱鈗阭陨隕霣馻齳
+yun4 傊孕恽惲愠慍枟熅熨緷緼腪蕴薀藴蘊运運郓鄆酝醖醞韗韞韫韵韻餫
+za1 匝咂帀拶沞紥紮臜臢迊鉔魳
+za2 偺喒囋囐嶻杂砸磼襍雑雜雥韴
+za3 咋
+zai1 哉栽渽災灾烖甾睵菑賳
+zai3 宰崽
+zai4 侢傤儎再在扗洅縡載载酨
+zan1 兂簪簮糌鐕鐟
+zan2 咱
+zan3 儧儹噆寁揝撍攅攒攢昝桚沯礸趱趲
+zan4 暂暫濽灒瓉瓒瓚禶襸讃讚賛贊赞蹔鄼酇錾鏨饡
+zang1 匨牂羘臧蔵賍賘贓贜赃髒
+zang3 駔驵
+zang4 塟奘弉脏臓臟葬銺
+zao1 傮糟蹧遭醩
+zao2 凿鑿
+zao3 早枣栆棗澡璪繰薻藻蚤
+zao4 唕唣喿噪慥梍灶燥皁皂竃竈簉艁譟趮躁造
+ze2 则則唶啧嘖嫧帻幘択择擇樍沢泎泽溭澤皟瞔矠礋笮箦簀舴荝蠌襗諎謮責賾责赜迮鸅齚齰
+ze4 仄伬夨崱庂捑昃昗汄蔶
+zei2 戝蠈賊贼鯽鰂鱡鲗
+zen3 怎
+zen4 譖譛谮
+zeng1 増增憎橧熷璔矰磳繒缯罾譄鄫
+zeng4 囎甑贈赠鋥锃鱛
+zha1 偧劄吒哳喳奓扎抯挓揸摣柤査楂樝渣皶皻觰譇齄齇
+zha2 札煠牐甴箚耫蚻譗鍘铡閘闸
+zha3 厏拃搩眨砟苲踷鮓鮺鲊鲝
+zha4 乍咤宱搾柞栅榨溠灹炸痄蚱詐诈醡霅
+zhai1 捚摘斋斎榸齋
+zhai2 宅檡
+zhai3 窄鉙
+zhai4 债債夈寨瘵砦粂
+zhan1 噡嶦惉旃旜栴毡氈氊沾瞻粘薝蛅詀詹譫讝谵趈邅閚霑飦饘驙魙鱣鳣鸇鹯
+zhan3 嫸展崭嶃嶄搌斩斬椫榐橏琖盏盞輾辗醆颭飐黵
+zhan4 佔偡占嶘战戦戰栈桟棧湛站綻绽菚蘸虥虦覱譧輚轏驏
+zhang1 傽墇嫜张張彰慞暲樟漳獐璋章粻蔁蟑遧鄣餦騿鱆麞
+zhang3 仉掌涨漲礃
+zhang4 丈仗嶂帐帳幛幥扙杖涱痮瘬瘴瞕粀胀脹賬账鏱鐣障
+zhao1 佋啁妱巶招昭皽盄窼釗鉊鍣钊駋
+zhao3 找沼爪爫瑵罀
+zhao4 兆召垗旐曌枛棹櫂炤照燳狣瞾笊罩羄肁肇肈詔诏赵趙鮡
+zhe1 嗻嫬蜇遮
+zhe2 厇哲啠喆嚞埑悊折摺晢晣歽矺砓磔籷粍虴蛰蟄袩詟謫謺讁讋谪輒輙轍辄辙銸馲鮿
+zhe3 乽啫禇者褶襵赭锗
+zhe4 柘樜浙淛潪蔗蟅这這鷓鹧
+zhe5 着
+zhen1 侦偵嫃寊帪搸斟栕桢桭楨榛樼殝浈潧澵獉珍珎瑧甄眞真砧碪祯禎禛箴籈胗臻葴蒖蓁薽貞贞轃遉酙針鉁錱鍼针靕鱵
+zhen3 屒弫抮昣枕畛疹眕稹紾絼縥缜聄袗裖診诊軫轸駗鬒黰
+zhen4 侲圳塦挋振揕敶朕栚瑱甽眹紖纼萙誫賑赈酖鋴鎭鎮镇阵陣震鴆鸩
+zheng1 争佂埩姃媜峥崝崢征徰徴徵怔挣掙揁炡烝爭狰猙癥眐睁睜筝箏篜聇蒸诤踭鉦錚钲铮鬇鯖
+zheng3 愸抍拯掟撜整晸氶糽
+zheng4 凧塣帧幀政正症証諍證证郑鄭鴊
+zhi1 之倁卮吱坧巵戠搘支枝栀梔椥榰汁汥泜疷知祗祬禔秓秖秪稙綕織织肢胑胝脂臸芝蘵蜘衼隻馶鳷鴲鵄鼅
+zhi2 侄値值儨嗭埴執墌妷姪嬂慹执摭植樴殖淔漐犆瓡直禃絷縶聀职職膱蟙褁貭跖踯蹠躑軄釞鉄馽
+zhi3 劧只咫址坁夂帋怾恉扺抧指旨枳止汦沚洔淽疻砋祉紙纸芷藢衹襧訨趾軹轵酯阯黹
+zhi4 乿俧偫傂凪制劕厔垁墆娡寘峙崻帙帜幟庢庤廌彘徏徔徝志忮憄懥懫扻挃挚掷搱摯擲擳旘晊智柣栉桎梽楖櫍櫛治洷滍滞滯潌瀄炙熫狾猘瓆畤疐痔痣礩祑秩秲秷稚稺穉窒筫紩緻置翐膣至致芖蛭螲袟袠製覟觗觯觶誌謢豑豒豸質贄质贽跱踬躓軽輊轾迣郅銍鋕鑕铚锧阤陟雉駤騭騺驇骘鯯鴙鷙鸷
+zhong1 中伀刣妐幒彸忠柊汷泈炂盅籦終终舯蔠螤螽衳衷蹱鈡銿鍾鐘钟锺鼨
+zhong3 冢喠塚塜尰歱煄瘇种種穜肿腫踵
+zhong4 仲众偅堹妕媑狆眾祌筗茽蚛衆衶諥迚重
+zhou1 侜周喌州徟掫洲淍烐珘盩矪粥舟謅譸诌诪賙赒輈輖辀週郮銂霌騆鵃鸼
+zhou2 妯軸轴
+zhou3 帚晭炿疛睭箒肘菷駲鯞
+zhou4 伷僽冑呪咒咮噣宙昼晝甃皱皺籀籒籕粙紂縐纣绉胄荮葤詋詶酎駎驟骤
+zhu1 侏劯朱株槠橥櫧櫫洙潴瀦猪珠硃秼絑茱蛛蝫蠩袾誅諸诛诸豬跦邾銖铢駯鮢鯺鴸鼄
+zhu2 孎曯欘泏灟炢烛燭爥瘃窋竹竺笁笜築舳茿蠋蠾躅逐鱁
+zhu3 丶主劚嘱囑宔拄斸渚濐煑煮瞩矚罜詝陼麈
+zhu4 伫佇住助坾墸壴嵀杼柱樦殶注炷疰眝砫祝祩竚筑筯箸篫紵紸纻羜翥苎莇著蛀註貯贮跓軴迬鉒鋳鑄铸霔馵駐驻麆
+zhua1 抓檛簻膼髽
+zhuai3 跩
+zhuai4 拽
+zhuan1 专叀塼嫥専專瑼甎砖磗磚膞蟤諯鄟顓颛鱄
+zhuan3 孨竱転轉转
+zhuan4 僎啭囀堟撰灷瑑篆篹籑腞蒃襈譔賺赚饌馔
+zhuang1 妆妝娤庄庒桩梉樁湷粧糚荘莊装裝
+zhuang4 壮壯壵戇撞漴焋状狀
+zhui1 椎追錐锥隹騅骓鵻
+zhui3 沝
+zhui4 坠墜娷惴桘甀畷硾礈笍綴縋缀缒膇諈譵贅赘轛醊錣鑆餟
+zhun1 宒窀肫衠諄谆迍
+zhun3 准凖埻準綧
+zhun4 稕訰
+zhuo1 倬卓拙捉桌棁棳涿炪穛穱蠿
+zhuo2 丵叕啄啅圴妰娺彴撯擆擢斀斫斱斲斵晫梲椓槕櫡汋浊浞濁濯灂灼烵犳琢琸硺禚窡窧篧籗籱罬茁蠗諁諑謶诼酌鋜鐯鐲镯鵫鷟
+zhuo4 劅
+zi1 乲兹咨嗞姕姿孜孳孶嵫栥椔淄湽滋澬玆璾禌秶稵粢紎緇缁茊茲葘觜訾諮谘貲資赀资趑趦輜輺辎鄑鈭錙鍿鎡锱镃頾頿髭鯔鰦鲻鶅鼒齍龇
+zi2 蓻
+zi3 仔吇呰啙姉姊子杍梓榟滓矷秄秭笫籽紫耔胏虸訿釨
+zi4 倳剚字恣渍漬牸眥眦胔胾自芓茡
+zi5 崰橴
+zong1 倧堫宗嵏嵕嵸惾朡棕椶熧猣磫稯綜緃緵综翪腙葼蝬豵踨踪蹤鍐鑁騌騣骔鬃鬉鬷鯮鯼
+zong3 偬傯总惣愡捴揔搃摠総縂總蓗鏓
+zong4 倊昮潈猔疭瘲碂粽糉糭縦縱纵錝
+zou1 棷棸箃緅菆諏诹邹郰鄒鄹陬騶驺鯫鲰黀齱齺
+zou3 走赱
+zou4 奏揍楱鯐
+zu1 租葅蒩
+zu2 傶卆卒哫崒崪族箤足踤踿鏃镞
+zu3 俎爼珇祖組组詛诅鎺阻靻
+zuan1 躜鑽钻
+zuan3 籫繤纂纉纘缵
+zuan4 攥鑚
+zui1 厜嗺朘樶纗蟕
+zui3 嘴噿嶊嶵璻
+zui4 晬最枠栬槜檇檌祽稡穝絊罪蕞辠酔酻醉鋷錊
+zun1 墫壿尊嶟樽繜罇遵鐏鱒鳟鷷
+zun3 僔噂撙譐
+zun4 捘銌鶎
+zuo1 嘬
+zuo2 捽昨椊秨稓筰莋鈼
+zuo3 佐唨左繓
+zuo4 作侳做咗唑坐岝岞座怍祚糳胙葃葄蓙袏阼飵
+`
+
+// pinyinPhraseData 多音字词组拼音字典,每行为"词组 逐字拼音",用于纠正单字默认读音.
+const pinyinPhraseData = `
+爱好 ai4 hao4
+肮脏 ang1 zang1
+班长 ban1 zhang3
+宝藏 bao3 zang4
+剥削 bo1 xue1
+薄弱 bo2 ruo4
+薄荷 bo4 he5
+补给 bu3 ji3
+不了了之 bu4 liao3 liao3 zhi1
+部长 bu4 zhang3
+财会 cai2 kuai4
+参与 can1 yu4
+草率 cao3 shuai4
+测量 ce4 liang2
+参差 cen1 ci1
+差别 cha1 bie2
+差距 cha1 ju4
+差异 cha1 yi4
+茶几 cha2 ji1
+差事 chai1 shi4
+搀和 chan1 huo5
+单于 chan2 yu2
+偿还 chang2 huan2
+厂长 chang3 zhang3
+朝鲜 chao2 xian3
+沉没 chen2 mo4
+沉着 chen2 zhuo2
+成都 cheng2 du1
+成长 cheng2 zhang3
+重播 chong2 bo1
+重重 chong2 chong2
+重叠 chong2 die2
+重逢 chong2 feng2
+重复 chong2 fu4
+重建 chong2 jian4
+重来 chong2 lai2
+重名 chong2 ming2
+重启 chong2 qi3
+重庆 chong2 qing4
+重申 chong2 shen1
+重试 chong2 shi4
+重围 chong2 wei2
+重写 chong2 xie3
+重新 chong2 xin1
+重阳 chong2 yang2
+重置 chong2 zhi4
+重装 chong2 zhuang1
+重组 chong2 zu3
+重做 chong2 zuo4
+冲床 chong4 chuang2
+冲压 chong4 ya1
+出差 chu1 chai1
+出没 chu1 mo4
+处罚 chu3 fa2
+处方 chu3 fang1
+处分 chu3 fen4
+处境 chu3 jing4
+处理 chu3 li3
+处于 chu3 yu2
+处置 chu3 zhi4
+畜生 chu4 sheng5
+处长 chu4 zhang3
+穿着 chuan1 zhuo2
+船长 chuan2 zhang3
+答应 da1 ying5
+打量 da3 liang5
+打中 da3 zhong4
+大夫 dai4 fu5
+单薄 dan1 bo2
+当成 dang4 cheng2
+当天 dang4 tian1
+当作 dang4 zuo4
+得要 dei3 yao4
+的士 di1 shi4
+的确 di2 que4
+地方 di4 fang5
+典当 dian3 dang4
+掉色 diao4 shai3
+东西 dong1 xi5
+懂得 dong3 de5
+董事长 dong3 shi4 zhang3
+都城 du1 cheng2
+都督 du1 du1
+都市 du1 shi4
+对应 dui4 ying4
+队长 dui4 zhang3
+发卡 fa4 qia3
+返还 fan3 huan2
+反省 fan3 xing3
+反应 fan3 ying4
+仿佛 fang3 fu2
+分行 fen1 hang2
+附和 fu4 he4
+咖喱 ga1 li2
+干部 gan4 bu4
+干活 gan4 huo2
+干劲 gan4 jin4
+干什么 gan4 shen2 me5
+干事 gan4 shi5
+干线 gan4 xian4
+歌曲 ge1 qu3
+蛤蜊 ge2 li2
+耕种 geng1 zhong4
+供给 gong1 ji3
+供应 gong1 ying4
+公转 gong1 zhuan4
+骨干 gu3 gan4
+瓜蔓 gua1 wan4
+关卡 guan1 qia3
+归还 gui1 huan2
+国都 guo2 du1
+蛤蟆 ha2 ma5
+行家 hang2 jia1
+行列 hang2 lie4
+行情 hang2 qing2
+行业 hang2 ye4
+行长 hang2 zhang3
+好客 hao4 ke4
+好奇 hao4 qi2
+好学 hao4 xue2
+衡量 heng2 liang2
+呼吁 hu1 yu4
+还击 huan2 ji1
+还价 huan2 jia4
+还款 huan2 kuan3
+还钱 huan2 qian2
+还原 huan2 yuan2
+还账 huan2 zhang4
+回应 hui2 ying4
+会儿 hui4 er5
+会长 hui4 zhang3
+和面 huo2 mian4
+和稀泥 huo4 xi1 ni2
+几乎 ji1 hu1
+几率 ji1 lv4
+击中 ji1 zhong4
+给予 ji3 yu3
+记得 ji4 de5
+系鞋带 ji4 xie2 dai4
+家长 jia1 zhang3
+结巴 jie1 ba5
+结实 jie1 shi5
+今朝 jin1 zhao1
+尽管 jin3 guan3
+尽快 jin3 kuai4
+尽量 jin3 liang4
+尽早 jin3 zao3
+京都 jing1 du1
+局长 ju2 zhang3
+觉得 jue2 de5
+角色 jue2 se4
+倔强 jue4 jiang4
+看护 kan1 hu4
+看守 kan1 shou3
+看中 kan4 zhong4
+科长 ke1 zhang3
+空调 kong1 tiao2
+空白 kong4 bai2
+空缺 kong4 que1
+空隙 kong4 xi4
+空闲 kong4 xian2
+口角 kou3 jue2
+会计 kuai4 ji4
+理发 li3 fa4
+连长 lian2 zhang3
+了不起 liao3 bu4 qi3
+了结 liao3 jie2
+了解 liao3 jie3
+了如指掌 liao3 ru2 zhi3 zhang3
+露脸 lou4 lian3
+露面 lou4 mian4
+露馅 lou4 xian4
+埋没 mai2 mo4
+埋怨 man2 yuan4
+勉强 mian3 qiang3
+明了 ming2 liao3
+命中 ming4 zhong4
+冒顿 mo4 du2
+万俟 mo4 qi2
+没收 mo4 shou1
+模板 mu2 ban3
+模具 mu2 ju4
+模样 mu2 yang4
+目的 mu4 di4
+内行 nei4 hang2
+能干 neng2 gan4
+宁可 ning4 ke3
+宁愿 ning4 yuan4
+暖和 nuan3 huo5
+排行 pai2 hang2
+排行榜 pai2 hang2 bang3
+配角 pei4 jue2
+偏差 pian1 cha1
+便宜 pian2 yi2
+器乐 qi4 yue4
+恰当 qia4 dang4
+牵强 qian1 qiang3
+强词夺理 qiang3 ci2 duo2 li3
+强迫 qiang3 po4
+轻率 qing1 shuai4
+龟兹 qiu1 ci2
+曲子 qu3 zi5
+人参 ren2 shen1
+色子 shai3 zi5
+扇动 shan1 dong4
+单田芳 shan4 tian2 fang1
+单县 shan4 xian4
+扇子 shan4 zi5
+商行 shang1 hang2
+商量 shang1 liang5
+上当 shang4 dang4
+社长 she4 zhang3
+声乐 sheng1 yue4
+生长 sheng1 zhang3
+省长 sheng3 zhang3
+师长 shi1 zhang3
+适当 shi4 dang4
+似的 shi4 de5
+嗜好 shi4 hao4
+适应 shi4 ying4
+市长 shi4 zhang3
+首都 shou3 du1
+首长 shou3 zhang3
+数落 shu3 luo4
+数数 shu3 shu4
+数一数二 shu3 yi1 shu3 er4
+树干 shu4 gan4
+率领 shuai4 ling3
+率先 shuai4 xian1
+睡觉 shui4 jiao4
+睡着 shui4 zhao2
+思量 si1 liang5
+宿舍 su4 she4
+所长 suo3 zhang3
+弹簧 tan2 huang2
+弹琴 tan2 qin2
+弹性 tan2 xing4
+坦率 tan3 shuai4
+填空 tian2 kong4
+调和 tiao2 he2
+调剂 tiao2 ji4
+调节 tiao2 jie2
+调解 tiao2 jie3
+调控 tiao2 kong4
+调料 tiao2 liao4
+调皮 tiao2 pi2
+调试 tiao2 shi4
+调味 tiao2 wei4
+调戏 tiao2 xi4
+调整 tiao2 zheng3
+同行 tong2 hang2
+头发 tou2 fa5
+团长 tuan2 zhang3
+退还 tui4 huan2
+妥当 tuo3 dang4
+外行 wai4 hang2
+尾巴 wei3 ba5
+为此 wei4 ci3
+为何 wei4 he2
+为了 wei4 le5
+为什么 wei4 shen2 me5
+午觉 wu3 jiao4
+误差 wu4 cha1
+西藏 xi1 zang4
+喜好 xi3 hao4
+戏曲 xi4 qu3
+厦门 xia4 men2
+显着 xian3 zhu4
+县长 xian4 zhang3
+相处 xiang1 chu3
+相应 xiang1 ying4
+相中 xiang1 zhong4
+响应 xiang3 ying4
+校长 xiao4 zhang3
+协调 xie2 tiao2
+血淋淋 xie3 lin2 lin2
+星宿 xing1 xiu4
+省亲 xing3 qin1
+姓解 xing4 xie4
+兄长 xiong1 zhang3
+畜牧 xu4 mu4
+旋转 xuan2 zhuan4
+削弱 xue1 ruo4
+淹没 yan1 mo4
+要求 yao1 qiu2
+要挟 yao1 xie2
+钥匙 yao4 shi5
+衣着 yi1 zhuo2
+因为 yin1 wei4
+音乐 yin1 yue4
+银行 yin2 hang2
+应和 ying4 he4
+应用 ying4 yong4
+游说 you2 shui4
+有空 you3 kong4
+尉迟 yu4 chi2
+院长 yuan4 zhang3
+乐队 yue4 dui4
+乐府 yue4 fu3
+乐谱 yue4 pu3
+乐器 yue4 qi4
+乐曲 yue4 qu3
+乐团 yue4 tuan2
+乐章 yue4 zhang1
+脏话 zang1 hua4
+藏族 zang4 zu2
+曾国藩 zeng1 guo2 fan1
+增长 zeng1 zhang3
+曾子 zeng1 zi3
+长辈 zhang3 bei4
+长大 zhang3 da4
+长官 zhang3 guan1
+长老 zhang3 lao3
+长孙 zhang3 sun1
+长子 zhang3 zi3
+朝气 zhao1 qi4
+朝三暮四 zhao1 san1 mu4 si4
+朝夕 zhao1 xi1
+朝阳 zhao1 yang2
+着火 zhao2 huo3
+着急 zhao2 ji2
+着凉 zhao2 liang2
+正月 zheng1 yue4
+支行 zhi1 hang2
+值得 zhi2 de5
+直率 zhi2 shuai4
+执着 zhi2 zhuo2
+种子 zhong3 zi5
+种地 zhong4 di4
+中毒 zhong4 du2
+中奖 zhong4 jiang3
+中肯 zhong4 ken3
+中暑 zhong4 shu3
+种田 zhong4 tian2
+种植 zhong4 zhi2
+主干 zhu3 gan4
+主角 zhu3 jue2
+转动 zhuan4 dong4
+传记 zhuan4 ji4
+转盘 zhuan4 pan2
+着陆 zhuo2 lu4
+着落 zhuo2 luo4
+着手 zhuo2 shou3
+着想 zhuo2 xiang3
+着重 zhuo2 zhong4
+着装 zhuo2 zhuang1
+自给自足 zi4 ji3 zi4 zu2
+自传 zi4 zhuan4
+自转 zi4 zhuan4
+总行 zong3 hang2
+组长 zu3 zhang3
+琢磨 zuo2 mo5
+作曲 zuo4 qu3
+`
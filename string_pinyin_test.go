@@ -0,0 +1,125 @@
+package kgo
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestPinyin(t *testing.T) {
+	var tests = []struct {
+		str      string
+		style    LkkPinyinStyle
+		expected string
+	}{
+		{"中国", PINYIN_STYLE_NORMAL, "zhong guo"},
+		{"中国", PINYIN_STYLE_TONE, "zhōng guó"},
+		{"中国", PINYIN_STYLE_TONE_NUM, "zhong1 guo2"},
+		{"中国", PINYIN_STYLE_FIRST_LETTER, "z g"},
+		{"重庆银行行长", PINYIN_STYLE_NORMAL, "chong qing yin hang hang zhang"},
+		{"重要", PINYIN_STYLE_TONE_NUM, "zhong4 yao4"},
+		{"绿色的", PINYIN_STYLE_TONE, "lǜ sè de"},
+		{"绿色的", PINYIN_STYLE_TONE_NUM, "lv4 se4 de"},
+		{"小说", PINYIN_STYLE_TONE, "xiǎo shuō"},
+		{"嗯", PINYIN_STYLE_TONE, "n\u0301"},
+		{"龘靐", PINYIN_STYLE_NORMAL, "da bing"},
+		{"國語", PINYIN_STYLE_TONE_NUM, "guo2 yu3"},
+		{"㐀中", PINYIN_STYLE_NORMAL, "㐀 zhong"},
+		{"𠀀", PINYIN_STYLE_TONE, "𠀀"},
+		{"你好，world 2020!", PINYIN_STYLE_NORMAL, "ni hao world 2020"},
+		{"hello", PINYIN_STYLE_TONE, "hello"},
+		{"", PINYIN_STYLE_TONE, ""},
+	}
+	for _, test := range tests {
+		actual := strings.Join(KStr.Pinyin(test.str, test.style), " ")
+		if actual != test.expected {
+			t.Errorf("Expected Pinyin(%s, %d) to be %s, got %s", test.str, test.style, test.expected, actual)
+			return
+		}
+	}
+
+	res := KStr.Pinyin("你好，世界", PINYIN_STYLE_NORMAL)
+	if len(res) != 4 {
+		t.Error("Pinyin fail")
+		return
+	}
+}
+
+func BenchmarkPinyin(b *testing.B) {
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		KStr.Pinyin("重庆银行行长", PINYIN_STYLE_TONE)
+	}
+}
+
+func TestPinyinJoin(t *testing.T) {
+	res1 := KStr.PinyinJoin("重庆", PINYIN_STYLE_FIRST_LETTER, "")
+	res2 := KStr.PinyinJoin("音乐会", PINYIN_STYLE_NORMAL, "")
+	res3 := KStr.PinyinJoin("长大", PINYIN_STYLE_TONE, "-")
+	if res1 != "cq" || res2 != "yinyuehui" || res3 != "zhǎng-dà" {
+		t.Error("PinyinJoin fail")
+		return
+	}
+}
+
+func BenchmarkPinyinJoin(b *testing.B) {
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		KStr.PinyinJoin("重庆", PINYIN_STYLE_FIRST_LETTER, "")
+	}
+}
+
+func TestAddPinyinPhrase(t *testing.T) {
+	if KStr.PinyinJoin("乐乐", PINYIN_STYLE_TONE_NUM, " ") != "le4 le4" {
+		t.Error("AddPinyinPhrase fail")
+		return
+	}
+
+	err := KStr.AddPinyinPhrase("乐乐", "yue4 yue5")
+	if err != nil || KStr.PinyinJoin("乐乐快乐", PINYIN_STYLE_TONE_NUM, " ") != "yue4 yue kuai4 le4" {
+		t.Error("AddPinyinPhrase fail")
+		return
+	}
+
+	if KStr.AddPinyinPhrase("乐乐", "yue4") == nil {
+		t.Error("AddPinyinPhrase length fail")
+		return
+	}
+	if KStr.AddPinyinPhrase("乐a", "le4 a") == nil {
+		t.Error("AddPinyinPhrase non-chinese fail")
+		return
+	}
+	if KStr.AddPinyinPhrase("乐乐", "le4 le45") == nil || KStr.AddPinyinPhrase("乐乐", "le4 l-e") == nil {
+		t.Error("AddPinyinPhrase syllable fail")
+		return
+	}
+}
+
+func BenchmarkAddPinyinPhrase(b *testing.B) {
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = KStr.AddPinyinPhrase("重庆", "chong2 qing4")
+	}
+}
+
+func TestPinyinSort(t *testing.T) {
+	names := []string{"张三", "李四", "alice", "王五", "赵六", "阿三", "Bob"}
+	KStr.PinyinSort(names)
+	if strings.Join(names, ",") != "阿三,alice,Bob,李四,王五,张三,赵六" {
+		t.Error("PinyinSort fail")
+		return
+	}
+
+	names = []string{"妈", "马", "麻", "骂"}
+	KStr.PinyinSort(names)
+	if strings.Join(names, ",") != "妈,麻,马,骂" {
+		t.Error("PinyinSort tone fail")
+		return
+	}
+}
+
+func BenchmarkPinyinSort(b *testing.B) {
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		KStr.PinyinSort([]string{"张三", "李四", "王五", "赵六"})
+	}
+}
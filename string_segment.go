@@ -0,0 +1,268 @@
+package kgo
+
+import (
+	"sort"
+	"strings"
+	"sync"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Keyword 关键词及其权重
+type Keyword struct {
+	Word   string  // 关键词
+	Weight float64 // 权重(TF-IDF)
+}
+
+// segmentDict 中文分词词典
+type segmentDict struct {
+	sync.RWMutex
+	costs   map[string]int // 词语 => 代价
+	maxLen  int            // 词语的最大字数
+	maxCost int            // 词典中的最大代价
+}
+
+const (
+	// segmentUnknownExtra 未登录单字的代价,在词典最大代价的基础上增加
+	segmentUnknownExtra = 20
+	// segmentUserCost 自定义词语的代价,较小以便优先切分出来
+	segmentUserCost = 70
+)
+
+var (
+	segmentOnce       sync.Once
+	segmentDictionary *segmentDict
+)
+
+// segmentStopWords 提取关键词时忽略的常用虚词
+var segmentStopWords = map[string]bool{
+	"我们": true, "你们": true, "他们": true, "她们": true, "它们": true, "自己": true,
+	"这个": true, "那个": true, "这些": true, "那些": true, "这样": true, "那样": true,
+	"这里": true, "那里": true, "什么": true, "怎么": true, "为什么": true, "如何": true,
+	"因为": true, "所以": true, "但是": true, "而且": true, "并且": true, "或者": true,
+	"如果": true, "虽然": true, "然后": true, "已经": true, "可以": true, "没有": true,
+	"就是": true, "还是": true, "不是": true, "一个": true, "一些": true, "以及": true,
+	"the": true, "and": true, "for": true, "are": true, "was": true, "with": true,
+	"that": true, "this": true, "from": true, "have": true, "has": true, "not": true,
+}
+
+// getSegmentDict 获取分词词典,首次调用时解析内置数据.
+func getSegmentDict() *segmentDict {
+	segmentOnce.Do(func() {
+		segmentDictionary = newSegmentDict()
+	})
+	return segmentDictionary
+}
+
+// newSegmentDict 根据内置数据创建分词词典.
+func newSegmentDict() *segmentDict {
+	sd := &segmentDict{costs: make(map[string]int, 42000)}
+	for _, line := range strings.Split(segmentDictData, "\n") {
+		fields := strings.Fields(line)
+		if len(fields) < 2 {
+			continue
+		}
+		cost := 0
+		for _, c := range fields[0] {
+			cost = cost*10 + int(c-'0')
+		}
+		for _, word := range fields[1:] {
+			sd.add(word, cost)
+		}
+	}
+
+	return sd
+}
+
+// add 添加词语,须持有写锁.
+func (sd *segmentDict) add(word string, cost int) {
+	sd.costs[word] = cost
+	if n := utf8.RuneCountInString(word); n > sd.maxLen {
+		sd.maxLen = n
+	}
+	if cost > sd.maxCost {
+		sd.maxCost = cost
+	}
+}
+
+// cut 对连续的汉字构建有向无环图(DAG),再用动态规划求总代价最小的切分,返回每个词的字数.
+func (sd *segmentDict) cut(hans []rune) []int {
+	sd.RLock()
+	defer sd.RUnlock()
+
+	n := len(hans)
+	unknown := sd.maxCost + segmentUnknownExtra
+	//best[i]为从第i个字到末尾的最小代价,next[i]为从第i个字开始的词的字数
+	best := make([]int, n+1)
+	next := make([]int, n)
+	for i := n - 1; i >= 0; i-- {
+		best[i] = unknown + best[i+1]
+		next[i] = 1
+		if c, ok := sd.costs[string(hans[i])]; ok {
+			best[i] = c + best[i+1]
+		}
+		for l := 2; l <= sd.maxLen && i+l <= n; l++ {
+			if c, ok := sd.costs[string(hans[i:i+l])]; ok && c+best[i+l] < best[i] {
+				best[i] = c + best[i+l]
+				next[i] = l
+			}
+		}
+	}
+
+	res := make([]int, 0, n)
+	for i := 0; i < n; i += next[i] {
+		res = append(res, next[i])
+	}
+
+	return res
+}
+
+// subWords 获取词语中包含的词典内的短词,返回各短词的起始位置和字数,用于搜索引擎模式.
+func (sd *segmentDict) subWords(word []rune) [][2]int {
+	sd.RLock()
+	defer sd.RUnlock()
+
+	var res [][2]int
+	for l := 2; l < len(word); l++ {
+		for i := 0; i+l <= len(word); i++ {
+			if _, ok := sd.costs[string(word[i:i+l])]; ok {
+				res = append(res, [2]int{i, l})
+			}
+		}
+	}
+	return res
+}
+
+// segmentHans 切分连续的汉字,繁体按转换为简体后的结果切分.
+func segmentHans(hans []rune, search bool) []string {
+	sd := getSegmentDict()
+	simple := []rune(getChineseDict(CHINESE_T2S).convert(string(hans)))
+	if len(simple) != len(hans) {
+		simple = hans
+	}
+
+	res := make([]string, 0, len(hans))
+	i := 0
+	for _, l := range sd.cut(simple) {
+		if search && l > 2 {
+			for _, sub := range sd.subWords(simple[i : i+l]) {
+				res = append(res, string(hans[i+sub[0]:i+sub[0]+sub[1]]))
+			}
+		}
+		res = append(res, string(hans[i:i+l]))
+		i += l
+	}
+
+	return res
+}
+
+// segmentText 对字符串分词;非汉字部分的连续字母和数字作为一个词,空白和标点被忽略.
+func segmentText(str string, search bool) []string {
+	res := make([]string, 0, utf8.RuneCountInString(str)/2+1)
+
+	var hans []rune
+	var other strings.Builder
+	flushHans := func() {
+		if len(hans) > 0 {
+			res = append(res, segmentHans(hans, search)...)
+			hans = hans[:0]
+		}
+	}
+	flushOther := func() {
+		if other.Len() > 0 {
+			res = append(res, other.String())
+			other.Reset()
+		}
+	}
+
+	for _, r := range str {
+		if unicode.Is(unicode.Han, r) {
+			flushOther()
+			hans = append(hans, r)
+			continue
+		}
+
+		flushHans()
+		if unicode.IsLetter(r) || unicode.IsDigit(r) || unicode.IsMark(r) {
+			other.WriteRune(r)
+		} else {
+			flushOther()
+		}
+	}
+	flushHans()
+	flushOther()
+
+	return res
+}
+
+// Segment 中文分词,基于内置词典构建DAG并用动态规划求最优切分,可识别繁体.
+// 非汉字部分的连续字母和数字作为一个词,空白和标点被忽略.
+func (ks *LkkString) Segment(str string) []string {
+	return segmentText(str, false)
+}
+
+// SegmentSearch 搜索引擎模式分词,在Segment的基础上对长词再切分出其中的短词,适用于建立全文索引.
+// 如"中华人民共和国"返回"中华","华人","人民","共和","共和国","中华人民共和国"等.
+func (ks *LkkString) SegmentSearch(str string) []string {
+	return segmentText(str, true)
+}
+
+// AddSegmentWords 添加自定义词语到分词词典,如专有名词、新词等;繁体词语按简体存储.
+func (ks *LkkString) AddSegmentWords(words ...string) {
+	sd := getSegmentDict()
+	t2s := getChineseDict(CHINESE_T2S)
+
+	sd.Lock()
+	defer sd.Unlock()
+	for _, word := range words {
+		word = strings.TrimSpace(word)
+		if word == "" {
+			continue
+		}
+		if simple := t2s.convert(word); utf8.RuneCountInString(simple) == utf8.RuneCountInString(word) {
+			word = simple
+		}
+		sd.add(word, segmentUserCost)
+	}
+}
+
+// ExtractKeywords 基于TF-IDF提取关键词,返回按权重降序排列的前topN个,topN<=0时返回全部.
+// 逆文档频率由词典中的词频估算,未登录词视为罕见词;单字、纯数字和常用虚词被忽略.
+func (ks *LkkString) ExtractKeywords(str string, topN int) []Keyword {
+	sd := getSegmentDict()
+	t2s := getChineseDict(CHINESE_T2S)
+
+	var total int
+	freqs := make(map[string]int)
+	for _, word := range segmentText(str, false) {
+		total++
+		word = strings.ToLower(word)
+		if utf8.RuneCountInString(word) < 2 || segmentStopWords[word] || strings.Trim(word, "0123456789") == "" {
+			continue
+		}
+		freqs[word]++
+	}
+
+	res := make([]Keyword, 0, len(freqs))
+	sd.RLock()
+	for word, freq := range freqs {
+		cost, ok := sd.costs[t2s.convert(word)]
+		if !ok {
+			cost = sd.maxCost + segmentUnknownExtra
+		}
+		res = append(res, Keyword{Word: word, Weight: float64(freq) / float64(total) * float64(cost) / 10})
+	}
+	sd.RUnlock()
+
+	sort.Slice(res, func(i, j int) bool {
+		if res[i].Weight != res[j].Weight {
+			return res[i].Weight > res[j].Weight
+		}
+		return res[i].Word < res[j].Word
+	})
+	if topN > 0 && topN < len(res) {
+		res = res[:topN]
+	}
+
+	return res
+}
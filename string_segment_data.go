@@ -0,0 +1,761 @@
+package kgo
+
+// segmentDictData 中文分词词典,每行为"代价 词语列表",包括单字和词语.
+// 代价约为词频负对数的倍数,越小表示越常用;切分时取总代价最小的路径.
+const segmentDictData = `
+31 的
+42 年 日 月
+45 在
+46 了 人 和
+47 中
+48 是
+49 円 我 第
+50 上 大 者
+51 中国 市 有 本 等 网
+52 与 会 分 新
+53 下 为 他 你 名 商品 次 用 県
+54 一 之 信息 元 对 我们 方 管理
+55 一个 不 使用 公司 前 小 就 日本 服务 行 论坛
+56 万 中心 也 产品 利用 到 区 及 号 图 地 性 您 或 教育 新闻 検索 版 私 表示 要
+57 世界 以 企业 価格 内容 出 化 回 回复 家 将 工作 所 投稿 数 来 生活 而 自己 评论 金 首页 高
+58 以上 件 内 可 可以 图片 多 大学 天 市场 店 提供 时间 水 点 研究 社会 税込 网站 色 被 都 页
+59 三 下载 个 事 从 使 北京 发展 名前 后 型 技术 文章 方法 时 更新 最新 法 用户 给 网络 联系 言 说 这 部
+60 一覧 上海 二 今日 但 何 健康 全 其 可能 商 她 好 室 思 情 文化 最 条 注册 游戏 町 相关 系 请 问题 集
+61 于 今 令和 会社 位 入 全国 其他 写真 发表 器 国家 安全 官 平成 必要 所有 手机 把 搜索 方式 更多 有限公司 机 没有 生 由 目 科技 系统 经济 美 聊天 自分 让 进行
+62 个人 他们 以下 作品 作者 先 关于 再 去 又 发 向 品 国际 外 女 如果 子 学校 已 并 广告 度 当 得 心 我的 手 推荐 支持 最近 期 木 気 王 电话 看 社 能 车 这个 道 里
+63 一般 主题 人民 什么 价格 会员 作 参加 各 同 国 土 地域 基本 如 学 学生 就是 希望 年度 建设 式 很 房 投资 政府 文 查看 此 比 法律 活动 火 着 知 站 笑 美女 表 该 贴 超 送料 通 那 重要 项目 食
+64 今年 代表 位置 做 先生 免费 分析 力 卡 台 四 国内 地方 城市 女性 対応 局 工程 已经 张 收藏 政策 旅行 无 更 未 李 案内 楼 汽车 海 爱 物 现在 电子 男 画像 科 第一 类 组 美国 自 至 花 要求 设计 资料 起 还 通信 部分 需要
+65 专业 主 主要 仕事 价 作成 光 全部 共 出来 出版社 制度 功能 加入 华 发布 合作 吧 啊 因为 地区 如何 字 安 帖 建 开发 情况 成 数据 数码 文件 旅游 星 映画 最大 株式会社 楽天 様 注意 注文 率 电影 电脑 留言 番号 発売 直接 省 社区 第二 系列 能力 路 这样 追加 选择 通过 非常 音楽
+65 香港 駅
+66 一些 不能 专题 五 交流 付 代 体 体育 你的 信 加 单位 名称 吗 品牌 地址 城 変更 大家 娱乐 它 密码 小时 屋 展 山 帮助 库 开始 引用 成功 报 持 指定 按 掲示板 文字 显示 普通 有关 朋友 村 条件 林 标准 株 歳 浏览 海外 特 生产 用品 目前 相 科学 篇 米 组织 自然
+66 行业 规定 设备 送 通知 量 非 音乐 频道 食品
+67 不是 业务 中央 予定 交通 人员 人才 人気 介绍 以内 以及 任何 但是 公告 其它 出版 分类 分钟 制作 削除 包括 南 历史 参考 取 合 同时 含 呢 周 声 女人 学习 完全 完成 家庭 工具 彩 总 想 成为 成人 或者 所以 才 招聘 提出 携帯 改革 料 料理 斯 方面 日期 明 本站 款 正 氏 深圳
+67 物品 环境 発送 登录 白 目的 秒 税 総合 经营 编辑 网上 网友 老 股 自由 行政 西 评 购 资源 込 过 还是 这些 这里 通常 郡 院 面
+68 一切 一定 一种 不同 不要 专家 业 中文 中的 也是 产业 京 京都 今回 以外 们 低 供应 保険 入力 全球 共同 初 募集 包 即 原 县 发现 取得 君 因 図 培训 基 声明 媒体 存在 学院 宝 実施 客 客户 帖子 应 应用 建立 开 强 德 必须 感 打 打印 报告 招 提高 支援 政治 春
+68 曲 最高 机构 来源 枚 查询 楽 概要 欢迎 消息 真 知道 神 积分 立 简介 精神 経済 级 街 読 记者 资讯 赛 足 跟 达 近 这种 进入 部门 都是 金融 销售 阿 雪 音 首 龙
+69 一番 一部 七 两 中华 书 事件 交易 他的 会议 住宅 作为 例 便 保护 修正 党 六 关系 关闭 其中 具有 出现 刘 利 制 动态 北 却 原因 发送 受 口 只 台湾 咨询 地図 地图 均 基础 处理 変 夏 夜 大会 太 子供 定価 対策 対象 少 广东 广州 広告 当前 形式 影响 很多 意见 房地产
+69 探 控制 操作 时尚 最后 服 朝 材料 板 権 歌 死 比较 热 焦点 片 版权 特集 理解 発行 発表 百 知识 短信 程序 程度 空 窗口 章 第三 综合 置 美容 考试 而且 英 英语 视频 解决 计划 认为 证 责任 走 转 还有 重 长 阅读 陈 限定 集团 顶 领导
+70 一次 上市 不得 为了 买 人物 人生 今天 以前 住 住所 俺 像 克 全体 全面 具体 写 决定 処理 则 利益 努力 効果 北海道 友 双 发生 只有 味 商业 回答 因此 固定 土地 基金 增加 士 委 孩子 实施 实现 家族 对于 尔 届 工 平 平均 建议 形 形成 影片 得到 怎么 思想 意味 我国 指 指南
+70 接受 教 教学 数字 文学 料金 方案 旅 明星 昨日 是否 标题 校 根据 毎日 求 法人 浙江 深 温泉 源 猫 理由 由于 申请 男人 画面 番 的是 看到 短 石 秀 种 管 精彩 素材 経営 红 线 群 致 艺术 若 行为 装修 设 详细 说明 谁 调查 财经 质量 购买 转载 过程 返回 送信 都市 酒
+70 银行 高校 黄
+71 一下 一人 一样 东 了解 事情 二手 云 亿 企画 传 体験 作用 保 保健 保存 修改 値 先进 免 八 关注 册 农业 列表 券 副 加工 加强 医院 十分 千 单 卖 只是 叫 史 吃 各种 周辺 商务 喜欢 增长 处 天津 头 女子 定 展示 岁 差 市民 店舗 府 座 引 彼 志 快 急
+71 感想 我要 投票 报价 报道 担当 授权 支付 放送 故事 教授 数量 方向 易 昭和 最初 服装 期待 未来 格 案 模式 正在 正式 水平 没 法规 波 注 派 流 流行 测 游 物件 特価 状态 球 生命 申 电 男性 病 病院 研究所 禁止 称 移动 类型 精品 続 经 经验 结果 继续 考 能够 航空 良 芯
+71 范围 获得 装 讨论 购物 费 越 身 那么 配件 配送 重点 钱 问 队 障害 雨 青 风 香 马 黑
+72 丁目 不断 不过 专区 之后 之间 九 交 交友 代理 便利 保持 保障 倍 值 儿 全新 公 公共 冬 创新 办 十 博 印刷 友情 反 取引 右 合同 堂 奥 家具 専用 山东 州 工业 左 巻 布 带 平台 应当 应该 康 当然 影 役 御 心理 快速 成果 成立 戦 房产 找 拉 拥有 按照 排行
+72 播放 收入 改善 效果 新着 时代 明日 普 曾 有效 有限 本文 来自 杨 杯 歴史 残 民 民族 江苏 测试 液晶 清 激 热点 照片 特别 特定 玉 现代 理 理论 电视 発 発生 百万 目标 直 相机 票 福 福祉 秋 秘密 空间 箱 精华 経 结构 统计 罗 美元 职业 股份 自身 茶 著作 蓝 虽然 観光
+72 觉得 解 设置 访问 负责 身体 达到 运动 速度 造成 道路 邪 部屋 酒店 采用 重庆 铃声 门 防 除 项 飞 魔
+73 一年 一直 三星 不可 不知道 世 东西 为什么 之一 人们 人数 令 任 传真 体制 体系 你们 依 保证 保险 全文 共有 关键 具 内部 农村 准备 出口 切 列 删除 判断 到了 办公 办法 化学 医学 医疗 十大 半 南京 占 原创 去年 参与 受付 古 可能性 同意 告诉 团 団体 园 地球 场 多少 大人 大型 大変 大量
+73 天下 天気 女孩 好友 姿 学会 安心 容易 宿泊 导航 小说 居 川 工事 左右 年代 建筑 开展 开放 当社 待 患者 感觉 战略 手数料 才能 执行 拡大 接 接続 推出 搜 撮影 改 放 教室 教师 新的 无法 旧 春节 景 本体 机会 机械 材 条例 某 森 横浜 正常 正文 母 每 求人 油 治 治疗 活用 港
+73 热门 然后 版本 牛 特殊 特色 犬 狗 独立 班 甚至 生物 田 甲 画 界 留学 监督 相互 相当 真正 研 突破 答 管理者 类别 経験 縺 经典 维护 联 自动 英文 行情 观点 规划 记录 证券 评价 语言 谈 资金 起来 趣味 这么 进 连 迪 选 速 配 配置 重大 集中 需求 青年 韩国 高级 高速 魅力
+74 一位 一张 一篇 一起 不会 专 世纪 丽 乐 了承 事故 亚 产生 人口 仅 付款 代金 以后 以来 价值 任务 休闲 传统 佳 信箱 公开 共和国 军 农民 出品 出演 分野 创业 制定 加盟 労働 包装 北京市 区域 午前 协会 协议 博士 卫生 印 厂 厅 参照 变化 只要 可是 各地 名古屋 听 呼 喜 嘉 四川 基地 売
+74 备 大全 大学生 天地 奇 奖 套 如此 娘 学科 安装 宣传 家居 容量 少年 就业 尼 屏 师 帰 幅 干部 并不 広 影音 彼女 律师 徒歩 性感 性能 恋 懈 成本 成都 找到 技 投诉 拍 指导 据 措施 摄影 攻略 教材 整理 新品 新年 无线 映像 有些 未经 本人 杰 档案 械 检查 検査 榜 欲 武
+74 段 江 注目 活 消费 添加 漫画 热线 爱情 犯罪 玩 现 瑞 申込 电信 症 的话 盘 相手 真的 硬盘 神奈川 积极 程 稳定 突然 符合 等级 管理人 素 紫 総 统一 网页 老师 联盟 股票 背景 胸 色情 节 英国 药 薪 血 表情 装置 観 见 规则 认证 讯 许多 论 词 诚信 课程 调整 贸易 资
+74 赚钱 赤 超级 超过 输入 运行 返信 进一步 那些 邮件 邮箱 配信 采购 镇 间 防止 雅 韩 驱动 鹿 黒
+75 一天 一度 一点 下午 不足 且 业主 严重 丸 举行 乗 乳 二人 二十 互联网 亦 人的 仍 仕様 他人 以降 伊 似 余 促进 信用 修 健 元気 公布 公式 兰 其实 再生 军事 冷 剑 力量 医 南方 単位 卡通 卷 及其 反映 受到 变 另外 同志 向上 呀 员 命 哈 啦 国民 图书 地震 坚持 埼玉
+75 塔 変化 复制 外国 大切 大小 天然 夫 她的 字体 宇 安排 实际 宿 富 导 小学校 少女 层 属 工商 带来 幸福 广西 引起 当时 影视 応援 快乐 怎样 惊 我是 户 所在地 手段 承担 投 投入 折 指数 排行榜 提案 提示 撮 收 整 文明 新潟 施工 日报 早 昔 星座 昭 最佳 有効 期间 朱 机关 杂志
+75 权 条款 来说 杭州 极 校园 株式 根 根本 梅 梦 植物 概念 横 死亡 比赛 毛 注明 泰 照 爆 狂 玩具 现场 男女 病毒 登记 皆様 目录 看看 眼 石油 码 研修 硬件 确定 禁 福建 竞争 端 第四 简单 絵 经过 结合 绝对 维 网址 群众 肉 肌 舞 良好 著者 表现 袋 规范 解析 警察 话
+75 语 调 谢 谢谢 谷 象 资产 赵 软件 连接 追 通讯 遵守 那个 酷 降 限 除了 雷 需 音像 领域 高度
+76 一家 一日 一条 万人 上述 不错 专辑 东方 中古 主任 之前 乙 九州 也不 也有 予想 事业 享受 亮 人事 人士 人大 企 优势 优惠 优秀 作家 供 依法 保留 倒 停止 儿童 充分 党员 入学 共享 内存 几个 凡 出租 分享 分别 刚 别 制造 剤 割 劳动 化工 十二 印象 厂商 原则 参数 及时 収入 另 只能 召开
+76 台北 吉 吴 员工 周年 哦 商店 喔 因素 団 国外 培养 增 大事 姓名 威 存储 孙 季 宇宙 完善 宗教 官方 定期 实践 実 実行 実験 容 密 寝 导致 将来 小姐 尾 履歴 山口 峰 巨 巨大 巴 席 帮 常 干 年前 年齢 广 床 徐 德国 心情 応 意 意义 感染 我想 战 戻 所属
+76 技巧 抜 招生 控 推 推广 描 摘 支払 改变 改正 数学 整个 新作 新宿 方便 族 日常 日本人 是非 晓 智 更加 最低 本日 札幌 权利 松 查 核心 格式 桥 欧洲 歌曲 歩 每天 气 永 求职 河南 泉 派遣 流通 消 液 游客 湖 湖南 激情 点数 牌 物流 特徴 特点 环 理想 男子 留言板 登陆 皆
+76 皮 盒 相信 礼品 祝 神戸 祭 科目 稿 立即 竹 第十 米国 精 约 纳 结束 编 编号 美丽 胡 自拍 船 获 菜 薬 视 角 訳 订阅 认识 记 诚 读 豊富 财务 费用 足球 较 迅速 过去 返事 远 迷 途中 道德 都有 配合 酸 采取 重新 野 野球 银 错误 阶段 题 风险 食事 鬼
+76 黄金
+77 一体 一口 一夜 一致 不仅 不再 不少 严格 中学 中部 人民政府 人类 介 代码 仪 优先 做好 充実 児童 入札 共通 关 兵 准 出席 创 别人 办理 动 动画 北方 医药 匿名 十一 十五 升 升级 反对 反馈 发行 可爱 叶 司 司法 各位 合格 合理 同学 名字 吨 启动 呵呵 唐 唯一 商家 商标 回到 困 国务院 在宅
+77 地产 地位 売上 多数 天使 存 学术 守 完 宏 宝贝 实 实行 対 尊重 小学 尚 属于 岐阜 帯 并且 弾 当日 形象 往 必 応募 恐怖 恩 悪 感情 慧 成分 成员 成绩 戦略 房屋 所在 打开 批 批准 技能 把握 抓 报名 拿 指出 振 振込 掌握 排 排名 推进 提 支 收费 改造 政 新疆 施
+77 施行 既 明显 明确 晚 普及 智能 曝光 最终 有人 有名 望 期限 未満 末 本公司 机制 机票 杀 果 案件 梁 检索 歌手 正确 武器 武汉 每日 比例 民主 汗 沙 河 法国 洋 海南 海洋 温度 湖北 満足 漂亮 演出 灯 炎 父 特性 状 玩家 环保 现象 用意 疑 疾病 痛 登 目次 真实 研究生 程式 稿件
+77 第一次 粉 索 美食 老人 考虑 而是 耳 职工 联合 聴 自主 舞台 艾 花园 苏 苑 英雄 草 药品 著名 行动 被害 装饰 褉 西安 要素 规模 计算 认真 讲 论文 设施 证书 话题 课 贷款 资格 赚 越来越 辽宁 返 返品 进口 部落 金属 鉄道 铁 铺 长期 附件 限制 随着 难 静 面积 革命 鞋 首先 香水
+77 魔法
+78 一方 丁 三年 三菱 三重 上午 下列 下面 不安 不明 不知 不良 专栏 中共 丰富 主机 举办 予算 于是 云南 亚洲 些 人材 仁 今度 从事 仙 令人 任意 优 伙伴 会计 伟 传播 伦 住民 作出 供求 依頼 価値 促销 信号 假 允许 兄弟 入荷 兴 兼 兽 再次 农 冠 冰 减少 出售 刀 创造 判 利润 到着
+78 刺激 剩余 加快 务 助 効率 十六 千円 卫 压力 厚 参 友人 双方 取消 后来 命令 哈哈 哲学 售 唱 善 国有 圣 地点 域 増 増加 增强 壁 声音 外部 多种 大丈夫 大分 大幅 大陆 天堂 失 夹 奈良 女生 如下 妹 妻 始 它们 它的 安定 安徽 宋 完了 完整 完美 宗 実践 寄 富士 寒 对象
+78 封 小组 居民 山形 山西 币 幻 广大 庁 底 庭 建物 弱 当地 当店 录 征 很大 很好 微软 心配 思考 性教育 总数 恢复 情人 情感 惠 意思 感到 我也 戦争 房子 打造 批发 承 抗 换 排序 接口 描述 提交 提升 搞笑 摩托 支部 收到 攻撃 故 敏 教程 整体 新刊 日付 日前 日记 旭 是在 曰 曼
+78 服饰 权限 杜 松下 柏 标 树 格安 案例 检测 森林 模 模型 次数 欣赏 止 残念 每年 毒 気分 気軽 江西 河北 洪 浪漫 涉及 涙 混 温 满足 然而 熊本 燕 父母 物业 物理 特价 状况 狼 玲 理念 生徒 用于 电源 皮肤 益 盛 目指 石川 破 秋田 科研 策略 精选 素质 终于 经理 维修 绿 绿色
+78 美味 联想 股东 股市 育 背 背后 胜 能源 脚 脳 膜 自宅 节目 莫 落 落实 薄 虎 衣 表明 表面 褋 解释 解除 読者 许可 证明 评估 诺 读书 豆 财富 责 货 资本 趋势 踏 辞典 边 运输 这次 追求 造 遭 郭 重量 野菜 鉄 针对 钢 阳光 附 雰囲気 零 青春 靠 颜色 风格 馆
+78 首次 骨 高考 鱼 鲁
+79 一只 一段 一生 上海市 上网 下降 不可能 不好 不用 专利 专用 东北 中国人 中山 主体 主席 乃 久 也可以 书店 书记 事例 事実 产权 人体 仔 仙台 份 伝 传奇 伪 伴 似乎 佐藤 佛 例如 倶楽部 值得 全般 公安 几乎 凯 出力 分子 分布 分配 刊登 创建 初心者 初期 制品 制限 割引 加拿大 动力 动物 北部 区分 协调 危険
+79 即可 即将 压 原来 发挥 取扱 受信 受験 各国 各类 合法 同一 同样 同様 吸引 吹 和平 哪 器材 回家 困难 固 图像 圈 在此 外科 多媒体 大众 大厦 好的 妈妈 宁 宅配 实用 实验 宠物 审计 室内 家园 富山 对比 寺 小型 居住 属性 岛 工学 工艺 市町村 希 常用 平方米 年生 年金 广场 建材 弊社 微 忘记 忙
+79 性格 性的 怪 总是 恒 意外 意识 成了 我在 战争 手册 扩大 批评 拍卖 持续 挑战 挑戦 探索 接触 推动 提醒 文献 新型 新春 无论 无限 时期 明白 昨年 是不是 晚上 晴 更好 最多 月份 有了 有权 本来 本科 本身 权益 样 桜 棒 楽器 様子 欧 此外 歯科 比如 毕业 水晶 永远 沈阳 泊 法制 消防 深入 清晰 清楚
+79 済 渋谷 渠道 満 满 演 演奏 灰 特典 独自 猪 现实 现金 珠 理财 甘 用途 田中 电力 电器 电池 留 疯狂 病気 症状 発言 监 相应 真是 眼睛 短期 破坏 确保 祝福 福井 离开 租 移民 空港 穿 突出 笑话 笔 第五 等等 简体 算 粤 糖尿病 纪 纯 纸 经常 给予 羊 翔 翻译 老板 考察 考生
+79 聘 肯定 腿 自信 自我 至少 色彩 芸能 苦 茨城 莉 菲 蔚 藏 虚拟 虫 装备 西藏 西部 要望 角度 训练 试 读者 豪 贝 跳 身上 软 载 运营 适用 逆 通用 郑 都会 都道府県 采 里面 重视 铃 链 错 降低 隆 随 露 霸 青岛 青森 面对 面白 预测 高知 高齢 魂 鲜花 麦 黑色
+80 一份 一名 一周 一旦 三十 上手 不到 专门 中华人民共和国 中学校 中村 丰 丹 之家 也许 乡 书面 乱 予防 事前 五年 交付 亭 人工智能 人文 今月 仍然 从而 仕方 休 体现 体验 你是 信頼 修理 値段 做到 兆 先日 入手 全然 公里 具备 内衣 冠军 凌 処分 出発 出荷 出身 刊 初回 别墅 到底 制御 前提 功 加上 动作 勇
+80 医生 千万 华人 印度 即使 原料 原理 厦门 反応 収集 发言 变成 另一 台式 号码 吉林 名单 含有 吸 周刊 和歌山 哪里 器具 四国 回来 国产 在于 地上 地下 地理 坊 坐 块 垃圾 塑料 塩 塾 壁纸 外贸 大法 大连 天天 天空 太平洋 女士 奴 妹妹 姆 娜 婚 婚姻 嫌 孔 学者 定义 宝宝 审批 宣布 寸 对方
+80 寻找 小区 小泉 尚未 就在 就要 尺寸 尽管 屏幕 山梨 岩 岩手 工资 市内 常见 平和 广泛 庆 店铺 引擎 强调 归 役割 微信 心中 心里 怡 总结 息 感覚 感谢 成熟 成长 戴 所得 手法 抢 抱 担保 指标 损失 捷 措置 揃 搞 支出 支店 攻击 放心 政务 故障 数据库 整合 斗 新手 日程 旨 明年 映 昨天
+80 显 暗 暴力 曾经 替 最好 有点 本地 本店 本格 本部 栃木 标签 核 桂 権利 正是 母亲 汇 汉 池 污染 汽 沈 沪 泣 泻 洗 洛 活性 流程 涓 淘 添 渡 满意 灵 炒 照明 熟 父亲 牙 独 独特 生存 生成 略 発展 登入 相对 矛盾 破解 硕 硕士 确认 示 礼 祥 离 秦
+80 穴 空気 窓 窓口 策划 粒 精英 継続 级别 组合 组成 经历 罗拉 罪 羽 翻訳 耗 育成 胁 腰 腾 自体 航 般 芝 芳 若干 英才 范 莱 萌 营 血液 西方 观 观察 规格 角色 言论 计算机 订单 许 设立 诗 贡献 财产 财政 贸 赴 跑 身份 转换 转让 轻 轻松 迎 运 违反 连续 述
+80 退 退出 适合 透明 遇到 避免 那样 那里 邮 金额 锛 键 门户 队伍 阳 降价 陕西 隐私 雄 雅虎 集体 集合 雇用 青少年 靓 音声 顺 食物 首都 高中 魏 齐
+81 一律 一批 一本 一言 一项 丈夫 三大 上升 上面 下来 不想 不敢 业界 中介 主人 主催 主办 主管 么 之中 之外 也要 习惯 事实 互动 井 产 人工 仕 付属 以为 仪器 件数 休日 众 伯 位于 住房 体重 使得 依据 価 便宜 俄罗斯 俊 保全 保育 借 做了 做出 做的 偶 儿子 充 入会 全市 公民 公路 内科 再度
+81 冲 净 几 出了 出国 分割 分辨 刑事 利率 剂 前回 前日 前期 剧 加大 加速 包含 十三 十年 半年 南部 単 危害 卵 反対 反应 发出 古代 句 各级 各自 合成 同期 名人 否则 告 和谐 品质 哥 哪些 喂 喝 回覆 回転 回顾 团队 国土 国立 圏 在一起 地元 型号 基于 塞 填写 复 多个 大力 大字 大学院
+81 大臣 大部分 天气 失去 奥运 她们 委托 姫 学员 学部 宁夏 宁波 宅 定位 宜 实力 审核 宣伝 宣言 宽 对外 将会 小売 小林 尤其是 尽 屑 山本 山田 帝 平日 年末 年轻 年龄 幻想 庄 延 开心 强大 强烈 当初 彩票 影像 従来 忍者 念 态度 怒 怕 恋人 悟 想像 感受 慰 托 扫描 扱 承诺 拍摄 拒绝
+81 招标 指摘 指示 提前 揭 援助 携 收购 放在 效率 效益 救 教育部 散 文集 断 新加坡 新增 日子 早期 旺 昌 明治 普遍 晶 更是 权力 来到 来年 来看 构成 架 柄 查找 柱 柳 标志 桌面 检验 概况 模拟 欠 次回 此次 氏名 法院 洁 济南 浜 浩 浮 浴 添付 清水 潘 焼 煮 熊 熟悉 燃
+81 爆笑 玄 王子 玻璃 珍 琳 瓶 甘肃 生态 生涯 界面 留下 畿 白色 百科 盗 盟 直播 相同 相比 看了 看来 硬 神社 神秘 立场 立法 第七 第六 答案 策 糖 索引 絵本 纺织 线路 缺乏 署 美人 美少女 老婆 考核 而言 耳机 联通 肝 肩 脱 脸 自行 艺 苹果 荣 菌 蒂 蔡 蝶 蟹 衣服 表演
+81 表达 観察 解放 解答 计 讲话 试验 详情 诱惑 说话 财 质 贵 贵州 軽 轮 辉 辛 辣 近期 进步 适应 选项 逐步 遅 道具 遭遇 邦 郎 部品 部署 都在 都要 配布 采访 銆 销 防治 阿里巴巴 难以 非法 面临 面前 靴 须 预计 预防 额 飞机 饰 香川 驱 驻 高兴 高手 高血压 黑龙江 鼓励
+82 一件 一声 一套 一括 一是 一片 上下 上涨 不在 不够 不限 世代 世界上 丝 両 个性 中医 中学生 串 为何 主动 主持 主持人 主流 主演 主要是 也没有 书籍 买卖 予 予以 人家 人形 仲 伊藤 众多 传输 低下 低价 佛山 依然 侵害 保密 保管 停 健全 健身 兄 光学 光碟 入口 全世界 全身 公园 公寓 关心 典型 写字 冬季 决策
+82 减肥 几年 出台 出来事 出生 前面 力度 助手 劲 勒 勤 北大 匹 単行本 危险 卸 厂家 厚生 去了 又是 古典 各个 吉田 否 告知 周边 呼吸 和弦 咖啡 咲 品种 嘛 嚗 四季 回収 回数 圆 土曜日 地下鉄 基因 墙 处于 备案 复杂 外语 多年 大师 大数据 天大 太多 太郎 太阳 夫妻 奖励 女儿 好像 好好 如今 如有 妇女
+82 妮 妻子 始终 威望 季度 学历 官员 実用 审查 宣 宪法 家里 对话 小学生 小物 尒 就像 就有 展开 展望 展览 履行 岗位 川崎 工房 巧 已有 市委 市立 帝国 平衡 年底 幼 幽默 弟子 录音 形势 往往 很快 微妙 心得 必然 応用 忠 性质 总体 总统 息子 您好 想到 想要 意大利 或是 户外 手术 才是 打击 払 执法 批判
+82 披露 抽出 担任 挨拶 排列 接近 推奨 插入 搜索引擎 摄像 摘要 操作系统 收益 收集 放弃 散布 斜 新人 旅行社 无关 日志 日立 明天 昼 晚报 普段 智慧 暂无 暖 暨 更改 曹 最好的 月末 有所 有料 朗 朝阳 期刊 本周 本当 本社 松本 构 枠 某些 柯 栏 株主 株価 栽培 框 检 棚 植 検定 欣 欧美 歓迎 正直
+82 步 歯 毎 毎年 每次 気温 汁 江湖 沟通 治理 泽 洗濯 津 深刻 温暖 港澳 湘 湾 演唱 潮 澳 澳门 灌水 火爆 火箭 炭 烟 然 焼酎 燃料 物质 特征 犯 玫瑰 现有 琴 瓦 申报 病人 発信 百分 百姓 百度 监管 盖 看法 看见 眠 研发 研究室 碟 祖 秘 租赁 空气 空调 立刻 站在 竟 童
+82 第三者 等待 签名 管理局 素人 红色 纪念 结婚 绝色 缺 翌日 翠 翼 耶 职位 职务 职场 聚 股权 育児 胜利 脂肪 腕 臭 艳 花店 花粉 苏州 苗 荒 蒙 藤 虹 蜿 蟿 行事 街道 补 观众 观念 观看 警 警告 订购 访 语音 谓 货币 贯彻 超市 距离 身边 転送 车辆 辅导 输出 运用 近日 远程 违法
+82 连载 迷惑 追究 透露 逐渐 通道 造型 逮捕 郑州 都不 都合 配套 鉴定 钟 铁路 锌 锦 键盘 镜 镜头 长沙 闻 附近 陆 陶 集成 零售 顺利 领 风云 饮食 马上 验证 高雄 髪 麺 黄色 鼻
+83 一句 一回 一応 一月 一步 一流 一边 一部分 一面 不了 不但 不如 不管 丘 业绩 两性 中止 中间 临床 主人公 义 义务 也就 事务 事项 五十 交换 亮相 亲 人妻 人民法院 今后 他在 仮 伊拉克 伊朗 休息 优质 传递 伤 伸 佚 佩 使用者 依照 侯 信心 傻 児 全集 公众 公平 公正 公演 典 养 内蔵 冒険 准确 减
+83 凤 刚刚 创作 制服 刺 前年 前往 割合 加藤 十八 半分 半角 协 卓 危机 厨房 収益 取材 受理 变得 口座 台北市 同步 否定 听到 周期 哈尔滨 国内外 图形 地板 地铁 坐在 坪 埋 基层 填 增值 处罚 外交 外汇 外资 多分 多様 多次 大地 大奖 大手 失败 奈 套装 女郎 好了 妖 妙 姐姐 委员 嬉 安値 官职 宫
+83 害 家用 家长 宾馆 对手 寻 导演 岗 工人 工会 工夫 帐号 帕 帰属 帽 幕 平板 平面 幸 广播 序 开通 弁当 弄 引导 引进 弗 强化 当年 彩色 彭 得意 微笑 忍 快捷 性别 性爱 总局 恵 悲 情形 想法 想起 戏 成就 我家 所谓 手続 手续 扣 承认 抑制 报纸 担心 拳 挂 挺 排出 推移 提携
+83 提问 插 援 搭配 摩 操 改进 攻 政协 散歩 数値 文本 新华社 新竹市 施策 旁 旗 日曜日 早速 旬 时候 更大 有着 朝日 期末 未知 机器 机场 权威 桃 桑 梦想 楼主 様式 模様 次第 歌词 殿 毅 每一 每月 比率 民宿 民间 水利 求助 汤 沙龙 法令 法定 泡 洞 活力 浪 消失 润 深夜 混合 温州 滑
+83 澳洲 激光 爱的 爸爸 狗年 猛 王国 环球 琪 痛苦 瘤 癌 监测 直到 省略 県内 県立 知名 砂 碧 礼物 神経 福利 秩序 称号 移 移転 税收 窗 竞技 竟然 端末 笔名 筋 简 篮球 粗 精美 紧张 累计 纵横 细胞 经贸 翻 耐 聊 职能 职责 聚焦 能否 腹 自慢 至今 舒 航天 英寸 茂 荣誉 药物 莎
+83 营养 萨 落札 董 蓝牙 蔗 融合 血管 补丁 补充 裁判 记得 记忆 评选 说的 说道 请问 豚 负 赔偿 走向 路上 较大 辣妹 近代 近所 近畿 这位 这时 进程 选举 避 邀请 那是 那种 都可以 金利 金山 锁 问答 间接 防水 院校 隣 集団 震 霍 霞 青海 面向 顾客 顾问 顿 风景 风暴 飞利浦 餐饮 饰品 首相 驾
+83 高校生 鸡 鸿 鹏 麻
+84 一杯 一百 一眼 一系列 一路 三条 三角 上了 上位 上映 下去 不幸 不得不 世帯 中小企业 中有 中身 中野 临时 主义 主编 之路 乐园 乘 也能 争 争议 五金 井上 亜 亡 亩 人権 仓 他是 代行 任何人 伟大 传说 伤害 佐 体力 作曲 你要 侠 侵犯 促 俄 保守 保安 保有 信仰 偏 做法 催 元素 充满 先后 先行 先锋
+84 免疫 党委 入院 公斤 公益 公表 六合彩 兴奋 内地 写作 冯 出价 出去 出店 函 分散 分解 切实 创意 判定 刻 办事 助成 助理 北美 区别 十七 卓越 卢 卫星 即时 原作 又有 収 可用 可能是 台中 史上 吊 同人 同盟 同类 名牌 后悔 后面 吾 呈 哲 唯 唱片 商人 商工 商情 啥 嘘 嘴 噂 四十 四大 四年
+84 团体 国会 国道 土木 圣诞 地带 地面 城镇 培 塑 境 境界 墨 壊 夕方 大大 大街 大豆 奇摩 女友 女装 妊娠 姐 姓 威胁 存款 孝 孟 学位 学年 学期 宏观 定休 实在 审 客人 容器 寿司 専攻 射 小心 小熊 尤 尴尬 尽快 尿 居家 居酒屋 工厂 差异 差距 己 市政府 帅 常常 帽子 平米 年利 库存 建成
+84 引发 弘 弟 形状 彻底 待遇 很有 快递 思维 思路 总经理 患 悩 情侣 情报 惹 感动 愿 愿意 慢 慢慢 我不 我有 戦士 戸 房间 所需 扩展 投影 护理 抵抗 抽 拓 拠点 持有 按钮 挙 掉 掌上 排除 接收 接着 播 改良 效 敏感 教会 敢 敬 文芸 斌 新生 旅客 日刊 日曜 时报 昆明 星空 春秋 昵称
+84 显得 晋 景点 暂时 暇 暗号 暴 最小 月刊 有时 朝食 期货 木村 本次 术 枝 样子 框架 棉 棋 楚 槽 正面 此时 毎月 每周 民事 水果 水道 江南 江戸 沢山 沿 法治 注重 洲 流量 浙 浙江省 浦 海上 渡辺 溪 演员 潮流 火影 炫 热情 煤 爆炸 爽 玛 珠宝 珠海 球员 理性 瑞士 生意 生日 生理
+84 申告 男士 男孩 留学生 番地 畳 疗法 疾患 登山 百年 皇 省钱 看板 睡 瞳 破壊 确实 祝日 私人 私服 称为 移行 稍侯 税务 税金 究 空中 第二次 策定 簿 类似 精灵 紧 紧急 総会 縦 繁体 练 练习 细 缘 美好 美术 老公 而不是 而已 职 联络 胃 胶 脂 脚本 腐败 舍 节日 芬 草原 莲 营业 董事
+84 蓝色 蔬菜 蔵 虽 蛋 蜈 表彰 表格 袁 袖 裕 解消 警方 认定 证实 证据 诊断 课题 调节 贺卡 赞同 赠送 走了 走进 超值 跨 転 转变 转移 轿车 辞 过来 迈 运作 进展 连锁 迫害 逃 逢 逸 部队 都没有 都能 酢 金沢 鑫 长城 阁 防犯 附属 陪 随时 隐藏 难道 青山 面接 面试 革 頼 预算
+84 预订 频率 餐 饭店 駅前 高原 高效 高潮 高等教育 魔力 鶏 鸟
+85 一半 一台 一块 一方面 一枚 三井 三人 三国 上路 下旬 不久 不宜 不必 不正 专卖 両方 严 中国科学院 中外 中旬 为此 主力 之下 乐趣 九十 也在 也就是 乡镇 了一 予告 事典 二期 二次 云计算 互相 五月 交渉 享有 仔细 付近 代替 代理店 以便 伦敦 估计 但在 低于 体内 余裕 作业 作了 作戦 作文 你在 偶然 光临 兔 入浴 入金 全力
+85 全角 公主 兴趣 内的 军队 决赛 凄 凤凰 出发 分为 分会 初步 利息 到来 刷 刷新 前半 前景 前沿 剣 功夫 努 励 北区 区块链 十四 卒 压缩 原始 参观 友好 反省 发放 受注 变更 口腔 合肥 合适 同士 同行 名义 名录 吕 听说 启 吸収 吸收 周围 周末 味道 品番 哥哥 哪个 哭 商取引 商用 回路 回避 因而 国家安全
+85 圧 在住 场所 坛 埃 城区 境内 墓 売却 备份 夕食 外出 外来 外观 多功能 大和 大桥 大道 天才 夫人 失眠 夺 奇怪 奇迹 套房 好玩 妆 姑娘 姚 姜 娃 嫁 字号 它是 定价 定番 宝典 実家 実感 客观 家人 寂寞 富士通 寿 寿命 尊 小孩 就不 局长 局面 层次 居然 届出 岳 崔 工作室 工芸 巨人 巴黎 市区
+85 帆 帰宅 常识 平安 平等 并非 庆祝 应对 廃止 当中 当代 当期 录取 彦 很少 循环 心灵 忘了 忧 总理 总裁 情绪 想定 成交 我知道 我能 战士 手中 手当 手軽 打折 扬 抓住 折扣 抵 押 担 拜 拟 拨 拾 挑 振替 捕 损害 掌 接待 提到 摄 擦 支付宝 支配 放大 放置 政权 敌人 教练 文物 文艺 新版
+85 新竹 方位 既存 日内 日文 旦那 时刻 是以 晨 智能手机 暴利 曽 有利 有害 有望 未定 本土 本物 札 机动 机器学习 村民 枕 染 査定 校长 档 梨 检察 椅子 検 楽曲 概 模板 欧州 欧盟 毕竟 毛泽东 水泥 永久 汇款 汉化 汪 沃 治安 法学 浅 测量 海关 海岸 消除 涛 涨 淘宝 混乱 清单 清除 港区 港台 満点
+85 滚动 滴 漆 澳大利亚 激烈 烧 焦 熙 牧 独家 环节 现状 理事 甚 生地 用地 电台 畑 番目 白领 皇帝 盈 监察 目安 県民 真相 睡眠 知事 知的 研究者 砖 磨 示范 神奇 神话 福州 移植 程序员 章程 端子 符 第八 签订 签证 简历 粮食 精度 経由 繁 纠纷 纳米 纷纷 细节 终端 经费 结 继 续 编制 缶
+85 肖 胡锦涛 脑 臣 自助 自治 自然语言 至于 船舶 花火 苦手 苦笑 英特尔 荘 获取 菜单 萍 蒋 虚 蛇 蝴蝶 融资 行使 行程 被告 裸 褟 西南 西洋 西班牙 要因 要有 覆 覆盖 観点 见到 规律 视觉 讲座 设定 诉讼 试用 说法 请在 请求 课堂 谈判 豆腐 豊 豪华 贤 货物 贫困 贷 贾 赏 走光 超越 趣 距
+85 路线 轩 辆 迅 过年 过的 进来 退休 选手 透 途 途径 通告 遂 遇 邮局 部位 部长 重复 重要性 金曜日 金钱 鍏 铜 铝 长度 长春 闪 阡 陵 隆重 障碍 霜 露出 面板 音响 韵 顶端 预定 领先 颖 食材 驰 驾驶 高层 高峰 高等 高达 鲜 鹰 麻烦 默认 鼎 鼓 鼠
+86 一九 一元 一座 一把 一支 一族 一期 一歩 一行 上帝 上班 上野 不太 不懂 不需要 专科 东风 两岸 中原 中期 乌 乎 乔 争取 二年 二级 互 五大 产量 享 人群 仅供参考 今夜 仙境 以往 仪表 仲裁 伍 休憩 伤心 伴侣 住宿 何故 你会 你好 依靠 侧 侮辱 信用卡 债券 偷 傅 元旦 兎 入门 八十 公社 兰州 冒险 冲突
+86 凝 几天 処 凭 出会 函数 切换 列印 刚才 别的 到达 加为 加以 加密 効 势 勿 化妆 医薬品 十九 十二条 十四条 十条 千代田 千里 南宁 南海 危 厘米 原文 原本 原材料 受益 另一方面 只好 只需 可惜 可靠 台南 司机 吃饭 各大 各社 合并 合资 吉祥 吋 同事 同社 同等 后果 吐 含量 吻 周囲 命运 和食 咱们 响 唇
+86 售价 商场 営 四半期 回忆 回收 团结 园地 囲 围绕 国防 土日 土曜 坏 坚决 型番 基本上 堀江 堤 增大 外商 外面 多彩 大人気 大战 大概 大胆 天津市 太原 失礼 女星 奶 姉 娃娃 子会社 子女 字幕 孤独 宛 实业 宴会 密切 富豪 寸法 寻求 対処 封建 封面 小屋 小川 少数 尝试 尻 尽量 山崎 岸 崇 差分 巴西 巷
+86 市政 市长 帐户 常州 平时 年内 年初 年月日 年版 幼児 床上 店内 庵 建造 开关 开拓 异常 弓 弥 当局 彩虹 径 律 得点 忽然 怀疑 怖 总部 总额 恋爱 恨 恶 悠 悦 情节 惊人 想象 愈 意志 憧 我来 戒 战斗 截止 扉 手表 扎 打工 打电话 打算 执政 承办 抑郁 投标 护 报考 报警 拒 拓展 指令
+86 指挥 指正 按摩 探讨 接到 控股 提起 揪 搭 收取 放入 救助 整形 敷 文具 文摘 斑 新鲜 方针 无锡 既然 日常生活 早已 时装 昂 春天 显然 晚会 景色 暑 曲目 更换 更高 月底 月曜日 有価 有力 有机 朝鲜 木材 本校 本田 机遇 杂 村上 杭 松山 极大 析 枪 树立 校友 梦幻 検出 楠 模特 欲望 正月 步骤 武侠
+86 歧视 殑 民俗 気象 水分 汇报 汚染 沟 河川 治愈 泄 法案 泛 泥 注射 泪 涂 消化 消毒 淑 淡 淫秽 深化 深度 清洁 渝 湿 滞在 演讲 潜在 激励 炉 点検 点点 爪 版面 牛肉 特区 特效 狐 球迷 球队 理科 由此 电气 男生 畏 留意 疾 痕 瘦身 皿 盛大 直径 直通 省级 真理 眼前 瞬间 矢
+86 石化 研制 研究院 社内 祖国 福建省 秋冬 种子 种类 科普 究竟 立体 竞 笔者 第九 筋肉 简称 粘 粮 糸 累 縁 繙 约定 纽约 绝 维持 署名 群体 羽毛球 耀 老百姓 老鼠 而不 肥 腐 自治体 自立 舌 舒适 舞蹈 舟 节约 芸 若者 草案 荷 获奖 菊 萧 蓉 蕾 薇 蜜 蜴 融 行政管理 街头 裙 西北
+86 要件 要注意 解读 誓 订 认可 记住 识别 询 详 语文 诸 诽谤 豆豆 账户 赌博 赛车 赢得 走出 走行 足够 跌 身近 辅助 辺 近年 进出口 迷你 送付 送给 通勤 逻辑 逾 道理 邀 邓 邓小平 那就 那时 部会 配当 酒吧 釈 金华 金币 銝 锋 长江 队员 防御 阴 限界 除去 除外 险 陶瓷 随后 雁 集会 雹
+86 非洲 面倒 韦 顾 预 预期 风光 餐厅 饭 饮料 首饰 验收 高档 魅 黎
+87 一体化 一同 一大 一手 一瞬 一道 一阵 丈 三天 三月 上半年 上司 上次 上演 上限 下跌 不具合 不大 不行 世界中 丙 东芝 两次 严禁 个体 中毒 中継 中级 临 主张 丼 举 之夜 之所以 之道 乐队 九月 也不是 也没 书画 亀 事宜 事物 五条 京城 人力 人格 什么时候 仇恨 今朝 仏 付出 代理人 仪式 仲介 休暇 住友 体会 体坛 体操
+87 佛教 你想 你有 使命 例外 例子 依存 便是 保养 信任 信誉 修复 偶像 光明 光纤 克斯 克服 全会 全民 公示 六十 六月 六条 关联 兵器 具合 兼职 内外 内幕 冬天 冲击 决议 冷静 出于 出色 击 分譲 刊行 刑 初中 初级 削 前后 前进 副主任 办学 加油 北九州 医科 十一条 十七条 十八条 十月 千年 华夏 协助 单元 南京市 南昌 単価
+87 原告 原子力 原稿 厳 反射 反而 发射 发明 发起 受伤 可见 台州 合唱 合金 同居 名誉 味噌 命名 品川 唔 喷 器械 四川省 四月 回去 回报 园林 国籍 坤 埃及 城乡 培育 塘 境外 复习 复印 夏天 夏季 夕 外形 多大 夜景 大正 大规模 天国 天皇 头发 女王 好处 好多 好象 妄想 妈 妳 姉妹 婴 婴儿 婷 孕 字符
+87 宋体 宗旨 宝石 実装 害怕 宾 宿舍 寂 密度 寨 寮 对待 対戦 将军 尊敬 小野 尤其 就算 履 山下 崎 巴斯 市役所 布団 布局 带动 帰国 平凡 年末年始 年来 年薪 应急 廉 开幕 弁 异 弦 弹 强奸 彼此 徐州 得以 得很 心地 心臓 必死 必要性 怀 恐 情趣 愉快 意向 意欲 愤怒 慢性 成效 我看 或许 扁 手工
+87 打破 扬州 找不到 承包 承受 投手 报刊 拒否 招待 拼 拿到 捉 捐赠 授 排水 推行 撒 撞 支撑 改装 放映 故意 教唆 斑竹 斗争 新建 新房 方正 旁边 无人 无奈 日益 早上 时事 时空 星期 春季 昼食 晃 暗示 更有 有意 有用 有趣 本町 机电 杀手 杉 杏 来店 杭州市 林业 枫 某种 柔 柜 柴 栄 格局 桁
+87 桂林 桐 桶 梓 模具 次世代 欢 欢乐 正当 此类 武装 歴 殊 残高 毎回 每个人 比重 气象 気付 気味 水着 氷 汇率 池田 池袋 沉 河北省 河南省 泡泡 泰国 泳 泵 洋楽 洋画 洗浄 洽谈 流出 流动 济 海报 消耗 淫 清理 渭 溜 溢 滝 漏洞 演示 漫 煤炭 煤矿 爱心 牡 物语 狙 猜 献 獣 玉米
+87 生育 用具 田舎 由来 申明 男友 畅 発注 盆 目光 目的地 直前 相片 盾 省委 看出 真剣 真実 真空 眼镜 矣 磁 磁带 福田 福袋 离婚 私立 科教 稳 空白 突 符号 米兰 粥 精力 精密 终 绣 综述 罚款 美军 翌 耐震 职称 聪明 肯 肺 肿瘤 胖 能量 脆弱 臂 自作 自觉 致富 节省 芋 芽 苦労 茜
+87 茹 荷物 葛 藤原 蜀 蟼 行列 补偿 衫 装着 西日本 要是 観戦 规 解散 解釈 触 譲渡 议 评审 译 谐 负担 贺 资助 赠 赢 走到 起点 越南 跚 跟踪 身材 軽量 转化 轻轻 辉煌 辛苦 辰 达成 迄 违背 迫 迷信 适当 逛逛 遍 那天 邪魔 邮政 邱 邵 部份 都很 酸素 醉 醤油 采集 重组 野村
+87 金牌 鍙 针 钢铁 钻石 锁定 锐 长安 门口 阵 附加 限度 随意 雕 雯 青木 须知 频 飞行 食器 食堂 首席 高山 高性能 高温 高科技 魔鬼 鸣 黑暗
+88 一代 一刻 一発 一直在 一郎 七十 七月 三次 三浦 上有 上班族 上级 上部 下手 下着 不便 不利 不变 不当 不愿 不満 丑 东京 丞 两国 中共中央 中川 中断 中日 中药 也不会 也不能 书法 二月 交往 交通事故 亲自 人权 仅仅 介入 从此 代理商 代言 以色列 件名 份额 仿佛 优点 住在 作战 作风 修行 假日 做爱 储 僧 儒 先端 入居 全年
+88 全日本 全程 全裸 八卦 八大 八月 公安局 六号 其次 养殖 兼容 内心 出版物 刃 分手 分离 分置 列入 列车 前田 前线 副刊 劫 劳 勇気 勾 医者 十九条 十二月 华东 协商 单击 单独 南北 南通 単独 即日 卿 原油 厦 厨 参画 反复 反発 发票 发达 变动 召 可在 右手 各方面 各界 合宿 合意 合计 名刺 名家 名片 否认 周波数
+88 呼吁 和田 哇 唉 商会 啤酒 喉 嘉兴 嘿嘿 园区 図面 圧力 地形 地质 坂 坡 坦 垂直 垄断 垫 堡 士兵 壹 备注 変数 复合 多元 多多 够 大体 大哥 大米 大约 太太 太空 央 失业 失望 奔 女神 如同 如果有 妃 娇 娟 婚礼 媚 嬢 孤 安徽省 定制 宝物 实例 実力 审判 审议 宣扬 家中 容疑 对不起
+88 导弹 対照 小児科 小子 小小 小田 小电影 尖 尹 尺 屋根 山区 岁月 岭 左手 差不多 市川 布线 带有 年収 幸运 幻灯 広域 度假 康复 引当 当天 彰化 彼氏 征收 很高 徒然 得分 徳 心态 忘 快感 快报 怀孕 急性 怨 总公司 总监 总量 惊喜 感兴趣 感激 愿望 慈 慎 慎重 懂 戈 成田 成都市 我慢 我还 截 所有者 所要
+88 手指 才有 托管 扮 扮演 扶 抄 抜群 护肤 报社 拆 拆迁 拖 拜年 持続 按此 挡 挤 挿入 捜査 换算 捧 据悉 授予 推定 推理 握 摸 擅自 放出 效应 救急 救援 敖 教案 教科 整治 文句 文部 新城 新潮 旋 无数 日历 早就 是不 是有 晕 晨报 晩 暴露 最为 最快 最早 有一 有效期 服用 期望 未能 本市
+88 本棚 本気 本稿 朴 朵 杆 束 条件下 来信 松井 极限 标识 栗 梱 権限 次元 正宗 正版 死了 残疾 民众 民生 民航 气候 气氛 水中 水曜日 汉语 没什么 没想到 沢 法官 法庭 活跃 流域 浜松 浠 浪潮 浪费 浮気 涂料 液体 涵 淇 淳 清华大学 清洗 温柔 湖北省 湖南省 滋 漏 演技 演绎 潜 潜力 澄 瀹 灌 火星
+88 火曜日 火车 灰色 灵活 灵魂 炼 烟台 热烈 焼肉 煽动 爆発 爱上 牛乳 特大 率先 珠江 班子 璇 甜 生长 用人 电缆 画素 疑问 発想 白石 白金 的确 皮革 盈利 盐 直辖市 直送 相似 相反 眉 看起来 真人 眼中 眼科 石井 石家庄 矿 碑 碗 磁盘 磊 社団 祈 神様 神经 离子 秋季 种族 种种 积 积累 稀 稍 穆
+88 窝 竜 竞赛 童话 第一章 筑 签 签署 算出 算是 管制 管道 籍 精心 精神病 素直 経理 絵画 継 纪录 纳入 纳税 纸条 线索 结论 绘 绿化 编写 网球 美化 翁 老大 聚会 肾 背中 能不能 膝 自律 自社 舎 舒服 航空公司 芙蓉 花卉 芸能人 荷兰 莠 落后 葵 蓿 薛 藤田 蛋糕 蛍光 蛹 螟 蟆 蟺 衣装 补贴
+88 袭击 裸体 西区 见过 见面 视为 觉 解像度 词汇 诞生 询问 请看 谣言 谨慎 赔 赚大钱 赞成 赤字 赫 趣旨 跟着 身高 转向 辻 辽 近隣 返済 这类 这项 进攻 远远 违规 追踪 通报 逝 逼 遗憾 遭到 避孕 邪教 配备 醒 释放 野外 金色 銭 钻 铭 银色 销量 锅 锡 锻炼 闹 防护 阻止 阿里 限量 院长 陷入
+88 随笔 隔 难度 震撼 音效 页次 顺序 颜 飘 食料 食用 首位 馨 駄目 駆 骂 骑 骑士 骗 高于 高松 高速公路 魔女 黄河 龙头
+89 一一 一万 一品 一时 一看 一票 一笑 一轮 一颗 三峡 三洋 上去 上旬 上来 下水道 不信 不受 不合格 不含 不多 不定期 不怕 不满 不锈钢 丕 世田谷 东南 个别 中午 中美 丰田 主导 主意 乃至 之内 乐器 书名 书库 买东西 乳房 了解到 亏损 交代 交给 人中 人次 人间 什 今天是 付加 任命 任期 仿 伏 众人 会谈 传感器 估价 似的 佐川
+89 佑 何処 侍 依赖 侵入 信件 信念 修炼 修订 倍速 候 倩 债务 假如 假期 傲 入侵 入社 八王子 公募 公害 公的 六大 六年 六本木 共済 共用 兹 冀 再说 写的 冤枉 冶金 冷却 几次 凶杀 凸 出前 出展 出血 分局 分数 分裂 刊物 刑法 划 列出 刘德华 判决 判明 剧场 剧情 剪刀 副作用 力学 功率 加算 动员 労 势力
+89 募 区内 医学部 医师 十一月 十万 午 华南 单身 卖场 博多 占有 印尼 历 历程 厉害 原子 原有 双手 反正 収支 取代 受取 口语 另有 可怕 台数 吃了 各方 吉林省 吉野 同胞 名作 名簿 吟 呆 呈现 告白 咬 咳 咸 品味 商事 商法 喊 嗯 嘉宾 回应 回归 回戦 因子 図表 围 国王 图表 土壌 在家 圭 地名 址
+89 坂本 坑 坚 垂 堆 堪能 堺 壮 处分 复发 外交部 外地 多久 多么 多摩 大厅 大声 大戦 大批 大汉 大洋 大笑 大自然 天井 天文 太大 太田 央行 头条 奉献 奏 女声 女子高 姐妹 姬 威力 婆 存入 季节 守法 安置 安藤 安静 完売 完毕 实习 实体 客厅 客室 家事 家教 富有 寒天 察 察看 导游 射击 将棋 小児 小康
+89 小麦 就任 就把 局部 屏蔽 展现 山水 岩波 崖 崛起 崩壊 嵌入式 巡 工作站 巧克力 巾 帮忙 常规 幅広 幅度 干什么 干扰 年华 幼儿 幽 廃 廖 延伸 延期 延长 廷 建国 开启 强制 录像 形态 彬 彰 往来 従 従事 得知 微生物 徴収 志愿 応答 忧郁 忽 忽视 怎 急速 怪物 总计 恐怕 悪魔 情景 惟 意図 慌 慕
+89 懂得 懐 我去 我把 战胜 截至 所谓的 扇 手配 执 执业 扶持 拍照 拯救 拿出 指名 指向 挖 据说 排放 探検 控除 掲 描写 提取 搬 摆 攒 改行 政党 政権 散文 敦 敷地 文教 斤 新一代 新娘 无极 日数 日本酒 日语 早稲田 早餐 时光 昆 昆虫 星野 昨 昨夜 景気 景観 暴富 曜日 替代 最悪 最新消息 最短 月光 木曜日
+89 木造 本件 本法 本质 松田 极为 架构 柯达 栋 校区 核准 根拠 桌 桌布 梧 梱包 正解 步伐 每人 比上 毫无 民工 民法 民警 氛围 氧 水电 水面 汝 江西省 污水 沉默 沙发 油价 泉州 泡沫 波导 注入 洋服 派出所 流星 流氓 浦东 浦和 浴室 海军 浸 涉 涉嫌 淘汰 渐渐 温馨 港口 港湾 渴望 游泳 湿度 滚 澶 激动
+89 火山 灭 炮 焉 煎 照会 照顾 燃烧 爆发 爬 牛奶 物资 特例 犯人 状元 猴 珍珠 琉璃 琦 琼 璐 瓜 瓷 生年月日 生死 田村 电压 电路 町村 画家 疑惑 疫苗 疯了 痴 瘦 百合 直销 相性 相簿 相続 盼 看好 真诚 真面目 眼光 眼泪 着手 着物 睡觉 督 睿 短期大学 石鹸 砂糖 碎 社团 祈祷 祐 神州 禽
+89 种植 秘书 租屋 税率 稼 立案 章子 竣工 第二章 等于 等地 筒 筹 算定 管辖 箭 篮 粒子 精油 紧密 紧紧 総数 繁殖 繋 纤维 纪律 纺 纽 终身 维生素 编码 编译 缴纳 缺少 缺陷 罐 网管 翌月 老年 耕 聪 自学 自杀 自民党 航海 芦 花束 茨 荐 菱 萎 藤沢 虐待 蛋白 蜂 蜩 螳 蠀 袍 被人
+89 裁判所 裂 裤 褔 西村 覗 览 角川 詹 警惕 训 词典 试卷 诗歌 请先 请教 谱 豫 豹 质疑 贼 起了 起诉 超音波 足以 転用 轣 轨道 转型 较好 辐射 辑 输 边缘 迎接 进去 送到 逆転 透视 通行 邦楽 郁 郷 都内 鄂 配偶 酔 酵素 酸化 野生 金刚 错过 长大 问道 阀 防火 防范 院士 陷 陷阱
+89 随便 随机 随身 隐 障 难题 雅典 集锦 霊 靖 革新 颁发 预告 领取 题目 风湿 饮 饲料 驴 高尔夫 鬣 鸡蛋 麻雀 黑白 默 齐全
+90 一下子 一切都 一千 一号 一层 一等 一线 一群 一股 一身 一遍 一首 万一 万全 万能 上架 上田 下半年 下岗 不一定 不予 不停 不准 不出 不去 不可欠 不存在 不对 不成 不然 不禁 不算 不见 不需 与其 专访 严肃 中区 中小 中途 主角 之上 之声 之类 之际 乘客 也就是说 乡村 事先 二重 五一 交互 交通安全 亨 人心 仇 介质 仍是 仓库 他社
+90 代价 以免 以此 伊人 伊豆 优良 优雅 会不会 会见 何人 何时 作物 供电 依旧 侨 俗 俞 信州 信托 修了 倒是 借金 债 倾 做得 储备 像是 元件 充电 充足 免除 党政 公会 公立 公约 其余 养老 内涵 冰箱 冷笑 准则 凌晨 减轻 凶 出勤 出境 出版日期 分担 分词 切替 初日 初版 到底是 制约 制药 制订 前月 前者 副主席 助教授
+90 勇敢 勇气 北海 匠 匹配 十字 升值 华侨 协作 单纯 单词 南区 南路 単品 博览 占用 卡片 原価 原田 又要 叉 发动 发育 变革 古城 叩 叫做 可怜 各号 合法性 名品 后勤 听力 听取 告别 告示 呐喊 呸 和尚 咏 咱 品名 哉 响应 唱歌 善良 喜爱 営利 喷墨 回头 园艺 图案 圧倒的 在内 地道 场景 均可 均衡 坚定 型式
+90 堀 堪 壮大 壳 変身 外套 外界 外観 大于 大作 大使 大公 大半 大国 大多 大山 大根 大楼 大気 大江 大田 大礼 大纲 大野 大门 大雪 天内 天涯 天神 夫妇 奇妙 奉 奋斗 奔驰 奕 奖金 女孩子 她是 妞 威海 娯楽 娶 媒 媒介 媛 嫌疑 学堂 学报 学费 安田 完璧 宜昌 实务 实战 宠 审理 宪 害羞 宴 家伙
+90 寄付 富士山 寛 对应 对策 导师 対抗 封闭 小品 小山 小松 少量 尘 就好 屁 层面 屋外 山西省 峰会 川口 巡回 工法 巨头 巩固 差别 已经有 帅哥 师父 帛 带领 常委 干净 平常 平静 年以来 年终 广电 应聘 店主 废 度目 廊 开工 开设 引入 弟弟 当今 彪 征服 征求 待望 很久 徽 必备 忌 快车 快餐 怜 急便 性交
+90 性病 恕 恤 恭 恶意 悔 您要 悪化 想想 愁 感受到 感性 慈善 成品 我不是 我又 我还是 战场 户口 所定 手元 手写 手里 扩 扩张 投与 护士 报表 抵押 抽象 拔 拭 持参 持股 挟 掘 控制器 提言 揭开 揭晓 搞好 摂取 撤销 收回 收获 改定 放松 政令 政治家 整形外科 整顿 文体 文法 斉藤 斎藤 新书 新年快乐 新西兰 旋转 无效
+90 无聊 日光 早在 旭川 旺旺 星星 星期一 星期五 星期六 晏 晒 晤 智力 暂 暖房 暦 曝 最寄駅 最少 月亮 有益 朋 木子 木马 本屋 本番 杀人 杖 来讲 松江 枚数 果然 柴田 査 标记 样本 格好 桃花 桜井 梅田 椅 椿 楼层 模仿 模糊 橘 橡胶 欧元 欧米 歌声 歌迷 正义 正好 正如 歪 死刑 死者 残酷 母子 每股
+90 毕 民営 气质 気楽 水上 水槽 水素 永恒 污 沿革 波特 洋食 洗澡 洪水 流水 流浪 浣 海口 海底 海水 浸透 涌 淋 深深 深蓝 清新 清浄 清算 湘南 溶 滑稽 滑雪 漂 澎湖 瀬 炊 点播 烈 烟草 烦恼 爱国 爱好 牛仔 物价 物体 特急 特撮 独占 狭 猿 王朝 王者 王菲 珊 珍惜 珍藏 理学 理工 瑞典 甜蜜
+90 生效 用心 用水 甩 甯 电机 町田 留在 疏 疗 疼 疼痛 症候群 痘 癖 発明 白云 白天 监狱 目黒 省市 省政府 看不到 真情 眼神 知恵 石头 石田 砍 砸 硅 确立 碰 磐 礼仪 神田 票据 福山 税理士 穷 穿着 立派 竞价 笑了 笑容 笔记 笛 第三章 第四章 等到 答复 答应 箱根 粉末 精华区 精确 精致 索取 紫色 経常
+90 経路 繁荣 约会 纬 纵 终止 绒 结算 统 继承 绩效 缓 缩小 罗马 罡 翡翠 考验 而来 耐心 肉体 肌肉 胎 胸部 脇 脱毛 膳 臀 自慰 自负 致命 舆论 舞曲 良品 苏联 若手 苺 茵 菁 蕊 薫 薬局 藤井 藩 蜂鸟 蜊 蜷 蝗 行政院 衡 袭 西游 西路 要点 覚 视野 解体 计量 讲述 访客 识
+90 诉 试图 试试看 请进 谜 谭 豆乳 賛成 责令 资格考试 赖 赤坂 走过 赶紧 跂 跃 跨越 路面 踢 蹴 身分 转发 轮胎 辅 过滤 迎新 近藤 这儿 迪士尼 迷人 退会 选用 遗产 遣 遥 遭受 遵 遵循 那覇 邦画 邮寄 邸 邻居 郁闷 都没 配列 重建 金子 釜 鍒 钥匙 银河 闽 阻 阿部 陌生 除非 隠 难得 雍
+90 雑 雑感 零零 雾 霖 领袖 飓风 飘飘 餐馆 验 骏 骗子 骨干 高田 高雄市 髟 黄山 黎明 黔 黛
+91 〇〇 一对 一般人 一辈子 七年 万博 三个月 三昧 三百 三郎 上原 上课 上门 下一步 下了 下次 不仅是 不住 不妨 不容 不易 不服 不肯 不论 不该 与本 专线 东亚 两者 中型 中田 中科院 丸山 为止 主办单位 主役 举措 乌鲁木齐 乐观 也好 书签 予感 争霸 二日 互助 五号 五百 交叉 交差点 交易所 产地 人身 今井 今晚 仍有 仏教 付与 仮想 仰 任天堂
+91 仿真 会说 传送 伺服器 低温 低端 住居 佐野 佰 佸 供水 侵略 便民 便秘 促使 俏 保温 信赖 俩 借鉴 倾向 储存 元甲 先物 光电 兑换 党内 兜 全域 全局 公关 公子 公文 内定 内田 内裤 内訳 再现 冒 写信 农药 冲动 决心 几百 処置 出手 出炉 出行 分工 分支 分泌 分科 分行 切断 划分 前列腺 前途 剩下 剪断 功效
+91 加到 加湿 勉 包包 化合物 化石 北欧 北路 单一 南山 南非 占地 占据 卧室 即便 卸売 厕所 原作者 参入 参考文献 友谊 反弹 収容 发烧 叔 取付 受験生 变态 口味 古本 古老 可谓 台中市 司会 叹 各省 吉川 同年 名为 名曲 名物 后期 含义 启用 周一 呵 呼叫 呼吸器 咋 品目 哈利 哎 喙 四级 回国 囲碁 固体 固有 国庆 国语
+91 土壤 土耳其 在京 场地 场面 坎 坝 坦克 埴 基隆 堵 塑造 增多 墨盒 处置 多元化 多重 夜中 大哭 大海 大片 大王 天上 天候 天后 天河 太平 太鼓 失常 失踪 奎 契 套餐 奥様 女朋友 她在 好看 好莱坞 如因 如意 妥当 嫩 子宫 孔子 孕妇 字数 字母 学力 定着 客运 宣告 家乡 家属 家政 密封 密集 富翁 对付 对抗 寺院
+91 小吃 小朋友 尽可能 居室 屈 履修 川村 工学部 已是 市街地 布置 师傅 师资 帐 带头 带给 帯域 平稳 平野 年春 并购 序列 应付 应有 店名 庙 庞大 座椅 开业 引退 引领 强劲 强势 当作 当面 形容 徒 得出 御座 心身 必读 忍不住 志向 忽略 态 怪我 总之 恐惧 情调 惊恐 惑星 惜 感冒 感度 憭 戏剧 我爱你 戒指 或者是 战术
+91 所蔵 手前 手袋 扎实 扔 扫 找回 技法 投放 抗战 抗菌 抗议 抜粋 报导 拉开 拍手 招募 择 拷贝 挖掘 振兴 捐 捐款 掀起 提名 携带 摆脱 摰 撤退 播出 收看 改修 改制 改版 攻克 放下 效力 教育局 教训 散热 数百 文书 斋 斐 斯坦 新兴 方言 旗下 无比 无疑 日本一 早朝 时机 星光 星期三 星期二 星期四 星际 晕倒 普查
+91 暗夜 曲名 曲线 曾在 最有 最美 月曜 有名人 期日 本山 本期 本条 本舗 来临 极其 果実 枯 某一 校内 校生 校花 格林 检疫 棉花 椎名 概述 横山 款式 歇 歌舞伎 正规 此事 武士 武林 武田 歩行 死去 残留 段落 殷 比分 比拼 毫秒 毫米 民意 气体 水戸 水资源 水野 汉字 汕头 沉重 沙漠 沼 波动 洗剤 活発 流入 浓 海域
+91 消化器 消耗品 淀 渐 游记 源泉 滤 激活 炸 烂 烟花 烤 热爱 热闹 熊猫 燃焼 爷爷 爸 特有 牺牲 犠 狠 王座 玮 现行 班级 琉球 瑕疵 甘味 生气 甫 电工 电梯 画廊 畜 疯 疱疹 疲労 痰 瘢 癌症 発掘 発足 発音 登出 百货 皇家 益智 直属 省份 看待 真心 真珠 真的是 着信 知名度 知恵袋 石原 矿产 研讨
+91 硒 碁 碰到 碳 祁 祛 祝贺 禅 福州市 福特 福音 禹 禾 租售 租金 税制 穗 空军 突入 窄 立地 竹内 笨 第二个 第五章 等着 简报 简直 箸 粟 紫金 継承 総理 约束 纯属 纹 绍兴 经纪 绘画 统治 综艺 缓解 缤纷 缴 缺点 网点 罚 罩 美容院 翅膀 老化 老年人 老虎 考古 而又 耳朵 职业教育 联邦 聚集 肝病
+91 股价 股本 肤 肥料 育毛 胆 背包 腔 膏 膨 自从 自在 臼 舰 艘 芙 苇 若有 苦情 英文版 荒川 荟萃 荣获 菴 萃 落下 葡萄 蒙古 蒸 薬品 藁 蛙 蛾 蜚 行方 裁 褑 西川 西湖 覚悟 観客 规章 解明 触摸 警戒 认同 记载 证件 话说 语法 误 说得 谁是 谈到 谈话 谋 豊田 象徴 贝尔 败
+91 货运 赋予 赞助 走上 起床 起源 超频 路径 跳舞 踉 踊 蹈 轻易 辞职 过于 过多 过度 进军 进度 违 连连 迫力 送货 适 选拔 逍遥 逐 通学 通訳 通话 通路 逛 那一 那位 邪恶 郷土 配分 酒井 酒造 野田 金庸 鍗 鎴 鏂 鏄 钙 闲 队长 阴道 陆续 降水 隐患 隔离 隶书 零件 震惊 鞭 音源 韶 颁布
+91 预警 风水 食欲 駅名 高压 鱚 鹤 麟 麻生 麻酔 黑马 龄
+92 一副 一向 一定是 一式 一根 一直是 一章 七日 三分 三千 三国志 三等 三维 上报 上方 下属 下滑 下落 不像 不再是 不合理 不好意思 不安定 不完全 不断地 不法 不高 与此同时 业余 东东 东海 东部 両手 両面 丧失 丫 中等 中西 中路 之二 乙女 九年 书评 乳腺 事由 二十四 二段 二百 二等 五千 五日 亚太 亥 亮度 亲密 人为 人人 人目 今年度 仍在
+92 从未 代号 代目 以其 任职 伐 休止 优美 会合 传来 伺 低迷 住人 住院 体型 体感 体检 体积 依托 便于 保卫 信贷 修士 借助 借款 倡 倪 偶尔 储蓄 傻笑 充分利用 光景 光芒 兔子 入所 全区 全套 全景 八年 公用 公证 兮 共感 共生 共青团 关怀 关税 内装 円滑 再来 军人 冰冰 冲刺 决 况 净化 凉 几点 凭借 凹
+92 出资 分开 切手 创办 初代 初恋 刮 刷卡 前方 剥 剪 副教授 副本 劝 加州 加盟店 加重 务实 务必 劣 动向 动手 勘 勘定 募金 北斗 北西 区画 十足 千人 半天 半导体 半袖 卓球 南国 卜 卡其 卡拉 卦 卧 原版 厢 去世 参戦 发亮 受欢迎 变量 口气 口臭 口袋 古玩 古着 只今 只想 召集 叮 可供 可否 可靠性 台商
+92 各有 同僚 同情 名店 名校 后者 向前 君子 吟醸 呉 周五 周六 味方 哀 品位 哄 哩 哪儿 哮喘 哼 商売 善于 喇叭 喜剧 喝酒 嘎嘎 嘟嘟 器件 器官 噪 噪音 囊 四周 団地 困倦 困境 困惑 圆满 在外 在校 地帯 地方自治 地狱 场合 垢 基板 基督教 増大 墨西哥 处女 変形 夏日 外人 多少钱 夜晚 大久保 大同 大唐 大幅度 大气
+92 大致 天体 天哪 天生 太子 太高 失效 头脑 夷 奇瑞 奖品 奖学金 奠定 奥斯卡 奥行 奶奶 好久 好事 好吃 好奇 好朋友 妍 妖精 妥 姑 姫路 姿势 字型 存档 孚 宅地 安慰 定例 定点 定量 实惠 实物 客観 客车 宸 宽频 寄与 寅 密着 寝具 对照 对面 封锁 専 射手 小小的 小店 小池 少了 尸 尸体 展出 展覧 屯 山庄
+92 峠 崇拜 巣 工学科 已婚 巳 希腊 幢 干燥 平井 平原 平方公里 平民 平気 年度末 年数 广阔 应当在 店面 府中 座席 延续 廿 开创 开车 开门 引言 弥补 弹出 强力 强度 当事者 当方 往事 很不 很可能 很简单 得了 微型 微微 忘年会 怏 思念 急救 性欲 总值 恒例 恭喜 恵比寿 悲哀 惊讶 惑 惠州 愚 感触 感言 慰问 成型 成形 成龙
+92 我只 戦国 所持 手上 扑 扣除 扳 找出 抗体 抗日 抢先 抱怨 抵达 抹茶 拘 拙 拨号 拼音 拽 持久 挑选 挫折 掀 排気 排队 掲示 提倡 握手 搜集 摇滚 摊 摩擦 撤 撰 擎 支柱 放到 放射 效能 敌 救命 教委 教官 散策 敲 文科 旋律 旗舰 无形 既有 日本海 日野 旦 早晨 昆山 昊 明珠 明示 昨晚 暂停
+92 暑期 暴走 有何 有序 有形 未婚 未必 本年度 本能 术语 杰出 果物 柏林 柔道 柚子 查阅 柿 校正 样品 样式 栽 桥梁 楼上 樽 橄榄树 橱窗 欝 次郎 欺骗 此后 步行 武功 武蔵 殴 殻 每期 毒性 毛衣 气息 気候 気配 氨 水木 水泳 水源 汽油 沸 沿海 泌尿 注意到 洛阳 活着 流失 浅草 测定 海峡 海滩 消去 消灭 涔
+92 涨价 淡水 清醒 渋滞 渔 渚 湛江 滋味 滨 漫步 潭 激发 瀬戸 火灾 火焰 灯光 灾难 灿烂 炳 点头 点灯 热血 照射 燃油 爱人 爽快 片手 特技 特许 犠牲 独身 狮 玄米 玉器 王様 珈琲 珊瑚 球団 琛 璁 生化 生息 用法 田町 畅销 疲劳 病例 発酵 白斑 皇后 盗版 盲 盲目 直至 省令 省长 看完 瞧 知人 矩阵
+92 短短 码头 破产 碣 磷 社名 票房 禁忌 秋天 科幻 秘籍 秘诀 移籍 税法 穂 究竟是 穷人 立川 立足 童年 竿 第三次 筑波 筹备 签字 简洁 算命 算法 粡 精神科 精细 経歴 絘 総括 総研 纠正 红旗 红的 组长 绕 绘图 统筹 绳 绵阳 缩 网际 罢 美肌 羽田 老舗 考勤 聚合 肚子 胶囊 脉 脱出 脱离 腰痛 自家 自愿
+92 自称 自覚 舐 舜 舵 艇 良心 艰难 节奏 花絮 花费 若是 苯 英数字 茶叶 草莓 荵 莫斯科 获悉 莹 萱 葡萄酒 葬 薪酬 薬剤 虐 虚假 蚂蚁 蚊 蛮 蜘蛛 融入 蠂 行走 行驶 衡量 衰 被迫 裴 褒 要旨 要用 视角 解析度 触媒 誉 謇 警示 譲 认 讨厌 记事 论证 评定 试行 诗人 说谎 调动 象征 贵族
+92 贵阳 赞 走在 赶快 路口 踩 身子 躺在 车站 车队 轨 转身 轴 轴承 辨 迁 过后 运会 近辺 返金 远离 追究责任 退回 送礼 送迎 逃避 透过 通称 逸品 遉 道歉 遮 那边 邮票 邹 郊 郝 郡山 都心 配方 醋 野口 野望 野蛮 金曜 金曲 金瓶梅 金銭 鉴 钢琴 长途 门诊 阅览 防守 阳台 阴茎 阵容 陈水扁 陌生人 陕
+92 随分 隞 隣接 隧道 雀 集群 雇 雕塑 青空 静态 靠近 面子 鞍 音量 项链 顽 颁奖 预言 频繁 颗粒 风味 风尚 飞翔 食卓 騒 騒音 驾车 骄 骄傲 骞 骨折 高中生 高分子 高木 髯 麦当劳 麻辣 默默 齢
+93 一件事 一例 一味 一幅 一撃 一斉 七天 七彩 万里 三位 三日 三田 上周 上品 上学 上当 上空 下班 下部 下限 不仅仅是 不全 不已 不快 不止 不看 不详 不起 世上 世人 世界一 両者 丢 丢失 中东 中京 中方 中标 中青 丹麦 为人 为我 主要原因 久留米 之地 乒乓球 乖 九条 书生 买到 争夺 争论 二郎 亏 交警 交谈 亦可 产值 亲切 人参
+93 人名 人造 今更 介面 仍然是 从来没有 他认为 以太 会长 伝送 伦理 低音 佐伯 体温 何况 何年 余人 余地 作曲家 例会 供给 便可 便捷 保健所 倍率 借入金 倡导 值班 倾销 假设 偏差 做人 停车 健美 傚 允 充满了 先着 党校 党组 入党 入境 入选 全书 全是 全校 全能 八戸 公安部 共演 共计 共识 其所 典藏 内在 再建 军团 冲浪 凡是 出典
+93 出门 分站 分销 删 到处 到期 制止 制裁 刺繍 刺身 前作 前年度 前述 剖析 力争 加油站 助言 势头 勧告 勫 包裹 北国 北米 十届 午餐 半岛 卍 华丽 华北 华语 协同 単体 占领 即使是 厂房 原点 县城 参拝 又一 友善 双重 反抗 发电 变形 口号 古今 可达 台海 史料 合流 吉祥寺 同上 同仁 同伴 同窓会 名所 后台 听见 启示 吸引力
+93 周恩来 周报 命题 和室 和解 咪 咯 哈佛 哟 唐山 商量 喋 喘 嗅 噢 四人 四方 团员 困扰 図解 国债 国税 图象 地下水 地段 坏死 坚强 垣 垮 堕 塾生 增收 墨水 处处 复兴 夕日 大名 大夫 大庆 大方 大理 大脑 大谷 大队 天山 天王 天线 失误 奉仕 奔腾 女主角 好啊 好感 如是 妖怪 委任 娱 字典 孤单 学子
+93 学的 安打 官僚 宙 定数 宝贵 客家 容赦 寇 富裕 寒假 寒冷 寝室 尉 小巧 小春 小樽 小沢 小雨 尖叫 就不能 就此 屁股 屋内 山村 屼 屾 岩石 峡 崩 嵌 川越 巢 工大 工藤 巧妙 巨星 巴基斯坦 巴士 市営 帧 帯広 常务 干预 平山 平行 年份 年限 庆典 废物 座位 廉价 开口 开机 弃 弥生 弯 弱点 弹性 强国
+93 当场 彫刻 影子 役者 很喜欢 很想 循 德文 心脏 必修 必将 必需 忆 志望 怀念 态势 怠 总会 悄悄 情结 惚 惟一 惩罚 想去 愉 感悟 感慨 我没 战役 戸田 所用 扈 手套 扭 扯 找个 抓紧 抖 折磨 抛 报酬 抬 抱歉 拉致 拐 拘束 招收 拡充 拥 指引 指甲 指纹 挣 挨 挽 捏 损 损坏 排版 排球
+93 排量 掠 探究 掩 揉 揭示 揭露 搏 搬家 搴 摂 摘自 撰写 操作性 支障 改名 放假 放射性 政法 敏捷 教堂 数式 数理 数额 整天 文京 文革 新卒 新曲 新株 新片 新知 方程式 方策 旅馆 无力 无须 既是 日产 日军 日用 旧版 早川 早报 旺盛 昆布 明石 昏 昔日 星期日 晖 晚餐 暮 曙 曙光 曜 最大限 月初 有事 有些人
+93 望月 木工 未経験 本学 本届 本报记者 本音 机床 杀死 村田 来日 杩 松原 松浦 极端 果汁 标致 栓 校舎 样板 核定 核实 根源 格子 格差 梯 梵 棍 棒球 森田 楼梯 榊 樊 模范 権力 横断 橙 橱柜 歌唱 此刻 武力 死神 殿堂 毯 氯 水产 水库 水曜 水质 永井 汀 汚 江戸川 沁 沐浴 河合 沿岸 泉州市 法则 法师
+93 法拉 法文 法语 泰山 洋子 洒 派出 流传 浄化 浄水 浓度 浼 淑女 淫乱 淫笑 淮 深受 混雑 渋 渔业 渠 渣 温室 湖州 滞 滨海 滩 瀛 火辣 火锅 灸 灾害 炎症 炒作 炸弹 热心 焊 熊谷 熟成 熟练 熤 爆竹 片面 牌照 牝 牡丹 特产 狄 狮子 现货 珍贵 瑛 瑜伽 瑟 瑶 璧 生体 生动 生殖 生肖
+93 甲信越 甲府 甲斐 电流 电玩 男根 町内 畔 畜牧 病情 症例 癣 白菜 皇室 皓 皖 监视 直流 相対 相继 看上去 看得 県庁 真象 眼球 着用 瞬 石材 确 碓 磁気 神六 禄 禧 秉 秋山 租用 稍候 稼働 稽古 章节 第六章 筈 算了 精子 精明 精算 精通 红包 红外 纤 纱 纲要 纸张 细致 细菌 织 绍 绑架 结局
+93 给人 绝不 综 缁 缸 罕 罕见 罠 美学 美景 美洲 美穂 羡慕 羽毛 翰 老子 耍 耕地 职权 聘请 聡 肴 背面 脑袋 脱衣 腑 腹部 至尊 致使 航线 舱 艺人 芝居 芭 花了 花钱 芸人 英镑 茅 茶馆 菊池 萩 萩原 葫芦 蒲 蓝天 蔓延 薄膜 薙 薬学 藤本 藻 虞 蜂蜜 蜃 蟾 蠈 蠢 血圧 行政法
+93 行李 补助 袗 被动 裁定 装潢 裳 西口 西山 要么 见证 视察 视窗 解禁 认知 讨 议会 议案 讲究 讲解 诈骗 诪 说出 请向 谁能 调解 賱 贞 账 赌 赶 起步 趁 超人 超出 趋 足立 跑到 跳到 跳水 身影 身心 躲 载体 辿 过渡 运费 近郊 还原 还好 进而 退役 退款 送花 适宜 适当的 逅 逗 通州 速率
+93 造就 逾期 遂行 遇见 道案内 遗址 邑 那儿 邮电 邯郸 郊外 部件 部委 部数 都不能 酵母 醇 释 重心 野郎 量子 金具 金城 鈭 鍼 钓鱼 钢材 钩 长篇 长远 门外 门槛 问世 闯 间谍 阮 阶层 阿根廷 降水量 陶器 陶芸 隅 隍 集落 雌 雕刻 雪山 雷达 霓 霸王 青睐 青蛙 静止 静电 静静 面包 鞋子 音色 预备 预报
+93 颇 颗 题材 风流 飞扬 飞船 食生活 香料 驿站 高低 高値 高崎 魁 鲁迅 鲍 鲜明 鸭 麻衣 黄昏 黄金岁月 黙 鼻子 齿
+94 一双 一所 一类 一角 一贯 七夕 三好 上流 上衣 上页 下一代 下流 下页 不上 不会有 不做 不必要 不管是 世贸 世间 丛 丝袜 両国 严厉 严峻 个人所得税 中奖 中安 中新 中秋 中途半端 主宰 久保 之争 之事 之子 之音 乍 乒 乘坐 九大 九江 乞丐 书房 书院 乳液 事迹 二二 五中 五十音 交大 交通工具 交通部 京子 京王 亲人 人事部 人性 人性化 今季
+94 从中 从来 他所 仗 仟 代表作 仮称 仮面 众生 传达 传闻 估 伴奏 伸出 何事 佘 作弊 佢 佩服 佹 使者 使馆 侃 侵 保姆 信越 俳句 倚 假冒 做事 做成 偿 傍 元宵 充气 先発 光沢 光源 光荣 兑 全家 八个 八幡 公分 公団 公有制 六合 兴起 其实是 养成 兼任 再会 再発 再见 军刀 农户 冰雪 冶 冻 凛
+94 凭证 出土 出场 出自 出走 击败 分化 分岐 分成 分校 分红 列为 初始 利便 制剂 制成 剃 前列 前卫 剞 剩 副总裁 剰余 劈 加班 劣化 勃 勇士 勇者 匆匆 化成 化解 北川 北村 匡 匪 区民 医薬 午夜 华中 华盛顿 卖东西 即是 却没有 历年 厘 原住民 原先 原宿 原来是 又在 又能 双击 双子 反击 反面 发信 发型 受骗 口岸
+94 口径 古川 只在 可行性 台上 台所 台独 台风 叱 各店 各式 合一 吉他 吉利 名叫 名著 名言 名词 吵 吹奏楽 吼 周知 和音 哪怕 哲也 唷 商社 喻 嗣 四位 四条 回首 国人 国境 国立大学 土佐 在籍 地味 地毯 地税 地酒 均一 均有 坠 城堡 基督 塑胶 増殖 增添 壱 备战 外壳 外表 多方面 多谢 夜叉 大侠 大兴 大原 大口
+94 大局 大工 大森 大津 大熊猫 大类 大雨 失恋 头痛 奇特 奥林匹克 奨励 好奇心 如需 妇科 妓女 始末 姥 姿态 威廉 娴 婆婆 婉 存放 学歴 宅急便 完工 官兵 定食 宛先 宝来 实况 实质 実物 客房 室外 宰 家畜 寄出 富人 寰 対立 封筒 小人 小平 小弟 小田原 少林 尝 就得 尺度 尼崎 尽力 届时 屋敷 屡 山上 山中 岐 岚
+94 岩崎 岬 峨 巍 川上 工具箱 工委 左边 巫 巴勒斯坦 帘 帝王 常年 干脆 平方 年次 年纪 幼教 庁舎 底部 庚 庞 度过 庶民 延安 建立起 开办 开张 开支 开辟 弊 引越 当然是 当选 录用 彤 很小 得不到 徘徊 心事 心底 心想 心目中 忍受 忠诚 怀旧 急需 总书记 恢 恭子 恰恰 悉 悬 悲剧 悲惨 惨 想不到 想做 愚痴 感心
+94 懒 戊 成交量 成份 我看到 所作 所能 所长 手形 手持 手足 才可以 打出 打分 批量 承知 抄袭 抑 投产 折叠 抢劫 抢救 护照 报到 披 抵制 拌 拥抱 拱 拼命 拿下 持分 指点 指责 按键 挪威 捕捉 损伤 捷克 授课 掌柜 接客 接龙 搜寻 搭建 摇 撠 攀 支社 收支 政治局 故乡 故郷 救済 散发 数目 文史 文字数 料理店 斧
+94 斩 新井 新婚 新旧 新时代 新股 无所谓 无疑是 日本国 日系 时钟 昌平 明亮 昕 星辰 春日 是用 晟 晶片 暑假 更正 最低限 最先端 最长 月内 月薪 服部 朝刊 木下 本国 本家 机密 松尾 极度 构造 架空 柴油 核算 桂林市 桌上 桌子 梅花 梢 梭 森下 楼下 概括 模样 檎 欠陥 欧阳 欺 歌舞 此人 步入 武术 武蔵野 歩道 母乳 母校
+94 毒品 毓 毛主席 气温 気合 氟 氧化 水位 水准 水域 水温 水路 水量 永不 汇编 沙滩 没收 河流 油漆 油田 油脂 沽 沾 泌尿器 法轮 波兰 注定 注意力 洗面 洽 派手 流感 测绘 浜田 海啸 海拔 海老 涉外 混凝土 清代 渗透 游行 游览 湿地 溃疡 溘 溶接 溶液 滥用 漂流 演算 澄清 瀑布 灏 灵异 点券 点滴 烤鸭 烦 烫
+94 焚 煞 爆弾 爵 爵士 爷 爹 牌子 牢 物価 犹 犹豫 狐狸 独资 猎 猎人 献血 玉女 玉川 玫 现任 瑄 瑕 瑚 瑜 生命力 用力 用量 申立 申诉 男朋友 番外 疫 病理 痒 痲 瘫 癈 登上 登校 白山 白衣 百灵 皇朝 盒子 直行 直面 相处 相模原 省内 看病 県知事 真似 着色 睇 督促 知情 短大 石垣 碰撞
+94 磅 社交 祉 祖母 祝祭日 神圣 祠 票券 祺 秤 移住 稔 稲 穿越 突发 窒素 窟 立方米 立花 笏 第一节 第七章 第二节 筐 答弁 筛选 简化 简约 算数 管内 粉色 粋 粹 素朴 繖 红军 约翰 纯粹 纲 线上 终结 缓慢 缓缓 羊毛 美咲 美式 美里 美金 翌年 翻唱 考场 耐久性 耿 肇 肖像 肘 肝心 肝臓 肠 肥満
+94 肯定是 肿 胺 能有 脆 脖子 脚步 脸色 自有 至上 舰队 艰苦 色素 艶 节水 芒 花儿 花花 芳香 茎 茶色 草津 荔 药店 药材 荻 莲花 菲律宾 落户 薄型 薬物 薬用 虾 蜉 螺 血糖 街上 衣料 表决 表参道 袙 被告人 裕子 裾 褐 褕 褞 西田 观光 观赏 视线 角落 解剖 解雇 言语 警官 讘 论述 话语 说给
+94 请到 诸如 调度 谎言 豚肉 貌 走廊 走路 走近 起始 起草 起身 超低 超大 足元 跛 车身 车间 辖区 辩 辩论 迄今 过分 返答 迟 送货上门 逊 透明度 透析 逑 途上 通算 逝世 造价 造形 遅延 遏制 遗传 遥控 遥远 部下 都営 酒精 酬 酮 醸造 釉 重现 重重 金星 鉄骨 针织 铁道 铅 银币 铸 锅炉 长得 长达 门前
+94 闪亮 阅 阐述 阜 阴影 阿姨 阿富汗 陀 际 陈述 降至 限期 院内 陥 随即 难忘 雨水 雿 露天 青色 靖国神社 非常勤 面貌 鞍山 顺德 顿时 颐 风波 飞跃 首先是 首要 香味 駅伝 験 骨头 高价 高価 高贵 高野 鬘 魅惑 魔王 鯵 麒麟 麓 黑夜 黼
+95 一二 一再 一堆 一头 一封 一带 一幕 一心 一目 一站 一色 丁度 七个 七喜 万事 万象 三代 三名 三木 上个 上村 上游 上线 下山 下方 下游 不停地 不公平 不分 不动 不十分 不及 不小 不死 不等 不自由 不许 不顾 世家 丝毫 两侧 中世 中俄 中南 中性 中核 中立 中越 临沂 主旨 主权 主观 丽水 乃是 之初 乌克兰 乖乖 九五 九届 九段
+95 书写 书架 乳首 事后 交纳 人前 人选 什么东西 从业 从前 从小 他不 他有 他要 代言人 以致 企图 伊丹 会同 会场 会对 伞 传记 伤亡 伸手 伽 体脂肪 何以 何处 何必 作法 你我 你来 佬 佳人 佳作 供需 侦探 俄文 保固 修养 修建 俾 借入 借口 做为 做起 偲 偷偷 儿女 元年 元町 元祖 充实 充当 先前 先月 光圈 光彩 光线
+95 克莱 免税 全米 全都 八一 公元 公报 公明 公顷 六方 共存 关机 兵团 兵士 典雅 兼用 内分泌 内藤 再也 再去 冗 农历 农机 冥 冬日 决不 决战 冽 凝聚 几十年 処方 出世 出任 出入 出路 分歧 分量 切除 创立 判例 制冷 前条 前瞻 前锋 剔 剣道 剧本 剧院 加工品 加热 动人 动机 効能 包丁 包机 北上 北市 北野 区间 千瓦
+95 升高 半日 半月 协定 单价 南町 南西 南阳 卡尔 卯 印章 厄 厚度 厚木 原件 原型 原著 原谅 厩 去除 参见 及川 双向 发个 发病 叔叔 取出 受入 变为 叙述 口令 口服 口述 古田 句子 只不过 只剩下 只得 只需要 台南市 台阶 右边 叶子 号召 合格者 合理化 同好 同日 同级 名胜 后卫 向下 向导 向往 吸入 周日 味覚 呼唤 咁 咒
+95 咖啡店 哨 哪一 哭了 哭泣 唯美 唰 商圈 喀 善意 喜悦 喵 嗜好 嘎 嘻嘻 噪声 四处 四日市 回想 围巾 围棋 国宝 国情 国旗 土豆 在场 地中海 地主 地理位置 均匀 均等 坚实 堕落 堰 塑身 墓地 墟 壬 声称 处方 处的 复旦大学 夏目 夕刊 外相 外食 多用 多発 夜间 大吉 大将 大成 大抵 大河 大石 大选 大都市 天籁 天野 夸
+95 夸张 夺得 奈央 奢侈 奢华 女子大 奴隷 奸 好人 好消息 妇 妨害 妩媚 威尼斯 娉 嫂 子犬 学分 学研 学芸 宁静 宇治 安価 安倍 安娜 完整性 官司 定年 実例 审美 家屋 家有 宽度 寡 寰宇 导入 封印 尊严 小偷 小狗 小雪 尧 就去 局势 山岳 山路 岑 岱 峻 崩溃 嵩 川町 工伤 工地 左侧 巨额 差点 市原 幕后 平价
+95 平田 年夜饭 年鉴 底下 康熙 庸 廊下 开个 开采 开除 异议 弐 引数 弘前 弧 强行 强迫 归属 当成 役所 往往是 往返 德育 心境 心思 心愿 必看 忙碌 快门 急増 急激 急行 怪獣 总共 总览 恋情 恐竜 恐龙 恰 恵子 恶劣 恶心 恶魔 悠久 悠悠 情歌 想在 想念 想说 意愿 感人 慈悲 戌 成年 戚 戯 戯言 户籍 手芸 扒
+95 打包 打死 托福 找了 找工作 承接 把手 抒情 投保 投信 投机 投票数 抛弃 报关 抬头 抹 抽查 担忧 拆除 拉萨 拖欠 拖鞋 拘留 招考 括 拿起 按下 捐助 换了 掉了 掏 探测 接吻 推迟 措 提及 提议 搞定 摔 撤去 支所 收拾 收款 收缩 收音机 放开 敉 敦煌 数值 整整 文中 文学部 斗士 斡 断面 新天地 新政 新村 新田 新町
+95 新高 旅人 旅程 旗帜 无声 无情 无权 日夜 日照 早急 昂贵 明明 星球 春光 昨今 昼寝 景品 晴天 暁 更能 最中 最主要 最大限度 最高峰 有三 有意思 有期徒刑 有毒 有线 服从 朔 未成年 末期 本命 本案 本着 本篇 本色 杉山 村里 条文 条目 条约 来自于 来访 松戸 林檎 林道 染料 染色 柔情 柔软 柳州 柳州市 树脂 栖 校外 格外 格斗 梅雨
+95 梦中 楷 概论 榕 樱 次期 欲求 款项 正体 正法 武者 歯医者 毁 每位 毛巾 毛布 毛病 毫不 民政 民用 気圧 氛 水田 水色 污染物 河口 河野 油画 油耗 沼津 泪水 津波 活泼 流体 流畅 流石 浆 浜崎 浮上 浴衣 液化 液压 淡路 深处 深情 混在 添加物 清晨 温水 港元 湁 湘潭 滇 演歌 漕 潜水 潮州 澜 濂 灌灌
+95 灞 灶 炭素 炭酸 烘 热潮 焊接 焙 煌 煤气 熏 熔 熬 燥 爱车 父子 片段 版画 牙齿 物事 物置 狂欢 玖 玻 班主任 琢磨 瑞穂 璋 瓦斯 瓶颈 甜美 生于 田辺 由美 电讯 男装 甸 画报 画期的 画集 界定 留守 留给 痕迹 痛快 痛风 瘾 癸 発射 発症 白板 白血病 百分比 皈 皮炎 皱 盗聴 直线 相互作用 相声
+95 相约 真好 真希 着実 着急 睡衣 瞄准 矢野 知能 短暂 砂漠 研磨 砦 破裂 硫 硫酸 硬碟 磐田 示唆 祖父 祝你 神仙 神情 神算 离不开 离去 私营 科室 秘笈 秦皇岛 稍微 稳步 空前 空姐 穿上 穿过 窑 窗外 竟是 笠 第八章 筅 简易 米子 粘土 粤语 糊 系数 素子 索赔 纂 红楼梦 绑 绝望 维基 缩短 罢了 美妙 翅 翟
+95 翠微 老外 耳鼻咽喉科 聂 职员 聘用 肇庆 肚 肥胖 肺炎 胞 胡同 胴 能登 脊柱 脳神経 膨大 臆 自豪 致电 致病 致辞 艮 良性 色球 节点 苔 苖 苗栗 苡 英和 英格兰 苳 茂名 荀 获利 菫 落在 葆 葡萄牙 葱 蒋介石 蓄 蓬 蔻 薰 蛛 蜒 螂 蠕 衬衫 衰退 袨 被捕 裁决 装订 裸露 褎 西医 西安市
+95 西班牙文 西瓜 西甲 言及 订票 设想 诊 诗词 诚实 诠释 调制 豁 豁免 貂 賛同 负债 负面 货款 贫 贱 赋 赣 走势图 走私 起因 起码 跑车 跨国 跺 踹 蹲 軽井沢 车祸 轩辕 转入 辟 迅雷 过期 运气 运转 运送 返却 还真 还说 这篇文章 这边 进取 远处 连云港 迦 迹象 退院 选定 递 通商 通风 造船 遇上 遇难 遗
+95 遗忘 邢 邻 郊区 部活 都不是 都立 酉 配管 酝酿 酯 酸性 醒来 重症 重返 量的 金地 鉴于 鉴别 鍐 鍖 钧 钱包 镇江 镇江市 长老 门禁 闪电 问候 防疫 阵地 阶级 阻碍 降到 院系 陪同 険 隙 难点 难过 雄厚 雑学 震度 震荡 霈 青海省 非公式 非凡 鞘 鞜 预约 颈 额外 颠覆 饲养 饼 饿 首映 首歌 駆使
+95 马自达 高官 高清 高跟鞋 髭 鮠 麻将 黑幕 黒川 黒田 龚 龟
+96 一世 一事 一共 一刀 一分 一匹 一文 一旁 一曲 一棵 一网打尽 一脚 一节 一起去 一起来 七星 万歳 三世 三分之一 三宅 三方 三步 三段 三项 上世纪 上京 上诉 下半身 下水 下痢 下达 不只是 不吃 不堪 不定 不尽 不通 丑闻 专员 专注 专职 両立 严谨 丫头 中正 中略 临近 久保田 久美子 之作 之情 之意 乌龟 乘车 九龙 习 书刊 乳幼児 事儿 事象
+96 二千 二号 二度 二楼 二者 五笔 五行 亢 交易会 交替 交通局 产物 亲情 亲戚 人称 从不 仑 仙人 代表性 仮定 价钱 任免 任用 优异 会津 伝承 伪造 低廉 低收入 佐世保 佐渡 佛法 佷 佺 例年 侑 依次 侦查 保定 保暖 信徒 信者 修学旅行 修得 借用 倡议 倾听 倾斜 偏偏 做大 做客 做工 停滞 停留 健一 偷情 傻瓜 儒家 儿歌 光大
+96 光辉 克拉 克里 免疫力 入世 八千 八条 八百 公务 公営 公尺 公认 公道 关押 兴建 典范 兽医 内臓 冈 冉 写给 军区 军医 军官 农场 净值 减免 几乎是 几何 几千 出卖 出差 分娩 分期 分级 分组 刈 利和 利害 利点 制覇 刻意 前世 前夕 前所未有 前言 前辈 剖 剰 力求 加剧 加深 助力 勃起 勉强 勘察 勤労 化肥 区切 区役所
+96 医用 十天 千歳 半径 半数 华裔 单曲 南口 博弈 卞 占卜 历代 原案 双眼 反日 发扬 发掘 发财 受不了 受害者 受贿 变压器 变换 叠 口中 口水 古人 古籍 古董 只要有 可乐 可可 可行 史学 右侧 右翼 吃惊 各区 各部 合力 合租 合约 吉本 同名 同左 同感 名医 名和 名城 启事 启发 吸烟 呎 周四 呪 味噌汁 呵护 命中 和泉 咧
+96 咨 咽 唑 售票 唯有 唾 喇 喘息 喽 嗜 嘴唇 嘴巴 嘿 四次 四海 四百 因果 国度 图为 圈子 土屋 圣经 在乎 地上波 地步 坐标 城南 埔 埠 基准 基隆市 埼 堳 填充 増田 声望 声誉 売店 壶 変革 夕阳 外地人 外接 外籍 外装 多位 多样 夜空 大帝 大晦日 大腿 大西 天子 天桥 天水 天真 失落 奄美 奇观 契机
+96 奥地利 奥田 奨学 奶粉 好不好 好几 好想 妓 妥善 姘 娅 娼 存続 孤立 学业 学内 学士 学时 学问 孩 孰 宇航 安利 安定性 完成后 官房 官能 定向 定型 定居 宝箱 实事求是 审定 客栈 宵 家庭的 家禽 宽容 宿命 富婆 専修 射精 将近 小事 小判 小包 小孩子 小弟弟 小白 小笠原 小麦粉 少人数 少子化 尖锐 就労 就学 就应该 尽情 尾崎 居士
+96 居宅 屋上 屋台 屌 屎 屠 山内 山地 山城 山川 山林 山荘 岳阳 工学院 市中 师范 帰省 常任 常德 并与 并无 広瀬 廉洁 开会 开头 开学 开花 异性 引爆 彗星 往年 征稿 很爱 得票 御礼 微小 徳川 德语 心头 心病 心跳 忠告 忠実 念头 怎能 总动员 恐慌 恶化 恶性 悄然 悍 悬赏 悲伤 情书 惧 感光 感叹 感恩 感知 愠
+96 憎 戎 戏曲 成语 战机 戠 戦力 戸籍 所沢 所生 所管 手下 手臂 打倒 打得 打扮 打撃 扩散 技工 投注 抗拒 抗生素 抚 抚顺 报复 招牌 招生简章 拝 拡散 指控 按时 振动 捞 据此 授与 掌声 排在 探求 探险 推向 推送 推销 掴 描画 插座 揺 揽 摨 撤回 擅长 操纵 擒 支票 改成 改编 攻关 攻防 救出 救济 救灾
+96 教研 教研室 教科书 敢于 数回 敷金 敹 文凭 斜面 斥 断念 断然 新光 新居 新日本 新星 新歌 新郎 新颖 旋风 无意 无穷 既要 日向 日趋 早日 旱 时时 明日香 明月 春路 昴 显微镜 景象 更年期 更生 更要 曹操 曾有 替换 最小限 最速 月饼 有志 有意者 有楽町 有空 朕 期盼 木曜 本会 本郷 本院 机组 杉本 杏仁 村庄 杜绝 来月 枪手
+96 柚 栄町 案外 桔 梳 棠 棣 検知 榜单 槐 槸 樟 樵 橡 檀 欠点 欺诈 正午 武将 歳児 歴代 死体 死死 毁灭 毎度 毒素 比起 毛穴 民国 民家 气势 水先 水流 江山 汰 汲 沐 没错 河西 治験 沿着 泄露 泌 波形 注释 洛杉矶 洞窟 津市 活塞 派对 流泪 浅田 浅谈 浅野 济宁 浓厚 海事 海苔 海鲜 涯
+96 淡淡 淡淡的 深厚 深海 渊 温和 渲染 湖人 湖畔 湛 湿疹 源头 溯 溶解 溷 滑鼠 滕 演变 演艺 漫游 漫长 漳州 潜入 潜艇 潟 濡 濮 瀬戸内 瀵 火力 灵感 灼 灿 点了 热力 照相 熊野 爱尔兰 片子 片山 牢固 特快 特意 特有的 特约 特长 犹如 狂野 狠狠 狩 狱 狸 猛烈 猜测 献立 献给 猴子 王道 珀 球场
+96 理光 琥珀 琵琶湖 瑜珈 瑰 瓷砖 甄 生前 生理学 生病 田口 甲级 甲醛 电厂 电磁 电阻 町民 町立 留住 留言簿 番禺 疑难 疗效 疲 病床 病的 痴呆 痴心 発光 発着 発覚 白夜 白银 白黒 百家 百花 皂 皇冠 盆栽 盛世 目前为止 目撃 直升机 相差 相遇 省庁 看不见 看似 看作 看望 県警 真皮 眩 着眼 着重 睫毛 瞎 瞻 瞿 矩
+96 短歌 短缺 矮 矿业 矿山 砌 碱 祂 祖先 神殿 神父 祸 禁用 秀才 秘技 秩父 移交 稳赚 稻 空手 窍门 窗帘 窗户 窥 站起来 笁 笑笑 笔试 笙 第三节 等候 答申 管弦楽 篠原 篡改 籽 粉丝 粉红 粘膜 精一杯 精粹 糊涂 糖果 糟 糯 累了 経済学部 縄 繁华 繻 红豆 纪元 线条 细腻 经销 绘制 缇 缘分 缝 缺口
+96 罪行 美奈子 美观 羡 群星 群英 翘 老头 老是 老爸 考古学 考量 而后 耐久 聘任 聴覚 肆 肩膀 背心 胎儿 胚 脊 脚下 脾 脾气 腺 膂 臓器 自制 自治州 自由化 至此 致死 舗装 舟山 节拍 芜湖 芝麻 芬兰 花开 花样 花瓶 花边 芷 芹 苦痛 苫小牧 范畴 茄子 茗 茸 草地 荒木 荷重 莨 菅 菅野 菏 菩萨 萎缩
+96 营运 落到 落地 著作物 蒜 蒸気 蓓 蕙 蕨 薄弱 薪水 蝠 螒 螺旋 蟷 血清 衍生 衡阳 衣裳 表扬 表述 表面上 袧 装甲 裤子 裱 襄 要不 観覧 観音 见解 观测 观音 规程 视力 订货 议员 讲师 设在 试剂 详尽 语录 读物 课本 谈论 谦 谴责 谷口 豕 象棋 贝克 负荷 货车 贪 购置 贯穿 贴近 贵宾 贿赂 赤色
+96 走访 起伏 超高 越前 越好 越野 越高 趟 跟随 路边 路过 跳蚤 踊跃 蹄 蹇 躁 身为 身亡 轰 轻便 轻薄 输送 辛口 辞退 辣椒 边境 边界 过剩 迎春 运算 近来 近江 返送 这几天 进修 进化 远方 违章 违约 连战 迹 追逐 送出 送受信 送金 逃亡 逐年 通院 造假 道徳 遘 都可 配色 酥 酸味 醍 重力 重度 金森 金陵
+96 鉴赏 鏀 鐩 钉 钛 钦 钰 银子 银川 锤 锦州 镀 镜子 镭 长久 閮 闪光 闭 闸 阀门 阎 阻害 陆军 陛下 除夕 除此之外 集客 雇主 霄 霆 震动 靖国 靴下 鞄 韐 韩文 頬 顶尖 顺便 频频 飞天 餐具 饶 首度 香格里拉 香车 馞 駆除 马路 骚扰 高三 高出 高圧 高尚 高科 高血圧 魔术 鲨 鶏肉 麹
+96 麻醉 黑名单
+97 一举 一倍 一听 一圈 一太郎 一想 一朵 一楼 一派 一粒 一読 一趟 七分 万个 万物 三元 三十日 三家 三层 三振 三河 三省 三脚 三脚架 三角形 上千 上山 上百 下周 下回 下地 下田 下线 下车 不一 不为 不二 不佳 不利益 不可避免 不孕 不小心 不忘 不惜 不时 不曾 不理 不由 不符 不被 不解 不适 与党 世俗 两千 严密 丫丫 中央社 中枢 中海
+97 中英 中高年 丰满 丹波 为期 举动 久久 之友 乏 九九 九十九 也想 习俗 习题 书单 事柄 二三 二代 二回 互补 五人 五反田 五种 井川 井戸 亘 亦有 亨利 享用 京剧 京成 亲属 人件 人像 人情 人様 今晩 今期 今生 介助 他想 代官山 代谢 企管 企鹅 休假 优越 伝票 伤口 伶 但从 但有 位居 低头 低成本 低调 住吉 体外 何卒 余姚
+97 佮 侧面 侮 俄国 俶 倍増 倒霉 停产 偿还 傍晚 傛 催眠 儓 先是 党性 入出力 入国 全党 全日制 八代 八木 六届 六日 关节 兵力 其间 养眼 内蒙 写下 农家 冠心病 冤 冷冷 冷水 冷蔵 処女 出入境 出口商 出来高 出没 出番 出示 分管 切削 切割 初一 初次 利亚 利器 利子 利物浦 到底有 制动 制式 刹 前款 前端 前身 剥夺 办案
+97 功力 动摇 劳工 効力 勘弁 勤奋 勺 包围 包头 匈牙利 化纤 化身 北口 匙 十多年 十字架 十日 千秋 卅 半夜 华尔街 卓上 卖淫 南极 南米 単一 単元 単身 卡特 卡车 即刻 即日起 厉 压抑 原爆 原町 原画 原発 去掉 双边 反动 反响 反感 发作 发光 发觉 取向 变脸 变迁 叙 口碑 古本屋 古都 只为 召唤 可笑 右上 号外 号室 号称
+97 司令 司马 各家 合伙 合体 合影 合戦 合肥市 吉村 同心 同月 名列 后退 吏 向外 吞 吠 周三 周二 呼声 咀 和牛 咳嗽 品尝 哪位 唆 啣 啸 喜好 喝了 嗨 嗽 囚 四字 四段 回升 団子 囧 図形 国分寺 国力 国学 圃 在即 在学 在意 地裁 地雷 坂井 坊主 坐下 坳 堤防 塁 境地 墅 增进 墩 壁画 声援
+97 备受 复古 外型 外壁 外文 多方 多目的 多选 夜里 大姐 大宅 大川 大概是 大理石 大蔵 大都 大院 天平 天然石 太少 失点 头部 奇闻 奉行 契约 奥会 女同志 好像是 好吧 好听 好用 好色 好转 好运 妈咪 妤 妨碍 委屈 姝 娥 婚前 媳妇 嫖 嫣 子弹 孔雀 存分 存取 存货 学说 宇部 安保 安理会 安芸 安阳 宋楚瑜 完备 宏伟 定律 定款
+97 定理 宜家 実情 実技 客气 宣称 密云 対面 専任 将与 将要 小伙子 小幅 小文字 小生 小町 小西 小路 尚有 就不是 尾道 层层 山羊 屹 岩田 崇高 崭新 嶋 川柳 巡礼 工程系 已久 市街 希少 帐篷 带到 帷幕 干涉 干渉 平坦 平淡 平谷 年报 幸好 幻影 幼稚 幼虫 幽灵 広大 底线 府立 廊坊 延迟 开玩笑 弄得 式典 弱者 归档 当下 当分
+97 彫 彰化人 彼岸 彼得 往下 往日 很大程度上 徐汇 微波 德州 心意 心血 必定 志摩 応急 怎么说 思索 急遽 怪人 怯 总机 总署 息肉 恰当 悚 悬挂 情境 惰 想你 想像力 想把 意匠 意图 感应 慕尼黑 慢慢地 慨 憾 我不会 我也不 房租 手提 手柄 手腕 打下 打字 払込 扩建 扭曲 扶助 抉 抵触 拉丁 拉力 拍的 拎 招呼 拿来 指教 指针
+97 挙式 挣扎 挪用 挽回 挿 捆绑 捏造 捜索 捺 捻 排斥 排泄 探査 接点 插画 揣 搬入 搬迁 搭乗 搭乘 摮 撕 攀升 收听 收盘 改変 改札 放浪 故宫 救治 敬意 敬称 整容 整数 文房具 新任 新到 新地 新米 新装 旅途 族群 无偿 无名 无处 时常 时限 明智 明细 春日井 昱 显现 晞 景山 智子 暧昧 暴行 暴雨 曋 曦
+97 更名 月収 月经 有待 有罪 朗読 朝廷 末日 本刊 本名 本栏 本目 杈 村山 杞 来客 松坂 枣 柊 某某 柑 柜台 标本 栗原 根性 根治 格尔 格言 桃色 桓 桩 梗 棕 棺 椎 椒 椰 楞 楽章 概率 概算 榜首 樋口 模索 欠席 正念 步步 武道 歳出 歹徒 死亡率 残存 残忍 比萨 毫 氢 氮 水沢 水瓶座 水稻
+97 水管 水系 水谷 水货 江北 汶 沈黙 沙洲 沛 没了 没能 河内 河道 油气 治虫 泄漏 泓 法学部 法政 波及 波斯 波浪 泰安 洋室 洋葱 派系 浑身 浚 浦安 浪子 海湾 海滨 海盗 海藻 海豚 海边 海面 涂鸦 涅 消极 涎 淘金 深田 深远 混沌 清朝 清爽 渓谷 渡假 渡航 渥 温浴 游侠 源自 滓 滝沢 演习 漾 潇洒 澈
+97 激素 瀚 灌溉 火曜 火炬 火花 灯泡 灾 灾区 炖 炙 炬 炭火 点子 点火 点燃 烧烤 热水 热量 焕 焦虑 焰 煲 煽 燮 爻 片刻 片道 牟 牧野 特写 狭窄 猜想 猪肉 獒 獣医 率领 玉子 玩的 玫瑰花 理智 理系 琉 瑙 瑞安 瑾 璃 甈 甜甜 生姜 用事 电线 男主角 畅通 界限 略有 畸形 疆 疑似 病患
+97 病房 痉挛 痔 瘟 白人 白浜 白玉 白皮书 白酒 白雪 百余 百元 的确是 皇上 皮具 盐城 盗窃 盛会 目玉 目睹 直人 相方 相爱 相连 看书 看重 県道 真夏 真夜中 真爱 眺望 眼下 眼看 眼部 睦 矢量 知己 知性 石炭 矽 破碎 硼 碎片 磁性 磋商 礼金 祀 祇 祝愿 神谷 禁区 私有 科研成果 称呼 移送 稳健 突撃 窒息 立委 竞选
+97 端的 竹中 竹田 笉 第三个 第九章 第四次 笹 答辩 筱 筵 筹划 签写 简要 箔 米沢 粘着 精工 絮 絵文字 繁忙 繁盛 纯净 经商 统战 绮 绿茶 缃 缅甸 编织 罪恶 置换 羊肉 美能 美香 羽根 羽生 翔子 老人家 老家 老爷 考前 而出 而定 而有 耐性 耐用 聆听 联考 肋 肢 背上 背叛 胖子 胜负 胤 脂肪酸 脏 脱发 腹痛
+97 膨胀 自力 自然界 至少有 臻 舞踊 航行 船上 色情电影 艳情 艸 芝加哥 芥 芦屋 花嫁 花生 花花公子 苓 苗木 苛 若要 英美 茄 荃 荧光 获胜 菅原 菇 菊花 菩提 萓 落合 落幕 葡 蒹 蔬 蕾丝 藏族 蚕 蛟 蜡 蜥 蝉 蝎 蝪 螃蟹 螘 蠁 蠋 蠖 血型 血症 衍 衔接 街角 衣物 表态 衰老 衷 衷心
+97 袜 袪 裁判官 装扮 裘 裙子 裹 褓 襟 西亚 西城 西域 西尾 西暦 要到 要塞 见了 解脱 言情小说 警察官 譬 譬如 订立 议题 讲的 访华 评比 话剧 诡异 语气 误会 误导 误解 诲 说不定 请将 谁在 调剂 谞 谨 谷川 豊中 豌 负载 贡 财物 贪官 贪污 赐 赢家 赤裸 走去 起算 起飞 越是 足利 趴 跌破 跑步 跪
+97 跫 路段 跳槽 跿 踏上 蹦 躺 転倒 车票 轨迹 较量 辖 辱 迁移 过关 过得 过敏 近似 近百 近视 还想 进士 连带责任 连忙 迫切 迷失 退屈 适度 选出 递交 通俗 逛街 速攻 造纸 逮 逵 遅刻 道教 遨 遶 那只 部局 酚 酷刑 醴 里斯 重宝 重申 野心 金字塔 釜山 鉱物 銋 鍑 鍜 鎷 钠 铸造 锦绣 镇压
+97 镍 长假 长寿 长征 闪烁 闭幕 间隔 闵 闻名 阔 队友 阯 防寒 防毒 防空 防腐 防除 阴谋 阵营 阶 阻力 阿拉伯 附带 附录 限于 隋 随风 隐瞒 隶 隼 难免 难怪 雅芳 雉 雨天 雪景 雪花 雷诺 霂 面上 面具 面孔 面部 靽 鞗 鞳 韩语 音符 音质 顕 颂 领土 食肉 饮水 馒头 首创 香山 香蕉 高位 高専
+97 高度化 高教 高明 高槻 高薪 高雅 髪型 鬲 魔界 鱆 鶤 麒 麦克 黑洞 黒豆 鼓舞 齿轮
+98 一休 一字 一室 一封信 一息 一挙 一昨日 一望 一架 一泊 一直都 一首歌 万分 万名 三九 三十五 三原 三成 三明 三者 三越 上台 上回 上官 上床 上榜 上越 上阵 下令 下北沢 下段 下町 下级 下雨 不一致 不出来 不合 不在乎 不妊 不懈 不敌 不正常 不清 不知所云 与众不同 专业人士 专制 世界史 丝绸 丝路 两岸关系 个个 个案 中也 中场 中式 中欧 中谷 中高 丰厚
+98 为首 主観 乇 之多 九日 乾坤 了不起 二酸化炭素 互通 五分 五四 五天 五届 五星 五色 五郎 井戸端 亚军 亜美 产销 享受到 人道 仆 从容 付着 代办 以至 仮名 伙 传承 传染 传言 伯爵 估算 似乎是 似合 住址 体会到 体质 何方 余分 作画 佟 你找 佽 依然是 侠客 促成 信封 修正案 俳 倒在 倘若 倬 倭 倶 债权人 假的 偕 做生意
+98 停留在 健太郎 偷看 傍聴 兄妹 光子 克星 入室 全巻 全日空 全曲 全社 全美 全职 八千代 八字 八届 八方 公然 六百 共催 其一 其后 兼顾 内面 冇 再到 再按 再有 再看 再起 再造 写在 军营 冨 冰冷 冰封 冰淇淋 冷漠 冻结 凝固 凝聚力 凯旋 凸显 凹凸 出局 出货 出面 分明 分権 分社 分院 切符 刚好 初三 初二 初夏 初学者 判刑 判处
+98 刺客 刻印 剂量 前夜 剑桥 剧烈 副校长 割烹 力作 力士 力气 加之 加害 动了 勇于 勋 勘探 勘案 勲 包容 北山 匣 医治 匿 十一日 十八日 十种 千代 千古 千金 半田 华山 华文 协办 卑 单项 南下 南美 占了 卷烟 历届 历经 厚生省 厨师 厳密 县长 参拜 参阅 双赢 反転 发条 发泄 发热 取回 取次 取胜 受容 受损 变性 古巴
+98 古道 另行 只不过是 只看 只要是 可不可以 可不是 可得 可望 台球 右下 号泣 叹息 吃完 吃得 各市 各族 吉井 同学录 同性 同样是 名声 名表 名额 后代 后方 吓 吝 听众 启蒙 吵架 吸取 吸着 吸血鬼 吹田 吹雪 告発 周到 呪文 呼和浩特 和光 和彦 和服 和英 咦 咩 咪咪 品德 品物 哆 哥伦比亚 哪有 哺乳 唐人 唐代 啄 商界 啼 嘻 噶
+98 嚼 四千 四届 四肢 四角 四项 回味 回族 因此而 固然 国体 国安 国富 国米 图纸 囿 圆形 圏内 土浦 圣地 圧倒 圧迫 在校生 地下室 地方自治体 地蔵 地表 坟 埌 城北 堆肥 塩化 塩素 増刊 增加到 增高 墨田 墿 壮族 备考 复仇 复活 复苏 夏期 外事 外星人 多余 夜总会 夜行 大卒 大卫 大叫 大垣 大城 大学院生 大崎 大悲咒 大手町 大村 大江戸
+98 大沢 大火 大牌 大物 大生 大竹 大衣 大象 大路 天外 天成 天文台 天王寺 太好了 太字 太极 太过 头疼 奔跑 奥妙 奴隶 好不容易 好意 妄 妾 姣 娌 婂 婕 嬅 子弟 季刊 学童 孵 守则 安妮 安易 官公庁 官庁 定额 宝藏 実体 実写 実効 実在 実戦 客商 室内设计 害虫 家住 容纳 宿舎 寄回 寄生 密室 密接 富力 富田 富贵 寓
+98 寓言 寸前 对称 对立 専有 専科 将使 小僧 小字 小森 小牧 小花 小豆 就寝 就将 尼克 尼古丁 尾巴 屋顶 岩井 峪 崇尚 崟 川西 左上 左翼 左键 巨匠 巫女 差额 已知 市南 市外 布朗 帙 带走 帮派 常人 常勤 常温 幕府 幕末 幡 年表 幸子 幼女 幽霊 庄内 序幕 底层 座谈 廃人 开封 开庭 开课 引受 张贴 弩 弹簧 强有力
+98 弾力 彊 影迷 彼方 很低 御覧 心爱 必有 必需品 志工 応対 忠实 快快 快晴 快楽 快要 怀柔 怔 急忙 性命 总算 恬 悄 悊 患有 患病 悬念 惣菜 想了 愣 愬 慕容 憋 懿 成名 或有 战友 战国 戦前 戸数 所知 所述 手镯 打个 打入 打动 打架 打球 扛 执着 扩充 扰 批示 扼 承载 技研 投向 投降 抗原 択
+98 抢占 抢购 披露宴 抬起 抽奖 拂 拍得 拚 招租 拟定 拥护 拦截 拨款 拰 拴 挂钩 挑逗 挣钱 挪 挫 挺好 捜 捣 授乳 掌控 接合 接地 接种 推翻 描绘 提唱 搓 搞得 搬送 摧毁 摩登 撞击 操縦 支架 收银 改建 攻势 攻读 放了 放宽 敛财 散步 敤 整套 整齐 敺 文人 斎 断言 新奇 新局面 新民 新锐 施肥 无产阶级
+98 无可 无忌 无知 无缘 无辜 既婚 既定 日渐 日高 早春 时髦 昍 明代 明太子 明朝 明清 星期天 星河 昨年度 昨晩 是好 是想 是正 昼夜 晋升 晦 景德镇 暖气 暗黒 暴笑 曼谷 最上 最好是 最期 最深 有其 有效性 望远镜 期中 期满 未完成 未曾 未熟 本州 本性 本数 本章 机上 杉浦 束缚 来不及 来年度 松村 松竹 板材 枢纽 柔和 柠檬 查出 栄光
+98 标明 树木 核查 核武器 格拉 桃太郎 桃子 桦 梦里 棘 森山 森本 棰 棵 植木 概略 榎 榜样 榭 槫 模版 横丁 横田 樱桃 橙色 次男 欢喜 欺负 此一 武昌 武陵 死人 殖 毋 毎朝 每一个人 比喻 比基尼 毛利 毛孔 民主化 民歌 民进党 气球 水处理 水文 水族 水深 永乐 永田 永远是 汇集 汉族 汐 汕 江口 汤姆 沙特 没关系 没法
+98 沫 河口湖 油墨 油断 治国 泂 法宝 泙 泡盛 泥棒 洗涤 洛克 津田 津贴 活字 派生 流产 流血 浇 测验 浓缩 浜町 浮动 浴槽 海量 涘 淀川 淀粉 淏 混入 淹 清原 清清 渤海 温哥华 游人 湖泊 溺 滃 满了 漱 澡 澧 激化 濠 炜 炯 点字 烈火 烙 烨 热带 热身 烹饪 焜 焼却 爆破 爱她 片方 牠
+98 牡丹江 物性 物料 物欲 物种 牵 特刊 特工 特权 犴 狂热 狨 献身 玉石 王家 王牌 玛丽 玩偶 玩法 玲子 珂 理容 理屈 琶 生机 甥 用以 甬 田原 由衣 甲状腺 电容 留守番 略歴 略称 畸 疣 疯子 痛感 痞 瘠 発作 発芽 白井 白川 白河 白痴 百事 百人 皮带 皮鞋 皷 盒装 盖章 盯 直営 直子 直感 直观 直达
+98 相思 相识 相近 眈 看一下 看守所 看清 真假 真央 真弓 真田 眼底 着目 瞩目 瞪 矢印 矢口 砕 硬化 硬度 确切 碧海 示例 示威 社外 神崎 神色 祭典 祭祀 禁断 离职 秀文 私房 科长 秩 称赞 稚 稽查 空有 空洞 窈 窦 立命 竹林 笑声 笘 第一歩 第四节 签发 简便 简短 管家 簇 粉碎 粗糙 精液 精采 糟糕 総裁 縄文
+98 红星 纲领 细心 绉 绝大部分 绝缘 绿洲 缘故 缩水 缺失 缺席 罪名 罪犯 置物 美加 美貌 羞 翎 耀眼 老乡 老太太 考查 耶稣 聴取 聿 肝炎 股利 肢体 胆囊 胖胖 脱水 脱落 腐蚀 膣 臀部 自发 自来水 自爆 自理 自由自在 航运 般若 芊 芍 花屋 花朵 苍 苟 若林 英俊 英明 茬 荆 荡 药房 莫名其妙 莫大 菊地 菠萝 萝卜
+98 蒙特 蒸汽 蓍 蔓 藤崎 蚓 蚤 蜍 蜑 蜻 蟒 蠉 蠠 血压 血腥 行人 行者 行长 衿 袘 被打 被爆 裁员 装配 裔 褐色 褒美 褖 褰 襄樊 西宁 西岸 西欧 西野 规矩 觉得很 角逐 解码 解调 言情 订房 讯息 诊疗 词语 试车 说服 请用 读写 谅解 豪杰 貊 财源 质地 贫穷 赚了 走出去 赴任 赶上 起用 路人
+98 躔 躲在 転勤 车厢 轮回 轻微 载入 辩护 迅猛 过头 过错 还算 还给 远东 远到 远洋 连环 迟到 迫使 迷路 追及 追悼 退学 退还 适量 逃走 选取 逋 途端 通年 通往 造林 逶 逼近 遍布 道中 道士 遮断 避开 邂逅 邮费 邯 郤 部材 郴州 都度 都想 酌 酒楼 酱 采纳 里山 里程 重型 重工 野草 量化 銵 钟表 银幕
+98 长宁 长治 闲置 防晒 防汛 防洪 防线 阴阳 阿波 附有 附表 陇 陌 降临 院子 陪伴 陬 隐形 隐蔽 隔壁 隗 难受 集邮 雇员 雫 零售商 需求量 霏 青梅 青青 静脉 静香 非要 靠前 鞠 韶关 预先 风俗 风范 风貌 飞舞 飞飞 食谱 餈 饺子 馈 首脑 首脳 香烟 駄 马克 马英九 驶 骗人 骗局 髓 高密度 高招 高等院校 髣
+98 鮨 鲜血 鷭 鸡年 鹅 麻木 麻痺 麻美 麻薬 黄埔 黑人 黒人 黒字 黒木 黒糖 鼻炎 鼾 齐齐哈尔
+99 一八 一周年 一团 一大堆 一定能 一寸 一招 一日中 一昨年 一滴 一班 一端 一路上 七成 七条 七海 万余 万国 三上 三中 三和 三宝 三毛 三沢 三章 三谷 上代 上任 上半期 上半身 上天 上戸 上段 下乡 下位 下雪 不乏 不作 不失 不完 不履行 不干 不平等 不平衡 不愉快 不慎 不放 不由得 不自然 不见了 不论是 专人 丘陵 东北地区 东区 东山 严正 中井 中将 中韩
+99 丸子 为难 举起 之势 乐清 乗客 九品 九天 乞 也对 买方 乱交 乳酸菌 争端 二届 互利 五花八门 井口 亜弥 亟待 交差 交接 亲友 人质 亿万 今夏 他者 仙子 以至于 众所周知 伝授 伝言板 伟业 传授 伴随 佃 体味 体现出 何者 佛陀 作客 作案 例假日 供暖 侦察 便器 俄语 保罗 俯 倍数 倥 值得一提 偆 偈 做主 停了 停在 健二 健在 傝
+99 催事 傲慢 充填 先制 先把 先祖 先程 光泽 光滑 児玉 兑现 党章 入団 入股 全天 八九 八尾 八成 八日 八重山 公投 公章 公车 六角 其二 其次是 典礼 兼并 内向 内阁 再三 再也不 再送 军委 军用 农作物 农田 冢 况且 冷冻 冷房 准予 减小 减弱 减速 凑 凡人 凶手 出征 出访 函授 刀具 分光 分流 切入 初出 制片 剁 削弱 办的
+99 功德 功耗 加分 加速器 加速度 动植物 动用 勦 北原 区划 医务 医大 十三日 十六日 十周年 千克 千夏 升华 升至 升降 卉 半身 卖点 南亚 南岸 南投 南昌市 南洋 南瓜 占到 印刷物 印字 印花 危惧 即効 卵巢 历时 压制 原子炉 原生 原色 厳重 参会 参政 参観 反映出 发过 发酵 发音 取暖 变异 变相 叛 口头 古怪 只卖 只怕 只用 召回 可不
+99 可疑 台词 右脳 司令部 司法部 吁 吃香 各校 合算 吉林市 吊销 同封 名次 向东 向日葵 君主 吩咐 听证会 吸毒 吾妻 呆了 呰 和夫 和子 咎 咸阳 品格 哈萨克 响了 哎呀 哽 唐辛子 唬 售出 啃 商号 商都 嗔 嗙 嗤 嘲笑 噬 四日 四章 四郎 回帰 团长 国交 国保 国泰 土方 土砂 在做 地盘 坂口 坂田 坚信 城内 基建 基督徒
+99 堀内 堂本 塩分 填入 填补 墠 壁垒 壁面 壷 处境 备忘录 备用 夏威夷 外交官 外伝 外围 外长 多忙 多来 夜色 大井 大介 大喜 大多是 大展 大屠杀 大文字 大本营 大泉 大福 大空 大英 大西洋 天府 天文学 天狗 天理 天秤座 天草 天赋 天鹅 太低 太原市 太湖 夺取 奅 奇异 奚 女鞋 奶牛 好坏 好笑 如水 妥协 姨 娆 娣 婧 婿 嫉妒
+99 嫉妬 嬬 孅 子猫 孕育 字画 学籍 孵化 宁愿 宇多田 安居 安康 安部 定性 定购 宝山 宝库 宝鸡 实地 客席 客机 室温 容忍 宽松 寞 寤 对决 寺田 対人 対比 封杀 射出 将成 尊贵 小城 小妹 小提琴 小牛 小猫 小站 小结 尖端 就叫 就用 就算是 尼亚 尼斯 尽头 尾根 尿毒症 屋子 山谷 山道 山野 岂 峯 崴 嶽 工序 工期
+99 左岸 巴格达 巽 市府 市町 市面上 帆船 希特勒 幌 平地 広尾 庄园 底盘 庢 度数 座敷 庭院 开阔 弊端 引人注目 张扬 归纳 当否 当家 当心 影城 影星 役目 彼等 很是 従前 得罪 微观 微量 徴 徿 心疼 心痛 必読 忍耐 忘却 忧伤 忧虑 思潮 急剧 性情 怪兽 怪异 总则 总编 恒星 恪 恵美 悗 悪徳 情欲 惊奇 惨遭 想看 惹火
+99 意地 愚蠢 慎吾 憟 憧憬 憨 憩 憿 成像 成年人 成田空港 我再 我吗 我被 战线 戟 戳 戴上 户外运动 房东 手感 手掌 才华 打到 打者 打败 扬声器 扶桑 承德 承租 承継 抉择 抒 投下 投身 抗争 抗击 折射 抚养 抹消 抽烟 拗 招集 拜托 拠出 拡 拥挤 拳击 拷 指先 指望 挥 挽救 捂 捅 捆 捡 掂 掏出 掘金
+99 探険 控告 推开 掩盖 掰 搂 搞怪 搭档 摇篮 撅 撌 撑 撩人 播磨 撼 擂 擅 攇 支局 攸 放流 政绩 敏锐 敓 敕 散乱 数分 文系 文苑 文责自负 斉 料率 断定 断裂 斯大林 斯顿 新乡 新入 新力 新家 新庄 新秀 方舟 施展 施政 旂 旌 旗袍 无罪 既不 日程表 早晚 早点 旺季 昀 昆仑 昆明市 明子 昏迷 晁
+99 晚年 晢 普陀 晶体 晶莹 智利 智商 暗暗 曲折 曳 更深 更衣室 曾任 曾是 最能 月球 有感 有所不同 有理 有田 有道理 服药 朝着 期生 朦胧 木更津 木曽 未有 末端 本位 本塁打 本局 本所 本片 本领 机体 朽 杀害 杏奈 来宾 松永 松花江 林木 枚方 果实 枣庄 某人 柘 查明 柬埔寨 柳沢 栃 核对 格式化 格格 桀 案子 桐生 桜田 森永
+99 椋 植民 楢 榛 槭 権威 樱花 樻 橘子 欠缺 欣慰 欧文 歌星 正本 此篇 武藤 歳入 殴打 殿下 毛皮 毧 毫升 民兵 民心 民政局 气味 气囊 気力 水仙 水利工程 水土保持 水性 水溶性 水滴 水草 水辺 永安 求婚 汞 江民 江淮 江阴 池上 没用 沢田 河原 河村 河田 河畔 治病 沿途 法廷 泛滥 波音 洁净 洋一 洗衣 活在 活気 派人
+99 流向 流派 浅井 浓郁 浩二 浪人 浮出 海中 海棠 海辺 海运 消防署 涉案 润滑 涩 淮南 深深地 添乗 清远 清香 渉 渓 渡部 渱 游泳池 湾岸 満月 満期 溛 滑走 演练 演芸 漠 潢 澎 激起 瀑 灯油 点心 烝 烹 烽 焦距 煜 照样 煮物 熸 爱护 片名 片瀬 牙膏 牛津 牛皮 牢牢 牢记 牵手 特地 特注 犀 犯了
+99 犯规 狂人 狠抓 狡 玉兰 玉山 玉林 王妃 玩笑 现阶段 玲珑 珍品 珞 珪 珮 球技 理学部 琢 瑳 璀璨 璞 瓁 瓣 瓶子 瓷器 甇 用语 用餐 田代 田径 由美子 甲骨文 电站 画册 番手 番茄 疤痕 疲惫 疹 病原 病变 病因 痺 瘙 発刊 発泡 発行済 白手起家 百三 百名 皮肉 盛典 盛宴 直性 直近 相伴 相棒 相符 相聚 眉毛
+99 看一看 看台 県外 県政 真有 真理子 真由美 着丈 着工 睁 睹 矛 矫正 短时间 短篇 短裙 石家庄市 石膏 石英 矿工 碘 磁石 磔 磕 礁 礼拝 礼服 礼貌 祈福 祗 祚 神楽 神楽坂 票数 祭日 私家 私生活 秃 秋色 科尔 秘方 稠 穆斯林 空席 空想 空格 空缺 空腹 穿梭 突起 窒 立山 立法院 竖 竭 端数 竺 笆 笝 笨笨
+99 第十章 笳 筏 筷子 筹建 筹集 签定 箍 箱子 篭 篮板 簑 粕 粘贴 精巧 精简 精米 糠 素描 素食 累积 絵柄 綔 続出 繁栄 纕 纠缠 红灯 纯洁 纯真 经管 结尾 结晶 绚丽 绛 维多利亚 绿豆 编剧 缘份 缠绵 罗斯 羌 美德 美文 美方 羹 羽田空港 翌朝 翻了 老实 考案 而不能 耐力 耐火 耕作 耳环 聊聊 肉类 肥大 肺癌
+99 胀 背影 背部 胳膊 腊 腰带 腻 膀胱 自演 自白 自私 自费 舔 舘 航路 良知 艰巨 艰辛 色调 芝生 芥末 芮 花子 花木 花草 花香 苏丹 若松 苦恼 苦难 英字 茉莉 茧 茨木 茱 茶屋 茶碗 茶道 草坪 荒井 荒野 荣耀 荤 药学 莫名 萝 落差 落成 蒲田 蒸留 蕉 薯 藤森 虻 虽说 蚊子 蛍 蛤 蜗牛 蜡烛
+99 螣 螤 血流 行政诉讼 行星 袚 袛 袜子 裁量 西新宿 西装 西里 西餐 観念 见识 见闻 觅 视图 角田 觜 解开 解毒 解说 言行 詑 諠 警报 讈 讙 讟 计委 议论 讽刺 诊所 译者 试着 诚意 诛 误差 诱 说不出 诺贝尔 课桌 谋求 谺 豢 賳 财力 货架 贵公司 赚个 赣州 走入 跋 跌幅 路地 路子 跳跃 踓 踪
+99 躲避 転移 軥 軽快 輆 轰动 轻工业 辛勤 辜 辞任 过节 近乎 迭 迭起 迷子 送交 逃跑 逆转 逐一 通所 通车 速配 逼真 遍地 遗失 遵义 邓丽君 邢台 那份 邮购 邵阳 郎中 郴 都应该 鄙视 配有 配音 酒家 酪 醒目 醸 重伤 野性 野营 金沙 金田 金髪 釮 釿 鉄人 鉄筋 鉱山 鉱石 鎌 鐑 鐪 鑳 钥 钳 铃木
+99 铛 铬 销毁 锂 镁 闷 防具 阶梯 阻挡 阿拉 陈列 降幅 降雨 除雪 随想 隘 雅子 雑草 雪地 零点 雷锋 霉菌 霎 青春期 面目 鞈 鞭炮 韭 页码 顺着 顽强 颁 颁奖典礼 预告片 预见 预选 颜料 颤抖 风中 风度 风潮 风筝 食道 饮用 饮酒 首富 首部 香取 香草 马丁 骇 骨格 高地 高大 高空 髱 鬼子 魄 魅了 魔神
+99 鱇 鲸 鸽 麻布 黄浦 黑社会 黑衣 黒沢 默契 鼻毛 龙门
+100 一六 一堂 一夫 一如既往 一年中 一把手 一新 一晩 一気 一波 一瞬间 一碗 七瀬 万岁 万户 三人行 三位一体 三八 三室 三通 三部 上前 上川 上杉 下场 下肢 不以 不免 不只 不可或缺 不就 不忍 不朽 不至于 不觉 不透明 专营 丛林 两手 两用 中之 中南米 中国地方 中天 中层 中居 中山路 中嶋 中庭 中文系 中等教育 中风 丰台 丰收 主干 主成分 主治医 主食 举人 之于
+100 乐山 乔治 乜 也来 乳児 亀井 予言 事変 事案 二世 二位 二次会 二氧化碳 五代 五位 五官 交货 交配 交际 京津 亲身 亲近 人命 人妖 人寿 人影 人手 人柄 人流 人际 仄 今治 今野 仍旧 仕込 他国 他家 他方 他用 付帯 仙女 代数 代用 以为是 以防 伊予 伊斯兰 会所 会晤 会期 会话 伝言 伺服 但愿 低估 低胸 低速 住宅地 住户 佐久
+100 体贴 作废 佳丽 使徒 侀 侄 供销 侧重 侬 俐 俗称 信用度 俪 修补 俵 倘 候选 倚天 倦 倾诉 偏低 偏光 做完 做梦 做饭 停下 停电 健保 傜 僚 僭 兀 充斥 先天性 先驱 光一 光影 光缆 光表 兢 全品 全天候 全心全意 八十八 公元前 公有 公署 六个月 六甲 六种 共和 共立 兴国 典子 养颜 内山 再把 写到 军方 冠婚葬祭
+100 决算 冷暖 冷淡 冷酷 准时 凋 几率 凡例 凳 出入口 出动 出向 出所 出水 出版商 出院 分子生物学 分部 分隔 刉 列举 初年度 刨 到场 到此 刹那 削减 前任 前原 前台 前売 前段 前菜 副总理 割安 割当 功名 加了 加倍 加古川 加水 加点 加紧 加茂 动工 动脉 动静 勋章 勘查 包茎 包袱 北条 北町 区号 十名 十多 十年前 十章 千千 千春
+100 升空 升起 半球 半生 半裸 南开大学 南湖 単発 博之 博文 博覧 卡通影片 卫士 印度洋 却下 厂长 历来 压迫 厝 原厂 原木 厥 厳守 参谋 参院 双倍 双层 双方向 反之 取景 取缔 取自 受発注 变的 叡 古来 古河 只剩 只因 叫了 可信 可向 可変 可用性 可知 可观 台大 史密斯 右折 吃到 各人 各科 合致 吉原 吉安 吉永 同定 名将 名气 名画
+100 名目 名苑 名车 后天 向左 呃 呕吐 呦 周易 呼吸道 呼称 咮 哀愁 唐朝 唖 唤 唤醒 商旅 商行 啤 啪 喜多 喝水 喧 喷血 嗾 嘴角 嚎 嚭 嚷 四名 四谷 四通 回生 回馈 图腾 土井 土台 土建 土星 圣人 坂下 坐了 坐落 垠 埋立 埋葬 城西 基数 堜 堵塞 塀 塌 墙壁 增减 增生 壅 壑 壕 壮观
+100 壻 変速 夌 外币 外援 外衣 外遇 多岐 多情 多摩川 夜光 夜勤 大亨 大关 大大的 大好 大平 大木 大潮 大爷 大肆 大跌 大通 大麦 大黒 天主教 天安门 天童 太白 夯 失利 失控 失格 奇缘 奏者 奢 奢侈品 奥村 奥秘 女将 女童 奸商 她不 好似 如来 姉歯 姓氏 姗 娓 娜拉 婃 婊 媲美 媴 子孙 子曰 孙中山 孙子 孜 孤儿
+100 学名 学界 宇野 守山 安南 安县 安吉 安宁 安庆 安泰 安西 宋代 完好 実演 审视 宣判 宣教 室井 宪政 宫廷 宫颈 家康 寂静 富民 富良野 寔 对战 对白 对联 寻常 対価 対外 封入 専念 射撃 射程 射频 小中学校 小便 小口 小姑娘 小銭 少有 尤其在 就读 就走 尼龙 尽早 局所 局限 居心地 山菜 山麓 岁时 岩国 岫 岸和田 峡谷 峥 嵊
+100 嵯 嶆 嶈 川内 川原 川端 巡逻 左下 巨型 巨蛋 巩 已然 巴拉 巴里 布施 布袋 帚 帷 常有 常磐 干旱 平凉 平平 平年 平滑 年始 年级 并列 并存 并行 并重 庇 序曲 庐山 废止 废水 庶 康夫 建构 建言 开朗 弈 弛 弯曲 强暴 当即 彦根 彰显 影印 影集 彷 彷徨 往上 往后 往往会 征战 很美 徒步 得失 得益
+100 御史 微分 微弱 微米 徳用 必不可少 必答 忔 志村 応力 快活 快照 怀抱 怐 怛 思春期 急于 性状 恋歌 恍 恰好 恵方 悖 悪性 悼 惇 惕 惯例 想必 愉悦 意想不到 感官 懆 懐妊 成套 成药 我先 我到 战神 戴着 房山 房市 所有制 扁平 手枪 手淫 手脚 手首 才算 才能够 才行 打听 打法 打点 扭转 扰乱 技士 投球 抗癌 抗着
+100 抗酸 折腾 折角 折起 抢险 报销 抵御 抵抗力 抽取 拆封 拇指 拉德 拍下 拍拍 招股 拜访 拣 拳头 持平 指明 挎 捍卫 换个 掐 排卵 掟 探知 接力 接班人 接送 控制台 推崇 揆 提早 提请 搜查 搬到 摩尼 摸索 撇 撖 撘 撤离 擂台 擐 擘 攐 支那 收件 收养 收受 放大器 放手 放火 故居 救世主 教主 教导 敝 敞
+100 敞开 散歩道 整合性 整洁 敶 文化大革命 文案 文理 斜体 斡旋 断片 新入生 新兵 新川 新市 新法 新生児 方圆 方能 斿 无从 无机 无耻 日吉 日比谷 早年 早早 早泄 旭日 昅 明快 明知 星城 星火 映射 昧 昨天下午 晚期 晚饭 普天 暗中 暴风 曞 更不 最先 最前 最想 最良 最高法院 月夜 有如 有情 木制 木头 木星 未然 末尾 本省 机油 杂文
+100 杉田 李白 杏子 村松 村落 杠 来回 杯子 杵 杷 松嶋 板金 极了 极力 枋 枕头 果树 柏原 柏崎 栅 标语 树林 株券 栫 核燃料 核电 核酸 根室 格段 桂花 桃源 桑名 梅酒 检修 棕色 森川 棱 椀 検察 楔 楝 榆 榎本 榕树 榰 槌 横行 橄榄 橿 檤 欐 欣喜 歆 歌剧 正明 此地 死因 殆 殉 段差
+100 母体 每秒 毫克 民主政治 気持 氦 氨基酸 水冷 水原 水口 水彩 水洗 水牛 求救 汉城 汉堡 汗水 汚泥 江河 沂 沅 沉思 沧 沧州 河出 河原町 河水 油性 治水 泉水 注塑 注釈 泼 洗净 洗牌 洗礼 津山 津軽 洵 流用 浄 浄化槽 浜松町 浩一 海景 涅槃 消火 涮 淋浴 淡化 深山 深思 深谷 混淆 混血 淽 清明 清晰度 清流 渓流
+100 温情 渴 游民 湖水 湡 湴 湿気 満了 満腹 源流 溴 滑板 滞留 漂白 演化 漫才 漫漫 澪 激怒 濆 濍 濯 火事 灭绝 灯台 灯笼 灰尘 炅 炭水化物 炸裂 点儿 烈士 烤肉 焦作 焱 熊田 熟知 燃放 燕子 爨 爬行 爱因斯坦 片中 片断 牛革 牧师 特売 特捜 特殊性 独断 独裁 狭山 猛男 猝 猫咪 率直 玉溪 王室 环绕 现今
+100 班车 班长 理工学部 琵琶 瑁 璅 璩 生平 生殖器 甦 用作 用到 田川 田野 甲基 电热 男星 畅谈 畷 疎 疤 疾走 病名 瘚 瘜 発散 発祥 白内障 白宫 白水 百一 皆可 皆有 皇太子 皮草 皯 皱纹 盆地 益田 盗贼 盛行 直売 直射 直撃 直立 直觉 相容 相川 相応 相手方 相沢 相逢 盼望 看不出 真一 真品 眨 眷 眸 眺
+100 瞑想 矢田 知多 知床 知育 知音 石榴 石狮 硝 硝子 硝酸 硫黄 碍 碰上 社论 社长 祐一 祟 祥和 祥子 禁令 秀丽 秀吉 秀明 秀美 私语 秉承 秋月 秋雨 科委 科系 秸 移除 稍有 稚内 稽 穿衣 突击 突破口 窃 窖 立志 童子 端正 竷 笠原 笢 第一卷 笼 笼罩 筋力 筝 筠 简讯 箴 篠 簧 簬 米奇 粉底
+100 精湛 精霊 精髓 糕 紊 紧凑 紧迫 縦断 繑 红叶 绅士 终点 经纬 结识 络 统领 绣花 继电器 维吾尔 绾 编排 缠 网路 美和 美姫 美工 美感 美沙 美艳 羚羊 群雄 羯 翊 翻身 老了 老兵 老朽 考证 耽 耽误 聃 联欢晚会 肛 肝癌 肝脏 胃口 胄 背光 背番号 胚胎 胜任 胡椒 胡麻 胭脂红 胯 胶片 胸口 胸罩 能率 脉冲
+100 脑子 脑筋 脚架 脩 脯 脱却 腹筋 膳食 臧 自国 自尊 自用 自発 臬 至高 致癌 舌头 舍不得 舛 色気 色狼 艳丽 花冠 花城 花巻 花旗 花柄 花王 花都 苍白 苏格兰 苗族 若菜 苦悩 苦戦 苲 苶 苹 苾 茀 茉 茖 茫然 茫茫 草加 草木 草花 莎拉 莘 营口 落入 落叶 葛藤 葴 蒿 蓖 蓝图 蓬莱 蓼 蔵王
+100 蔷薇 蕃 蕗 薡 藕 藤村 蘑菇 虚弱 虫歯 蛇口 蛐 蜜蜂 蝏 蝘 蝙蝠 蝡 蝣 蝮 蝵 螜 螝 蠕虫 衣柜 表白 衬 袈 装载 褪 西北西 西原 西斯 西施 西条 要好 覃 覇 覝 覧 规避 角膜 解决办法 解放思想 解救 誓言 読本 読解 讃 讃岐 计数 议事 议程 讲义 讲授 证人 诅咒 译文 诞辰 课文 课时 豪华版
+100 财金 货源 贯 赞美 赞赏 赤羽 赦 走得 走走 赶来 起义 起初 超人气 超前 超音速 越谷 趋向 趣事 足迹 趾 跟上 跧 路程 践 踝 踢球 踵 蹬 蹭 身内 身在 身穿 躬 転写 転落 转动 转变为 转折 轮廓 辨别 边防 过时 迈出 迎合 近世 远大 远景 连败 迷茫 追查 追追 追问 追随 退化 退治 选民 逝去 逞 遁 遏
+100 遐 遗体 遗留 邂 郑和 部外 郭晶晶 鄙 配付 酎 酒田 酷似 采暖 采样 采矿 里子 重任 重版 重生 重装 重金属 野兽 金光 金平 金杯 金牛 金牛座 金融界 金银 釢 錀 錧 鎯 针灸 钓 钞 钱币 铂 镶 闀 闭上 问了 闹钟 防御力 阳性 阿呆 陂 附中 陡 隡 隧 隹 雄大 集资 雇佣 雏 雨中 雨量 雪崩 雪青
+100 零食 霉 露点 露面 霸主 靘 靛 非公 靡 面粉 面纱 靳 靶 鞎 韆 頧 顺畅 预料 领带 飞龙 食塩 餐桌 饱 首日 香奈 香香 马德里 马拉 马桶 马车 驹 骆 骑兵 骨董 骨髄 骸 髄 高举 高二 高円寺 高千穂 高台 高架 高津 高涨 高砂 高裁 高音 髻 鬟 魔法师 黄梅 黄瓜 黄石 黒髪 黹 鼻水 龙珠
+101 一分钱 一回事 一国 一年生 一成 一戸 一抹 一星期 一本书 一案 一步步 一球 一致性 一艘 一足 一通 七人 七百 三五 三倍 三分之二 三口 三度 三江 三色 上交 上尾 上层 上期 上街 上车 下功夫 下巻 下课 下駄 不俗 不值得 不加 不参加 不善 不太好 不平 不把 不振 不気味 不爽 不留 不老 不行了 不计 不负 不走 丐 东华 东欧 东阳 両替 丧 中尾 中段
+101 中津 中空 中继 中金 临汾 主事 主将 主査 主管人员 举例 乂 久米 乐意 乙醇 九成 也许会 乡亲 买单 乳酸 亀山 亀田 予知 争执 二条 云龙 五十六 五名 五彩 五次 五段 五项 亚太地区 交涉 交锋 产妇 亲吻 亲手 人去 人民大会堂 人群中 今冬 今宵 今村 从一 从去年 从头 仏像 他妈的 仝 以北 以南 以求 仰天 价款 任教 伅 伊始 伊甸园 伊那 会席
+101 伪装 伸展 体位 体裁 余暇 作坊 你爱 佣金 佳美 使命感 例文 供用 侵占 保值 保利 保镖 俟 修道院 修饰 倒下 借地 借贷 假定 偏向 偏好 偏高 停留所 储量 催促 催化 催生 僵 僵尸 儚 儴 元帅 先天 先知 光亮 光棍 光良 克勤 免得 全长 公款 公爵 六人 六千 共益 共舞 关键时刻 关门 兴旺 兵法 内政 内膜 内需 円形 再做 再婚
+101 再掲 冒充 冒出 写成 军情 军训 冬期 冬眠 冬至 冰山 冰川 冲洗 冶炼 冷暖房 冷汗 凌辱 凡事 凧 凪 出奇 出家 出社 击中 刀片 刃物 分期付款 分立 刑警 划定 划算 则为 初戦 初歩 初衷 利于 别再 别致 到时 刺绣 刻苦 剌 前川 前払 前程 前立腺 剥离 剪影 剪纸 割増 加固 加西 动荡 助攻 労使 労力 効用 势必 勣 勯 勾结
+101 包子 匈 化验 北极 匚 匝 区立 医护 十几个 十面埋伏 千方百计 千米 升学 半端 卖方 南充 南宫 印染 危及 即为 即座 厌恶 厚道 原产地 原付 原名 原地 原子能 原是 原音 厳格 厳禁 去向 及早 双鱼座 反撃 反法西斯 反过来 反驳 发誓 取手 取水 受害 受害人 受灾 受阻 变种 变速 叟 口感 口罩 口译 古墓 古文 古畑 叫停 叫好 可与 可哀想 可恶
+101 可比 台下 台中人 右京 右足 司徒 吃出 吃喝 各界人士 各色 合伙人 合奏 合掌 吉普 吉沢 名乗 后门 向北 向来 吟味 吩 听我 听话 听起来 吸水 吸附 吻合 呆呆 呆在 告诫 呜呜 呻吟 咚 咭 哑 哲理 唇膏 唐僧 唐津 唐突 唱的 商丘 喂养 喝茶 喝道 嗕 嗡 嘀 嘉定 器用 噩 噭 噺 嚆 嚜 四合院 四天 四重奏 四面 回信
+101 回廊 回扣 国分 国歌 国策 圆明园 土木工程 地内 地学 坏事 坠毁 型肝炎 垚 城墙 城邦 埜 堀川 増幅 増量 增产 增设 增量 士郎 声响 声色 壱岐 处女座 复审 复查 复核 外加 外向 外在 多半 多国 多田 多量 多面 夜曲 大三 大丸 大便 大修 大坝 大堂 大宗 大巴 大意 大显 大步 大炮 大船 大蒜 天主 天亮 天仙 天心 天性 天海 天窗
+101 太快 夭 失真 头顶 奇兵 奇趣 奔波 奖牌 女児 女兵 女学生 女房 好心 好物 如遇 妖娆 妖艳 委嘱 姩 娱乐场所 婢 嫉 嫌悪 嫌犯 嬭 字形 存量 孟子 孢 学制 学府 学法 学派 孽 完熟 官邸 定于 定金 宛如 宜兴 宜宾 宜春 宝玉 実名 宣誓 室町 宥 宫殿 家门 容貌 宽敞 寄托 寄生虫 寄稿 富康 寒冬 寒波 寲 导出 対局
+101 寿险 専属 射线 将至 小兵 小原 小国 小坂 小声 小心翼翼 小数点 小新 小明 小杉 小野寺 小金井 少子 少将 少林寺 尚可 就到 尼日利亚 尼泊尔 尿素 尿道 屈辱 屠杀 山河 山里 山顶 岔 岛屿 岩本 岸本 崇明 崠 崽 嵂 川田 巡航 巡视 工科 左京 左折 差错 巿 市容 布希 布料 布衣 席位 常任理事国 干支 干活 年少 幸雄 广义 广安 庄严 床下
+101 庐 库尔 应变 应邀 底座 店家 废除 座布団 廉価 延误 开场 开水 弋 引出 引力 引渡 弟兄 张开 强悍 强硬 弼 归还 当众 彗 影展 彻 往前 往生 征用 很像 徒弟 徙 御用 徳山 德里 徽章 必携 必着 必至 忖 忱 念佛 念珠 忻 怙 思想家 急募 恐怖片 恣 恩恵 恩田 恭祝 悎 患上 悪口 悪意 悪用 悪者 悲痛 悼念
+101 情事 情爱 想唱 想象力 愅 意境 慰労 慷慨 憡 懊 懋 懐石 戌年 戍 我愿意 戒毒 或许是 战舰 战车 戣 房里 扇子 手口 手帖 手札 才好 才艺 扑克 打交道 打好 打席 打扰 打量 扣押 批次 技师 投资收益 抗生 折旧 抜本 护航 报废 报案 抱住 押印 抽样 拆解 拉拉 拍子 拓哉 拖延 拦 指在 挑剔 振奋 捌 捕手 换取 捧场 捩
+101 据称 捶 掖 掘削 探戈 探望 接纳 接连 推测 掩饰 掬 提要 插图 插曲 揭幕 搅 搅拌 搬出 搬运 摄氏 摇摆 撩 擃 擢 攀登 支庁 支度 收件人 收缴 改称 攻击力 攻城 放牧 放电 放眼 放纵 放进 政局 政治协商 政界 故人 教徒 教皇 教育界 敬之 敬老 数线 敲定 文山 文稿 料亭 新店 新撰 新横浜 新界 方程 无缝 日航 早坂 早苗
+101 时节 时速 明朗 星川 星系 春夏秋冬 春日部 春色 春菜 春雨 晄 晋江 晚宴 晚间 景气 智者 晾 暗恋 暴跌 曜子 曲艺 更低 最优 最古 最善 最贵 月票 有余 有助 有数 有明 服役 朝向 朝霞 期初 木目 未遂 末広 末永 本多 本来是 朮 朴素 机能 杀菌 杂交 李铁 村子 村镇 条形 来个 来往 来得及 枃 构思 构想 林家 林立 果子 果断
+101 枫叶 柏木 柒 柞 查到 查封 标价 栩 栭 核兵器 根元 根岸 格里 桂冠 桄 桜子 桜木町 梅干 梧州 梧桐 梨花 梳理 检举 检讨 棩 植物性 植生 植田 楀 楂 楼房 概不 概念的 榆林 槇 樔 樼 次序 欢呼 歓 正值 正常化 正要 正道 此処 步兵 武夷山 死不 歼 殖民 每每 每隔 比亚 毛毛 毽 气愤 気功 氧气 水壶 水手
+101 水泵 水瓶 水里 汉中 汛 江宁 江津 江浙 沉淀 沊 沓 沢口 沥青 沸腾 沼田 法典 法科大学 泗 泥土 泸州 洒落 洗手 洗浴 洗脑 洙 洞穴 活得 活性炭 洼 流光 浙大 浜名湖 浪花 浴缸 海口市 海部 海里 浸水 浸泡 涉足 淌 淘气 淤 淮安 深呼吸 深川 深造 淼 清澈 渗 渤 温泉郷 港町 游乐 湄 湣 満室 満席 源氏 溟
+101 溶剂 溽 滀 滥 漆器 演奏者 漫天 漱石 潃 澹 激战 激突 濇 濈 濋 瀳 火柴 灯火 灰皿 灵动 炕 炫耀 炵 点缀 烽火 然后就 煖 照合 熟睡 熹 爆裂 爬上 片隅 牛市 物色 牲 牵引 特権 特此 特里 犯行 犵 犹太 狂言 狎 独一无二 独白 狮子座 猛然 猜猜 献上 献策 玥 玩了 玩玩 现存 珈 珍味 理恵 琮
+101 瑠璃 瓙 瓠 瓢 生技 由你 男児 男孩子 男爵 画展 界隈 畦 畾 疏忽 疏散 疙瘩 疫病 病弱 痣 瘀 瘫痪 癜 白白 百二 百色 皋 皮脂 盈余 盛夏 盟友 目印 盲人 直営店 相撞 相模 真下 真想 真美 眼圈 眼影 着想 睍 睡了 睨 瞄 瞅 瞥 瞧瞧 知子 知覚 短文 石器 石巻 石狩 矼 矿物 砂浜 砠 破获 硬式
+101 碇 磷酸 示意 示波器 神学 神経痛 神道 福原 禐 秋水 科威特 秘鲁 租税 秦始皇 秧 积压 移入 稀少 稀有 稲垣 稲荷 稿酬 空虚 穿的 突变 窈窕 窜 窢 立会 立春 站立 竟然是 竣 笋 笑得 笑脸 第五次 筒井 简明 箕 箕面 管材 篁 篆刻 篇幅 篇章 篝 篷 米酒 粉雪 糅 系主任 紒 素肌 紧缩 絖 綍 続行 総体 総称
+101 繁多 繣 纁 纯正 纽带 线性 经历过 经受 经验谈 统统 绠 绩 绫 绯 维也纳 缓冲 编的 缩写 缪 罐头 罢工 置身 罹患 羊皮 羚 群岛 羲 羵 老朋友 老王 而无 而生 而起 而过 耐磨 耳塞 联营 聚餐 肃 肇事 肉眼 肌着 股东会议 胎児 胙 胜地 胡子 胥 胴体 能代 脉搏 脊椎 脊髄 脱臭 脱退 腐食 腥 腰部 腱 腹泻
+101 腹腔 膀 膊 膵 自卑 自古 自如 自强 自救 自然人 自述 至福 致力 致敬 舀 舞子 航道 舫 舷 良子 良种 芦荟 芭蕉 花咲 花心 花瓣 花田 花色 花落 苍蝇 苞 若年 苦苦 荒唐 荔枝 荷花 获准 菊川 菜肴 菲亚特 萃取 萄 萌芽 萍乡 营救 葛西 葬式 葵花 葹 葺 蒸发 蔚蓝 蔽 蕃薯藤 蕴 薬事 薰香 藤野 蚌埠 蛁
+101 蛊 蛸 蜜月 蝑 蝓 蝙 蝞 蝬 融通 螯 蟇 蠡 血小板 血栓 血案 血色 血行 衙 表札 被写体 被子 袷 裟 褚 褬 西元 西服 西海岸 西町 西站 西红柿 覚醒 解読 触发 読了 警察局 警车 认清 议定书 讲课 设法 评判 诉求 诏 话筒 该死 诸侯 谁有 调情 调理 调配 调频 谍 谢绝 豊川 豪快 豪雨 豺 賛助 财源滚滚
+101 财神 贪婪 贬值 赌场 赛马 赞扬 赤松 走遍 超速 越冬 趣向 趣闻 跑去 路桥 路灯 路透社 跳出 踏实 踟 蹊 蹐 蹝 身形 身躯 転生 輋 车位 车库 车轮 转播 轮流 轰炸 输了 辛酸 边框 辽阳 达尔 过失 过往 近海 连同 迟迟 迷彩 追伸 追赶 退休金 退去 退票 逆境 逍 透天 逗子 通期 通気 通缉 速成 造反 造影 逡 逼迫
+101 遑 道人 道员 道楽 遗迹 遮光 避妊 郑重 郛 郜 都好 都民 鄄 配属 配料 酐 酣 酱油 醍醐味 里程碑 重振 重播 重演 野中 野市 野猪 金券 金石 鈱 鉱 錞 錬 鎌田 鎭 钢板 钾 铁打的 铣 银杏 镶嵌 长乐 长相 长短 閰 闫 阁下 防卫 防潮 防音 阳朔 阿拉法特 附加值 附注 陆地 陋 降下 降雪 除草 陲 隈
+101 随手 雀巢 雅彦 雑音 雪佛兰 雪白 雷射 青铜 青龙 面值 面谈 音速 頞 顾虑 预示 领会 领略 颈椎 颤 风力 风气 食中毒 饥饿 饼干 香菇 馥 马力 马甲 骂人 骆驼 骑马 骚 骨骼 骨髓 高井 高卒 高周波 高学年 高昂 高楼 高瀬 髦 髫 鲨鱼 鳄鱼 鴈 鸳鸯 黄色电影 黄龙 黏 黑眼睛 黒崎 黒色 鼓掌 鼻血 龙泉
+102 一五 一如 一安心 一帯 一年半 一排 一早 一晚 一段落 一缕 一途 一闪 一齐 丁香 七色 万字 三万 三区 三十路 三合一 三品 三房 三番 三相 三部曲 三面 上巻 上所 上智 下册 下午茶 下巴 下期 下表 不休 不健康 不公 不凡 不力 不可多得 不可抗力 不妥 不屑 不拘 不改 不注意 不眠 不祥事 不负责任 不退 不都合 丑陋 专心 专案 专长 世尊 业者 両社 两厅 中南海
+102 中印 中意 中目黒 中美关系 中餐 中高生 丸亀 丹阳 主因 主妇 主旋律 主権 久慈 久治 之举 乐曲 乐章 乗用 乘机 乙烯 九章 乞讨 也会有 乳癌 争吵 二个 二子 二胡 互换 五万 五分钟 五味 五洲 五角 亜希 亜希子 亟 亡国 交了 交待 交番 亦即 亳 人寿保险 仅次于 今春 今秋 从小就 从来没 付随 代打 代收 以供 以期 仨 仿宋 伉 伎 优于 会津若松
+102 伜 位元 位址 位子 位相 低级 体格 体能 体臭 何不 何等 作好 你将 佣兵 佯 佳一 佳木斯 佻 佼 使劲 例行 侍郎 侗 供与 侦 便座 便所 俊一 俊介 俊彦 保住 信函 信奉 信条 信通 信道 信阳 修二 修身 修道 倒也 倒闭 候鸟 假装 停业 健太 傲世 僻 儒学 儿科 先到 先去 光栄 光照 光环 光顾 党籍 入校 入江 入睡
+102 全军 全力以赴 全台 全学 全幅 全智 全貌 八万 八角 公売 公牛 六安 六成 兰花 共振 共通点 共鸣 关切 兼具 内径 内海 内野 再也没有 冕 军民 冷战 冷落 几下 几千元 処遇 凰 出个 出众 出轨 刁 分解能 分身 分钱 切忌 切抜 切片 切身利益 刈谷 刑事案件 刑罚 列宁 列席 则会 创伤 创刊 创设 初対面 初旬 到处都 到家 制图 前去 前妻 前排 剜
+102 力不足 功课 加值 加国 加收 加薪 劣势 动词 助教 劫持 勾配 匀 匕 北约 北里 十分钟 十四日 十里 午后 半透明 华东地区 单据 单调 南安 南市 南平 卧龙 却不能 卷入 厌倦 原定 原液 原石 原野 双峰 反光 反向 反差 反革命 収束 受审 受精 变速箱 变频 叙利亚 叛逆 古迹 古龙 叫声 可到 可就 可悲 可想而知 可看 台语 史诗 号令 吃亏 吃掉 各路
+102 合弁 合板 合気道 合营 同和 同点 名利 名山 名流 后市 后排 吐出 吐血 向井 向右 向后 吞吐量 含蓄 吸血 呐 周公 周密 周转 呬 呯 味精 呻 命中率 咀嚼 咆哮 和美 和香 咕 咖 咽喉 咿 哲夫 哸 哺 唳 唴 唾液 啁 啗 啜 喃 喜讯 喟 喳 嗒 嗓子 嗖 嗥 嗦 嗲 嗷 嘕 嘟 嘶 噤
+102 噩梦 噰 噳 嚏 囗 四分之一 四年级 四期 四球 回分 围攻 固化 固形 国名 国営 图画 圆满结束 圜 土器 土地改良 土岐 土肥 圧巻 在留 圩 圭吾 地価 圻 坂道 坎坷 坏人 坚固 坚守 坦然 坯 垂水 垒 垛 埋蔵 城中 城山 堙 境遇 増収 増益 増税 士官 夃 変容 変貌 夎 外婆 外注 外用 多加 多变 多头 多说 夜市 大乘
+102 大佐 大典 大判 大友 大叔 大哥大 大大小小 大宝 大林 大滝 大社 大神 大麻 天命 天皇杯 天秤 天花板 天道 太一 太古 太后 夫子 失意 失禁 头盔 夸大 夹克 奀 奄 奇才 奈美 奋 奋力 奔放 奔走 奠基 女工 女方 女生宿舍 奶油 奶茶 她想 好些 好帮手 好日子 如上所示 如月 如期 妇人 妊 妒 妖魔 妗 妺 娄 娑 婄 婚宴 婷婷 子守
+102 孔明 孕期 字眼 存有 存活 孙悟空 孛 学友 学外 学好 学家 宁可 宇宙船 守望 安然 安眠 完治 完走 宗教信仰 官场 定形 宛名 实证 审讯 客体 宣武 宰相 家务 家家 容姿 寄附 寐 寝不足 寺庙 寻觅 対称 封口 小中学生 小室 小嶋 小梅 小浜 小百合 小草 小谷 小车 小龙 尘埃 尚书 尚子 就拿 就近 尻尾 尾声 居多 屈折 屈指 届满 展品 展销
+102 屠宰 山坡 山根 山海 山王 岷 峇 崛 嵌入 巅峰 巌 川菜 巡演 工科大学 左足 巧合 差出人 巴巴 巴特 巻末 市井 市新 布雷 帆布 师尊 希尔 帑 席卷 常务理事 常在 幄 干潟 干物 干线 平安夜 平家 平松 年俸 年头 年率 幼少 広州 庄子 庆幸 废话 庣 庾 廓 延滞 开战 开枪 弁理 异形 弊害 张力 张家口 张掖 弥漫 弾性 当月
+102 当番 当行 彝 彝族 形体 彩排 影本 往外 得利 御所 御社 微电子 徳永 忒 忕 忘不了 思惑 思绪 怪奇 恋曲 恍惚 恒久 恒温 恚 悄悄地 悌 悠闲 悦子 悬疑 悲观 惊险 惟有 惣 惬意 惯 愕然 感受性 愤 愧 憳 憺 懦 成千上万 成因 成美 我妈 战火 房総 房门 所存 才子 打仗 打扫 打招呼 打歌 打通 托运 执照 找人 技艺
+102 把关 抑止 抗旱 抗衡 抗震 抚摸 抛光 披肩 抬高 抽签 拈 拉链 招商局 招式 拳法 持田 按住 按期 挑発 挑错 挡住 捐献 损益 损耗 捱 捷径 掉落 探亲 接替 接管 掷 掺 提拔 插头 揖 揩 搏斗 摆设 摧 摩尔 撃退 撤出 撤廃 撤消 撬 播种 撰文 支点 收了 收货人 收音 改写 改用 政委 效用 救救 敛 散装 敦促 文华
+102 文字幕 文春 文选 斑点 斛 断食 斯通 新党 新安 新居浜 方形 方才 方略 施主 施用 旅先 旗舰店 无助 无害 无常 既然是 既能 日内瓦 日暮里 日生 日田 日货 旧金山 旨味 早熟 时下 时效 时而 旻 明信片 明和 明晰 易学 昜 昨天晚上 昶 晨星 普遍性 晰 晶子 晶晶 暴涨 曚 曲面 曾经有 最不 月历 月影 有幸 有病 朗读 朝夕 朝比奈 朝河 木业
+102 木板 木瓜 木质 未完 未明 本土化 本堂 本庄 本港 本溪 本职 本邦 朵朵 李登辉 村井 村人 杜威 条西 来华 来历 松崎 构件 枉 林子 架子 柁 某所 柑橘 柟 柢 查核 查获 柬 柳川 柳田 树叶 栗山 校方 栦 株数 核桃 根付 根底 格付 桃井 桅 桌椅 桑原 桑田 桔梗 桜木 梃 梅林 梗塞 梦境 梦见 梨子 梶原 椈 植被
+102 椎茸 検体 椰子 楁 楅 楕円 楥 楯 概観 榨 榴 槻 横手 橀 檐 櫅 欧式 歌喉 歓声 正人 正味 正和 正方形 歧 歪曲 歯科医 殃 毅力 母性 毎晩 每逢 毒物 比一比 比不上 毗 毛糸 毛髪 民芸 气功 気管 水力 水北 水印 水城 水星 水池 水生 永住 永和 永嘉 永生 汉口 汉英辞典 汐留 江川 沉没 沉迷 沙耶 没说 沧海
+102 沮丧 油圧 沿江 法子 法术 法理 波士顿 波澜 洋介 洋房 流行歌曲 流行病 浪速 海德 海河 海燕 海老名 海贼 海野 涌现 涤纶 涪 涪陵 涸 淡季 淮河 淮海 深切 深感 深水 深沉 淳一 淳子 淹没 添削 清偿 清查 渔船 渡口 渡瀬 港币 渲 渶 游玩 湀 湅 湇 湔 湘江 湜 湿润 満州 溅 溢出 溶剤 滆 滉 滝川 漂泊 漏水
+102 演义 演说 漪 潇 潜伏 潮水 潮湿 激增 濊 濛 火烧 火腿 炴 烛 烟云 烟火 烟雾 烯 烷 焚烧 焦急 煡 煦 熀 熄 熊熊 燧 爆出 爰 爱在 片长 版権 牒 牛角 特务 特邀 犁 狂気 猷 玄机 王府 王府井 玛瑙 玩意 玩耍 现时 珍妮 珑 珒 珛 珩 班上 理会 理工学院 理所当然 理沙 琥 瑗 璟 璺
+102 瓦解 甔 甘草 生化学 生花 甭 由佳 甲板 电报 电量 电镀 男女平等 男用 留存 番台 疄 疲倦 疵 病症 痛哭 痛恨 痴情 瘁 瘣 瘥 瘰 癫 発病 発育 発色 白泉 白糖 白髪 百川 百里 皜 皮皮 盂 盈盈 监制 监听 监护 目白 直下 直美 直言 相乗 相亲 相生 相田 相貌 相邻 省会 省力 看中 県営 真冬 真琴 真菌 眠気
+102 眯 眼霜 着实 睛 睡袋 督察 睫 睾丸 瞟 矩形 短裤 石山 石灰 石碑 矿井 砂防 研修班 研修生 砧 砰 砷 砺 碾 磐梯 磨损 磬 礵 礼包 神保町 神威 神山 神明 神童 祫 祭奠 福永 福生 禺 离奇 秀夫 秀逸 私鉄 秘伝 租借 秣 积蓄 移到 移管 稍稍 稳妥 稽核 穹 空地 穿透 突如 立式 竑 站台 竭力 竹子
+102 竹山 笅 笈 笊 笓 笔下 笠木 等身 筑摩 箑 篆 篠山 篦 篱 簨 簰 米勒 米粉 粨 粪 粯 粽 糜 紑 縁起 縦横 繘 纠 红了 红茶 约旦 纳粹 绅 织物 结实 结成 绪 续集 绵 绸 缔造 罍 罏 罔 罗伯特 罹 美保 美奈 美少年 美智子 美浜 美英 美誉 美酒 群落 羸 翦 翰林 翻天 翻新
+102 老先生 老夫 老汉 老者 耆 联席会议 肝硬化 肩上 育苗 肾炎 胃癌 胆固醇 背筋 胡萝卜 胰 胶带 脑子里 脖 脘 脚印 腋 腌 腓 腩 腮 腰椎 膙 臓 自传 自粛 臭氧 致词 舅 舍得 舞踏 船长 节庆 芠 芫 芬芳 芭蕾 花语 苒 若能 苦味 苫 英勇 英尺 英汉 英里 茂木 茫 茴 荫 莞 莫非 萨斯 葔 葭 葳
+102 蓁 蓝宝石 蔘 蔵元 薄荷 薪金 薬草 藤枝 虔 虚伪 虚幻 蛭 蝝 蝢 蝯 螖 螚 螺丝 行楽 行贿 衔 衣着 补救 表皮 表象 袂 袟 裕美 褥 褶 西京 西南地区 西式 西西 西郷 要不然 要紧 见效 觉悟 解任 解法 触动 譗 警钟 订婚 讲到 讲堂 许愿 许昌 诊治 诌 诱导 请假 请示 诸位 谁会 调酒 谈不上 谊 谋划
+102 谦虚 谷村 谷町 谿 象牙 豸 貉 貔 貘 賛否 賮 贝壳 贩 贩卖 贩子 贮 贮存 赎回 赢了 赤穂 赤道 走出来 起作用 赽 超声 超大型 越过 足首 趴在 跆拳道 跐 跑道 跟在 跟进 路易 跳动 踔 踜 蹂 身心健康 躯体 车速 车道 轻声 辈 辈子 输给 辞去 辰巳 迂 过量 迎战 运河 近接 近道 还为 还真是 这下 进食 连结
+102 迷宫 迷恋 追寻 追忆 追放 退任 退房 退社 送去 逃生 逃脱 递增 通化 造福 造血 遅滞 遍及 遒 道夫 遛 遵照 遽 郁金香 郭富城 都城 都比 都由 都知事 都筑 鄢 配制 配角 酒后 酒泉 酸奶 酿 醚 醛 里亚 重厚 重回 重温 野党 野原 野沢 野獣 金井 金像奖 金田一 金管 金花 鉄板 鋆 鋿 鍧 鐇 鐏 鑸 钗 钞票
+102 钱财 铁矿石 铅笔 铎 铲 锐志 键入 镖 长官 閤 闅 闪耀 问到 闯入 间隙 防震 阳痿 阻塞 附上 陟 院生 险些 陶磁器 隤 隰 隶属 隼人 隽 难民 难看 雅之 集大成 集聚 雑多 雕像 雪国 雪景色 雷电 雷霆 震源 霰 霸道 霹 霾 靉 青春痘 青菜 静寂 非正式 靠在 靠自己 面容 韏 韔 韬 音楽家 顺应 预付 领奖 领悟
+102 领队 额头 颠 风靡 飞快 飞鸟 饱满 首播 香气 駄文 駅弁 马达 驳回 驾驭 骗取 骤 骨科 高产 髢 魔物 鲜艳 鳄 鳞 鸟类 鸭子 鸽子 鹦鹉 黎巴嫩 黒板 黒部 黯 鼓吹 鼬 龙岩
+103 一串 一人前 一元化 一区 一平 一战 一扫 一拍 一目了然 一筋 一転 一青 丁丁 七一 七七 七五三 七千 七尾 万点 三一 三七 三次元 三笠 上地 上浮 上着 上身 下半 下半期 下台 下垂 下校 不信任 不倒 不健全 不及格 不器用 不得已 不意 不打 不掉 不提 不燃 不特定 不甘 不由自主 不知所措 不耐烦 不胜 不自觉 专属 专政 両端 丢掉 两头 两百 两队 严查 严防 个子
+103 中亚 中尉 中年人 中指 中沢 中津川 中游 中草药 中西部 临安 丹羽 为爱 主委 主攻 主教 久美 义工 乌兰 乌鸦 乗船 九百 乡下 书包 乩 乱世 乱暴 乱舞 乳制品 乳品 争点 争鸣 事事 二元 二区 二次元 二路 二部 于是就 互惠 井冈山 井田 亡命 交割 京沪 亲热 人因 人头 人気者 人潮 仕切 仞 代入 代物 以示 任性 仿古 会费 伝道 伟人 传单
+103 传给 伤了 伤势 伸缩 低位 低到 住家 体形 佛像 佳子 佶 侏 侘 供不应求 供述 依依 侵蚀 便乗 便有 便能 俊男 俎 俏皮 俑 俘 俘虏 俚 保加利亚 保荐 修一 俸 倒了 倒塌 倒退 借家 借款人 借此 偏离 偏见 停用 停顿 偣 健身房 傕 傻子 僖 儋 元老 元首 先用 先看 先駆 光头 克制 免去 党派 入主 全靠 全高 八章
+103 八路军 公公 公序良俗 公益事业 公私 六章 共栄 共约 关照 兴办 兴隆 内战 内村 再不 再考 写过 冥福 冬瓜 冰河 冰点 冲着 冲锋 冷清 冷藏 净土 减缓 処刑 凶猛 出光 出兵 出撃 出清 分机 分泌物 切成 切记 列伝 列挙 刘备 初任 初夜 初春 初等 刺史 刺激性 刺青 前兆 前瞻性 剣士 力推 功労 功臣 加加 加奈 加奈子 加山 动情 劳模 劾 勤劳
+103 勤勉 勾引 匆 匆忙 匈奴 匕首 北半球 北宋 北岸 北平 北洋 北辰 匾 十二生肖 十人 十位 十倍 午饭 半晌 半期 半点 半熟 华北地区 华南地区 单向 南北朝 南大路 南宋 南沙 南波 南青山 博多駅 占星 卫冕 卫星电视 卮 印证 即位 即売 卵子 卵巣 卵黄 厄介 历任 压岁钱 厖 厚厚的 厚重 原酒 友邦 友里 双胞胎 反倒 反戦 发呆 发展潜力 叔父 受益人 变幻 变色
+103 变质 叙事 口交 口内 古谷 只做 只要能 只限 叭 可变 可喜 可有 可言 可贵 史记 史郎 右派 司令官 叼 吃苦 各所 各省市 合乎 吉宗 吉隆坡 同化 同市 同色 吞噬 吞食 否决 吭 呗 呱 呴 呷 呼噜 咄 和文 和装 和訳 咥 咻 哂 哈哈大笑 哑巴 哧 哲郎 哼哼 售完 商船 啐 啖 啡 啾 喀什 善待 善用 喝彩 嗄
+103 嗑 嗓音 嗛 嗟 嘲 噌 噗 噷 嚚 囚犯 四家 四射 四平 四星 回答说 回音 因地制宜 囡 团聚 围城 围墙 围绕着 固执 国政 国营 图版 在眼前 在读 圪 地検 地貌 圳 坂上 均已 坟墓 坠落 坦白 坨 坩 埂 埋怨 城下町 城主 城外 城崎 域内 基点 基石 堀田 堡垒 堣 塙 塩基 塩田 塩谷 填表 墓石 墨尔本 墬 墺
+103 壒 壤 売価 変色 外教 外环 多元的 多得 多边 夜半 大功率 大喊 大四 大安 大沼 大洋洲 大洲 大浦 大清 大漠 大町 大病 大白 大祭 大粒 大约有 天保 天台 天明 天池 天花 太仓 太好 太守 太监 太长 失明 失调 奇人 奠 奥山 女婿 女巫 女流 女用 女系 好呀 好文 好比 妇产科 妙用 妧 妨 妳的 妹子 姏 姐弟 委内瑞拉 姞 姳
+103 姶 威信 娘子 娠 娾 婀 婆媳 婵 嬨 嬮 孑 学前教育 学区 孩童 孺 守信 守候 守口 安溪 宒 宗像 宗派 官民 定罪 实话 実地 宠爱 宣读 家内 容积 宽广 宽阔 寄给 富于 寒気 寒风 寓意 寘 寝台 寝坊 寝屋川 寥 对准 对台 对奖 対等 封神榜 将给 将领 尊厳 小伙 小刀 小切手 小型化 小手 小指 小早川 小玉 小米 小腹
+103 小腿 小铺 小青 少不了 少先队 尤物 尰 就职 就餐 尾瀬 尿酸 局限性 层出不穷 居所 居留 居高不下 屋子里 屏障 山中湖 山岸 山峰 山手 山武 山洞 山猫 山科 山脉 山行 岂不 岂不是 岩佐 岩瀬 岸田 峙 峭 崁 崋 崚 崧 嶉 川嶋 川辺 工分 工研院 左派 巨石 已到 巴尔 巴林 巴赫 市价 市北 布拉格 师长 希薄 常住 干事 干掉 平均值 平川
+103 平息 平整 平生 平阳 并举 幽幽 広末 広角 庄司 床位 序章 底片 废墟 废弃 度量 庨 廅 延伸到 建制 开平 弄虚作假 弓道 引诱 引述 弦楽 弱小 强壮 强盗 当选为 录取分数 彖 彩乃 役人 往路 很短 徒然草 御免 御徒町 御殿 徨 微粒子 微量元素 心拍 心智 心肌 心肺 心霊 必然性 忏悔 快到 快去 忿 怒江 怒涛 怦 性器 总和 总能 恁 恃
+103 恩怨 恶作剧 悕 悠然 悪臭 悬崖 情妇 情操 惊醒 惘 惨败 惭愧 想出 意念 愓 愮 憔悴 懒得 戈尔 成城 成文 成瀬 成行 戛 截然不同 戮 戾 房客 手势 手提包 打上 打人 打进 扭矩 扶手 批判的 抑或 抓到 抓起 投函 投射 折合 折算 报仇 抵挡 抽屉 拇 拉住 拍出 拍到 招待所 招致 拠 拧 括弧 拶 拿去 拿破仑 拿走 持株
+103 指圧 指派 挈 挑衅 挑起 挙句 挥手 振幅 挿絵 捕获 换句话说 掑 排尿 排骨 掠夺 探访 掣 接任 接手 接见 接触到 推敲 推断 推算 掩护 揍 提成 提货 握住 搔 搜捕 搭载 摇了 摘录 摵 撃破 撱 擦拭 攘 支流 收据 收视率 改了 改悪 改组 放射能 救人 教书 教养 教団 教改 教父 敦子 数子 文子 文成 文昌 文言 文雄 文面
+103 斗志 斟 斯克 斯托 新意 新招 新生代 新近 旁人 无为 无底 无视 早有 星云 昨天上午 昵 晓得 晛 晨曦 暀 暗杀 暗自 暗藏 暟 暲 暴力団 暹 更衣 最下位 最怕 有位 有声有色 有心 有效率 有时会 有権者 有此 有礼 朋子 朋美 朝野 木偶 木屋 木炭 木雕 未読 本尊 本意 本県 本系 朱雀 机车 杂技 杉村 李家 杓 杠杆 来季 杪 杰作
+103 杳 松平 松林 极好 林地 林肯 果品 果然是 枯燥 枳 枷 柔性 柩 柮 柴犬 栗田 栞 校歌 校舍 栨 核能 根基 根本性 桃花源 案情 梅毒 梶 棋手 棌 棨 棬 森村 森町 植林 植物油 椸 椽 楣 楹 楽屋 榻 槄 槇原 槎 様相 樋 横扫 樿 檄 檗 檺 欠如 欠款 次号 欢笑 欧亚 欬 欹 步枪 武豊
+103 武部 歹 死角 殖民地 毁了 毅然 母女 每晚 毒药 毗邻 毘 氐 民乐 民情 民谣 气门 気仙沼 気体 気管支 水下 水份 水墨 水害 水彩画 水母 水玉 水稲 氷川 永年 求才 求爱 汇票 汚水 汜 江原 江藤 江青 池塘 污染源 汲取 沈没 沉浸在 沙子 沙河 沙田 沱 河川敷 沼气 沿用 泄密 泉町 法例 波段 注力 注视 泰坦 泰斗 泸 泾 洁白
+103 洋酒 洗脳 洞察 洸 活了 活化 派兵 洿 流利 流山 流逝 流量表 流露出 浑 浘 浦江 浩司 浬 浮现 浮躁 浮雕 海岛 海水浴 海浜 海盐 海绵 海螺 海难 涂抹 消印 涗 涤 涧 涬 淆 淞 淦 淩 深渊 深知 混同 清末 清河 清泉 清淡 清白 清秀 清酒 渊源 渔民 港人 港北 游走 渺 湖面 湿原 溥 滂 滋养 滋生
+103 滑坡 滑翔 滑落 滔 满怀 漉 演奏家 潞 澸 瀼 火线 火车时刻表 灭亡 灯红酒绿 灾民 炭化 炮弹 热忱 烹调 焯 焼津 煎茶 照亮 照度 煨 熟人 燃料油 燊 燕山 爆撃 爱过 片桐 版税 牙刷 牧场 牧民 牧草 物力 物理系 牲畜 特制 特攻 特番 特种部队 犬山 狂潮 狛江 狡猾 狩猟 独创 独学 猛虎 猛龙 猟 猪头 猪木 玄人 玄关 玄武 王冠
+103 玟 玩弄 玩得 珅 珊瑚虫 珥 琅 理化 琨 琬 琰 璀 璈 璨 瓦版 甘口 生保 生出 生子 生怕 生放送 生田 用不着 田畑 电极 电能 电解 男前 畋 留保 留置 留美 留萌 畚 畯 疋 疫区 疫学 痂 病室 病害 病死 病状 病菌 病虫害 病逝 痢 瘉 瘝 瘯 発令 登台 白沙 白米 白虎 白骨 百分之百 百战 皆是 皇子
+103 皇宫 皮包 皵 盅 盆景 盐酸 盔 盗用 盛开 目击 直列 直奔 直航 相等 相通 省城 眄 眉头 看不起 看了又看 看守 看得见 看清楚 県南 真挚 真澄 真真 真空管 眼见 眼角 着地 着陆 睡在 睡着了 睫毛膏 睼 睾 瞒 矗 矜 矢吹 矢沢 矢部 知觉 短所 石门 矿区 砚 砥 破格 破灭 破砕 硌 硝烟 硫化 硬币 碟片 碧水 碱性 碳粉
+103 磁力 磁场 磋 磺 礒 礼子 礼拜 社员 祈求 祐子 祖父母 祜 神农 禁物 福德 福知山 禴 离别 离心 秀一 秀作 秀敏 秀雄 私事 私自 秕 秘境 秘宝 秘蔵 秦野 移譲 稀土 稲本 稳固 稳重 稷 穂高 究明 空手道 空运 突発 突破性 窃盗 窘 窞 站了 竜也 竜王 童心 竦 竹下 竹本 笀 笑傲江湖 笚 笨蛋 笫 第六次 笱 等侯
+103 筑紫 筛 筹措 筹码 箐 算入 箭头 篌 篠田 簪 米原 粉砕 粍 粑 粗暴 粱 精制 精良 糪 糯米 紊乱 素有 索性 紫竹 経口 経度 絣 絵里 繁重 繊 纀 纋 红利 红十字会 红河 红花 约克 纪要 纸箱 绀 终究 经由 结交 结帐 结盟 结石 绝技 绞 绳子 缓和 缠绕 罗兰 罪人 美佳 美沙子 美满 群组 群集 羽球 翩
+103 翳 老兄 老天 老牌 老齢 考官 而为 而今 耗费 耘 耙 耨 耶路撒冷 耻辱 聆 联军 聚乙烯 聚合物 肆虐 肉食 肚皮 肩幅 育英 胃病 胃痛 胆小 胎教 胡耀邦 胰岛素 胸元 胸怀 胼 能干 能看 能说 脑中 脑海 脱力 脸红 腰包 膈 膝盖 膺 臑 自信心 自助餐 自然而然 自虐 自重 至急 臿 舗 舞会 舞弊 舶 舸 船只 船员 艬 艳舞
+103 芣 芭比 花纹 苗栗人 苛刻 苤 若狭 英知 苴 茶室 草根 草野 荏 药剂 荼 莆田 莉莉 莽 菎 菖 菠 菩 菲菲 萁 萋 萨尔 萸 萼 落伍 落水 落网 葡萄糖 葫 葮 蒸発 蓐 蓬勃发展 蔇 蔑 蕀 蕠 蕫 藐 藑 藤川 蘣 蘸 虫子 虱 蚌 蚸 蜇 蜓 蜰 蜻蜓 蝚 蝥 蝴 蝺 融化
+103 融洽 蟋 蟑螂 蟠 衄 街口 衢 表率 衰竭 衲 衾 被覆 裂缝 装卸 装束 裕之 裕二 裸奔 裼 褗 西双版纳 西子 西边 西麻布 要命 要害 觉醒 觡 解惑 触犯 触目惊心 訳者 訾 詨 謦 謰 譑 警卫 讅 计较 让步 讲坛 讲演 论语 诀 诀窍 诉说 诗意 诚恳 请客 诸葛 读了 读本 课外 谁家 调料 谋取 谋杀 谎 豆浆
+103 豆粕 豪情 财务报表 财政预算 货物运输 贫血 贵重 赘 赢取 赤城 赤星 走好 走开 走査 起名 起家 起立 超额 趔 足下 趺 跟前 跠 踱 蹼 転売 转了 转帐 转弯 转机 软弱 轻盈 辊 辜负 迂回 过早 还把 还被 迢 迥 迫不及待 迫切需要 逆向 选票 透支 通向 通宵 通行人 通达 通通 速回 速水 道内 道家 遗嘱 遗漏 邃 邰 邻近 郅
+103 郡上 郢 部族 都做 都庁 鄞 酋 配售 酒会 酒屋 酒杯 酒蔵 酢酸 酥胸 醉酒 里头 里昂 里美 里边 重叠 重合 重病 野人 野崎 野洲 金物 金箔 鉄工 銕 銭形 鋳造 錌 鐖 钀 钢笔 钦州 钨 钻研 铜陵 银牌 铺设 锈 锰 门锁 问卷调查 闲话 闽南 阁楼 阙 阜新 防府 防滑 防臭 阳泉 阿曼 阿里山 陈旧 降落 陏 除名
+103 除夕夜 陶醉 随所 随行 隐秘 雄一 雅致 雍正 雎 雑煮 雨季 零下 雷雨 露骨 青柳 非売品 非得 非线性 非金属 面影 鞣 韅 音乐剧 頝 顺手 顺风 颈部 颠倒 颲 飙 飞雪 食客 食指 食物中毒 食用油 饭菜 饱和 馅 首长 首领 香炉 驭 驳 驻华 驾照 驿 骗术 骚乱 骨灰 骰 高尾 高强 高球 高盛 高超 高高 鬼畜 魍 魔剑 鮟
+103 鳵 鴃 鶦 鷟 鷶 鸥 鹭 麂 麻布十番 黄土 黄帝 黄海 黍 黑手 黯然 龙山
+104 一三 一不小心 一九九九 一亿 一任 一作 一包 一念 一把抓 一改 一文字 一星 一服 一枝 一瞥 一群人 一跳 一连 七宝 七里香 万余元 万圣节 万种 三季 三日月 三村 三板 三枝 三民 三版 三郷 上乘 上半 上古 上告 上校 上站 下京 下図 下宿 下述 下野 不客气 不比 不求 不甘心 不甚 不相 不破 不进 不问 与信 专有 世事 东边 丞相 両足 两旁 丧生 中正路
+104 中退 中里 中锋 丰盛 丱 临海 为何不 主体性 主唱 举止 举重 之十 乏力 乒乓 乓 也把 书信 书本 买一 乱七八糟 乱歩 乱用 乳头 乳白色 亀梨 了三 予期 事变 事实证明 二八 二分 五感 五成 井下 井原 亚丁 亚马逊 亚麻 交出 交织 享年 京浜急行 亲王 亲眼 人品 人文景观 人死 人民元 人马 仁川 今晨 今泉 仏具 付清 仙界 代工 以西 仰望 仲村 伊万里
+104 伊犁 伊能 休眠 会派 伝播 伤痕 伸二 佃煮 位移 住处 佐原 佗 余剰 余震 佣 佫 佳品 佼佼者 使途 供奉 依存度 依存性 侭 侵攻 侵略者 侵袭 便在 保佑 保单 保送 信夫 修剪 修学 俯瞰 俴 倅 倍受 倍增 倏 倒地 倒壊 倒数 倔 借主 借着 倾倒 偃 偌 偍 偏僻 偏爱 偏重 偛 偷袭 傞 傣 僮 僵局 元本 兄弟们
+104 充血 充裕 先例 先河 光绪 光谱 光速 克彦 党首 全家福 全席 全彩 全权 全港 全称 八分 八女 八德 八郎 八重 八重洲 公仆 公判 公孙 公平性 公所 共性 兵役 其为 典故 典籍 内含 内环 内祝 内陆 再过 写生 军力 冥王星 冰冻 冰凉 冰岛 冲破 冲进 冼 凌云 减退 凐 几十个 凡在 凯莉 凶悪 出人意料 出先 出厂价 出庭 出汁 出海 出生地 出生率
+104 分发 分子量 分段 切実 切磋 划分为 初心 初雪 利尻 利根 利根川 别无 制胜 刻字 剑法 剥削 剧团 剪定 剿 功利 功劳 功底 加味 加沙 加里 加长 动听 助役 勒索 勖 勤続 勧 匁 包皮 匍 化率 北面 北韩 十元 十和田 十字路口 十岁 十郎 千手 升到 半数以上 华声 协和 卑鄙 单车 南汇 南蛮 南街 南郷 博亮 博爱 博物 博雅 印有 印税
+104 危急 却要 压倒 厚手 原状 厩舎 参上 参事 友田 双臂 反攻 収蔵 发愁 受聘 变数 古堡 句型 另行通知 叨 只管 只读 召集人 可亲 可口 可燃 可要 台山 台新 台本 史前 右肩 叻 各具 各处 各式各样 各行各业 各駅 合図 同氏 名册 名副其实 名菜 后世 向南 向西 向量 听觉 吮 启程 吱 吾郎 呉服 呜 周期性 呼呼 和洋 和睦 咖啡色 咬牙 哀悼
+104 品等 品茗 品薄 品行 哈密 哈雷 响亮 哮 唏 唧 唹 商学部 商定 商科 商讨 商议 商谈 啵 啻 喉咙 喜多方 喧嚣 嗐 嗓 嘉峪关 嘧 嘱 嘻哈 噎 噘 器皿 噫 噮 噾 嚓 囚人 四万 四六判 四十九 四楼 四轮 回流 回程 回车 囟 因病 团圆 囮 図版 囹 国信 国库 圈圈 圉 土匪 土手 地下城 地底 地方裁判所 地殻
+104 地球人 圹 坍塌 坐上 坐到 坚硬 坷 垃圾桶 垓 埆 埋伏 埋没 城关 城门 域外 埣 埭 基底 堆积 堋 塩味 塩酸 墉 墫 墽 士气 売春 変位 复原 夔 外景 外省 外貌 外道 外野手 夙 多亿 多米 大业 大井町 大仏 大伙 大兵 大坪 大埔 大妈 大娘 大峡谷 大差 大帅 大年初一 大棚 大浪 大熊 大牟田 大鹏 天城 天女 天意 天正
+104 天竺 天蝎 天边 太朗 失事 头晕 奇效 奈何 奋战 女体 女医 女学院 女官 女演员 奻 好美 如火如荼 如花 妇幼保健 妙法 妲 姐夫 委派 姤 娩 娲 娵 婚外 婚外情 婚姻法 媚薬 媲 嫘 嫦 嬚 嬲 孝之 孝子 季后赛 学园 学徒 宁海 宄 守卫 安室 安家 安平 安房 安达 完结 宏大 宓 宕 定住 定率 宝剑 宝座 実印 実数 客船
+104 客轮 宣讲 宦 宴请 宽大 宾客 宿主 寄信 寄宿 寄席 富余 富强 寒流 寛子 察觉 寳 对不对 对峙 寺内 寺尾 寺町 対岸 封面人物 将士 小丑 小夜 小将 小岛 小岩 小川町 小径 小栗 小石 小道 小道具 小野田 小高 小鬼 小鸡 尖峰 尺八 尾牙 尿道炎 屈服 屋形船 屐 属下 属实 山小屋 岨 岸边 崏 嵇 嶋田 嶬 巉 川奈 川本 巡查 巢湖
+104 巫师 巴哈 巾着 市道 布丁 布告 布里 希釈 帰路 帴 常驻 幔 平反 平庸 平房 平手 平湖 平穏 年刊 年配 并从 并发 并回 幸亏 幸田 幻境 幻觉 床垫 序言 应答 底値 府内 废气 庤 康乐 廃墟 廯 延缓 建具 建起 廾 开到 开拍 开班 开端 开销 弁済 引取 张宝 弸 弹头 弹药 弾圧 归于 归国 当做 当务之急 当座 彧 彩券
+104 影后 往常 征兆 征途 待合室 徊 得体 得力 得手 御苑 循序渐进 微电脑 心碎 心筋梗塞 心酸 忘掉 忙于 忝 忠于 快眠 怎会 急切 急着 性趣 怪怪 总的来说 总结经验 恐吓 恝 恣意 恨不得 悆 悇 悜 悪役 悪戯 悸 惆 惊动 惠民 惶 愈演愈烈 愍 愕 感染性 愯 愶 慧眼 慵 憌 憪 憸 懂事 懐中 成佛 成吉思汗 成就感 成真 成虫 成衣
+104 我爸 我还没 戒烟 或多或少 房车 手羽 才会有 才女 才对 打发 打楽器 打率 扣留 抠 抢夺 抨击 抵当 押金 拆卸 拉到 拓也 拘役 招来 拯 挂了 挠 振荡 捍 捎 掌中 排便 排练 掠过 接通 推拿 提了 提灯 提炼 提纲 提防 插上 插花 揭底 揭穿 搜救 搜罗 搞了 搞清楚 搭上 搽 摁 摂津 摩洛哥 摩西 摹 撃沈 擭 攥 支配人 收容
+104 改性 放任 放屁 放慢 放水 放荡 放行 政客 敌对 敏感性 救护 教具 教化 教员 教宗 教本 敬佩 敬明 敬畏 敲诈 敲门 整人 文博 文曲星 文武 斩草除根 斯堡 斯巴达 斯洛伐克 斯里兰卡 新山 新式 新春佳节 新案 新港 新科 新薬 新陈代谢 方丈 施加 旄 族人 无用 无能 无误 既成 早乙女 早些 时辰 明媚 明暗 明湖 明美 明言 春江 春联 是啥 是故 显出 显而易见
+104 晥 景物 晴朗 晴海 暄 暇人 暙 暠 暸 暺 暻 曲子 更像 最大手 最常 最最 有一套 有色 朗诵 朝市 朝方 期首 朦 木乃伊 木兰 木田 木箱 末梢 本拠地 杉原 李玉 村长 杜甫 条纹 来春 来生 来院 杨柳 杼 松前 松屋 松木 松茸 板凳 极光 枇 林海 林野 果园 果敢 果肉 枝豆 枡 枪击 查收 查理 标示 校尉 校庭 校服
+104 校本 核武 根気 桃园 桃山 桧 桴 桷 梅子 梆 棋子 棚卸 棡 棤 棹 椑 検挙 検疫 椹 楄 楉 楮 楷模 楸 楽団 榊原 榧 槙 樗 橇 橏 橾 橿原 櫌 欑 次女 欣然 欧姆 歉 歉意 歌合戦 歌姫 歔 歙 止血 正中 正向 正彦 正方 正气 正美 正行 步道 武雄 死活 残余 每件 比丘 比武 比照
+104 毾 民放 民运 气压 气流 气缸 気筒 氙 氥 氰 水体 水土流失 水栓 水箱 水虫 水龙头 氶 氷河 永久性 永别 永田町 永続 求得 汉代 汉奸 池田町 汴 汾 沉寂 沉睡 沉积 沌 沏 沙拉 没落 沢村 河岸 河津 河童 油彩 油炸 油箱 油菜 法兰西 法新社 法西斯 法要 泠 波乱 注视着 泯 泰顺 泳池 泳衣 洍 洏 洖 洗面所 洞口 津久井
+104 洰 活佛 流下 流亡 流星雨 流言 流露 浩瀚 消炎 消防局 涨了 淄 淅 淋巴 淬 淮北 深交 深信 深入人心 深邃 混合物 混蛋 清水町 清里 渇 渋川 渐渐地 渐进 渕 渡邉 港南 游艇 湃 湖沼 湫 湮 湳 溶融 滈 满族 满洲 漂浮 漓 演戏 演进 漳 潜意识 澍 澒 澬 激戦 激进 濞 瀬川 灉 灰姑娘 灵敏 灵芝 炎热 炤
+104 炸药 点差 烘焙 烙印 烛光 烧伤 热电 热能 焔 煄 煇 煎熬 熨 熼 燎 燔 爣 爱家 爱恋 爱戴 牛排 牮 牸 特尔 狁 狂奔 狂犬病 狩猎 狭小 狭隘 猖獗 猩 猪苗代 獶 獽 王女 王立 玨 玩乐 现成 珍宝 珠江三角洲 珠玉 珨 班次 球体 球根 球磨 球菌 理不尽 理化学 琇 琚 琵 瑍 瑼 璆 璘 璸 瓂
+104 瓒 瓟 瓯 瓴 甍 甑 甘露 甜心 生意気 生薬 生野 用例 用处 田间 由衷 甲乙 甲州 甲虫 甲醇 电动机 男方 画材 留有 畜生 畤 疏通 疑虑 疝 疮 疸 病历 病害虫 痳 痴迷 瘽 発券 発声 発案 発汗 登板 白光 白城 白子 白木 白血球 白身 百倍 百合子 百味 百米 皇族 皎 皛 皝 皞 皮套 皮毛 皮质 益子 盎
+104 监考 盘子 盛唐 盟主 盱 直角 相传 相原 相楽 相隔 省下 省吾 看不 看去 看懂 看成是 真不 真中 真切 真意 真能 真里 眳 眼皮 着席 着火 着脱 睡眠不足 睡着 瞍 瞏 瞪着 矘 矙 知足 知青 矮人 石刻 石林 石破天惊 石窟 石雕 矽谷 砂利 砒 砖头 砭 破损 破案 硃 硉 硐 确信 确是 碚 碠 磨合 社民党 神器 神态
+104 神通 祧 禅师 福林 福清 福美 禽类 秀和 秆 秋元 秋日 科幻片 秖 秬 称谓 稀释 稄 税源 稲毛 稻草 空心 空母 空空 空闲 空降 突袭 窃取 窕 窿 立国 竟有 竹北 笄 笑意 笑起来 笔录 笔钱 笣 笥 第一人者 第五节 筌 答谢 筷 算得 算算 箙 箜 管教 管楽器 箫 箴言 篮子 簦 簩 簸 米色 米饭 粈 粉刺
+104 粗大 粪便 粲 精准 糒 糖分 素案 紫水晶 紶 綅 繌 纆 纚 红衣 纱线 纶 纷争 细小 绝食 绢 继而 续约 缅怀 缉 编者 编造 缝隙 罗汉 罚金 罢免 罫 羊角 美帆 美幸 美男 美联社 羞涩 群像 羽目 羾 羿 翕 翱 翻印 翻开 翻弄 老友 老爹 老街 而以 耕耘 耗子 耗资 耳光 耳鼻科 耽美 联想到 联欢 聚丙烯 聚酯
+104 肋骨 肪 胃炎 胆子 背诵 胜过 胝 胡乱 胡桃 胡适 胭脂 胶卷 能不 脱光 脱帽 脱掉 脱皮 脱硫 脱走 脳卒中 脸庞 脸颊 腐朽 腴 膛 臇 自伝 自卫 自定 自尊心 自建 自此 自焚 自生 臼井 舅舅 舒畅 舰船 船井 良久 艵 艺妓 芒果 芝浦 芟 芥川 花季 花茶 花道 芳草 芹沢 芹菜 芾 苅 苣 若妻 苦心 英二 英夫 英子
+104 英治 茂原 茶具 茶坊 茼 荄 荅 荒漠 荣幸 荻原 莓 莩 莪 莫扎特 莫测 莺 菔 菟 菠菜 菡 营地 落日 葎 葩 葬礼 葬祭 蒙古族 蒟 蒲生 蒲郡 蓄水 蓑 蔀 蔫 蕈 蕞 蕴藏 薏 薨 藋 藜 藤子 藤木 蘜 虑 虔诚 虚构 虚空 虤 虹口 虺 蚀 蚁 蛆 蛚 蜡笔 蝌 蝔 蝛 蝧
+104 蝭 融融 螓 螽 蟓 蟡 蠰 血拼 血液循环 血统 行之有效 补课 衬衣 衰弱 衶 袖丈 袖珍 袢 被疑 被选 被逼 被験者 袱 裌 裕一 裻 褂 褫 襄阳 襴 西海 西脇 西行 西街 要花 觟 解梦 觤 觥 触手 觾 謺 认出 记账 讳 论点 识字 试探 试过 话来 诧异 调味 调和 调皮 谷底 谷物 象山 豪州 豳 貅
+104 賛 贮藏 贯通 赚取 赞叹 赞誉 赤身 赫然 起火 趁机 超凡 足足 足音 跌倒 跑跑 路线图 跳下 跳了 踏入 踏切 踒 踙 踞 踧 踪影 踮 蹋 蹙 身世 身幅 身手 身段 転入 転出 転居 軽度 车费 转头 转而 轮到 轻柔 轻视 轿 输血 辛子 辩证 辫 辽源 达喀尔 迁徙 过夜 近代史 返上 返乡 远古 远见 连任 迟早 迤 迨
+104 追击 追捕 退団 送进 逃出 逃逸 逄 逆流 选派 逐次 通巻 速记 逸脱 道口 道道 遗弃 遢 遴 遵从 邈 那几个 那片 邦彦 郃 郙 郚 都去 都江堰 酒瓶 酓 醤 里加 重修 重整 重用 重金 金八 金太郎 金谷 金鱼 釪 釭 鉄分 鉄拳 鉄腕 鉾 鋓 鋠 鋻 錝 錣 錵 錹 鍞 鎏 鐀 鑪 鑵 钮 铜板
+104 铮 错位 锥 镐 镼 长兴 长白山 长裤 长辈 长青 闲云野鹤 阐明 阐释 队列 防虫 阻挠 阿倍野 阿南 降格 陕北 陛 除却 除臭 陵辱 隆一 隆太 隆盛 隆起 隐含 隐约 隔音 隠岐 隢 隣人 雄二 雅美 集结 雑炊 雨林 雪中 零星 雷神 霙 霪 霸权 青州 青果 青衣 静的 非行 非鉄 面会 面条 面色 革命性 靴子 鞅 鞞 鞦 韦伯
+104 韧 音読 頩 顶点 顽固 预赛 领事 领养 题词 颠峰 风向标 飘逸 飞到 飞碟 飞越 食宿 饕餮 饙 饰物 饿死 首府 馗 香江 香精 香艳 駜 马铃薯 驱逐 驻地 驼 骑车 骡 骥 骨架 骭 骼 高僧 高山市 高村 高浜 高龄 髷 鬃 鬯 鬼怒川 鬼神 鮹 鰤 鱍 鶣 鶨 鸡肉 鹿角 麑 麦田 麧 麻子 麾 黐 黒毛
+104 黥 鼎盛 鼻腔 龙江 龙虾
+105 一丸 一九九八 一国两制 一大早 一季 一掌 一期一会 一朵花 一束 一栋 一概 一男 一秒 一绝 一袋 一见钟情 一队 一雄 丁字裤 七嘴八舌 三叉 三尺 三山 三平 三水 三界 三轮车 上下水道 上皮 上马 下放 下村 下身 不下去 不介意 不以为然 不值 不光是 不屈 不息 不晓得 不着 不约而同 不经 世相 东街 丝毫不 严寒 中央研究院 中巴 中庸 中德 中性子 中森 中油 中町 串列 丸太 丹念 久喜
+105 久违 之徒 之舞 乏味 乗物 九千 九洲 九郎 买进 乱入 乱码 亀戸 亀裂 争斗 争锋 事态 二一 二十歳 二哥 二天 二枚 云云 云雾 五台山 五年级 五级 井手 亚东 亚历山大 些许 交战 交融 交错 产区 亲民党 人世 人行道 人鱼 仁科 仁美 仆人 今日子 今昔 仍能 仔犬 仕草 他人事 仡 代管 仮眠 仮装 仰木 任由 份量 伊豆高原 伊香保 休克 休学 伙计 伝染
+105 传播学 伯伯 伯父 伸一 伺候 似地 体液 体面 余下 余白 作秀 作证 佳肴 侈 侠盗 侥幸 便益 便要 保田 保税 保龄球 信子 信玄 修缮 倌 倞 倦怠 偅 偎 偏方 偑 停放 停水 停泊 健司 健常 偯 偶数 傀儡 傔 僈 僠 僣 僰 僵硬 元凶 充沛 先手 先秦 光阴 克己 党团 兜兜 入港 入籍 全城 全村 全科 八通 公房
+105 公馆 六年级 六次 六项 共产 关了 关掉 兴致 养活 养的 内情 内脏 内野手 再者 写照 军校 军舰 农夫 冤家 冱 冷戦 冷门 凄凉 准许 凘 几米 凵 出乎 出新 出港 击毙 凿 刀子 分批 分摊 分配器 刊出 刘少奇 刘海 则要 刚到 创世 初演 利尿 利得 利権 别名 别处 别把 刷牙 刻画 前髪 割高 剽 力图 劝说 功用 劫匪 劭 劳累
+105 劼 勃発 勘测 募捐 勾勒 勿体 化物 北千住 北日本 北美洲 北郡 匴 区区 十二个 十代 十多岁 十方 十日町 十项 千本 千歳空港 升迁 半价 华府 单体 单方面 南城 南大 南斯拉夫 南美洲 南面 博士班 博大 卤 即席 却能 卸妆 厌 厕 厜 原子核 原本是 厤 厳正 参考书目 参道 又去 叉车 友崎 双打 双目 反乱 収量 发抖 取而代之 受孕 受惠 受苦 口数 口音
+105 古希腊 古物 古物商 另一面 只求 只顾 可児 可别 可耻 台座 台灯 台胞 台资 右上角 右岸 右端 右腕 叽 吃力 各不相同 各州 各系 吆喝 合同庁舎 同一性 同位 同然 同质 同龄 名列前茅 名取 后记 吐鲁番 向山 含笑 听从 吸尘器 告急 呛 周回 呼之欲出 呼出 呼和浩特市 呼喊 呼应 和歌 和正 和男 咐 咖啡豆 咫 哈特 哽咽 唊 唎 唐沢 唤起 啀 啍 啶
+105 喇嘛 喝醉 喱 喷射 喷泉 嗈 嗉 嘴上 噱 嚝 嚵 囃子 囊括 囋 四星级 回暖 因故 囤 囥 困局 固网 国务卿 国文学 圈地 圈套 土特产 土石 土著 在任 在座 圭子 地势 地政 地面上 圴 圾 坏蛋 坐坐 坞 坦诚 坪井 垃 埒 埤 埸 基业 基本功 基调 基隆人 埻 埽 堛 堥 堵车 塞浦路斯 塩原 塩尻 墝 增益 声带
+105 声楽 売主 备课 変圧 変性 夏川 夕刻 外周 外科医 多台 多孔 多角 多试 夜店 大举 大仙 大内 大千世界 大坂 大奥 大学卒 大怒 大曲 大杉 大槻 大殿 大汗 大白菜 大盗 大肚 大里 大金 大闹 天元 天变 天门 天黑 太不 太宰 太宰府 太平洋戦争 太慢 太早 太重 夬 失効 失灵 头目 奇数 奇遇 奋进 奖章 套利 奘 奥多摩 奥菜 奨 女主人 女史 奶瓶
+105 好了吗 好多人 好歌 妙子 妙高 妣 妥善处理 妯 姒 委任状 姠 姥姥 姻 婇 婓 媳 媸 媿 嫂子 嫌気 嫍 嫡 嬉野 嬖 嬗 嬠 嬴 孀 孖 字串 存折 存放在 孝感 孟宗竹 季报 孤児 孤寂 学苑 孱 宇佐 守谷 安井 安堵 安安 安息 安抚 安来 官吏 定论 宜野湾 宝贵意见 実体験 害人 家常 寄存器 密切配合 富商 富士吉田 富野 寯
+105 寱 导论 封套 射箭 小千谷 小柄 小柳 小段 小河 小溪 小破 小粒 小船 小菜 小虎 小蜜蜂 小费 小题 小马 尐 少佐 少吃 少尉 尚在 就做 就怕 就打 就绪 就问 局番 居于 居然是 屏风 屙 屮 山咲 山寺 屿 岆 岋 岌 岦 峒 峨眉山 峰值 崆 崇拝 崇敬 崞 崥 崦 崮 崷 嵁 嵃 嵬 嶝 嶱 嶷 巅
+105 巠 巡警 工匠 工薪 帝都 常来 常青 幓 幰 平常心 平素 幵 幸喜 广元 广岛 床屋 库房 应力 底板 底特律 底面 庖 废品 废料 庠 庥 康乃馨 康德 庹 廌 廔 廛 廨 建交 建德 弄清 弅 式样 引文 弘文 弦楽器 弱気 强弱 彃 当此 形形色色 形而上学 彩名 影坛 影帝 彴 彶 徂 很受 很慢 律子 得奖 徘 御前 御膳
+105 徭 微处理器 心心 心性 心想事成 心理学家 忌讳 忍法 志乃 志明 志木 忞 忤 忥 快走 忸 怒吼 思案 怠慢 急转弯 怨恨 怪不得 怪盗 总比 总督 恂 恐怖症 恒生 恙 恩格斯 恭敬 恵美子 恵那 悝 悲壮 悴 悻 惀 情不自禁 情意 情话 惨案 惩 想再 想得 想得到 想方设法 想用 惴 惺 愆 愈合 意地悪 意気 意象 愔 感染力 慈禧 慎太郎 慥
+105 慷 憯 懵 成人病 成全 战争片 战犯 戙 戯曲 房内 房地 扂 手品 手头 手帕 手引 手拉手 手料理 手电筒 手相 扎根 打不开 打完 打官司 打数 打牌 打理 托马斯 扦 扬帆 找死 承运 把头 抑圧 抓取 抖动 抗洪 折抵 抱抱 抵扣 抻 拉丁美洲 拉大 拉斯维加斯 拉票 拉近 拘捕 拘禁 招工 拜拜 拟订 拨付 挂号 指使 指宿 指尖 振袖 捋 捗 掍
+105 排放量 掞 探寻 接了 接骨 推到 推展 推选 掾 提单 提款 提督 揭发 援引 揹 搡 搦 摄取 摆出 摔倒 摘出 摘発 摞 摧残 摩耗 撂 撒谎 撕裂 撞上 撞车 播音 操心 擗 擤 擽 攫 收款人 收起 攷 攻打 放学 放尿 放肆 政要 故此 故里 敌军 敔 教示 散落 敬礼 数位 整骨 敷居 敼 斁 文例 文殊 斒 斫
+105 斯坦福 斯文 新一 新天 新座 新発田 新都 旅店 旋回 旎 旓 无事 无性 无故 既出 既刊 既往 既知 日新月异 旧址 旧暦 时不时 旸 昃 昉 明神 昏倒 易居 易懂 易燃 春城 春田 春野 晙 晨光 晩年 普尔 智久 智慧型 暂行条例 暖冬 暗器 暗黙 暴发 暴落 暴言 曮 曲阜 更少 更易 更远 曷 最让 月例 月齢 有二 有功 有希 有朋 有源
+105 朝天 朝日町 木原 未了 未免 未到 本庁 术士 朿 杀入 杆菌 杏林 来校 来给 杲 松川 松弛 松野 构图 枓 果冻 果真 枠内 枪支 枰 枵 枸 枹 枻 柉 柌 柎 染上 柔美 柜子 柦 柳井 柳生 栄一 栄子 标兵 栖息 栠 校准 栩栩如生 栮 栴 核潜艇 桜花 桢 桮 桽 梗概 梛 梠 梦露 梯子 棋士 棕榈 棘手
+105 棚田 棺材 植村 検事 椴 楋 楗 楛 楠木 楦 楫 楽観 榔 榜上有名 榠 榫 槢 槦 槼 槿 樴 橔 橕 橘色 橠 橡皮 檕 檬 檹 檽 櫂 櫼 欠薪 欣欣 欲火 歌集 歓喜 正一 正史 正大 正治 正统 正雄 此行 武士道 武威 武家 武生 歳三 殇 残暑 残骸 每家 毒打 毒蛇 比拟 比高 毛利率 毛坯 氏族
+105 民主集中制 氓 气死 气派 氖 気品 気密 氡 氤 氨基 水溶液 水蒸気 永山 永春 汇丰 汊 江水 污泥 汹涌 沆 沉吟 沉着 沔 没去 沮 油絵 沺 治好 沿道 法定代理人 法式 法王 法界 泛舟 泡菜 波长 泥沙 注音 泫 泮 泰勒 泱 洇 洎 洐 洗手间 洗钱 洛夫 洞房 洟 津田沼 洮 洹 活下去 活水 活生生 流星群 流氷 流速 浅川
+105 浜辺 浩之 浩子 海伦 海地 海峰 海浪 海港 涂装 消化不良 消火器 消灯 消遣 涕 液状 淈 淋病 淙 深秋 深蓝色 深部 淴 清扫 清瀬 清真 清脆 渟 渡过 温存 游子 渹 湍 湖边 湟 湲 溪流 滘 滽 漆黑 演目 潗 潦 潧 潸 潼 澣 澥 澭 澺 濄 濉 濒临 濩 瀬谷 瀯 灌输 火炮 火狐 炮兵 炰
+105 点钟 炽 烋 烠 焕发 焗 焰火 煆 照料 照耀 熂 熠 熬夜 熵 燂 燅 燕窝 燠 燹 燽 爃 爬山 爱慕 爱滋病 片头 片栗粉 片片 牌坊 牛久 牛顿 牴 犀利 犒 犩 犪 犯下 犺 狂想曲 狂风 狒 独奏 猇 猛增 猛暑 猥 猩猩 献花 猲 獐 獗 獢 玉手箱 玉环 玉田 玉置 王位 玠 玩忽职守 玩过 现用
+105 玲奈 玳 玻璃瓶 珊瑚礁 珖 珙 珣 珵 球形 球界 球面 理应 琲 璜 璾 瓀 瓜子 瓞 瓥 瓮 甘蔗 甚大 甚是 生计 用完 用纸 田崎 田端 甲壳 男童 甾 畏惧 留心 留恋 畠 畠山 畬 疽 痛心 痼 痿 瘑 瘛 瘟疫 瘦肉 瘨 瘩 癗 癚 癪 癫痫 発効 発端 登用 百人一首 百景 皙 皮球 皴 皻
+105 益处 盏 盐田 监禁 盗塁 盛事 盛名 盛装 目瞪口呆 相距 眅 眇 看成 看过来 県下 真如 真性 真要 真言 真谛 真鍮 眣 眨眼 眴 眶 眹 眼界 眽 睆 睙 睚 睡前 睡得 睥 睩 睿智 瞁 瞋 瞑 瞛 瞠 瞰 瞽 矇 矍 矢先 知之 知悉 矧 短路 石坂 石板 石柱 石河子 石黒 矿石 砩 砬 破除 硊
+105 硎 硬是 硬直 碉 碌 碕 碳酸 磁卡 磁器 磡 磨砂 磲 磴 磾 礤 祆 祓 祖宗 神功 祤 票选 祩 祪 祭司 祹 祼 禁不住 禅宗 福地 禳 离谱 秀喜 私募 私学 秋吉 秋本 秋生 秏 科考 秫 称得上 移出 稀饭 税収 稑 稗 稲田 穏 空知 空隙 穾 穿刺 突尼斯 窆 窙 立党 立石 竞相 章太郎 竤
+105 竹原 笃 笞 笠松 笹川 笻 等伯 等価 答卷 筮 签了 简陋 箄 箖 箘 管用 管网 管路 箤 篙 篚 篠崎 篡 簌 簻 籀 籇 籊 籍贯 籚 米山 米田 籺 粒度 粗品 粗放 粢 粺 精辟 粿 糎 糙 糟蹋 糢 糬 糮 素数 素行 素足 累累 絵図 絵巻 絵美 絽 総覧 緳 縦覧 縩 繁育 纛
+105 红斑 纳闷 纸巾 纹身 练兵 经文 结伴 绝妙 维他命 维系 缅 缎 编导 缩减 缺氧 罘 罨 美商 美山 美形 美雪 羔 羔羊 羝 羞辱 翀 翋 翡 翮 翻版 老伴 老挝 考取 考査 耋 耒 耦 耦合 耻 联名 聚众 聚氨酯 肛门 股下 股息 肥皂 肩负 肮脏 肯尼亚 肱 肾脏 背离 胏 胱 胸囲 胸膛 胸针 能手 脁 脇役
+105 脊髓 脐 脤 脰 脱下 脱星 腆 腹水 膘 膮 臊 臡 臣服 自住 自前 自嘲 自明 自知 至极 舞厅 舡 舰艇 艨 艭 节油 芘 芡 芩 芭比娃娃 芰 花井 芳心 芳村 苗字 若槻 苦涩 苦瓜 英名 英気 英英 英訳 英豪 苻 茁 茅野 茍 茩 茭 茯 茶几 茶器 茶座 茶髪 荇 草堂 草子 草履 草本 荋 荍
+105 荒地 荒谬 荿 莆 获益 菁英 菘 菜穂 菝 菰 菽 萷 落雷 著述 葛根 葞 蒙面 蒧 蒯 蒴 蒻 蓬勃 蕥 薜 薞 薤 薬理 薹 藩主 藾 藿 蘀 蘅 蘑 蘥 蘩 蚚 蚝 蚳 蚽 蚾 蛃 蛗 蛪 蛬 蛯 蜅 蜘 蜢 蜤 蜱 蝃 蝐 蝜 蝷 螗 螛 蟫 蠗 蠤
+105 蠲 蠵 血性 血盟 行李箱 行进 街路 补给 衧 衩 衪 衰落 袋井 袋子 被曝 裁断 装入 装具 裨 裮 裯 裶 裾野 褢 褪色 襦 襻 西夏 西大路 西蒙 西风 要覧 覟 観音寺 解聘 詀 謜 謵 謻 警力 讔 认得 讨价还价 记帐 讹 设限 词组 诚心 诚挚 该是 说成 说起来 诵 请拨 请进来 诺言 调换 谋生 谏 谷中
+105 豆油 豊平 豪雪 豵 豻 貌似 贝多芬 败诉 质问 贰 贵了 贵的 贻 资产负债 资历 赚得 赤川 赤旗 赤裸裸 赳 趄 超乎 超重 趎 跌价 跏 跑得 跖 跟不上 跨入 跪在 践踏 踏板 踦 踩踏 蹂躏 蹉 蹖 蹜 蹥 躅 躞 身元 身障者 躯 躰 躾 軮 軽微 軽油 軽食 轇 转眼 转运 轮船 轴心 输出功率 输卵管 辗转 辨认
+105 辩解 边疆 过生日 迋 近江八幡 迒 返程 远期 远近 远销 连夜 迟疑 迷惘 迷糊 迷走 迸 迺 迻 追溯 迿 逆行 选修 透出 透彻 透水 途方 逗留 通宝 通航 造花 遇害 遍路 遐想 道上 道义 道明 道端 道筋 遮蔽 避税 邕 邞 邮递 邳 邻国 郗 部内 都留 都还 鄵 酅 酊 酌情 酘 酲 酵 酷冷 酸甜苦辣 酸酸甜甜 醅
+105 醪 醯 醸成 醾 釆 采光 释义 里奈 里香 重创 重奏 重油 野良 量子力学 金门 金马奖 釜石 釬 鈆 鈶 鉄板焼 鋐 鋑 鋕 鋘 鋡 鋳 錭 鍣 鎕 鏖 鏸 鏻 鏿 鐊 鐎 鑢 鑴 钂 钊 钢丝 钢厂 铜牌 锭 镇定 镕 长处 问过 闹剧 闺房 阱 防湿 阴暗 阻击 阻抗 阻断 阿姆斯特丹 阿弥陀佛 附送 降伏
+105 降级 降解 陑 陜 陷害 隆之 隆史 随身携带 隔热 障子 隠居 隳 雄鹿 雅人 雒 雰 霅 霉素 青海湖 青花 非但 非同 面交 面相 靺 靼 鞁 鞊 鞫 韭菜 音乐节 音痴 韶山 頛 頠 頨 顶峰 颅 颓废 题名 风情万种 风韵 飘扬 餔 餗 餟 饕 饥渴 饭碗 馈赠 馘 香皂 香菜 香辛料 香里 駅舎 駻 驐 驙 驞
+105 马良 马蹄 骋 骐 骗了 骤然 髀 髑 高亮 高声 高密 高志 高杉 高梁 高点 高能 髡 鬕 鬻 魃 魑 魵 鮛 鰡 鲤鱼 鳷 鴎 鵰 鶞 鶢 鶿 鷜 鷬 鷮 鸁 鹿屋 鹿沼 麝 麴 麹町 麻疹 麻痹 麿 黀 黑心 黑板 黑道 黒目 黜 黳 鼰 鼷 齝 龙卷风
+106 一丁 一九九七 一助 一変 一岁 一席之地 一彦 一役 一愣 一拳 一挥 一斤 一朗 一枪 一桌 一様 一死 一盒 一触即发 一试 一课 一辆车 一进 一重 一问 七福神 七里 万份 万花筒 三六 三戸 三本 三枚 三男 三百六十 三角洲 三门 三餐 上书 上尉 上岸 上水道 上町 上端 上肢 上高地 下品 下妻 下层 下挫 下落不明 不二雄 不光 不可解 不在家 不均衡 不夜城 不妙 不景気 不正之风
+106 不畏 不知情 不绝 不致 不言 不言而喻 不起眼 与那国 丐帮 丑恶 专款 专程 世外桃源 东道主 両用 丢弃 中产 中根 中洲 中耳炎 中铁 中院 丸井 主修 主夫 主治 主犯 久子 久远 乌拉圭 乙太 九重 也向 书局 买过 乱了 乱伦 乳化 乳沟 事半功倍 二极管 二氧化硫 二百五 二轮 二酸化 互不 互信 五周年 五峰 五木 五楼 五里 井出 井水 亜矢 交信 交到 交手 交界 亭主
+106 亮了 亵渎 亹 仂 仃 仈 今次 介意 仕立 仙人掌 代名词 代购 仵 伊奈 伊福部 伊集院 休市 众议院 优胜 传导 伤员 伤者 伯特 佌 低周波 低沉 低腰 住之江 住戸 佐竹 体验到 余力 佛光 作図 作答 佝 佞 佾 例示 依稀 侵犯到 俊之 俊夫 俊雄 保冷 保坂 保定市 信一 信服 俣 俭 修女 修好 修法 修长 倍感 倔强 倜 偀 偁
+106 假象 停住 停工 偠 健治 偨 偷渡 偷走 偺 僎 僦 僧人 僩 僳 儃 儅 儊 儑 儒教 儗 儜 儠 儡 儹 元禄 兆候 先人 先代 先住民 先期 先要 光度 光束 克也 克利 免不了 免职 党主席 党的基本 入伍 入狱 全班 全盘 八丁堀 八仙 八坂 八幡平 八戒 八景 八犬伝 八里 公会堂 公升 公法 公益金 公诉 六位 六十年代 六法 共进
+106 兴安 兴衰 具足 内服 内江 内河 再向 再问 冒犯 冓 军火 农学 冥王 冥界 冨田 冰上 冲到 冲天 决斗 冷血 冷轧 冾 凉鞋 凌乱 凌空 减半 减去 凝结 凝重 凝集 几声 几日 凤山 凶器 凸凹 出乎意料 出汗 出钱 分室 分断 切身 刎 刓 刚性 初中生 初学 初段 初秋 初稿 初音 利弊 利民 别出心裁 前奏 前文 前略 前野 剔除 剪裁 剫
+106 副食 剸 劀 劂 劗 劙 加利福尼亚 加厚 加持 加瀬 劦 励起 勇猛 勍 勓 勰 勲章 匂 化作 北师 北戴河 北斗星 北沢 北浜 北边 匷 十万元 十二分 千伏 千分 千奇百怪 千家 千岛湖 千石 半成品 华中地区 卓也 单程 卖得 南天 南港 南边 南韩 博得 博美 占拠 卡塔尔 卡通片 卢森堡 卣 卧底 印花税 印记 卸任 压低 原形 友美 友香 双眼皮 双肩
+106 反美 反而会 反而是 反覆 反问 叔母 取样 取经 取舍 受挫 受访者 变小 变电 口元 口才 口癖 口红 古井 古代史 古木 古诗 句号 另加 叫作 叮嘱 可作 可信度 可说是 叵 号角 司祭 吃吃 吃肉 各局 各期 各班 吆 吇 合否 合法化 吉凶 吉崎 吉野川 同友 同源 名园 名残 名水 名駅 后座 后裔 君津 君王 吥 含糊 听不懂 吸了 吸汗 吹牛 吽
+106 呔 呤 周旋 周遭 呫 呼啸 命案 咂 咆 和久 和希 和雄 咰 咸丰 咾 哃 哈维 哏 哞 哭笑不得 哱 哲哉 哳 哿 唐人街 唐诗 唠叨 唯物主义 啅 啷 喃喃 喌 喏 喝采 喿 嘈 嘓 嘪 嘱咐 噀 噁 噆 噊 噙 嚧 嚽 囃 囆 四分 四层 四技 四格 囝 回过 因縁 図式 囷 国共 国定 圄
+106 土田 圣战 圣洁 在原 圮 圯 地下街 地基 地平线 地热 坂出 坂戸 坍 坢 坦率 坻 垂涎 垝 垦 城池 埢 埧 培植 堀口 堇 堔 堬 堵住 塈 塍 塑像 塑性 塩野 塭 塺 墀 墓碑 增殖 墣 壂 壆 壈 壛 壝 士林 壬生 声乐 声势 売値 处世 夏子 外公 外力 外边 外逃 外野 多半是 多少个 多想 多看
+106 夜宴 夜幕 大佛 大刀 大合唱 大和田 大和町 大尉 大法官 大波 大牛 大班 大约是 大船渡 大蛇 大雄 天上天下 天白 天龙八部 太强 太晚 太田市 太祖 夭折 失神 头版 头皮 头颅 夸奖 奇形 奉化 奔向 奔赴 套用 奢望 女作家 女皇 奴才 好得 好手 好歹 好転 如何是好 妙方 妦 妵 姇 始発 始祖 姑姑 姫野 姵 姶良 威慑 娘娘 娸 婐 婜 婞 婟
+106 婴儿期 婺 媐 媶 媷 媺 媾 嫀 嫆 嫚 嫦娥 嫨 嫫 嬁 嬐 嬓 嬔 嬛 嬞 嬶 嬿 孈 孎 子役 字句 孟加拉 孥 孬 守住 安奈 安逸 宋朝 官府 定常 定植 宜人 宝地 宝生 实干 実寸 客串 宣泄 室友 家主 家出 家境 家宅 家庭科 家系 容积率 容许 寀 寄存 密教 富永 寖 寛容 寺社 导线 対向
+106 対日 封存 尊者 小公主 小册子 小太郎 小峰 小巷 小技 小松菜 小枝 小波 小王 小班 小畑 小穴 小舟 少男 尕 尚且 尢 尨 尪 尾部 局在 居合 居然有 屈原 履约 山头 山奥 山椒 山田町 屹立 屻 岍 岩村 岩槻 岳飞 峋 峘 峨嵋 崤 崨 崱 崳 崼 嵋 嵎 嵫 嵯峨 嶂 嶒 巃 川井 川沿 州长 工龄 左端 左腕
+106 币值 帊 帨 席上 帮手 帰着 常滑 常被 幋 幍 幭 干得 干洗 平尾 平戸 平易 平泉 平良 年关 并入 并非是 幸夫 幸存者 幼小 幼年 幽雅 広帯域 広田 庆贺 床单 应征 应运而生 应酬 座落 庸俗 廆 廙 延吉 建党 建功 建安 廿日市 异乡 弃权 弄到 引率 引致 张三 弢 弭 弱火 弾丸 彉 归侨 当过 彩子 彩香 影碟 彳 往前走
+106 征兵 待人 徇 徇私舞弊 律法 徕 得当 徜 御宿 微不足道 微粒 德意志 徼 忀 心神 心筋 心胸 忏 志保 忘情 忠信 忠雄 忨 忪 快挙 快気 忮 念仏 怒气 怒火 思考力 急促 急落 性差 怪事 怵 恄 恍然大悟 恐怕是 恟 恫 恺 悃 悈 悬殊 悬浮 悰 情势 情理 情谊 惉 惊异 惊心动魄 惊慌 惋惜 惠安 惢 惤 惧怕 惨烈
+106 惨重 惰性 想哭 想看看 惹人 愀 愎 愚人 愚昧 愤慨 慊 慌忙 慓 慢性病 慢速 慦 慴 慺 憍 憬 憴 懂了 懒人 戒律 戕 战俘 戡 戦死 戦犯 房事 所内 所掌 手心 手技 手拿 手握 手稿 手艺 才不会 打成 打拼 打掉 打磨 打起 扔掉 払拭 扙 扞 扫雷 扬言 抄本 抆 护卫 抱有 抵消 抹去 抿 拄 担负 拉伸
+106 拊 拍片 拔出 拖到 拖累 招待券 招手 拢 拦住 拮 拮抗 拵 拸 持刀 指図 挖出 挣到 挥舞 挬 挹 挽留 捄 捕捞 捕食 据置 捺印 掉进 掌心 掌管 排他 探路 控诉 掭 提价 提升到 提法 插在 揠 揶 搁 搎 搞的 搪 搰 搷 摄象 摇晃 摇曳 摠 摩纳哥 摬 摲 摸了 摸摸 摽 摿 撒娇 撞死 撤収 撽
+106 擖 擦肩而过 擳 攍 攎 攭 支线 收信 收场 收紧 攻入 攻守 政経 故而 敌方 敏夫 敏郎 教法 散户 数次 整修 整列 文盲 文采 斑斑 断固 新垣 新奥尔良 新婚旅行 新来 新津 新站 新芽 方角 旁听 旃 旅券 旒 旞 无味 无暇 无理 无色 无非 无题 日出生 日圆 早漏 旷 昌吉 明光 明德 明文 明明是 明菜 昏暗 昑 易经 昝 星球大战
+106 春先 春意 春香 显露 晔 晚安 晜 晡 晴美 晷 晸 暆 暐 暑中 暗算 暝 暴君 暴露出 暾 曘 曩 曫 曼哈顿 最好能 最重 月経 有否 有喜 有成 有能 服刑 朝一 朝晩 朢 朣 木津 未央 本季 本府 本籍 本金 札所 朱元璋 机智 机枪 杀戮 杀掉 杂质 杗 杜鹃 来期 松开 松鼠 板栗 板野 极富 枅 枌 枢 枭
+106 枸杞 柏拉图 柙 柚木 柝 查证 柱子 柶 柺 査察 栂 标杆 标榜 标的物 栈 栏杆 栔 校际 栲 栵 核融合 核销 根除 桉 桜庭 桟 桭 桵 梁山 梂 梡 梨沙 梩 棇 棈 棉布 棉衣 棉被 森野 棻 棽 検察官 椪 椳 椵 椼 榙 榬 榶 槂 槃 槉 槬 槲 樉 横井 横川 横目 橄 橝
+106 橤 橦 橪 橶 櫑 欉 欓 欗 欙 欠乏 欠落 欶 歃 歋 歌名 止痛 正反対 正名 正夫 正幸 正当理由 正想 正比 正派 正经 正装 武井 武内 武勇 武帝 歩合 歪歪 歯学部 死守 死掉 殈 残暴 殛 殿様 毁坏 毁掉 毊 母音 每公斤 每桶 毒害 毒舌 比价 比利 毙 毛发 毛色 毞 毠 毣 毳 氅 气管 気泡 気管支炎
+106 気道 水利局 水底 水気 水肿 永川 永昌 求教 求生 求解 求道 汉人 江崎 池内 污蔑 汨 汽船 沈下 沉闷 沙丘 沢木 沥 河上 河床 河谷 沸点 油料 沼泽 泉佐野 泉南 法事 法医 法曹 泚 泝 波纹 泥沼 注解 泭 泰迪熊 洃 洄 洋溢 洌 洒脱 洘 洚 洞察力 津村 洫 洭 洱 活活 流布 浂 浏阳 浓情蜜意 浞 浥 浦添
+106 浮世絵 浯 浶 海王 海草 海风 消化管 消化道 消逝 液态 涳 淂 淉 淋漓 淋菌 淖 淛 淝 淠 淡出 淡然 淡薄 淡雅 淢 淫荡 深得 深沢 深色 深表 深雪 混声 混战 混杂 混浴 混混 清心 清清楚楚 清田 清真寺 清美 清茶 渍 渐变 渡船 渢 渥美 渧 渨 温饱 渵 游荡 渽 湎 湖山 湖滨 湨 湱 満塁 溉 溏
+106 溪水 溶出 溶岩 溿 滚筒 滴水 漇 漫骂 漯 潪 潬 潮汐 潺 澎湃 澢 澯 澴 澼 澿 激流 濔 濢 濷 瀁 瀄 瀊 瀍 瀬田 瀷 灒 火光 火拼 火葬 火薬 火速 灭菌 灵山 灵巧 灵气 炊事 炟 炩 炭鉱 炮制 点亮 烇 烔 烘干 烡 烦躁 热卖中 烻 焦炭 焼却炉 焼成 照常 照搬 煮熟 熄灭 熟年
+106 燃起 燕京 燗 燚 燨 燱 燲 爆音 爟 爬到 爱德华 爱抚 爱琴 父様 爹爹 爿 牁 牂 牌号 牙科 牛羊 牡羊座 牯 特使 特命 特异 特洛伊 犅 犉 犍 犞 犮 狂牛病 狃 狅 狆 狗肉 狤 狩人 狩野 狴 狷 狺 狼狈 猁 猊 猎豹 猗 猟奇 猥亵 献金 獥 玉名 玉造 玉野 玩儿 玶 玷 玺 珃
+106 珌 珗 珘 珜 珫 珺 理发 琫 琯 琼瑶 瑀 瑑 瑔 瑿 璥 璵 瓜果 瓡 瓤 瓨 瓵 甘南 甚多 甚少 甜蜜蜜 生生 用功 由利 由香 甲南 甲子 甲第 申论 电汇 电视直播 电车 男声 畀 留了 留校 留美子 略微 番付 疀 疌 疏导 疔 疚 疳 疼爱 病危 病変 病痛 痊 痔疮 痛楚 痤 痩身 痱 痹
+106 瘊 瘴 瘸 癓 発情 発疹 登时 登机 白兔 白根 白皙 白粉 百利 皆川 皇位 皏 皐月 皭 皮下 盎然 盓 盔甲 盘旋 盥 目下 目先 目途 盲点 盷 相助 盹 盺 盻 盾牌 省油 眃 看得出 看样子 県境 眑 眙 眚 眛 眝 真名 真奈美 真悠子 眭 眲 眻 眼眶 眼花缭乱 着心地 睊 睒 睢 睧 睬 瞈 瞉
+106 瞎子 瞕 瞙 瞳孔 矞 矢崎 短波 石丸 石堂 石墨 矸 砂川 砓 研一 研削 砟 砣 破旧 破烂 破片 砺波 硍 硥 硪 碞 碴 磁碟 磐石 磨房 磨练 礌 礓 礞 礼堂 礼节 礽 社宅 社工 祄 祔 神兵 神迹 祴 祷 祷告 禊 福星 秀行 私信 私利 秎 秝 秞 秦王 秷 移民局 稀奇 稂 稐 稙
+106 稢 稲作 稲沢 穈 穔 穨 穰 穴道 空战 空海 空袭 穿戴 穿插 穿衣服 窀 窇 窉 窸 立功 立方 立陶宛 端倪 端午 竹光 竹村 竹野 竻 笐 笑了起来 笔画 笛吹 笭 笲 筊 答覆 筰 筲 箌 箛 管区 管好 管子 篞 篹 簁 簠 籅 籈 籉 籔 籗 籛 籫 籵 粁 粔 粖 粘性 粘液 粳
+106 粽子 精心设计 精査 精益求精 糨 紧急情况 紩 紫川 紫禁城 紫荆 紫薇 経年 継手 緌 総量 緧 緷 縖 縢 縻 繀 繁衍 繄 繇 繉 繶 繷 红牌 纤细 纵然 线圈 细雨 终日 结了 结结巴巴 统称 维和 绵绵 绵羊 缀 编纂 编队 缿 罅 罟 罥 罧 罪悪 羃 羇 羊羹 美代子 美子 美援 美玲 美瑛 美色 羛 群山 羽化
+106 羽咋 羽村 羽衣 翑 翩翩 翽 老式 老弟 老板娘 老若男女 考究 耄 而立 耟 耰 耳目一新 耷 聋 聐 聑 联大 聚居 肄 肆意 肥沃 背骨 胚芽 胛 胭 胰腺 胻 脀 脑海中 脚光 脟 脢 脱了 脽 腐烂 腹地 腿上 膆 膇 膕 膦 膲 膷 膼 臩 自営 自家用 自幼 自性 自炊 自言自语 自认 自首 至宝 至爱 臾
+106 舂 舍利 舍弃 舒展 舞妓 航程 舲 船体 艄 良一 色即是空 色差 色相 节俭 节制 芃 芨 芭蕾舞 花器 花山 花期 花样年华 花梨 花花草草 芺 苗人 苗圃 苗条 若水 若草 英姿 茂雄 茆 茈 茉莉花 茦 茶杯 茶楼 茶水 茻 荆棘 草稿 草薙 荌 荒尾 荒山 荒廃 荟 荡漾 荧 荨麻 荻野 莇 莚 菀 菂 菈 菖蒲 菜刀 菥
+106 菹 萉 萑 萧条 萨克 落空 落花 落花生 落选 葃 葠 葨 蒑 蒡 蒤 蒫 蔆 蔑视 蔬果 蕇 蕔 蕣 蕤 蕦 蕬 蕮 蕱 蕵 蕺 薄手 薄毛 薚 薬効 薬学部 薬科 薸 薿 藃 藱 蘉 蘗 蘙 蘟 蘡 蘾 虥 虻田 蚇 蚑 蚜 蚰 蚶 蚹 蚼 蛀 蛌 蛑 蛯原 蜎 蜞
+106 蜧 蜨 蜿蜒 蝆 蝍 蝎子 蝳 蝿 螃 融为一体 螏 螔 蟀 蟜 蟢 蟪 蟳 蟴 蠪 蠳 蠸 蠹 血浆 血肉 行先 行径 衒 衡山 衣食住行 表哥 表妹 衵 袖口 袹 裀 裃 装丁 裍 裐 裚 裰 裲 裺 褊 褮 褽 襐 襞 襳 襶 西昌 西本 西雅图 要领 覅 覛 角落里 觔 觚 觫
+106 觬 觰 觷 詈 詻 誧 誸 諅 諓 諯 譀 譒 譔 譕 警觉 警部 譪 讄 许诺 评点 识破 诈 诗句 语调 说唱 说清楚 谛 豂 豆子 豊臣 豌豆 豍 豭 貏 貹 貾 賛美 賟 賨 贀 贂 财团 败笔 贬 费力 费城 贺词 赤壁 赤血球 赧 赭 走下去 赶忙 赶超 起价 起舞 起重 起重机 越智 趓
+106 趡 趵 趹 趼 跁 跈 跌落 跨年 跨行 跰 跲 踄 踅 踏步 踗 踘 踛 踠 踪迹 踽 踿 蹁 蹞 蹠 蹩 蹶 躎 躤 身前 軓 転向 軦 軯 軵 軶 軷 輍 輐 輤 輴 輹 轖 轧 转告 转账 轮椅 软体 轰轰烈烈 轶 辛抱 辛辣 边走 边际 过境 迎娶 迎面 还与 还只是 还能够 远征
+106 迡 迫在眉睫 迵 迷离 追回 追尾 退伍 逃到 逆光 选区 透了 透光 逐鹿 逖 逗号 通史 通畅 通番 通红 造作 逼人 遣返 遧 遨游 遮住 遰 遳 避暑 邆 那句 那句话 那珂 那颗 邦人 邦夫 邺 邾 郔 郪 都像 鄃 鄅 鄮 酒醉 酕 酗酒 酩 酳 酷暑 酸度 酸痛 酹 酿造 醂 醋酸 醑 醣 醥 醭 醵
+106 醷 采摘 里沙 重曹 重松 重逢 野战 野手 野狼 野花 金发 金川 金昌 金玉 金立 釚 釳 鈊 鈖 鉠 鉯 銈 銴 鋂 鋈 鋊 鋗 鋞 鋧 鋳物 錂 錍 錔 錴 鍌 鍎 鍕 鎍 鎒 鎤 鏺 鏾 鐉 钉子 钵 钼 铲除 铵 锄 锋利 锐利 错觉 锚 锯 镇上 镇海 镇长 镉 长出 长子
+106 问好 防务 防波堤 防病 阴性 阿弥陀 阿梅 限价 陔 陨石 隔膜 障壁 隠蔽 难关 难堪 雄伟 雄心 雅安 雅楽 雅治 雑文 雓 雨刷 雨雪 雩 雪乃 雪原 雪梨 雷同 霤 霬 露店 霸气 靃 靆 青田 青草 静物 面授 鞨 韄 韟 韱 音域 音程 顕在 顺势 顽皮 顾及 顾忌 风趣 飞了 飞过 食欲不振 食盐 飧 飹 餐桌上 餮 饱受
+106 香槟 香美 馯 馵 駆逐 駍 駶 騥 騵 马尔代夫 驱使 骚动 骨子 高丽 高居 高柳 高畑 高跟 高野山 高高的 鬠 鬼太郎 鬼故事 魕 魡 魧 鯃 鯆 鰅 鰆 鰫 鱌 鳹 鴩 鵻 鵿 鶅 鶏卵 鶗 鶵 鶸 鷍 鷤 鷻 鸡西 鸦 鹃 鹸 鹿嶋 麈 麋 麌 麦子 麦茶 麻衣子 麻里 黄泉 黄蜂 黑山 黑河
+106 鼊 鼐 鼖 鼘 鼜 鼯 鼳 鼶 鼻孔 齁 齃 齍 齐聚 齘 齛 齮 齴 龑 龙口
+107 一伙 一具 一千元 一因 一塁 一大片 一女 一帆风顺 一年四季 一心一意 一扇 一指 一无所知 一晃 一村 一桶 一氧化碳 一番町 一盏 一石 一箱 一觉 一转 七次 万代 三份 三军 三台 三周 三咲 三崎 三张 三晋 三流 三角架 上下文 上瘾 上郡 下个月 下伊那 下棋 下沉 下跪 下边 不久就 不依 不充分 不具 不向 不在意 不均 不変 不多见 不失为 不得而知 不忍心 不急 不折不扣 不景气 不条理
+107 不治 不灭 不眠症 不知火 不祥 不肖 不覚 不遗余力 世道 丙烯 丙烯酸 东汉 丝带 両日 丢下 丢人 两下 中山北路 中州 中港 中道 串联 丹青 主眼 丽丽 久世 久而久之 之久 之交 之罪 乌云 乌托邦 乗合 乗降 乙部 九成新 也像 书斋 乱数 乳汁 乳牛 乳糖 乳胶 乳酪 二倍 二六 二分之一 二千元 二塁打 二戸 二本松 二番 亍 亓 五十二 五千元 五岁 五期 五目 亚当
+107 亚特兰大 交尾 交情 交点 交钱 享乐 京香 亲生 亶 人事室 人口密度 人吉 人工呼吸 人情味 人文学部 人畜 什器 仁慈 仇人 今川 仓促 付息 付诸 付钱 仙桃 仲根 仴 任凭 仿冒 伀 伊吹 休刊 伒 伕 会变 会田 伝染病 伢 伯恩 伯母 估量 伸向 低俗 低着头 低落 住地 佐田 体态 体谅 何其 佴 侂 侇 侒 侔 依附 侠义 侣 侦测 保山
+107 保重 信使 信行 信金 俨然 修司 修善寺 修整 修罗 倗 候补 倢 倨 倳 倷 偋 偓 偗 做做 停靠 偝 健全性 偭 偮 偰 偶遇 偷去 偷税 傀 傣族 储藏 催告 傰 傲视 傺 僄 僆 僊 僔 僛 僝 僬 僯 僾 儳 儽 元代 元就 元幸 元春 先从 先入観 先得 先方 光合成 克拉克 克明 兟 入部 全土
+107 全店 全盛期 八人 八位 八句 八折 公信力 公克 公正性 公理 公聴 公费 六名 六天 兰斯 兰溪 共管 共谋 关员 关头 关羽 兵种 养分 养育 内包 内疚 内皮 内覧 円満 円筒 再任 再好 再审 再演 再版 冏 冑 写法 军中 军国主义 军政 军阀 农经 冥想 冬令 冬物 冰糖 冴 冷媒 凅 凉爽 凌波 减产 减低 凝视 凞 几时 凡夫 凳子 出产
+107 出刊 出嫁 出招 出航 出资人 出逃 分册 分居 分清 分裂法 划出 刖 列传 刚果 初五 初冬 初四 初生 删掉 判事 利明 利比亚 别在 别扭 刮起 到站 到那里 刳 制衡 制造厂商 刺杀 刺痛 前夜祭 前奏曲 前座 剒 剕 剖腹 剚 剡 剪切 副总统 副食品 剼 剽窃 劋 劖 加勒比海 加美 动不动 动乱 动起来 助长 势在必行 勀 勅 勒令 勴 勾起 勾魂
+107 包厢 包囲 包里 匏 匐 北大路 北投 北浦 北站 北纬 匢 医事 十七岁 十件 十多个 十期 十番 千明 半信半疑 半条 卌 卑劣 卑怯 卓有成效 单边 单音 南华 南县 南澳 南站 南端 南野 博嗣 博子 博学 卡洛斯 卡门 卫兵 印旛 印第安 印象派 卲 即兴 卷发 卷土重来 厞 原始森林 参军 参看 友爱 双流 反共 反叛 反常 反町 反转 发声 发泡 发疯 发脾气
+107 受制 受托 受検 受精卵 受邀 受限 变大 叛徒 口内炎 口吻 口试 口香糖 古今中外 古屋 古式 古生物 古筝 另一边 只收 只看到 可免 可分 可惜的是 可真是 台地 史实 吃法 各党 各派 合照 吉良 同乗 同列 同国 同席 同校 名札 名神 后盾 后边 后顾之忧 吐露 向上心 向前走 吝啬 吟秋 含水 含金量 吸気 吼吼 告一段落 告终 周平 周慧敏 呲 味儿 呶 和县 和幸 咒语
+107 咖啡屋 咸宁 哀求 哅 响声 哒 哖 哠 哢 哤 哪来 哪能 哫 哻 唅 唇部 唈 唐宋 唖然 唭 唱出 唲 唵 唶 唻 商戦 商榷 啋 啎 啰 啿 善行 喍 喓 喜事 喜人 喢 喧闹 嗌 嗏 嗝 嗢 嗹 嘀咕 嘁 嘌 嘬 嘹 嘾 噉 噞 噣 器物 嚂 嚅 嚬 四人帮 四亿人 四区 四句
+107 四房 四色 四起 四路 四面八方 回折 回旋 回覧 因公 因缘 囫 固态 国光 国公立 国士 国益 图谋 圂 圆柱 圆珠笔 圏外 圚 圛 土俵 土城 土居 圢 圣殿 圣母 圣诞夜 圭一 地层 地所 地籍 坁 坅 坌 坚韧 坦承 坫 坴 坼 坽 垂钓 垔 垙 垸 垽 埇 埋下 埋头 埋藏 城址 埏 埕 埥 埮 埲 堀井 堂堂
+107 堆放 堍 堎 堐 堹 堽 塉 塥 塨 塩沢 塩辛 塩釜 填料 填空 塱 塴 塼 墁 境港 墏 墓葬 増毛 墘 增补 墡 墦 墯 墼 壁挂 壉 壏 壮丽 壮士 声声 处事 夏彦 夒 夕子 外延 外流 外电 外苑 多党 多哈 多寡 多湿 夜夜 夜雨 夜食 夤 大中小 大前提 大同市 大呼 大御所 大悲 大打折扣 大拇指 大概有 大洗
+107 大田原 大系 大群 大街小巷 大袈裟 大计 大跃进 大道西 大部 大雑把 大骂 天坛 天満 天界 天香 太刀 太夫 太宗 太忙 失地 失火 夺目 奇景 奉命 奊 奏效 契合 套牢 奥野 奨学生 女婴 好几天 好心人 好说 妏 妙手 妢 姌 姛 姽 威严 威士忌 威风 娘家 娶了 婌 婖 婥 婪 婬 婼 媃 媋 媎 媕 媩 媮 媵 媹 媻
+107 嫁接 嫄 嫇 嫈 嫊 嫜 嫢 嫪 嫭 嫳 嫶 嫷 嫹 嫽 嬃 嬉戏 嬥 嬾 孋 孓 孕妇装 字元 孝义 季军 季风 孤岛 孲 孳 孵化器 守在 守秘 安危 安否 安城 安徒生 安德森 安检 安楽 安永 安物 安贞 安迪 完封 宙斯 定局 宝珠 审慎 室内楽 室戸 室新 害死 家喻户晓 家村 家臣 寁 密不可分 密林 富田林 富阳 寎
+107 寓所 寛之 寝言 察知 对岸 寺山 対峙 対訳 尌 小兔 小出 小布 小惑星 小板 小样 小气 小看 小行星 小金 小雅 少林寺拳法 少校 尖沙咀 尘世 尚志 尚美 尚需 尟 尬 就应 就爱 就航 尼古拉 尾形 尿液 屄 居中 屋主 展翅 属地 履物 山南 山吹 山楂 山芋 岈 岐山 岣 岩下 岩城 岩塩 岪 岶 岸上 岸壁 峈 峉 峌 峐 崌
+107 崒 崝 崰 崲 崶 嵉 嵒 嵙 嵢 嵣 嵥 嶍 嶪 川北 川合 巡游 工友 巨款 巫婆 巫山 己任 已定 已故 市営住宅 市城 市立病院 帎 带去 帰化 幊 幎 幏 幙 幛 幦 平壤 平定 平沢 年度内 并肩 幸一 幸男 幻想曲 幼童 广度 広辞苑 庄村 庄稼 庄重 庇护 店长 庛 府県 度胸 座落在 康弘 廗 廥 廧 廲
+107 延命 开刀 开明 异彩 弘子 弘法 弘美 弣 弤 弱化 彄 彋 彏 当会 当直 彘 彩花 彭德怀 彾 徉 徐徐 徖 得逞 御坊 徫 微分方程 徳田 心不全 心力 心律 心急 心扉 心法 心甘情愿 忍住 忑 志士 志文 志穂 忠孝 忠心 忡 忧愁 快了 快打 快步 快艇 怑 怓 思源 思量 性器官 怩 怷 怹 总共有 总得 总长 恅 恒常
+107 恩人 恩典 恪守 恰到好处 恶梦 恶毒 恼 悐 悒 悛 悪人 悪霊 悲観 惁 惃 惄 情愫 情敌 惈 惋 惌 惎 惓 惔 惙 惛 惝 惦 想不出 惵 愊 感光度 愧疚 愫 愲 慅 慌乱 慝 慢了 慬 慰霊 慱 憓 憔 憖 憝 懒惰 懘 懰 懻 戈壁 成天 成婚 成熟度 成群 我也没 我行我素 戒备 战事 战区
+107 戢 戦法 截取 房主 所幸 扃 扊 手稲 手荷物 手铐 扑灭 打劫 打捞 打消 打造成 扚 扜 扠 扡 扢 扣分 扣篮 扤 执掌 扬起 承保 承包商 抃 抈 把握住 抔 折原 折扣率 抢了 抢修 护送 报上 报应 报答 抨 抭 抱起 抵债 抸 押尾 抽动 拉了 拉起 拏 拑 拝借 拨开 拫 括号 拿不到 按需 挋 挌 挎包 挓
+107 挣脱 挥霍 挨打 挪用公款 挶 挸 挼 挽歌 捊 捚 损毁 换下 换发 捣乱 捽 掀开 掇 掎 排定 排水管 掔 掝 探花 掤 接洽 控室 推倒 推力 推察 推论 揄 揊 揋 揌 提花 插手 揧 揳 搁置 搒 搛 搞错 搠 搤 摆动 摋 摒 摘下 摛 摭 摴 摸底 摸清 撉 撊 撗 撜 撞到 撮像 操场
+107 擏 擨 攌 攮 收买 攻占 放不下 放炮 政教 敌意 救国 救星 教区 教派 教祖 敢行 散去 散髪 敯 敲打 整地 整流 整站 敷衍 敻 斀 斄 文夫 文彦 文様 文豪 文静 斟酌 断电 新体操 新四军 新妻 新婚之夜 新海 新谷 新货 斸 斻 施压 旅団 旆 旛 无期徒刑 旡 日本食 日益增长 旧作 早安 早良 早起 早退 早饭 旮 旯 昋
+107 明新 明明白白 昏睡 昒 春奈 春晓 昭和町 昭彦 显眼 显赫 晌 晚辈 晩秋 晩餐 普段着 普特 景致 智之 智也 智美 暋 暌 暍 暖和 暖炉 暴动 暴政 暷 曈 曊 曛 曝露 曭 更美 曽我 曽根 替身 最前面 最年少 月牙 有目共睹 朊 服従 服薬 朐 朘 望向 朝代 期望值 木戸 木桶 木谷 木酢 未収 未婚妻 未知数 未踏 未雨绸缪 本息 本村
+107 本荘 本钱 本题 本馆 机率 朻 朼 权势 权衡 杅 李世民 李子 村内 杙 杜氏 杝 来世 来栖 松柏 松沢 板式 林场 林森 果皮 枟 枯竭 枴 架站 架起 柂 染发 柔弱 柤 查清 柰 柳原 柳林 柴崎 柿子 栅栏 栋梁 栗子 栗林 栥 样样 根津 栾 桂子 桌球 桎 桨 桫 桲 梊 梏 梯田 梴 检阅 棆 棉纱
+107 棜 棝 椇 植保 植松 植株 検品 椥 椭圆 椻 楌 楙 楜 楬 榄 榑 榗 榛原 榞 榦 榩 榯 榱 榷 榾 槁 槊 槮 樀 樆 樛 模组 樫 樾 橉 橍 橐 橯 檀香 檃 檑 檓 檞 檠 檷 櫠 欠佳 欴 欷 欸 欻 欼 欿 歌谣 歌颂 歕 歠 止步 正信 正前方
+107 正太郎 正好是 正子 正座 正答 武则天 武学 武彦 歩兵 歩数 歳上 殊不知 残虐 残雪 殍 殏 殕 殡葬 殢 殣 殪 殭 毃 每套 比企 比比皆是 毛毯 毡 毤 毦 毨 毷 毹 毻 毼 氁 氉 民政厅 民风 气泡 気流 気球 気象台 氘 氪 水井 水俣 水圧 水桶 水泡 水菜 水面上 氷上 氷室 永兴 永吉 永定 永新 永野 求知
+107 求职信 汋 汌 汏 汒 汔 江坂 江田 池州 池袋本 汪洋 汯 汽水 沈殿 沈着 沉沦 沙场 沙尘 沙汰 沙特阿拉伯 沝 没意思 没过 沢井 沪江 沬 沭 河井 沴 油分 油泵 治郎 泅 泒 法力 法理学 泛起 泞 注射器 泰兴 泲 洋平 洋行 洪恩 洬 洯 洴 活人 活儿 浊 浓烈 浓重 浔 浜口 浟 浤 浦口 浩劫 浮力 海星
+107 海津 海神 海航 海马 消亡 消退 涒 涟 涟漪 涡 涡轮 涴 涵义 淊 淔 淕 淗 淟 淡泊 淯 深井 深意 深津 深爱 淲 清大 清川 済州 渎职 渡米 渴求 游学 游船 游说 渺茫 渼 湆 湓 湖西 湠 湤 湩 湷 湸 溒 溓 溜池 溧 溱 溲 溶媒 溹 溾 滏 滐 滑川 滔滔 滖 滫 滭
+107 滴血 滹 漂移 漃 漅 漈 漞 漠视 漡 漩 漩涡 漭 漼 潏 潕 潚 潜心 潩 潫 潮来 潻 潾 澂 澅 澉 澞 澨 澲 澽 激写 激増 激辛 濌 濎 濏 濜 濣 濦 濧 濨 濻 瀀 灌木 灖 灟 灢 火炎 火球 灯塔 灱 灵性 炎上 炔 炘 炷 点到 点对点 炼狱 炼金术 烈日
+107 烈焰 烜 烟囱 烤箱 烧录 烧毁 热切 热气 焀 焄 焓 焠 焥 焮 煚 煮沸 煸 煽情 熇 熉 熏陶 熐 熰 熿 燆 燡 爁 爓 爝 爞 爦 爱不释手 爱得 父兄 片足 版式 牉 牌价 牏 牖 牛群 牞 牟利 牢骚 牧业 物超所值 牳 牵制 牵扯 牵连 牶 牷 特教 特段 犆 犑 犡 犯法 犰 狂喜
+107 狛 狟 狠心 狢 独唱 独栋 独行 狭义 狶 狻 狾 猀 猎物 猒 猖 猛禽 猦 猫头鹰 猵 猾 獊 獙 獟 獠 獯 獳 獾 獿 玃 玆 玉葱 王将 玎 玔 玝 玡 玢 玤 玦 环氧树脂 现役 玵 玹 玼 玾 玿 珋 珍重 珔 珚 珠子 珸 珼 珿 琁 球状 球王 球类 理恵子 理智的
+107 理髪 琌 琍 琐事 琐碎 琝 琤 琭 琱 瑆 瑞金 瑧 瑭 瑶族 瑹 瑽 璭 璯 璲 璶 璻 瓕 瓘 瓜分 瓬 瓾 甃 甋 甐 甓 甘油 生活必需品 生起 生食 用尽 甪 田上 田地 田尻 田沢湖 由実 电感 电表 男鹿 甹 町名 町屋 甽 畈 界线 畎 留念 畛 略带 番话 畹 疎通 疥 疪 痄
+107 病了 病态 痍 痎 痐 痑 痗 痟 痡 痦 痧 痯 瘃 瘈 瘌 瘐 瘔 瘕 瘖 瘵 癹 発振 発火 登高 白雪公主 百世 百岁 百般 皇居 皇甫 皈依 皉 皦 皮肤科 皮膜 盍 盎司 盐水 盚 目元 目盛 目薬 盯上 盯住 盲目的 直也 直入 直方 直系 相交 相依 相公 相映 相本 相知 相称 眉山 看透 眒 眕
+107 真主 真司 真宏 真由 眢 眱 眵 眷属 眼力 眼看着 着任 着迷 睁开 睄 睅 睎 睖 睠 睡魔 瞌 瞚 瞝 瞡 瞢 瞨 瞷 矉 矔 矢板 知世 知春 矨 矫 矬 短篇小说 石像 石和 石块 石崎 石野 砂丘 砃 砉 砑 研二 砝 砡 砢 砥石 砨 砮 破天荒 破局 砵 硈 硬派 硱 硿 碅 碆
+107 碎石 碏 碙 碨 碲 碳水化合物 磢 磨难 磳 礂 礄 礐 礑 礗 礯 礸 礹 祅 祈念 祊 祋 祐介 祑 祖德 神事 神代 神像 神庙 神曲 神木 神武 神气 神経症 神野 祢 祯 祽 禁果 福本 福江 禔 禗 禛 禫 禬 禲 禶 离异 秀水 秘史 秠 积木 秶 移居 稃 稊 稌 稓 稕 稞
+107 稫 稹 稻田 稻米 稿子 穆罕默德 穚 穟 穣 穬 穮 穴位 穸 空难 穿出 穿孔 穿得 突显 窗子 窠 窨 窱 窳 窷 窾 竀 立木 立正 竖起 站稳 竞购 竫 竮 端庄 笎 笑嘻嘻 笑语 笔墨 笛子 笠井 笮 笸 筋骨 筑豊 筥 简史 简章 箒 算不上 箠 管家婆 箬 箱庭 箹 篊 篎 篛 篝火 篣 篥
+107 篨 篻 篾 簉 簋 簏 簐 簥 簭 簳 籥 籦 米格 米老鼠 粀 粄 粅 粉嫩 粊 粌 粗略 粞 精光 精炼 糕点 糖度 糗 糜烂 糸井 糸満 紌 紞 素手 索道 紨 紫外 紻 絁 絇 絊 絏 絒 絔 絜 絻 絿 綎 続伸 綦 綩 綮 綷 総力 総社 緟 縚 縤 縦走 縪 縯
+107 縰 縿 繂 繈 繓 繴 纇 纯度 纷 纸币 纸牌 细看 绋 经得起 结业 结论是 给钱 绚 绝地 绰号 绳索 绿卡 缆 缔结 缚 缝制 缹 缺的 罃 罄 网罗 罜 罦 罽 罾 美由 美神 美空 羕 羖 羢 羧 羷 羼 羽翼 翃 翏 翗 翛 翠绿 翬 翱翔 翴 翾 老妇 老少 老廃 而把 耑 耔
+107 耕一 耛 耜 耴 耶和华 聈 聊聊天 聏 聒 联结 聚首 聱 肉牛 肏 肓 肝功能 股市行情 肫 肭 肮 肯尼迪 育才 肺结核 肺部 胁迫 胂 胆汁 胇 背负 胍 胔 胡瓜 胡说 胳 胵 胸腔 能吃 胾 脓 脚跟 脚踏 脡 脧 脱俗 脱着 脳死 脳症 脸谱 腇 腏 腛 腠 腧 膌 膍 膟 膰 膻 臃 臄
+107 臅 臌 臒 臭気 臼杵 舁 舑 舞步 舞浜 航船 舳 舴 舶来 船内 船票 舽 舿 艂 艕 艖 艚 艞 艟 艰 色白 艿 节育 芑 芚 芛 芞 芢 芦苇 花好月圆 花岗岩 花式 花椒 芼 苏维埃 苕 苜 若想 若非 苪 英一 英雄榜 茌 茤 茥 荂 荎 荨麻疹 药水 荾 莋 莒 莤 莫不 菃 菆
+107 菉 菊水 菑 菛 菢 菣 菩提心 菪 菵 菻 萣 营利 萫 萰 萳 萻 落寞 葇 葐 葑 葙 葛城 葝 葸 葽 葾 蒍 蒙恬 蒝 蒨 蒩 蒰 蒲公英 蒶 蓄意 蓊 蓌 蓔 蓴 蔟 蔾 蕃茄 蕄 蕛 蕝 蕡 蕧 蕴含 蕻 蕼 薄板 薋 薎 薕 薗 薣 薷 薽 藀 藄
+107 藇 藏身 藙 藫 藲 蘛 蘠 蘧 蘮 蘴 蘻 蘼 虋 虌 虍 虓 虚心 虚无 虡 虢 虣 蚅 蚋 蚍 蚙 蚞 蚡 蚧 蚩 蚪 蚴 蚵 蛄 蛅 蛉 蛔 蛖 蛘 蛝 蛞 蛣 蛨 蛩 蛫 蜁 蜛 蜜柑 蜣 蜥蜴 蜪 蜮 蜲 蜸 蜾 蝂 蝖 蝻 螫 螭 螶
+107 螺栓 蟊 蟋蟀 蟑 蟔 蟙 蟛 蟥 蟦 蠃 蠊 蠓 蠝 蠦 蠩 蠯 血迹 行田 行踪 行骗 街坊 街灯 衙门 衣笠 衣衫 补考 表姐 表层 衰减 衱 衴 衹 衼 袒 袓 袖子 袡 袤 被服 袶 袸 袺 袾 裁剪 装满 裉 裋 裎 裒 裕司 裕福 裖 裛 裞 裬 裷 裸足 褡 褦 褭
+107 褯 褱 襁 襆 襌 襘 襙 襚 襛 襜 襡 襢 西伯利亚 西北部 西南部 西文 西楼 西汉 西江 西沢 西谷 西郊 要带 覆面 覇者 覢 覤 覮 视网膜 角质 觝 觠 解决不了 触电 触角 觳 言辞 詄 詅 詴 誂 読破 誻 諀 諈 諕 諨 諰 諻 謆 謑 謓 謣 謥 謧 謪 謯 謱 謶 謷
+107 譂 譇 譈 譋 譓 譠 譺 譿 认错 记号 记述 论断 论题 诋毁 译名 试一试 说不清 请愿 诺亚 调戏 谕 谜语 谢幕 谢意 谷地 谷山 谽 谾 豃 豅 豇 豉 豊作 豋 豗 豩 豯 貐 貚 貣 貤 貥 财务费用 财政支出 责备 贬低 费了 赏识 赤帽 赤木 赫尔 赮 起动 起居 赸 赻 超商 超群 越位 趌
+107 趖 趛 趜 趠 足袋 足部 趶 趷 跅 跌到 跑出 跕 跗 跙 跢 跣 跦 跨国企业 跨度 路途 跳出来 跳起来 跴 踑 踖 踚 踣 踫 踳 踸 蹔 蹚 蹛 蹢 躄 躇 躌 躨 身家 身辺 軗 軘 軜 転校 軨 軱 軿 輀 輂 輖 輗 輘 輠 輣 轕 轗 轚 轞 车床 转世
+107 软化 轶事 轻而易举 输电 辛亥革命 辞世 辨证 边说 辽阔 迁入 迅捷 过人 迎客 这间 远望 连拍 连江 迟钝 迮 迶 退室 退散 退缩 选集 逌 通货 逜 速写 逯 逼供 逿 遄 道场 道子 道长 遝 遮音 遴选 遻 邋 邍 邗 邛 那件 那般 那覇空港 邦訳 邧 邪悪 邪道 邬 邴 邸宅 郁子 郁郁 郇 郘 郠 郣 郥
+107 部室 郩 郫 郬 郭沫若 郯 郲 郳 郺 郻 鄛 鄡 鄩 鄪 鄫 鄯 鄱 鄹 鄾 鄿 酀 酆 配役 酏 酖 酡 酺 醀 醁 醐 醒悟 醗酵 醢 醨 醮 醺 釈放 里斯本 里根 里芋 里谷 野上 野山 野川 野良猫 野茂 量产 金丝 金峰 金日成 金正 金沢文子 金黄 釫 釱 釸 鈂 鈅 鈤 鉊
+107 鉒 鉧 銇 銔 銤 銧 銶 鋉 鋍 鋎 鋲 鋷 鋹 鋺 鋾 錋 錤 錥 鍡 鍪 鍭 鍹 鎎 鎞 鎱 鏕 鏮 鐆 鐱 鑅 鑈 鑮 鑯 鑶 钃 钒 钜 钦佩 钴 铀 银座 锋芒 镀金 镇静 镻 长方形 长期负债 閍 閐 闣 闯关 闲暇 阅兵 防制 防备 防风 阴天 阴蒂 阵线 阿克苏
+107 阿寒 阿波罗 附帯 附着 降雨量 陎 限制级 陓 院落 陴 陵墓 陷于 陾 隅田川 隆夫 隇 随一 隐居 雄性 雄鹰 雅典娜 雅史 集散地 集金 雌雄 雑木林 雔 雚 雥 雨伞 雨具 雪人 雪村 雪茄 零度 雽 露光 露营 青松 青椒 靖子 静悄悄 面霜 面食 靭 鞋底 鞠躬 音波 韹 韺 頀 頍 頫 顕彰 顗 顜 顣 预感 预知 预祝
+107 颐和园 飋 风沙 风车 风速 飞出 飺 餪 餭 餯 餲 饎 饐 饔 饖 饘 饛 饥 饭馆 饮茶 饲 饵 馅饼 馋 馍 駂 駋 駌 駓 駗 駴 駹 騇 騜 騣 騴 騺 驆 驈 驖 驩 马里 驳斥 驻军 骄阳 骨肉 骨质疏松 骿 髁 髂 高信頼 高呼 高效能 高根 高森 高炉 髜 髲 鬅 鬌
+107 鬖 鬙 鬫 鬵 鬺 鬼平 魄力 魆 魒 魔咒 魔镜 魙 魦 魬 魰 魶 鮀 鮕 鮡 鮿 鯸 鰔 鰝 鰬 鱄 鱱 鱴 鱵 鱼头 鱼雷 鲜美 鲤 鳻 鳼 鳿 鴀 鴄 鴅 鴐 鴔 鴙 鴥 鵌 鵙 鵟 鶒 鶜 鶝 鶭 鶱 鶳 鷃 鷇 鷌 鷎 鷑 鷕 鷛 鷡 鷢
+107 鸃 鸄 鸓 鸙 鸦片 鹿毛 鹿港 鹿野 麃 麇 麡 麦芽 麶 麻奈美 麻省理工 黄疸 黄豆 黑了 黑影 黒岩 黒潮 黒石 黓 黔江 黚 黛玉 黝 黠 黤 黭 黻 鼀 鼏 鼙 鼢 鼩 鼸 鼻祖 鼽 齌 齱 齾 龙女 龙眼 龙骨 龠 龢
+108 一万个 一九九五 一九九六 一二三 一动不动 一天到晚 一戦 一无所有 一昔 一睹 一筹 一纸 一苦労 一蹴 一酸化 一顶 丁山 丁香花 七生 万寿 万座 万桶 三十六计 三味 三四郎 三塁 三石 上伊那 上信越 上边 上过 下士 下松 下榻 下海 下腹部 下院 丌 不下来 不出去 不在此限 不妊症 不愧是 不成立 不放过 不毛 不管怎么 不缺 不羁 不跟 不遇 不配 不限于 与原 丑小鸭 东张西望 东明 东洋 両氏 丢脸
+108 中公 中心地 中流 中研院 中经 中起 丳 丹沢 丹田 为非 主人翁 主席台 主语 久住 久居 久野 乐透 乖巧 乙酸 九三 乞食 也去 习性 乡情 买一送一 亀有 亃 了望 了结 予稿 二塁 二流 二版 云彩 云霄 五保 五十八 五子棋 五常 五所川原 五折 五步 井村 亚热带 亜矢子 京浜 京田辺 京町 亮光 亮太 亮晶晶 人形町 人脉 人身自由 人道的 仁义 仁志 仁者 仉 今田
+108 从化 仕訳 仙北 仙道 仜 以色列人 仩 仳 仿制 伂 伄 伈 伋 伏在 休息室 伓 伔 伙食 传讯 伬 伻 似是 伽利略 低潮 低血糖 何苦 佖 余市 佛寺 佛祖 作主 作付 作揖 佤 佩带 佪 侉 例证 侍女 侐 侕 侚 依偎 依序 依旧是 依田 侞 侵食 便衣 便覧 俅 俉 俊二 俊太郎 俊明 俋 俍 俙 俛 俜
+108 保守派 保母 保水 保驾 信之 信和 信彦 信楽 俷 倎 倒卖 倒立 倓 倕 借了 借给 倯 倰 倱 倶知安 假若 假面 偏激 偏移 偏远 停售 停车位 偢 偤 健一郎 健健康康 健壮 健次 偪 偳 偷了 偷偷地 偷懒 偷窃 傃 傌 傍観 傮 傱 傶 傸 僋 僗 僤 僪 僵化 僽 僿 儆 儢 儤 儦 儩 儭 儮
+108 儱 儵 元培 光化学 光波 光雄 兔毛 兕 党外 入内 全息 全盛 全速 八丈 八名 八宝 八岁 八次 八潮 八田 八神 公允 公家 公鸡 六道 兰克 共犯 关中 兴盛 兼有 兼治 内戦 再出 再回到 再往 再想 再打 冘 军装 农工 冞 冤案 冬虫夏草 冰块 冰心 冷眼 冷艳 凄惨 减税 凑热闹 凔 凶狠 出入国 出山 出稿 出羽 出过 击落 击退 刀剣
+108 分不清 分外 分派 分队 切花 刌 列强 刘邦 判官 利夫 利川 别了 刮目相看 制圧 制程 刷刷 刷毛 刺猬 前例 前夫 前歯 剟 剢 剭 剺 剻 劁 劄 劓 力促 力道 劝阻 加纳 劣等 动能 助人 助威 劬 劳伦斯 勂 勷 包帯 包揽 包退 匎 匑 化装 北城 北田 北街 北西部 北郊 北都 匜 匟 匪夷所思 匰 十五岁 十全 十六岁
+108 十次 千家万户 千恵子 千秋楽 半场 半山 半永久 半空 华亭 华工 单子 南会津 南半球 南朝 南都 南都留 単色 博史 卫队 印加 危害性 即死 即答 卷轴 卸下 卼 历险 厌烦 厔 厗 原口 原寸 原意 原水 原罪 厬 参列 参赛者 友禅 双刃 反战 反潜 反落 发火 发自 发自内心 发芽 变卖 变性手术 变通 叛乱 口唇 口実 口角 古罗马 只留下 叫醒 可先 可恨 可把
+108 可被 台塑 叱咤 史実 史无前例 右下角 吃上 吃人 吃醋 各県 吉日 同位素 同年代 同理 名嘴 名瀬 后头 吐息 向原 吓坏 吕布 君臣 吞下 吤 吪 含意 呁 呅 呏 呕 呣 周文 周济 周防 呺 呾 咇 和信 和合 和哉 和宏 咠 咡 咢 咶 哀伤 品茶 哆嗦 哗 哥斯达黎加 哭得 哲男 唁 唃 唋 唌 唐伯虎 唐装 唒 唗
+108 唦 唪 唱得 唼 商学 商展 啈 啒 啕 啽 喁 善悪 喈 喊了 喊出 喑 喕 喜怒哀楽 喤 喦 喭 喷出 喷头 嗂 嗃 嗅觉 嗍 嗼 嗿 嘂 嘉禾 嘉穂 嘏 嘐 嘝 嘳 嘺 噈 器楽 嚘 嚪 嚫 嚾 囊中 囌 囔 四万十 四万十川 四代 四天王 四部 回声 回教 回老家 园丁 図案 固守 国平 国府 圁
+108 圈里 圊 圌 圞 土工 圣保罗 圣灵 在世 圬 圭介 地坪 地平 坉 坋 坐牢 坒 坚持下去 坡度 坰 坱 坲 坵 坶 垀 垂下 垄 垌 垏 垗 垣根 垤 垶 垺 城下 埬 埶 堀越 堁 堈 堉 堌 堕胎 堠 堧 堩 堭 堮 堶 堷 堿 塌陷 塎 塕 塛 塞进 塩水 塯 塶 塽 塿
+108 境目 墋 墎 墐 墑 墔 墥 墨镜 壧 壮年 声名 声学 夆 备件 夏娃 外债 外気 多年草 多花 多起 大公报 大包 大名鼎鼎 大和郡山 大嫂 大志 大提琴 大教堂 大昔 大破 大肠 大道芸 天下一 天宝 天晴 天母 天涯海角 天皇陛下 天竜 天魔 夫君 失身 头等 夺走 夼 奇想 奈津子 奈美恵 奈落 奉上 奜 奭 奰 奱 奴役 好学 好累 奿 妀 妁
+108 如若 妄言 妅 妎 妐 妓院 妘 妠 妡 妱 姅 姎 姑息 委外 委婉 姖 姫君 姫川 姭 姮 姺 姼 娊 娖 娙 娹 婈 婍 婑 婒 婚事 婛 婠 婤 婩 婰 婻 婽 媄 媔 媝 媞 媰 媱 嫁人 嫙 嫥 嫩肤 嫬 嫴 嫸 嬂 嬇 嬏 嬧 嬯 嬽 子供部屋 孝一 孝敬
+108 学识 孮 孷 孻 宇佐美 宇文 守门员 安之 安信 安土 安居乐业 安川 安葬 安详 宎 定之 定山渓 定式 定要 审阅 客数 宣战 宣示 室伏 宨 宬 害处 害得 宴席 家常菜 家相 容子 宽的 寄到 寄居 寊 寋 富国 富樫 富足 寑 寒河江 寙 寡妇 寪 对得起 寻访 対数 対流 寿光 封包 専売 尃 将之 尊崇 小久保 小六 小姨子 小数 小次郎
+108 小津 小燕子 小玩意 小白菜 小皿 小竹 小箱 小羊 小老鼠 小虫 小记 小贩 小郡 小鹿 尘土 尼姑 尽其 尾上 局外人 居首 展露 屘 屝 屠夫 屡次 屪 屭 山歌 山脊 山药 山高 山鹿 岉 岊 岏 岒 岓 岕 岢 岩松 岳母 岳父 峆 峊 峓 峛 峞 峟 峤 峮 峰山 峿 崵 崸 崹 崺 嵅 嵑 嵕 嵧
+108 嵨 嵱 嵷 嵹 嵺 嵾 嵿 嶊 嶙 嶞 嶭 嶯 嶰 巀 巂 巆 巑 巘 巟 巡抚 巡査 工头 工钱 左上角 巨像 巨响 巨峰 巨木 差一点 差劲 差引 巴拉圭 巴拿马 巴掌 巷子 巹 市村 市松 布地 布教 帔 帗 帟 帠 帢 帯出 常会 常去 常客 帾 幁 幌子 幕僚 幝 幧 幨 幪 幯 干拓 干潮
+108 年糕 并称 幸太郎 幻覚 庈 庉 庋 序文 底力 底辺 店员 府民 庭园 庰 庲 庳 康定 康成 康明 康雄 廋 廎 廑 廕 廜 廦 廱 建业 开去 开国 开运 弁天 弁明 异步 弇 弊病 弓箭 引出物 引得 弘之 弝 张飞 弥勒 弧形 弨 弯腰 弹道 强奸犯 强盛 弾道 当人 当阳 彔 彯 影山 影象 彸 彼岸花 彽 往昔
+108 征服者 徆 很遗憾 従属 得救 得过 徛 徟 御影 御池 徥 徦 微积分 微薄 微调 徯 徲 徶 德行 徾 心子 心室 心念 心意気 心房 心眼 心经 必先 忉 忌避 忍痛 忐 志同道合 志田 忘我 忙得 応接 忠和 忠臣蔵 忣 快跑 忭 忯 忳 忴 念书 忽地 怋 怗 怜悯 怠惰 怡人 怢 急功近利 急死 怨言 怫 怭 怮 怳
+108 总司令 总干事 恀 恉 恋心 恋恋 恓 恘 恧 恭维 恮 息吹 恵一 恵庭 恿 悀 悁 悉心 悔恨 悠扬 悠游 悢 患部 悪天候 悱 悹 悺 悾 悿 情商 情愿 惆怅 惊吓 惍 惏 惨剧 惩戒 想不通 想好 惶恐 愃 愈加 意料 愒 愚人节 愚弄 感化 愩 愿景 慆 慎一 慎二 慏 慡 慢跑 憃 憛 憢 懈怠 懖
+108 懠 懦弱 懪 戃 戄 成家 成本核算 我像 我想去 戤 戥 戦没 截留 戭 戸隠 戽 所感 扁桃 手把 手提袋 才干 打伤 打坐 打手 打气 打碎 扔到 托克 托福考试 扥 执勤 执意 扫除 扭力 扳手 扴 批文 扻 技量 抁 抎 抏 抑制作用 投了 投奔 投薬 折断 折込 抪 披上 抯 抰 抱负 抳 抶 抽搐 担子 拉升 拉手 拒收
+108 拓郎 拘置 拙者 拭目以待 拱手 拷问 拹 拺 拻 拿手 挀 持之以恒 挃 指値 指头 挏 挔 挥发 挲 挳 挴 挺拔 捁 捃 捇 捑 捕鱼 捖 捘 据付 据点 捰 掁 掅 掉下 掊 排长 接下 掫 掮 掱 掽 揂 揅 揇 揈 提个 揓 揕 揟 揫 揯 揰 揱 揵 搊 搋 搌 搐 搕
+108 搣 搬上 搮 摃 摎 摔角 摙 摝 摦 摫 撋 撙 撞球 撰稿 撰稿人 操守 擙 擛 擦亮 擩 擫 攉 攠 攡 攦 攳 收下 收割 收复 收纳 攻破 攽 放在心上 放款 放生 政制 政变 敁 故名 敊 救世 救主 救赎 教义 教代 教教 散在 散漫 敥 敦化南路 敬重 数珠 敳 整夜 敿 文雅 斑马 斖 斗牛 斜坡
+108 斜里 斠 斢 斧头 断层 断开 斮 新制 新北 新台币 新堂 新浦安 新纪元 新衣 新酒 斲 施放 旅路 旐 旖 旗号 旗手 旚 旝 旟 无异于 无礼 无缺 既得 日光浴 日本刀 日没 旧型 旧式 旨意 早口 早瀬 旳 时差 旼 旽 昄 昈 昌弘 明令 明彦 明道 星相 昡 昢 昤 春日町 春来 春水 春花 春菊 昭夫 昭文 昭示 昹
+108 昼前 晃一 晅 晊 晚霜 晚霞 晩酌 景子 晱 晴子 晶石 智恵 晻 暂且 暊 暔 暕 暗喜 暗影 暗淡 暩 暪 暯 暴徒 暽 曀 曌 更迭 曶 曹雪芹 替用 最南端 最大值 最远 朅 有利子 有加 有失 有心人 有福 有道 朝气 朠 木内 木匠 木町 木耳 未亡人 未受 未尝 未得 未成 末吉 本丸 本殿 本署 本草 朱熹 朳 朸
+108 机灵 朾 杀气 杀虫剂 杂草 杂记 杂费 杂项 杇 杌 杍 杏仁豆腐 杏里 杕 条理 来去 来车 杨树 杶 杹 杺 杻 杽 松任谷 松懈 松散 板垣 板子 板桥 枇杷 林原 林口 林田 枙 果菜 枯萎 架上 枺 柀 柃 柋 某日 柔柔 柣 柧 柸 柼 栒 栗本 栘 栚 栝 栢 栳 样机 核子 根部 栻 桂木 桍
+108 桜川 桜町 桥头 桯 桶川 桶装 桻 桾 梀 梉 梌 梑 梒 梖 梣 梨奈 梫 梬 梮 梯次 梼 棋盘 棐 棑 棓 棔 棞 棦 棫 森林浴 棯 棸 椄 椊 椌 植草 椐 楏 楑 楖 楟 楡 楪 楰 楱 楴 榃 榓 榚 榜眼 榣 榳 榼 槥 槴 槶 槷 槾 樄 樇
+108 樍 樒 樕 樖 樝 樠 樨 横尾 横跨 横道 橁 橆 橑 橛 橞 橧 橭 檇 檌 檍 檒 檖 檚 檛 檡 檥 檦 檨 檶 櫆 櫇 櫙 櫹 欀 欂 欘 欚 欭 欯 欱 欳 款款 歂 歅 歈 歖 歜 止水 正之 正副 正博 正取 此起彼伏 步履 武夫 武志 武进 歭 歼灭 殀
+108 殂 殄 殎 殗 殠 殥 殧 殳 毇 毉 母国 母语 每一件 每份 每名 每星期 毐 比嘉 比比 比起来 毕生 毖 毚 毛线 毛蟹 毢 毰 毸 氄 氆 氍 民声 民宅 民族自治 民权 民调 气概 気化 氚 氧化物 氯化 水土 水浒传 水筒 水郷 氷点下 永世 永平 永福 氻 氿 求证 汃 汉江 汉阳 汍 江上 污垢 汥 汩
+108 汪汪 汫 汳 汸 汾阳 沀 沄 沉沉 沉醉 沉静 沋 沘 沙弥 沚 没収 没找到 沢尻 沦落 沰 河豚 河辺 沶 沸沸 治本 泀 泃 泇 泏 泐 泔 法郎 法隆寺 泖 泜 泡茶 泥酔 注水 泩 泰子 泹 洀 洋裁 洑 洗完 洗米 洗衣粉 洠 洢 津南 洧 洨 洪流 洲本 洳 活出 浀 流木 浄土 浡 浢
+108 浭 浴巾 海参 海山 海带 海戦 海潮 海豹 海道 海门 海鸥 海龟 浺 浽 涄 消瘦 涌出 涍 涐 涑 涫 涷 涺 涽 涿 淐 淰 深入基层 深有 深浅 深藏 渀 渃 清廉 清正 清静 渉外 渗入 渗透到 渜 渡来 温床 渫 渮 渰 游击 渺小 渻 湉 湋 湑 湚 湝 湥 湦 満潮 溃 溗 溙 溜息
+108 溜达 溠 溮 溶血 滍 滑冰 滑行 滒 滜 满载 滮 滵 滼 漍 漎 演者 漟 漧 漰 漶 漹 漺 漻 潀 潎 潓 潠 潳 潶 潽 澌 澐 澔 澖 澡堂 澫 澰 激光器 激変 激昂 激痛 濝 濴 瀌 瀔 瀖 瀙 瀣 瀬名 瀱 瀴 灊 灗 灡 灥 灦 灩 火化 火口 火把
+108 火药 火龙 灯管 灺 灾祸 炃 炆 炑 炓 炡 炭疽 炮火 炱 点睛 点菜 烆 烊 烑 烚 烟消云散 烟灰 烧饼 烸 烼 烿 焂 焎 焐 焘 焞 焣 煃 煋 煍 煐 煘 煤焦 煰 熆 熥 熨斗 熪 熯 熽 燇 燋 燏 燖 燘 燛 燢 燰 爆满 爇 爊 爌 爔 爚 爢 爱财
+108 父女 片尾曲 版元 牊 牧歌 牬 特约记者 牾 犈 犌 犗 犣 犯科 狂妄 狂想 狂笑 狂跌 狊 狑 狗屁 狙撃 狚 狦 狪 独居 狰狞 猋 猌 猛攻 猜拳 猜疑 猣 猭 猱 猺 獂 獚 獩 獬 玂 玄奘 玄门 玅 玉井 玉城 玊 王后 王小波 玖珂 玗 玛莉 玩意儿 玩物 玭 环岛 环形 环球小姐 现年 玴 珆
+108 珍爱 珍珠港 珓 珝 珧 珶 球拍 球门 球鞋 琄 理工科 理清 理直气壮 理睬 琊 琖 琠 琡 琣 琩 琴平 瑂 瑎 瑏 瑐 瑞浪 瑞雪 瑢 璊 璗 璠 璡 璱 璿 瓋 瓗 瓝 瓻 瓿 甂 甘心 甘愿 甘木 甘酒 甚至于 甝 生硬 甡 甮 田嶋 电冰箱 电瓶 男色 町営 町家 画伯 画卷 甿 畇 畑中
+108 留个 留空 畟 略图 疑点 疕 疘 疙 疰 疶 疺 疻 痁 病原菌 病友 症候 痋 痌 痏 痝 痴呆症 痷 瘱 瘳 瘼 癃 癐 癙 癠 登米 白地 白昼 白浪 白猫 白细胞 白羊 白肌 白露 百十 百合花 百恵 百草 癿 皆为 皊 皒 皕 皮衣 皽 盄 盗作 盛况 盛期 盛赞 盝 盬 目処 盲学校 盳 直哉
+108 直球 盵 相位 相加 相宜 相容性 相望 相良 省事 省去 省道 看客 県土 眐 眓 真壁 真子 真正面 真相大白 真髄 眩晕 眷恋 眼色 眼袋 睋 睌 睟 睦月 睭 睮 瞀 瞧不起 瞫 瞱 瞴 瞺 矂 矊 矛头 矜持 矠 知力 知名人士 知心 短命 矰 矱 石壁 石子 石室 石棉 石灰岩 矺 矻 砂岩 砅 砎 砏 砐 砖块
+108 砪 砫 砯 砱 砳 砸了 硠 硩 硫磺 硬度计 硬性 硭 确凿 硰 硾 碔 碪 碰碰 磁化 磁界 磅礴 磈 磟 磠 磥 磨擦 磭 磹 磻 磼 磿 礅 礉 礛 礜 礝 礣 礧 社会学部 社刊 社寺 祌 祏 祐希 祒 神剑 神勇 神埼 神尾 神来 神灵 票面 祭礼 禀 禁制 禈 禋 福寿 福气 福沢
+108 禒 禓 禖 禢 禤 禭 禸 离散 秀山 秉持 秋子 种群 科举 秦岭 秪 秺 税负 稒 稘 稛 稦 稯 稰 稲妻 稲敷 穄 穇 穘 穜 穧 穱 穵 空山 突厥 突飞猛进 窃听 窅 窋 窌 窐 窘境 窣 窫 窬 竁 立地条件 竘 站住 竹木 竽 笒 笤 笪 第六节 笵 等效 筎 筑前 筘 筡
+108 筣 筩 筬 筳 筸 箂 箅 箈 箊 算术 算盘 算起 箪笥 箯 箱崎 箵 箷 篜 篟 篪 篫 篲 篴 篸 篽 篿 簂 簃 簊 簎 簙 簝 簟 簼 籓 籧 籯 米兵 类比 粒径 粒状 粴 粻 粼 精妙 糋 糐 糱 糷 紎 紟 索尔 紫苑 累死 紸 紽 紾 絓 絟 絩
+108 絫 絯 絺 綖 綟 緀 緅 緆 緎 総代 緛 緪 緰 緺 縌 縒 縓 縜 縡 縥 縼 繁杂 繐 繟 纗 红卫兵 红宝石 红树林 红薯 纱布 纽扣 经络 绒毛 结核 统帅 绥 维纳斯 绿树 绿灯 缕 缘由 缘起 缨 缩影 缴获 缺憾 罋 罚球 罝 罞 罪状 罪过 罬 罭 置疑 羁押 羉 美作 美浦 美语
+108 羜 羞愧 羠 群生 羰 翂 翇 翉 翥 翻一番 翻滚 翻过 老一辈 老张 老母 老翁 老道 耇 耎 耏 耖 耞 耡 耤 耩 耪 耳饰 耳鼻喉科 聚在一起 聚氯乙烯 聤 聴力 聸 肆无忌惮 肉麻 肊 肌色 肐 肒 肙 肜 肝胆 肣 肥肉 胉 胐 胘 胡思乱想 胡须 胲 胹 能楽 能耐 脕 脚上 脚色 脝 脥 脬 脭
+108 脱口 脱节 脳波 脺 脾胃 腃 腊月 腍 腕前 腞 腢 腯 腶 腹中 腾达 膗 膞 膫 膹 臆病 臐 臗 臙 臞 自然体 自称是 自责 臭味 臮 致意 致歉 臸 臹 舄 舒心 舞女 舢 舥 舨 舯 舺 舼 艀 艉 艋 艗 艜 艡 艣 艩 良平 艽 节目表 芅 芎 芐 芤 芧 花弁 花形
+108 花房 花旦 花枝 花盆 芴 芵 芶 芸者 苀 苃 苏轼 苏醒 若无 苰 英司 英烈 茂盛 茅台 茇 茛 茞 茢 茪 茳 茶会 茶房 荈 草刈 草草 草药 草野球 荏原 荑 荒凉 荒诞 荓 荣昌 荪 药理学 荷包 荷尔蒙 荸 荺 莅 莕 莗 莛 莝 莣 莦 莫札特 莯 莰 获救 获释 莿 菅谷 菊芋 菋 菌糸
+108 菜子 菞 菧 菬 菮 菱形 菳 菶 菼 菾 菿 萆 萏 萛 萭 萲 萴 萹 萺 落落 葀 葂 葄 葍 葚 葥 葧 葶 蒆 蒙受 蒙蒙 蒚 蒛 蒠 蒢 蒺 蓇 蓎 蓏 蓒 蓟 蓱 蓲 蓳 蓹 蓾 蔌 蔏 蔜 蔱 蕑 蕸 薂 薃 薄纱 薄薄的 薆 薉 薐 薠
+108 薧 薬味 薮 薱 薵 薶 藂 藆 藏着 藒 藗 藞 藟 藡 藢 藣 藤井寺 藤山 藤谷 藦 藨 藬 藭 藯 藰 藸 蘤 蘦 蘱 蘹 虈 虒 虖 虚报 虚荣 虭 虮 虰 虳 虼 蚆 蚎 蚐 蚔 蚗 蚘 蚢 蚣 蚥 蚨 蚯 蚱 蚷 蛂 蛇足 蛈 蛎 蛓 蛜 蛢
+108 蛦 蛵 蛶 蜂拥 蜄 蜋 蜌 蜠 蜦 蜬 蜭 蜺 蝁 蝇 蝋 蝒 蝫 螁 螉 融雪 螬 螸 螺母 蟃 蟌 蟗 蟞 蟟 蟤 蟧 蟭 蠌 蠕动 蠛 蠜 蠥 蠫 蠮 蠷 蠼 蠽 血泪史 衃 衈 衋 行不行 行不通 衎 衖 衣架 衣食 补习 补血 表彰台 衬托 衭 衯 衽 袀 袁世凯
+108 袉 袋鼠 袌 袎 袲 袼 装作 裗 褧 褩 褵 褷 褼 褾 襋 襑 襒 襱 襺 西伊豆 西周 西成 西新 西林 西线 西面 要写 覂 覇王 覜 覞 覣 覭 観月 覶 角球 觓 觢 触発 觩 觱 觲 言霊 訄 訏 訞 訬 訹 詍 詙 詶 誁 誖 諘 諙 諟 諿 謈 謋 謏
+108 謞 謢 謤 謮 謽 譆 譊 譐 譥 讋 认罪 议长 记录下来 记起 论谈 诃 谅 谚语 豆类 豊崎 豊明 豖 豚汁 豜 豝 豟 豥 豦 豨 豱 豷 貁 貑 貒 貗 貜 貵 賏 賗 賝 賥 贆 贉 贕 贙 负伤 责怪 败坏 账单 贵妃 贵金属 赏心悦目 赔款 赛跑 赤子 赤毛 赯 走下 走者 赶出
+108 起死回生 起毛 赹 趀 超然 趉 越低 越来越好 趒 趥 趪 趫 趬 趭 趿 跃进 跆 跇 跌入 跍 跎 跓 跜 距今 跩 跪下 跬 路基 路易斯 跳跳 跽 跾 踀 踕 踥 踼 踾 蹀 蹅 蹍 蹓 蹗 蹡 蹪 蹯 蹳 蹴球 蹸 蹻 蹿 躆 躈 躐 躗 躘 躣 躩 躺下 躽 軂
+108 軏 軞 軡 軧 軴 輁 輎 輚 輵 輶 轃 轈 轋 轐 轑 轒 轓 转生 轮子 轮换 轻功 轻快 轻音乐 辛味 辨析 辱骂 辴 辽河 过不去 过冬 过敏性 过道 过问 迈阿密 迉 迍 迎风 运通 迓 返校 还怕 还清 还让 连声 连队 迟缓 迼 追上 追分 追到 追従 追撃 追溯到 迾 退路 退避 送别 送検 逃命 选号
+108 逊色 通气 造出 造化 造物 造谣 逤 逭 逴 逼得 遍歴 遏止 道程 道里 遫 遮掩 邙 邟 邠 邡 那篇 那首 邥 邦子 邲 邶 邽 郁夫 郈 郖 郡川 部将 部属 郰 郹 郼 郾 郿 鄁 鄇 鄈 鄎 鄐 鄗 鄚 鄜 鄝 鄠 鄣 鄦 鄨 鄳 鄻 酁 酄 酋长 配点 酒鬼 酗 酟
+108 酤 酨 酸菜 醄 醆 醊 醰 醳 釂 釈迦 里村 里菜 重工业 重担 重拾 重文 野宿 野尻 量刑 量表 金榜 金港 金相 釴 釽 鈇 鈌 鈗 鈙 鈜 鉄塔 鉆 鉎 鉏 鉐 鉡 鉰 鉲 鉼 銊 銌 銎 銙 銡 鋀 鋄 鋋 錉 錎 錓 錖 錪 錼 鍉 鍱 鍻 鎃 鏒 鏔 鏣
+108 鏬 鐌 鐍 鐕 鐬 鐻 鑀 鑉 鑏 鑗 鑨 钟点 铐 铝箔 铿锵 锻 镺 镽 长成 閛 閞 閵 闑 闚 门市部 闪闪 问号 闸门 闹事 阅历 阚 阞 阢 阬 阰 防身 防雨 阳春 阻拦 阻隔 阿拉伯语 陀螺 陈毅 陈独秀 陊 降了 陜西 陡然 除掉 险恶 陫 陶冶 陶然 陼 隀 隆司 隆志 隆行 随缘 隑
+108 隔天 隔开 隩 隬 隮 难免会 难解 雂 雄一郎 雄三 雅各 集荷 雊 雑用 雨后 雨夜 雨点 雨衣 雱 霊感 霓虹灯 霘 霝 霠 霨 露呈 露底 霵 霺 霿 靇 靋 青天 青涩 青野 非议 靬 靮 靰 靲 鞚 鞡 鞤 鞥 鞪 鞻 韇 韖 韗 韘 韝 韡 韥 韰 音羽 韾 顁 顃 顅 顈
+108 顊 顑 顤 顶了 顷 项羽 颁奖仪式 预想 颠簸 颩 颬 颾 颿 飂 风浪 飘移 飞去 飞鹰 餇 餤 餥 餫 餰 饟 饡 饤 首台 首航 香河 香油 香火 馜 馡 馣 馫 馰 馺 馻 駃 駉 駎 駏 駖 駥 駧 駮 駷 駺 騃 騑 騔 騝 騞 騬 騲 騹 騽 騿 驎 驒
+108 驔 驠 马术 马来 马赛 马龙 驮 驻华大使 驻扎 骂了 骅 骏马 骳 骹 骻 髐 高保 高傲 高喊 高嶋 高工 高斯 高歌 高深 高烧 高生 高畠 高石 高速度 高院 高高在上 髬 髳 髹 鬈 鬎 鬐 鬑 鬗 鬞 鬳 鬷 鬼才 魂魄 魊 魌 魔羯座 魖 魟 魠 魤 魱 魼 魽 魾 鮂 鮅 鮇 鮈 鮢
+108 鮯 鮴 鮵 鮸 鮽 鯈 鯓 鯗 鯙 鯚 鯠 鯱 鰋 鰯 鰶 鰿 鱐 鱕 鱞 鱮 鱳 鱿鱼 鳖 鳪 鳱 鳺 鳽 鴗 鴮 鴸 鴽 鴾 鵔 鵘 鵛 鵨 鵫 鵳 鵵 鵸 鵹 鶀 鶐 鶔 鶛 鶶 鶷 鶾 鷅 鷋 鷏 鷒 鷘 鷝 鷨 鷩 鷰 鷷 鷾 鸀
+108 鸅 鸆 鸉 鸐 鸑 鸔 麆 麎 麔 麠 麭 麮 麰 麻婆豆腐 麻帆 麻花 黄酒 黏膜 黑格尔 黑金 黕 黖 黦 黧 黫 黯淡 黵 黺 鼆 鼒 鼓动 鼛 鼞 鼪 鼫 鼱 鼻涕 齀 齇 齈 齉 齐声 齖 齥 齫 齯 齸 齹 齺 龌龊 龘 龙井
+109 一变 一向是 一命 一尊 一席 一打 一提 一朝 一档 一氏 一生一世 一码 一翻 一语 一锅 七味 七夜 七宗 七年级 七草 万万 万世 万历 三光 三千元 三友 三字 三支 三朝 三池 上前去 上牌 上篮 下川 下端 不乱 不二子 不切实际 不外乎 不如意 不孕症 不寻常 不忠 不悦 不惑 不惧 不愁 不感兴趣 不择手段 不散 不敬 不発 不落 专一 丘珠 东吴 东安 丝绒 两军 两分钟
+109 两国人民 两极 两段 严酷 中下游 中卫 中叶 中暑 中选 中阳 丮 丰产 串通 临界 丹佛 丹尼尔 主产 主峰 主菜 主轴 久雄 之乐 之宝 之流 之爱 之身 乌尔 乌龙茶 乐谱 乔木 乗鞍 乘船 九折 九星 乡里 书柜 书桌 买主 乱立 乿 亄 争先 争取到 争辩 二名 二女 二年生 二班 二甲 二院 亏本 五五 五八 五国 五家 五层 五指 五日市 井筒 亡者
+109 交感神経 亦同 产前 京介 亮子 亲笔 人声 人工授精 人文地理 仁和 仁爱 今津 今般 仍要 仏事 仚 代售 代官 代弁 代步 企盼 伊坂 伊宁 伊美 伊都 休养 会友 会食 伝奇 伪科学 伸直 似曾相识 似非 佁 佉 低地 佒 体罚 余光 余命 余波 余生 余程 佛性 作怪 作息 作爱 佡 佧 佳境 佳奈 佳音 使然 侁 侄女 侅 例句 侏儒 供血 侜
+109 侻 俀 俇 俓 保科 信也 信利 信心十足 修习 修平 修路 修辞 倇 倒不如 倒不是 倒影 倒置 倛 倠 倧 倵 倾注 假使 假药 偊 偏食 做菜 偞 偟 偡 健介 健美操 偩 偫 偶有 偶発 傂 傇 傋 傎 傒 傲人 傽 傿 僁 僓 僶 僸 儇 儌 儥 儰 儿媳 元利 元朝 元来 充份 充其量 充満 先烈
+109 光之 光光 光太郎 光男 光量 免状 兜售 入伙 入射 入山 八幡浜 八幡町 八幡西 八日市 八百屋 八级 八路 公一 公使 公国 公墓 公差 公职 公言 六国 六岁 六期 六甲山 六花 共事 兴高采烈 兵刃 内乱 内壁 再也不会 再燃 再読 冔 军士 军费 农化 冰雹 冲绳 决选 冷气 冷遇 冷饮 冹 净水器 凉水 凊 凋零 凎 减慢 凑合 凗 凝望 凝血 凡有 凭借着
+109 凶暴 凶残 凹陷 出合 出版界 出狱 出血性 出金 函件 刀刃 刁蛮 分団 分寸 分掌 分水岭 分贝 切切 切口 切腹 刈羽 刐 划时代 创投 创纪录 初八 初等教育 刞 刡 利克 利剑 利多 利己 利空 利落 别拿 刱 券売 刺眼 刺耳 剆 剉 前哨 前庭 前掲 前晚 前沢 剪接 剬 劘 力不从心 力所能及 劝告 功绩 加法 加注 助演 劮 劻 勉励 勉学
+109 勤工俭学 勼 勿忘 包涵 匉 匊 匋 匒 北魏 匼 十万个 十二支 十全十美 十六夜 十和田湖 十年后 十指 十文字 十月份 十楼 千住 千晶 千田 千里眼 卆 午睡 半截 半空中 协和医院 卑微 单手 单选 南向 南河内 南里 博大精深 卡式 卡路里 卧虎藏龙 卧铺 卯月 印刷所 危难 却因 厄年 厄瓜多尔 历练 厉声 厊 厎 厏 厒 厚岸 厚板 原典 原封 厧 去接 去留 友和
+109 友子 反手 収受 収用 发炎 发片 受命 受用 受辱 变故 变更为 口里 古寺 只可 只差 只限于 叫喊 可哀相 可塑性 可没 可逆 台糖 史家 司空 叹气 吃素 合川 合拍 合格者数 合生 合著 同室 同属 同族 同治 同道 名句 名寄 名犬 吘 吙 君嶋 吜 吟唱 听见了 吰 吴国 吷 吸气 吹上 呇 告辞 呠 呡 呥 周全 周瑜 命脉 呿 和田町
+109 咑 咖啡杯 品数 哎哟 哥本哈根 哲人 哷 哺育 售票处 唱了 唱腔 啄木 啑 善事 善人 善光寺 善通寺 喒 喜乐 喜帖 喜怒哀乐 喝完 喝得 喡 喣 喥 喧哗 喧騒 喷洒 嗀 嗅覚 嗋 嗺 嘄 嘉奖 嘒 噅 噜 器乐 噿 嚁 嚃 嚄 囚禁 四合 四岁 四步 四苦八苦 四街道 回响 回忆说 回荡 因人而异 図柄 国之 圔 土石流 圠 圣女 圣诞礼物
+109 地瓜 地窖 坑道 坭 垘 垞 垟 垥 垹 垼 垿 埁 城楼 城郊 埐 埔里 埩 埱 埳 埵 培育出 基尔 基部 堄 堞 堨 堲 堸 堻 塓 塔吉克 塝 塞尔维亚 塣 塩川 填上 塻 墂 墆 墇 墈 墙壁上 增刊 增援 墱 壔 壖 壣 壨 士大夫 壮行 売出 壴 壾 壿 处死 変人 変型 変装 夏木
+109 夏秋 夏美 夏至 夕凪 外史 外字 外山 外郭 外露 夗 多利 夜桜 夜深 大乱 大井川 大台 大小便 大岩 大府 大德 大捷 大株主 大河原 大港 大玩 大碗 大葱 大起大落 大足 大雁 天下无双 天佑 天柱 天灾 天然林 天王星 天目 天象 天长 太保 太短 太蔵 失信 失学 失手 失笑 失速 头巾 奇声 奇案 奇葩 奏法 契丹 奓 套数 奡 奥州 奫 女娲 女帝
+109 好喝 好记 好酷 如其 如皋 妃子 妒忌 妖艶 妙手回春 妫 妴 妶 妹尾 妼 妽 姀 姁 姃 姈 姑且 委办 委譲 姨妈 姱 姴 姷 威武 娇媚 娏 娗 娞 娭 娮 娳 娴熟 娷 婀娜 婗 婘 婝 婸 媊 媌 媏 媓 媗 媜 媟 媢 媥 媦 媬 嫌味 嫕 嫖客 嫛 嫝 嫞 嫟 嫠
+109 嫣然 嬣 嬬恋 嬼 孇 孍 子安 子民 字词 存亡 存钱 孙女 孝志 孝行 孝雄 孝顺 孤高 学舎 宇田川 安住 安佐南 安在 安政 安稳 安里 安野 宍戸 宏一 宏之 宏志 宏美 宗族 宗祠 宗谷 定性的 宝塔 実働 客座教授 宽裕 宿屋 宿毛 寅泰 密密麻麻 密布 富雄 寍 寒川 寒意 寝床 寝袋 寠 寣 寥寥 寰球 寻欢 専従 射阳 将校 小五 小兰
+109 小堀 小夏 小寺 小山田 小暮 小村 小渕 小瓶 小矢部 小石川 小童 小美 小菅 少得 尔雅 尚待 就吃 尳 尼采 尿布 屇 屈曲 屋号 屔 展期 屖 屣 屩 山姆 山泉 山海关 山県 山石 屳 屴 屺 岝 岟 岥 岧 岩壁 岩船 岮 岰 峎 峏 峗 峚 峨眉 峬 峱 峷 峸 崀 崄 崣 崿 嵀 嵞 嵩山
+109 嵲 嵼 嵽 嶀 嶓 嶜 嶟 嶡 嶨 嶩 嶲 嶵 巇 巕 川俣 川浜 川瀬 巡回演唱 巡洋舰 工时 左官 左肩 巨幅 已对 市役所前 市街化区域 布鲁塞尔 帄 帐单 帣 帤 带点 帮会 帰郷 常数 常理 幜 幠 幩 幮 干燥机 平局 平屋 平抑 平林 平民化 平沼 平蔵 年令 幸之助 幸便 幸免 幸治 幻象 幽谷 幽静 庀 庄原 庌 庍
+109 府警 庭木 庮 庱 庴 康平 康生 廒 廘 廞 延至 建屋 开垦 开得 开罗 开路 异人 异军突起 弊店 弘道 张口 张望 弥漫着 弮 弱体 弶 弹奏 强健 强攻 强敌 强求 彀 归入 归结为 当之无愧 当值 彫金 影视圈 役柄 彻夜 往里 径直 待命 得法 御前崎 御津 徻 心上人 心悸 心电图 心路 必定是 必是 忌口 志郎 志高 快把 快船 忷 忺
+109 怉 怌 怍 怒放 怒斥 怚 思惟 怤 急病 急躁 怬 怲 怴 总而言之 恇 恌 恔 恛 恞 恦 恩仇 恩惠 恩爱 恩赐 恬静 恰巧 恰恰相反 恲 恳 恶行 恼火 悔改 悟性 悪寒 悲喜 悲歌 悷 惠顾 惨事 想想看 惷 惸 惼 惾 惿 愄 愋 愐 愖 愘 愻 慀 慁 慈祥 慌张 慒 慔 慖 慛 慞
+109 慲 慹 憀 憉 憎恨 憎悪 憰 憱 憵 憼 懁 懅 懤 懥 懩 懫 懭 懱 懽 戁 戉 戏院 成増 成材 戦利 截获 戫 戸川 戸畑 戺 所作所为 所収 扆 手先 手印 才不 才智 才知 扔下 扔在 托起 扩建工程 扫地 扲 扶植 扷 扺 扽 抇 抌 抒发 投靠 抗性 折服 折衷 抜擢 抜歯 択一 抢到 抢手
+109 护身符 抩 抮 押下 押井 押収 抽空 抽筋 抾 担负起 拆开 拉门 拍成 拐杖 拖车 拝観 拲 拳王 拾得 拾起 拿回 指间 挍 挐 挕 挣扎着 挥动 挭 振付 挺身而出 挻 捀 捈 捉弄 捐出 捔 捙 捡到 捥 捭 捯 捵 捸 捻挫 捼 掉在 掉头 排泄物 掜 接壤 接戦 推举 推陈出新 掯 揎 揘 揜 揝 揤 揥
+109 援手 揶揄 搉 搘 搚 搞不好 搟 搢 搫 搯 搹 携行 搿 摍 摐 摓 摡 摥 摷 摸到 撒旦 撦 擉 擎天 擸 攃 攕 攗 攩 攲 收成 收效 放逐 政事 政体 敃 故作 敆 敏行 敏雄 救火 救生 敜 散播 敦化 敧 敨 敪 敬仰 敬子 数列 敷物 文宣 文楽 文王 文职 文莱 文通 斔 斤量
+109 斨 斪 断続 斯波 新军 新大久保 新德里 新栄 新野 斳 斶 施以 旅情 旅社 旍 无党派 无益 无话可说 既不是 既然如此 日寇 旧时 早先 早生 旰 旲 旴 旵 昌子 昌宏 明体 明宏 昏了 昐 映象 春物 昫 昭一 昮 是能 昲 昳 晇 晑 晚清 晪 晬 晴彦 晴空 晹 智囊 晼 暇暇 暡 暰 暴躁 暵 曒 曣 曤
+109 曲解 更愿意 更重 替代品 最上面 最低气温 最高潮 朄 有句 有明海 有氧 朏 朒 朓 朝圣 朝香 朡 木曽川 未払金 末裔 末路 本子 本心 本戦 本票 机缘 朽木 杂乱 杂烩 杋 李斯 李鸿章 杏菜 材料力学 杚 杜夫 杢 来电显示 杨文 杴 杸 松子 松树 松谷 枆 枎 枑 枔 林区 枘 果糖 果酱 枪声 枪毙 枯渇 枲 架式 柅 柆 柈
+109 某位 柑桔 柱状 柲 柳家 柷 査読 栄誉 树干 栜 栟 栯 栱 核弹 根深蒂固 栺 栽植 桃李 框框 案卷 桋 桏 档期 桥牌 桧山 桱 桸 桼 梁启超 梅原 梅山 梅森 梅里 梇 梋 梐 梜 梤 梨香 梪 梯度 梯形 梳子 棍子 棎 棪 棳 棴 棷 棼 椆 椓 椕 椗 椯 椷 楈 楎 楩 楶
+109 楺 楻 榆树 榍 榛名 榜上 榥 榵 榹 榽 槆 槏 槔 槱 樈 樏 樘 樥 樧 権益 樲 橎 橖 橚 橡树 橨 橩 橱 檅 檭 檴 櫍 櫏 櫐 櫜 櫡 櫯 欅 欈 欋 次代 欥 欧宝 歁 歊 歌会 歍 歑 止住 正像 正则 正弘 正敏 此生 此等 此花 武义 武松 歯学 歯肉
+109 歯茎 歴任 死地 死海 歾 残缺 殌 殔 殙 殟 殦 段位 殶 殷切 殷勤 殽 毁损 毄 毈 毌 母鸡 毎秒 毒气 比为 比喩 毛骨悚然 毲 氀 氃 氋 氝 氠 水俣病 水工 水晶球 水没 水灾 水痘 水解 水费 水防 永丰 永磁 汆 汉武帝 汐止 汛期 江刺 江古田 江夏 江岸 江美 江都 池波 汦 汭 汱 汻 沇 沉痛
+109 沎 沕 沜 河中 河内屋 河内町 河本 沷 沿袭 泆 泉北 泉大津 泊位 泌尿外科 泍 泑 法眼 法老 法身 波折 泥巴 泥石流 泧 泬 泰和 泰雅 洈 洉 洊 洋品 洋洋 洋装 洗了 洗刷 洗碗 洗衣服 洝 洞庭 津巴布韦 洪武 洪湖 洷 洺 洽商 流口水 浅蓝色 浅薄 浇水 浓烟 浦河 浧 浨 浩史 浩平 浩然 浮世 浮肿 浰 浵 海原
+109 海波 海洛因 海派 海狮 海王星 海路 海防 浸润 浻 浾 浿 涀 涃 涆 消磨 消音 涊 涋 涻 淍 淑子 淑慎 淓 淘淘 淜 淣 淭 淮阴 深重 混成 淹死 清和 清子 清澄 清雅 清音 渗漏 渥太华 温热 渳 渿 湒 湕 湖上 湖岸 湘军 湢 湹 湿性 満天 満面 溎 溞 溡 溣 溦 溪谷 溯源 溶性 溶洞
+109 滁 滊 滤波 滶 漀 漂漂 漂着 漆黒 漊 漏斗 漒 漘 漙 漜 漥 漦 漷 潍 潒 潜伏期 潝 潡 潣 潮位 潲 澓 澕 濑 濭 濲 濿 瀎 瀜 瀤 瀩 瀺 瀻 瀿 灀 灂 灅 灈 灌注 灚 灛 灨 灪 火山灰 火辣辣 灭火器 灰暗 灴 炂 炄 炒菜 炚 炫目 炮台 炸死 炾
+109 烁 烅 烍 烎 烒 烓 烗 烧了 热浪 热诚 烰 烳 烶 烺 焆 焋 焌 焍 焖 焛 焨 焺 焼失 煂 煓 煟 煠 照例 煮汁 熁 熄火 熊毛 熚 熛 熟了 熟読 熡 熧 熩 熳 燀 燎原 燤 燸 爅 爙 爧 爩 爱丽丝 爱恨 爱沙尼亚 父老 爽朗 牄 片上 片场 牓 牚 牣 牧区
+109 牧村 牧羊 牪 牰 牵涉 牻 牼 牿 犀牛 犄 犋 犎 犘 犛 犝 犤 犥 犦 犯错 犽 犿 狂乱 狂暴 狉 狋 狌 狐狸精 狔 狖 狘 狣 狫 独走 狭心症 狳 狿 猈 猏 猑 猓 猖狂 猘 猜到 猞 猢 猧 猪口 猰 猳 猼 獀 獌 獍 獏 獘 獛 獝 獞 獡 獦
+109 獧 玄海 玉体 玉木 玉露 玉髓 王维 王羲之 玒 玓 玕 玖珠 玩到 玬 玻璃杯 玻璃窗 珇 珠洲 珠算 班组 琀 球场上 理奈 理数 理疗 琋 琐 瑊 瑮 瑱 璒 璔 璚 璪 瓃 瓅 瓖 瓛 瓽 甀 甏 甒 甖 甗 甘酢 甜点 生日蛋糕 生来 生身 用命 用水量 甩掉 田家 甲乙丙丁 甲殻 甲等 甲苯 电位 电波 电焊
+109 男男女女 画一 画室 留影 留神 畜产品 畣 畽 疏远 疐 疑云 疑犯 疗养 疢 疾风 疿 病魔 痚 痭 痵 痸 瘏 瘦了 発狂 発起人 登喜 登山鞋 白居易 白族 白杨 白桃 白眼 白老 白花 白面 百花齐放 皆宜 皆知 皤 皪 盉 盖子 盗取 盛产 盦 盩 目地 盰 直肠 直訳 直飞 相得益彰 相模大野 眊 看天 看得出来 看海 看球 看管 県西
+109 真命天子 真宗 真帆 真庭 真得 真木 真衣 真言宗 眧 眩目 眼珠 睔 睕 睡到 睡去 睡的 睡眠薬 睯 睽 瞂 瞃 瞣 瞪了 瞲 瞵 矄 矌 矏 矐 知立 短途 矮小 石川町 石段 石畳 矶 矹 矾 砆 砍伐 研判 破绽 础 硞 硢 硬朗 硹 硻 碃 碄 碑文 碡 碢 碤 碥 碧南 碧玉 碫 碻 磁性材料
+109 磃 磄 磉 磌 磍 磛 磞 磩 磪 礔 礨 礩 示威者 示范性 礿 祖籍 祝辞 神力 神鬼 祠堂 祡 祣 祭神 祰 祲 祳 禁酒 禂 福留 禘 禚 禜 禠 禨 禷 离了 离任 离地 离校 秀隆 秅 秋千 秜 租界 秦国 秭 稍等 稚嫩 稨 稲城 稻谷 穊 穋 穖 穛 穴子 空冷 空头 空気圧 空爆
+109 空耳 空色 空话 空路 穻 突兀 窊 窍 窓辺 窔 窥探 窥视 窲 窴 立入検査 立德 立方体 立柱 立院 站到 竟被 端着 竹筒 笑傲 笑死 笔直 笔筒 笨拙 第六感 笯 笰 笴 笼子 筀 筄 筇 筑紫野 筤 筦 筶 算式 管他 箾 篕 篘 篢 篧 篰 簅 簢 籣 米粒 籸 籹 籿 粉刷 粉末冶金 粛 粟国 粣
+109 精兵 精干 精深 糈 糌 糑 糟屋 系図 糽 紃 紏 素粒子 素面 紧贴 紫檀 紫波 紫罗兰 紫藤 紫衣 紫阳 絑 絪 絼 綀 綒 綝 綡 綧 綪 綼 緁 緂 緉 総武 総督 緮 縁日 縃 縍 縎 縏 縔 縠 縸 繁星 繗 繜 繠 繨 繲 繵 繺 纑 纙 纠葛 红海 红烧 红肿 纯一郎 线图
+109 绂 细纹 细长 绊 绌 结点 统筹规划 继父 绵延 缂 缾 罊 网眼 罗列 罗嗦 罛 罪案 罪魁祸首 置信 罶 罺 罼 罿 羍 美佐子 美声 美展 美恵子 美称 美籍 羑 羒 羦 羬 羱 羳 羻 羽曳野 翍 翐 翞 翢 翨 翪 翭 翯 翲 翷 翸 翻到 翻案 翿 老伯 老哥 老太婆 老店 老祖宗 考完 而将 而归
+109 而论 耐圧 耐水 耐火材料 耳元 耳目 耵 耶麻 耹 耾 聇 聡子 聧 聬 肂 肉屋 肉搏 肉身 肕 肖邦 肚兜 肤浅 肥厚 肥羊 肥育 肵 肸 胃袋 胃酸 胆管 胈 胊 背书 胎内 胎盘 胕 胗 胠 胡蝶 胦 胶水 胶漆 胸中 胸脯 胸襟 脑后 脑部 脙 脚趾 脞 脱字 脱臼 腄 腒 腜 腤 腲 腹膜 腺癌 腾冲
+109 膢 膧 膬 膱 膵臓 臕 臦 自修 自力更生 自始至终 自居 自成 自拔 自画 自省 臲 致胜 臷 舆 舋 舌鼓 舕 舝 舞台剧 舞姿 舠 船用 船越 船队 艅 艎 艏 艐 艑 艒 艓 色情片 色系 艴 艼 芄 芏 芓 芔 花台 花招 花掉 花炮 花畑 花芽 芳醇 苂 苍天 苍老 苙 苠 苨 苬 苭 英式
+109 英德 苵 茂密 茙 茠 茶壶 茶树 茷 茺 茿 荁 荖 荘厳 药方 荽 莁 莃 莈 莌 莍 莎士比亚 莏 莐 莔 莥 菄 菕 菗 菙 菜花 菤 菨 萐 萒 萯 萶 落得 落榜 落胆 葅 葋 葖 葟 葬送 葰 蒎 蒏 蒗 蒘 蒙上 蒙蔽 蒪 蒮 蒱 蒲鉾 蓅 蓝山 蓝领 蓧 蓩
+109 蓰 蓶 蓺 蓻 蔂 蔈 蔉 蔊 蔋 蔍 蔎 蔕 蔖 蔙 蔝 蔠 蔧 蔨 蔩 蔪 蔯 蔰 蕅 蕍 蕖 蕶 蕹 薁 薄力粉 薅 薖 薢 薬価 薬害 薾 藅 藈 藏匿 藘 藚 藤代 藤堂 藽 蘁 蘌 蘘 蘪 蘲 蘳 蘵 虀 虇 虙 虨 虩 虪 虴 虷 蚖 蚯蚓
+109 蚺 蚻 蚿 蛋黄 蛤蟆 蛷 蜙 蜜月旅行 蜳 蜵 蜼 蝀 蝤 蝩 蝹 螅 螇 螈 螑 螰 螲 螴 螵 螷 螹 螼 螾 蟂 蟅 蟉 蟘 蟝 蟨 蠬 蠾 血压计 衁 行善 行距 行革 衍生物 衕 衞 衣袖 补发 表征 袃 袑 袕 被套 袬 袽 裁缝 裂痕 裧 裫 褁 褅 褆 褣
+109 襂 襉 襓 襗 襩 襫 襭 襹 襼 襾 西三 西伯 西多摩 西大寺 西川口 西市 西德 西浦 西米 覇権 覕 覾 见长 视同 视而不见 角力 角形 觕 觖 觙 觛 解冻 触感 觨 觻 觼 訇 訧 訰 訿 詊 詌 詏 詺 誙 誺 誽 諃 諆 諔 諲 諴 謍 謕 謘 謼 譝 譣 譧 譨
+109 譭 譹 譻 讂 讆 议事规则 议价 讯号 论据 评评 诗篇 诗经 诙谐 该做 该说 诱因 说不清楚 说真话 读报 谆 谣 谹 谼 豊浦 豊満 豏 豤 豪侠 豪放 豪爽 豪赌 豪迈 豲 豽 貀 貆 貕 賌 賛歌 賹 责成 贤才 贪图 贫乏 贸易顺差 费劲 赡养 赤潮 赦免 赨 超新星 越剧 越战 越洋 趍 趏 趮 趯 足腰 跌停
+109 跘 跮 跱 跳板 踂 踃 踆 踍 踤 踶 蹎 躝 躠 軉 軝 軠 転嫁 転覆 転身 軩 軬 輑 輮 輷 轏 轘 轙 轛 轠 转交 转盘 轴线 辛辛苦苦 辞令 辨识 辫子 辰光 边远 辺境 辻本 迆 运载 迖 还带 进补 远端 远航 远行 违宪 连绵 连贯 迣 迷上 迷思 迷迷糊糊 追思 追肥 退一步 退却 退订
+109 送配 逃犯 逃税 通电 通病 造像 逸夫 逽 逾越 道光 遥望 遭人 遭殃 遮盖 遹 遾 避难 邅 邑楽 邔 邘 那珂川 邪神 邿 郋 郎君 郕 郦 郱 都督 鄀 鄋 鄏 鄑 鄘 鄤 鄸 酃 配水 酒仙 酒粕 酒量 酒馆 酴 酸酸 醍醐 醏 醓 醙 醝 醟 醠 醡 醧 醲 醹 采伐 重信 野坂 野马
+109 量具 金原 金坛 金堂 金库 金粉 金钟 釨 鈏 鈚 鉖 鉥 鉱泉 鉹 鉽 銂 銢 鍷 鎀 鎉 鎑 鎝 鎟 鎥 鏊 鏎 鏚 鏦 鏧 鏼 鐷 鐹 鐼 鑆 鑋 鑐 鑝 鑤 钝 钻井 铁拳 铁观音 铆 铜锣 铠 铨 锐减 锣 镇痛 长天 閟 閷 閺 闉 闛 闟 门徒 门铃 闪现 问话
+109 闻讯 阠 阣 阤 阭 防冻 阴部 阴霾 阺 阼 阿兰 阿妹 阿尔及利亚 阿尔巴尼亚 阿拉斯加 陃 附言 陆上 除以 陭 陯 陶艺 隃 隓 隔绝 隿 雀斑 雄介 雅加达 雅弘 雈 雌性 雑巾 雑菌 雘 雝 雟 雡 雨露 雪上加霜 雫石 零散 雷鸣 雸 雺 需用 霁 霋 霐 霒 霣 霥 霦 霫 霯 露台 露地 青光眼 青城 青霉素
+109 静坐 静脉炎 非同期 非対称 非暴力 非礼 面有 靸 靾 靿 鞂 鞔 鞬 鞮 鞶 鞷 鞿 韎 韕 韣 韧性 音带 韵律 頚 頯 頵 顄 顉 顐 顝 顟 顪 顲 顶住 顶替 顺从 顺其自然 顺差 顾名思义 顿了 颊 颌 颍 颤动 颽 飁 飉 飌 风起云涌 飞奔 食味 飶 餀 餧 饓 馆内 首尾 首屈一指 首座 首筋
+109 香甜 香肠 馝 馲 駅南 駣 駤 駩 駪 駬 駾 騄 騆 騉 騊 騚 騠 騪 騱 驉 驓 驧 驨 马兰 马家 马祖 马虎 骨太 骨粉 骫 骱 骴 骷 髆 髊 髍 高估 高升 高宗 高市 高次 高祖 高程 高等法院 高粱 髧 髶 髺 髼 髾 鬁 鬄 鬊 鬋 鬒 鬤 鬼魂 鬼魅 鬾 魁北克
+109 魈 魋 魔道 魻 鮤 鮥 鯄 鯜 鯥 鰇 鰎 鰴 鰽 鱁 鱊 鱋 鱎 鱙 鱢 鱹 鲍鱼 鲫鱼 鲸鱼 鳦 鴘 鴠 鴢 鴬 鴭 鴱 鴳 鵀 鵅 鵊 鵋 鵖 鵗 鵚 鵩 鵱 鵴 鵽 鶁 鶂 鶆 鶈 鶋 鶌 鶙 鶟 鶠 鶧 鷞 鷣 鷴 鷵 鸋 鸒 鸗 鸟语花香
+109 鸡尾酒 鸣谢 鸾 鹤山 鹤峰 麀 麉 麊 麙 麛 麜 麷 黂 黄玉 黈 黑盒子 黑色素 默然 黟 黮 黰 鼁 鼎力 鼚 鼣 鼥 鼨 鼭 鼮 鼵 齆 齞 齤 齰 齻 龒
+110 一九九三 一九九二 一九九四 一人称 一介 一党 一厢情愿 一城 一壶 一夫多妻 一尺 一志 一意 一推 一揆 一民 一气 一江 一波三折 一炮 一秒钟 一隅 一顿饭 一餐 七位 万端 三句 三合 三四四 三女 三川 三思 三日坊主 三春 三条本 三样 三根 三津 三班 三眼 三船 三蔵 三藏 三里 上上下下 上桌 上进 下北 下総 下腹 下马 不了了之 不亦乐乎 不低 不可收拾 不可逆 不吉 不明朗 不本意 不止是
+110 不测 不然就 不相上下 不相干 不等式 不自在 不轻 不降 丏 且看 世界末日 丙酮 东县 东岸 东平 东江 东面 両目 两把 两首 严守 中东地区 中北 中味 中央台 中央道 中曽根 中村屋 中江 中泊 中肯 中腹 中译本 串本 临场 临街 丹生 主考 久里 久里浜 之恩 之计 之辈 乘法 九尾 九月份 九谷焼 也门 乱说 乳名 二个月 二十四小时 二技 二种 互访 五合 五岳 五番 五百元 五福
+110 五颜六色 亘理 亟需 亡霊 交叉口 交回 交城 交歓 交相 交野 亦得 京野 亮起 人口普查 人家说 人工林 人造板 仁兄 仁成 仅存 今市 今早 介在 从今天开始 他校 他界 付表 仙侣 仙山 代人 代位 代替品 以身作则 仰慕 仱 任人 仿效 伊佐 伊沢 伏击 休整 优厚 会堂 会盟 伝搬 伝来 传统观念 传遍 伭 伳 伾 伿 但无 低劣 低圧 低木 低空 低能 佐用 佐证
+110 何分 何妨 佛家 佛经 作假 作祟 你讲 佳代 侄子 例题 侍者 依拠 侯爵 侳 侵吞 侹 侺 便道 俄然 俊哉 俊郎 俖 俗语 保安林 信教 信雄 修持 修筑 倍加 值得一看 倾销税 偌大 偏心 做假 做官 停火 健気 健身运动 偷盗 傍受 僵持 元金 先取 先向 先天的 先民 先般 先让 光代 光彦 光洁 光靠 克什米尔 兖州 兢兢业业 入冬 入城 入念 全容 全焼
+110 全胜 全量 八区 八哥 八天 八街 八面 公事 公休日 公共秩序 公婆 公敌 公称 六日町 六版 六福 六类 六郎 共商 共学 兴业银行 兴化 兵马 养了 兼六 兼营 内観 再也不能 再帰 再犯 冒出来 冒牌 冒用 写本 写进 冠状 冤罪 冨士 冬型 冲撞 冷光 冷凝 冷泉 减价 凝神 凭空 凸版印刷 出借 出其不意 出塁 出欠 出火 出船 击倒 击剑 刀法 刁难 分列 分明是 分枝
+110 切莫 刑事犯罪 刑期 刚要 刜 利息收入 利水 别具一格 别有用心 别离 到校 刲 刵 刷子 刺刀 刻度 刻板 前头 前部 剑气 剣客 劔 加地 助益 劫难 勃勃 勇往直前 勾当 包办 包庇 包扎 北伐 北信越 北斎 北新 北新地 北江 北越 北非 匪徒 医局 匽 十亿 十几岁 十回 十字路 十戒 十津川 十秒钟 千代子 千恵 千篇一律 升任 升天 升旗 升格 半壁江山 半自动 半路 华诞
+110 协力 协奏曲 卑弥呼 卑猥 卓著 卖出去 卖力 南仏 南伊豆 南原 南岳 南川 南疆 南知多 南郊 南高来 卧床 卫浴设备 卬 即墨 即応 卵白 卷起 原以为 原动力 原籍 厹 参赞 友部 双唇 双城 反逆 収拾 发回 发怒 取悦 取款 受宠 受身 口吃 口琴 口笛 口舌 古市 古琴 古里 只吃 只把 只眼 只身 叫到 叫嚣 叫苦 可燃性 台北市立 史书 史奈 史朗 史観 右往左往
+110 右臂 各回 各行 各院系 合影留念 吊灯 同伙 同型 同工 同情心 同数 同文 同省 名古屋港 名堂 名士 名媛 名望 名演 吐蕃 吓唬 吞吐 听来 吹嘘 吹捧 呆板 呉羽 告状 呢喃 呧 周折 周身 味觉 呼応 命日 咈 和博 和弘 和风 咍 咖啡因 咘 咚咚 咬人 咺 品管 哄抬 哗啦 哲雄 唐草 售卖 唯恐 唱起 啄木鸟 商洛 喀麦隆 善心 善玉 喊叫 喜久
+110 喜气洋洋 喜鹊 喷漆 嘉庆 嘉靖 嘲弄 嘻嘻哈哈 嚍 嚷嚷 四月份 四版 四眼 四至 四重 回击 回娘家 回春 囡囡 团结一致 困在 図工 図画 围裙 国大 国技 国文 国界 图线 圆滑 土下座 土司 圧着 在勤 地下道 地利 地目 坂町 坐月子 垕 埃塞俄比亚 城壁 城戸 城郭 堀之内 塞内加尔 塩山 墓穴 壁虎 壮举 处子 处所 备查 変化球 変奏曲 夏夜 外皮 外行 多事 多美 多肉植物
+110 多能 多香子 夜班 大不相同 大助 大匙 大厨 大和高田 大学野球 大寒 大庭 大打出手 大搞 大智 大河内 大皿 大矢 大秀 大脳 大荒 大谈 大败 大赦 大隅 大隈 大黒屋 天丼 天工 太閤 失主 失窃 失策 失言 失足 头儿 头灯 夹在 夹杂着 奇抜 奉送 奔流 奥付 奥底 奥歯 奲 女警 奶嘴 奷 她到 她来 好景 好棒 好生 好难 奾 如火 妖女 姡 姲 姾
+110 娀 威厳 威夫 娕 婚期 媚惑 嫮 嬦 子夜 子羊 字面 存心 孝夫 孢子 孤身 学割 宇奈月 守屋 安乐 安原 安哥拉 安恒 安立 安顿 宏明 宗男 定刻 定稿 宜兰 宝刀 实则 実像 実状 実生活 客家人 宣戦 宦官 宧 宪章 宭 宰杀 家史 家底 家路 宽恕 寄予 寄港 密会 密友 密宗 富士川 富沢 富津 富里 寒中 寒梅 寛政 寡头 寺子屋 导引
+110 対中 対空 対米 寿山 封面女郎 小名浜 小和尚 小幡 小形 小憩 小木 小椋 小浪 小海 小节 小袋 尓 尘封 尥 就读于 尼克松 尽心 尾翼 尾行 尿床 居候 履历 屧 山丘 山北 山寨 山居 山脇 山茶花 岠 岤 岩屋 岩山 岩沼 岩谷 岯 岵 峔 峖 峥嵘 峹 崩塌 嵯峨野 嶕 嶚 巏 川尻 州县 工件 工党 工商界 工部 左近 巧手 巧遇
+110 巨无霸 巻物 市三 市制 市域 市田 市郊 布景 布达拉宫 帕斯 帡 带回来 带子 帩 常山 幡豆 平壌 平江 年初来 年幼 幸彦 幸手 幸村 幻化 幽默感 广博 广西省 庂 広小路 広野 庄主 床罩 应得 底子 底色 府城 座右铭 庪 庬 康之 康健 康博 康子 康隆 廃材 廃液 廇 廮 建前 建売 开学典礼 开建 开火 开窗 开锁 引水 引申 弘一 弚 张老师
+110 弰 弱酸 强光 强加 强权 当主 当事 当归 形似 形像 影武者 役名 往北 待嫁 很能 很酷 徐志摩 徒刑 従兄弟 得一 得不偿失 御存知 徴兵 忁 心切 心太 心寒 心肌梗塞 心虚 心醉 必中 必得 必由之路 志成 志望校 忠夫 忠男 快意 快慢 快治 念念不忘 怊 怞 急迫 怨念 怪病 恐喝 恭候 恳求 恼怒 您说 悪党 悬空 悲愤 情史 情场 惜别 惨状 想入非非 想学
+110 惹得 意志力 意料之外 愚民 愚者 愝 感涙 慉 懐疑 懧 戏水 戏法 成色 战况 战败 截面 手忙脚乱 手榴弹 手渡 手球 才去 扐 打天下 打打 打猎 打针 扮演着 扶养 批处理 找碴 承重 抄家 把戏 把持 抓狂 投掷 抗诉 报国 报税 抴 担架 拉出 拉多 拉夫 拉扯 拉杆 拉面 拍打 拐弯 拓殖 拖了 拖拉 拘留所 拚命 招揽 拡幅 拨出 拼写 拼盘 持久力
+110 持家 持病 挂帅 挂断 指日可待 按分 挑了 挙行 挚 挤满 挨了 挫败 挺有 挺直 捉住 捕物 捣毁 授受 掉到 排挤 排档 排水量 掘出 探明 接写 接班 接续 控球 推车 掩埋 措手不及 提上 提包 提高警惕 插口 揖斐 揗 揙 揣摩 揨 揭批 揲 搬走 搭起 搳 摆平 摊派 摩耶 撞人 播送 擦鞋 擿 攁 攓 收尾 收留 放回 放得 政大 政通
+110 故有 敏之 敏子 敏感度 教子 教条 教诲 散人 散射 散水 敦史 敬一 敬三 敬告 数不清 敲了 敲击 文告 文官 文才 文政 文秀 斝 斞 斥责 斩首 断崖 断断续续 断水 断绝 斯德哥尔摩 新平 新治 新泽西 新添 新神戸 新郑 方志 施暴 无价 无功 无所适从 日暮 日月潭 旧友 旧来 旭山 时序 旷野 昆曲 明夫 明春 明解 明镜 星団 星夜 星子 春分 春华 春期
+110 春生 春耕 昦 昭雄 昺 晋江市 普照 普陀山 晲 智勇 暖流 暗室 暗道 暧 暮色 暴虐 曲棍球 更替 曾国藩 朁 月丘 月野 有感于 有朝一日 有的放矢 服侍 服气 朝礼 木棉 未処理 未成熟 本厚木 本因坊 本城 本巣 朱子 朹 杀出 杀灭 杂牌 李元 李岚清 材木 村中 村主 村瀬 村立 来着 杨子 杬 松中 松代 枍 枕元 林语堂 林黛玉 枪口 枪战 枮 架势
+110 柍 某地 某天 柛 柪 柫 柭 柴山 标号 栓塞 校本部 根菜 桂川 桃源郷 桜丘 桹 梁山泊 梅津 梦魇 梵天 梵蒂冈 棋王 森内 森口 森高 植毛 椔 椲 楒 楔子 楘 楚国 楚楚 楚留香 榐 榔头 榡 榤 樦 横型 横梁 横町 櫋 櫮 櫰 欃 欠航 款待 歌人 歞 正光 正平 正広 正果 正毅 正気 正男 正餐 步子 武史
+110 武官 武打 武艺 武蔵村山 歶 死得 殉情 残像 残害 残席 殡仪馆 毋庸置疑 每题 比值 比叡山 比对 毛笔 毛细 毫安 氏家 民房 民有 气力 气化 气来 气魄 氕 気迫 水杯 水火 水珠 水球 水落石出 水酸化 永存 永平寺 永瀬 汉书 江村 江梨子 池谷 池野 汧 沈静 沉降 沙巴 沦陷 沧浪 河山 油井 油水 油污 治山 沿街 法务 波切 波多野 波涛 泥潭 泪珠
+110 洋司 洋娃娃 洋式 洗髪 洞庭湖 洞悉 津和野 津川 活该 活路 派别 流川 流放 流沙 浄土真宗 浅香 浜野 浦沢 浩特 浮生 浴场 浴池 浸入 消却 消散 消火栓 消耗量 涾 淡忘 淡漠 淡黄色 深刻印象 深奥 深灰 深草 淳朴 混和 混戦 添了 添置 清一色 渇水 渔夫 温江 游历 湖口 湖底 湘南台 湿布 湿式 溍 源远流长 溔 溜溜 溤 溰 溳 溺水 满月 满清
+110 滱 漏掉 演武 漠然 漮 潐 潜逃 澋 激辩 瀗 瀚海 瀡 瀢 瀪 瀫 瀬尾 瀸 灁 灆 灌醉 火候 火炉 火警 灰心 灰烬 灵光 炼油厂 炽热 烃 烘烤 烞 烢 烫伤 热讯 焟 焢 焦化 焲 煁 煔 煕 煝 煣 煤业 煤层 煪 煮干 煻 熜 熝 熞 熟食 熠熠 燃眉之急 爂 爆米花 爪子 爱惜 爽口 牙买加
+110 牟取 牡牛 牡蛎 牢房 牦牛 牧羊犬 特立独行 犐 犓 犕 犚 犨 狜 猛者 猪名川 猿人 獉 獑 玁 玈 玉村 王储 王励勤 王安石 王宫 玛利亚 现况 玸 玻利维亚 班机 珴 珽 球児 球赛 琅琅 理子 琈 瑞穗 瑵 璕 璷 甘美 甜味 甜食 生乳 生根 生猛 生粋 生菜 生铁 生鲜 用件 用兵 用词 田七 由一 由加里 由良 甲烷 甲骨
+110 电化学 电场 电泳 电灯 电炉 画策 畅快 留任 留年 畭 疏漏 疑心 疑念 疑案 疟疾 疧 疾苦 病灶 病重 痒痒 痛得 痛斥 痶 痽 瘦弱 瘭 癵 白开水 白梅 白浜町 白百合 白话 白雪姫 白鹭 百代 百子 百家姓 百老汇 皇太后 皇女 皫 皾 盈亏 盘山 盘整 盛土 盛田 盛衰 直之 直交 直营 相仿 相待 相投 相接 相模湖 相配 省府 省得 看做
+110 看向 看穿 真像 真治 真空泵 眸子 眼病 眼福 着力点 睁眼 睡梦中 瞗 瞠目 矎 矕 矢作 知念 知美 知行 短小 矲 石仏 矷 砂石 砍下 硬着头皮 硬要 碎裂 碖 碧波 碬 碰见 碰面 磎 磏 磝 磨耗 礥 礭 示范作用 社稷 祐二 祝寿 神官 神往 神栖 神泉 神速 神魔 票证 祭祖 福光 福西 秀峰 秀色可餐 私处 私藏 秋川 秋芳 秋香
+110 秮 移位 稀疏 稀罕 稍加 稻草人 窃贼 窈窕淑女 窏 窗台 窟窿 竃 立于 立意 立食 竜二 竜巻 章鱼 笑一笑 笔迹 第一声 第三国 笺 笼统 等值 等离子体 筑波山 筭 簜 籾 精肉 精读 精进 精錬 糔 糗事 糸口 紁 素性 絧 絭 絵梨 綄 続落 総局 縁起物 红学 红绿灯 约在 纯朴 线性代数 绁 经典之作 绑匪 绝配 绷 绸缎 缓刑 编了 编审
+110 编撰 缝纫 缠着 缺一不可 罕有 罗素 罳 罻 羊毛衫 羊群 美和子 美得 美智 美玉 美祢 美羽 美齿 羟 羟基 群臣 羭 羺 翔太 翜 翣 翵 翻出 翻看 翻翻 老去 老娘 老手 老杨 老树 老牛 老远 考卷 考成 耐寒 耕地面积 耳鸣 耸 聚落 聜 聝 肉汁 肉质 肛交 肝要 肩负着 胅 胆怯 胆沢 胆量 胑 胞子 胣 胶州 能成 能美
+110 能见度 脊梁 脚踝 脱税 脱脂 腋下 腐化 腷 腹巻 腼腆 膀胱炎 膉 膋 膴 臛 臝 臣民 自上而下 自他 自保 自欺欺人 自画像 自腹 自賛 臭臭 至今已有 至多 致函 致谢 舞剧 舞蹈家 般若心経 船首 艛 芀 节度使 节约用水 节食 芜 芝山 芦田 芬奇 花穂 芳子 芸大 芽以 若山 若竹 苦了 苦于 苦头 苦行 苦衷 英之 英昭 英法 英男 茏 茯苓 茶花
+110 茶话会 草率 草鞋 荒芜 荣华 药典 荴 荷台 荷塘 莆田市 莉奈 莙 莲子 菌株 菜籽 菺 萌生 营养价值 萧邦 落着 落魄 萿 葌 葡萄牙语 董卓 蒙城 蒙山 蒟蒻 蒬 蒲原 蒸蒸日上 蒸馏 蓂 蓗 蓛 蓝田 蓨 蓪 蓫 蓷 蔒 蔤 蔮 蕃薯 蕴涵 薄片 薍 薝 蘬 蘶 虃 虎口 虎尾 虏 虚实 蚩尤 蜃気楼 蜈蚣 蜜桃 蜷川
+110 融券 螐 螪 螳螂 蠙 蠿 血友病 血吸虫 血尿 血汗 行使职权 行军 行囊 行徳 行文 行脚 行路 补足 表弟 表露 袈裟 裁军 裁减 裂纹 裕次郎 裕香 裨益 裸身 褙 西征 西日暮里 西柏 西淀川 西端 観望 覹 解围 触覚 觭 觿 訑 訳注 詷 誃 誋 誏 誫 諵 謒 警局 警犬 警醒 讃歌 计画 订金 讨伐 讯问 许国 论者 讼
+110 访人 词句 试制 试管 诞 该去 诫 诬陷 误以为 诱饵 说教 课余时间 谐音 谷崎 谷津 谻 豆瓣 豊洲 豰 豹子 貄 貔貅 贝拉 败类 账簿 贪心 贵校 费解 贺龙 贿 赌注 赐给 赞许 赢的 赤井 赤水 赤石 赤面 赩 赫赫 走狗 走私案 赲 赵国 赶不上 起跑 起降 超自然 越境 趐 趑 趧 足柄上 足柄下 跋涉 跌宕 跑出来 跑马 跨出 路北
+110 路德 踇 踢出 踩在 蹒跚 躖 躟 身故 身法 転校生 輲 轩然大波 转子 转录 转达 软着陆 软骨 轰然 载重 边区 边看 边锋 辺野古 达拉斯 过份 迎撃 近在咫尺 返航 迕 迗 进到 进账 违禁 连个 连城 连年 连珠 迟延 迠 迩 迷津 迷药 退休年龄 送水 送走 通用电气 通知函 造句 遇险 道北 道徳的 道玄坂 遗物 遣使 遭劫 那几 那智 那群 那辆 邦雄
+110 郊游 部首 郷愁 都区 鄍 鄟 鄬 配下 配戴 配本 酒器 酒席 酒窝 酒菜 酠 酬金 醽 采掘 釈由美子 释疑 重出 重拍 野本 野沢温泉 量程 金品 金宝 金成 金枝 金沙江 鈲 鉄棒 鉌 鉓 鉔 鉣 銗 鎈 鎙 鎨 鏏 鏙 鏶 鐡 鐰 鐽 鑇 鑩 针锋相对 钟头 钟楼 钣 铁匠 铁板 铉 铜像 铺路 销路 锣鼓 锯齿
+110 镱 长久以来 长孙 长江流域 长袍 问个 间歇 阕 防磁 阳具 阴沉 阵亡 阹 阽 陈设 降板 降生 陡峭 院外 除非是 陥落 陱 隆平 随同 隒 隔了 隣国 隷 雃 雄山 雅也 雅夫 集解 雕花 雗 雪上 雪橇 雪糕 雵 雷声 震天 霊的 霟 霩 霮 露水 露背 霸占 青石 静内 静子 静心 非人 非情 非破壊 靠山 面无 面目全非 面罩 靪
+110 靶子 靻 鞃 鞙 鞢 鞹 韧带 音信 韸 頄 頖 頼朝 顠 顩 顶多 顺心 顺治 顺眼 顾不得 领海 飒 飞鱼 食管 餂 餩 饇 饥荒 饭桌 馄饨 首开 香住 香椎 香田 香芝 馦 馧 馽 駄作 駇 駼 駽 騋 騒然 騕 騛 騢 騩 马其顿 马尼拉 马耳他 驴子 驷 骑在 骾 髇 高尾山 高屋 高山流水 高座 高気圧
+110 高耸 高萩 高阶 高飞 髽 鬼怪 鬿 魔境 魔头 魔性 魔教 鮆 鯞 鯦 鰗 鱀 鱦 鱼翅 鳭 鳰 鴎外 鴶 鵏 鷐 鸍 鸠 鸡翅 鸵鸟 鹫 麍 麚 麝香 麻耶 麾下 黄油 黄身 黄道 黏土 黑熊 黑猩猩 黒幕 黒砂糖 鼤 鼲 鼻息 鼻梁 齂 齵 龙舟 龤
+111 侲 圀 弑 懹 挞 掳 欨 涝 渎 滢 畴 痻 盭 碀 祎 纨 纭 荶 莮 萦 裄 裆 襣 襮 觺 讶 讷 诡 诣 谒 谟 谬 賎 贲 赎 辄 邝 钽 锑 镑 閜 闺 闾 韽 驯 鬓 鯬 鳌 鳍 鴫 鵧 麸
+112 啴 喩 嗳 壌 娯 尭 峦 嵘 慑 掸 撷 柾 潴 瀞 烩 瑷 皐 箪 绰 绽 蔺 讽 谥 赃 踬 钡 阑 颉 饷 骧 鯏 鵺 鸢 鹊 鼡
+113 佥 傩 兖 垭 娈 婶 幂 抡 昙 栀 椁 槛 浏 滦 璎 疱 痉 痫 睑 缔 蔷 虬 袄 诬 谌 谙 谯 赈 赊 趸 轼 辙 铑 铒 锆 锴 镛 闰 闱 颓 颪 飨 骁 龛
+114 堑 岼 杣 椛 沦 涢 犇 犊 瘪 秽 篓 纒 纾 绡 绶 缈 缰 萠 萤 蛰 讥 讫 诧 谶 赓 辋 逓 銮 钕 钺 钿 锗 锜 锵 镝 镬 霭 鯑 鸳
+115 哔 唛 唠 嚔 惭 撵 柠 沣 涣 犷 獭 箆 箩 绐 缮 羁 荚 荠 蘂 诘 诠 谗 谚 赁 辍 辐 辘 迳 钯 铏 锟 镂 镗 镯 镰 阊 阗 陨 颢 饺 骊 鮖 鰊 鳗 鳝
+116 偾 匮 嚣 圷 垰 挛 搀 栎 殚 氩 沩 濒 睐 碛 簗 粃 糀 纣 缤 苌 荞 蓙 蔼 蜕 裢 褛 觑 觞 诒 谑 谘 谤 谧 谩 赟 赡 辕 逻 铋 铱 铼 铿 锉 锹 闳 阉 阖 顼 颀 颦 骀 骘 骜 鲟 鲲 鸷
+117 俨 凫 剐 叺 埙 嫔 恽 戬 橹 殁 浒 涞 潋 炀 玑 眦 硲 綛 绺 芗 苁 襷 觐 讪 诰 谠 谡 谴 轸 辇 郧 钏 铊 铍 锶 镳 韫 颛 骈 骝 骠 髋 鯣 鳃 鹘 鼍
+118 刍 嚊 奂 嬷 崭 怂 恹 恻 悯 惮 槟 沤 泺 澁 犂 瞩 纥 绎 绦 蓦 蘖 蝈 诤 跷 轭 轲 轹 鑓 铌 铢 铰 镒 阆 阐 颞 驺 驽 鹗 鹞 龈 龋
+119 厍 啧 垩 塲 嫒 尴 岿 峄 恸 扪 挝 杁 欤 泷 浐 猯 疟 疡 箓 糺 纰 耧 胫 腭 蓠 衮 诩 谇 谔 谪 谮 谰 跹 钤 铖 铟 铡 镡 阌 靥 韪 颏 颚 馔 骓 魇 鯲 鱶 鲇 鲢 鹄 鼋
+120 俤 哙 哝 垈 嫱 孪 岘 帜 廪 弪 怅 惫 戗 抟 斓 桡 椚 樯 殒 涠 痈 痨 皑 砾 籁 籴 缙 缬 茔 蛏 衅 袅 褄 诳 赂 赉 赍 钘 钹 铄 铈 铧 铳 镌 镓 闼 阄 阈 阏 鰌 鰕 鰰 鲛 鲫 鹳
+121 偬 呖 啮 屉 屦 庑 悫 悭 朶 梺 梿 炝 珲 癞 簓 绨 绻 聟 胪 荩 荭 莟 蚬 觇 讦 谀 谄 跸 辔 郸 锷 镔 镫 饬 馁 馊 骢 鲈 鲵 鲻 鸰 鹩 鼹
+122 伫 凩 劢 啬 垳 妩 帏 怆 戆 桠 桤 椟 橼 檩 殓 殡 毂 渌 牍 牺 狈 瘗 皀 皲 砗 砻 箧 纫 绲 缦 缭 胧 莳 蒉 蕋 蕲 蝾 袿 褴 诅 诓 诹 谵 赅 蹑 轫 辗 钭 铕 铻 锢 镦 阃 馐 鲎 鲠 鲥 鲷 鵤 鸵
+123 亵 俦 刿 哓 嘤 媪 怄 愦 揿 摈 烬 皹 硖 缄 缗 缛 缜 蒌 藓 觊 觋 觌 讴 诽 谝 谲 贳 贶 贽 跻 辂 酝 钖 铗 铙 锔 镧 闩 闶 阍 雠 馏 鶫 鸩 鹑 鹦 鹬 龀
+124 厣 咛 啭 嗫 妪 崂 帼 怿 懔 栉 椭 榉 牦 狞 珐 疖 瘿 糁 縅 纡 纮 缯 聩 膑 苋 袆 誊 讣 讵 诂 谂 谖 赜 趱 郓 酽 钇 钍 钲 铚 锲 鞑 颔 颙 飑 骛 鱿 鲂 鲑 鲧 鳅 鸨 鸫 鸲 鹂
+125 侥 傥 呓 喾 奁 掼 枞 栊 棂 槠 潆 痪 砀 簖 缢 缥 脍 蒇 蚂 诜 诟 诮 赝 跄 釛 铔 铯 锕 镊 镞 颧 饯 饴 鲳 鸯 鹌
+126 匦 囱 婳 怃 挢 枥 栌 溆 硗 稣 缟 罂 罴 苈 蓣 蚫 袵 觯 诋 谫 轵 钌 铪 阂 阒 飓 飕 驵 鲆 鲩 鳎 鸱
+127 侩 偻 刭 剀 呒 垆 惬 擞 氲 浈 浍 狝 穑 笾 箨 篑 缑 缵 芈 茑 荥 诔 谳 赀 赆 躜 錾 钐 铦 铷 镨 阋 陧 颡 饨 馄 馒 鳕 鸮 鸶
+128 亸 咙 垲 帻 怼 懑 榈 汹 猬 碜 缌 缒 荨 莸 躏 辁 郏 钪 铤 镏 闿 雳 餍 饩 饪 骖 骟 髅 鲽 鳇 鹈 黩 龇
+129 囵 撄 滠 絷 缫 缱 茕 荛 荦 蛴 觏 诎 诖 诙 诨 诿 辏 钆 铓 镆 镪 鞯 驸 髌 鲋 鸸 黾 龅
+130 伧 傧 嵝 忾 枨 榇 氇 狰 珰 琎 秾 笕 筚 纩 缣 荜 虿 觎 诼 赙 钬 陉 饧 魉 鳟 鹪 龌
+131 伥 埘 埚 溇 狲 瘅 礴 缡 蛳 赕 跶 踌 蹰 逦 郐 钋 钫 钶 铩 铫 锇 镄 镟 镠 顸 颃 饫 骕 鲔 鲣 鲦 鹾 黉 龊
+132 侪 崃 撺 柽 椠 槚 橥 沨 浃 猕 缳 脔 跞 轺 轾 辎 钸 锓 鲭 鳏 鳐 鸪 鸹 鹎 齑
+133 刽 哜 峣 摅 渑 狯 疠 缧 翙 舻 莴 赇 轳 铥 锞 飖 饽 馑 骃 骎 骒 鲀 鲐 鲚 鲮 鳜 鸬 鸺 鹆
+134 伛 岖 窭 绖 聍 蝼 蹒 锠 锩 鲡 鳆 鳔 鹁 鹣 黪 龃 龆 龉
+135 慭 猃 猡 玱 疭 纻 舣 铽 锒 锬 锱 锾 镘 镤 鲰 鲱 鳢 鹉 龁
+136 冁 挦 缋 赪 钔 锼 镈 颋 鹕
+137 唝 壸 帱 戋 纴 缲 赑 辚 酾 锖 飔 饦 骉 鹧
+138 毵 硁 缏 缞 踯 鲕 鹟 鹯
+139 硙 腽 蛱 酂 骍 鲊 鳓 鹖
+140 嵚 滪 赗 辀 铹 锊 镃 飐 鲒 鳒 鹓
+141 屟 糵 锽 颟 骣 鲙 鲯 黡
+142 昽 窎 赒 辒 镮 霡 鳣 鹢 鹥
+143 沵 瞆 祃 筼 鞟 骦 鹠
+144 萚 镴 闬 阓 阛 飗 鹙
+145 筜 阘 颣 饾
+146 臜 蚃 锧 镵 馌 鲖 鸤 鹔 鹡
+147 蒷
+148 蟏 酦 馎
+149 豮
+150 螀
+`
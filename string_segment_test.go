@@ -0,0 +1,115 @@
+package kgo
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestSegment(t *testing.T) {
+	var tests = []struct {
+		str      string
+		expected string
+	}{
+		{"我来到北京清华大学", "我/来到/北京/清华大学"},
+		{"南京市长江大桥", "南京市/长江/大桥"},
+		{"中華人民共和國成立了", "中華人民共和國/成立/了"},
+		{"hello world,你好，世界.", "hello/world/你好/世界"},
+		{"iPhone12发布了", "iPhone12/发布/了"},
+		{"", ""},
+	}
+	for _, test := range tests {
+		actual := strings.Join(KStr.Segment(test.str), "/")
+		if actual != test.expected {
+			t.Errorf("Expected Segment(%s) to be %s, got %s", test.str, test.expected, actual)
+			return
+		}
+	}
+}
+
+func BenchmarkSegment(b *testing.B) {
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		KStr.Segment("我来到北京清华大学")
+	}
+}
+
+func TestSegmentSearch(t *testing.T) {
+	res := strings.Join(KStr.SegmentSearch("我来到北京清华大学"), "/")
+	if res != "我/来到/北京/大学/清华大学" {
+		t.Error("SegmentSearch fail")
+		return
+	}
+
+	res = strings.Join(KStr.SegmentSearch("中華人民共和國"), "/")
+	if res != "中華/華人/人民/共和/共和國/中華人民共和國" {
+		t.Error("SegmentSearch traditional fail")
+		return
+	}
+}
+
+func BenchmarkSegmentSearch(b *testing.B) {
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		KStr.SegmentSearch("我来到北京清华大学")
+	}
+}
+
+func TestAddSegmentWords(t *testing.T) {
+	str := "他来到了网易杭研大厦"
+	if strings.Join(KStr.Segment(str), "/") != "他/来到/了/网/易/杭/研/大厦" {
+		t.Error("AddSegmentWords fail")
+		return
+	}
+
+	KStr.AddSegmentWords("网易", "杭研", " ", "")
+	if strings.Join(KStr.Segment(str), "/") != "他/来到/了/网易/杭研/大厦" {
+		t.Error("AddSegmentWords fail")
+		return
+	}
+
+	KStr.AddSegmentWords("網易雲音樂")
+	if strings.Join(KStr.Segment("网易云音乐和網易雲音樂"), "/") != "网易云音乐/和/網易雲音樂" {
+		t.Error("AddSegmentWords traditional fail")
+		return
+	}
+}
+
+func BenchmarkAddSegmentWords(b *testing.B) {
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		KStr.AddSegmentWords("杭研")
+	}
+}
+
+func TestExtractKeywords(t *testing.T) {
+	str := "机器学习是人工智能的一个分支。机器学习算法从数据中自动分析获得规律，并利用规律对未知数据进行预测。"
+	res := KStr.ExtractKeywords(str, 3)
+	if len(res) != 3 || res[0].Word != "机器学习" || res[1].Word != "规律" || res[2].Word != "数据" {
+		t.Error("ExtractKeywords fail")
+		return
+	}
+	if res[0].Weight < res[1].Weight || res[1].Weight < res[2].Weight {
+		t.Error("ExtractKeywords weight fail")
+		return
+	}
+
+	res = KStr.ExtractKeywords("我们的 2020 the 和 Go", 0)
+	if len(res) != 1 || res[0].Word != "go" {
+		t.Error("ExtractKeywords filter fail")
+		return
+	}
+
+	res = KStr.ExtractKeywords("", 5)
+	if len(res) != 0 {
+		t.Error("ExtractKeywords empty fail")
+		return
+	}
+}
+
+func BenchmarkExtractKeywords(b *testing.B) {
+	b.ResetTimer()
+	str := "机器学习是人工智能的一个分支。机器学习算法从数据中自动分析获得规律，并利用规律对未知数据进行预测。"
+	for i := 0; i < b.N; i++ {
+		KStr.ExtractKeywords(str, 5)
+	}
+}
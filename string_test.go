@@ -1297,6 +1297,12 @@ func TestFirstLetter(t *testing.T) {
 		return
 	}
 
+	//字典未收录的扩展区汉字
+	if KStr.FirstLetter("㐀") != "" || KStr.FirstLetter("𠀀中") != "" || KStr.FirstLetter("中㐀") != "Z" {
+		t.Error("FirstLetter rare han fail")
+		return
+	}
+
 	//其他
 	KStr.FirstLetter("布料")
 	KStr.FirstLetter("从来")
@@ -1734,6 +1740,19 @@ func TestCountWords(t *testing.T) {
 		t.Error("CountWords fail")
 		return
 	}
+
+	word_all, mp = KStr.CountWords("北京的大学,北京的天气")
+	if word_all != 6 || mp["北京"] != 2 || mp["的"] != 2 {
+		t.Error("CountWords chinese fail")
+		return
+	}
+
+	//汉字以外的部分不分词
+	word_all, mp = KStr.CountWords("x² chapterⅫ 北京x²")
+	if word_all != 4 || mp["x²"] != 2 || mp["chapterⅫ"] != 1 || mp["北京"] != 1 || mp["x"] != 0 {
+		t.Error("CountWords non-chinese fail")
+		return
+	}
 }
 
 func BenchmarkCountWords(b *testing.B) {